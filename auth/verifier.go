@@ -0,0 +1,229 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+)
+
+// constants for verifier defaults
+const (
+	DefaultRefreshInterval    = 1 * time.Hour
+	DefaultMinRefreshInterval = 1 * time.Minute
+)
+
+var (
+	// ErrInvalidToken is returned when a token fails verification
+	ErrInvalidToken = errors.New("Invalid token")
+	// ErrUnknownKey is returned when a token is signed by a key that is not in the JWKS
+	ErrUnknownKey = errors.New("Unknown signing key")
+)
+
+// HTTPClient describes a default http client
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Verifier verifies access tokens and returns their claims
+type Verifier interface {
+	Verify(tokenString string) (jwt.MapClaims, error)
+}
+
+// Config declares the variables used to verify access tokens
+type Config struct {
+	// JWKSURL is the location of the identity provider's JSON Web Key Set
+	JWKSURL string
+	// Issuer is the expected iss claim, skipped if empty
+	Issuer string
+	// Audience is the expected aud claim, skipped if empty
+	Audience string
+	// RefreshInterval is how long fetched keys are cached for
+	RefreshInterval time.Duration
+	// MinRefreshInterval limits how often an unknown kid can trigger a refetch
+	MinRefreshInterval time.Duration
+}
+
+type jwksVerifier struct {
+	config Config
+	client HTTPClient
+	now    func() time.Time
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// NewVerifier creates and returns a new Verifier backed by the JWKS at cfg.JWKSURL
+func NewVerifier(cfg Config, client HTTPClient) Verifier {
+	if cfg.RefreshInterval == 0 {
+		cfg.RefreshInterval = DefaultRefreshInterval
+	}
+	if cfg.MinRefreshInterval == 0 {
+		cfg.MinRefreshInterval = DefaultMinRefreshInterval
+	}
+	return &jwksVerifier{
+		config: cfg,
+		client: client,
+		now:    time.Now,
+		keys:   make(map[string]*rsa.PublicKey),
+	}
+}
+
+// Verify checks the signature, expiry, issuer and audience of a token and returns its claims
+func (v *jwksVerifier) Verify(tokenString string) (jwt.MapClaims, error) {
+	parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodRS256.Alg()}}
+	token, err := parser.ParseWithClaims(tokenString, jwt.MapClaims{}, v.keyFunc)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.Wrap(ErrInvalidToken, "Token has no expiry")
+	}
+	if v.config.Issuer != "" && !claims.VerifyIssuer(v.config.Issuer, true) {
+		return nil, errors.Wrap(ErrInvalidToken, "Token has invalid issuer")
+	}
+	if v.config.Audience != "" && !verifyAudience(claims["aud"], v.config.Audience) {
+		return nil, errors.Wrap(ErrInvalidToken, "Token has invalid audience")
+	}
+	return claims, nil
+}
+
+// keyFunc looks up the public key for the token's kid, refetching the JWKS if
+// the cache is stale or the kid is unknown (e.g. after a key rotation)
+func (v *jwksVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	fetchedAt := v.fetchedAt
+	v.mu.RUnlock()
+
+	now := v.now()
+	stale := now.Sub(fetchedAt) > v.config.RefreshInterval
+	canRefetch := now.Sub(fetchedAt) > v.config.MinRefreshInterval
+	if ok && !stale {
+		return key, nil
+	}
+	if !ok && !stale && !canRefetch {
+		return nil, ErrUnknownKey
+	}
+
+	if err := v.refresh(); err != nil {
+		// fall back to cached key if the identity provider is unreachable
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	key, ok = v.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// refresh fetches the JWKS and replaces the cached keys
+func (v *jwksVerifier) refresh() error {
+	req, err := http.NewRequest("GET", v.config.JWKSURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "Failed to fetch JWKS")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.New(fmt.Sprintf("Failed to fetch JWKS: status %v", res.StatusCode))
+	}
+
+	var set jwks
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return errors.Wrap(err, "Failed to decode JWKS")
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		pub, err := k.rsaPublicKey()
+		if err != nil {
+			return err
+		}
+		keys[k.Kid] = pub
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.fetchedAt = v.now()
+	v.mu.Unlock()
+	return nil
+}
+
+func (k jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(k.N, "="))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid modulus for key %s", k.Kid)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(k.E, "="))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid exponent for key %s", k.Kid)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+// verifyAudience checks the aud claim, which may be a string or an array of strings
+func verifyAudience(aud interface{}, expected string) bool {
+	switch a := aud.(type) {
+	case string:
+		return a == expected
+	case []interface{}:
+		for _, v := range a {
+			if s, ok := v.(string); ok && s == expected {
+				return true
+			}
+		}
+	case []string:
+		for _, s := range a {
+			if s == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://issuer.test/"
+	testAudience = "https://api.test"
+)
+
+type testJWKS struct {
+	keys    map[string]*rsa.PrivateKey
+	fetches int32
+}
+
+func (s *testJWKS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&s.fetches, 1)
+	set := jwks{}
+	for kid, k := range s.keys {
+		set.Keys = append(set.Keys, jwk{
+			Kid: kid,
+			Kty: "RSA",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		})
+	}
+	json.NewEncoder(w).Encode(set)
+}
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return k
+}
+
+func sign(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                    testIssuer,
+		"aud":                    []interface{}{testAudience, "https://other"},
+		"exp":                    time.Now().Add(time.Hour).Unix(),
+		"https://hubbedin/id":    "1",
+		"https://hubbedin/roles": []interface{}{"Admin"},
+	}
+}
+
+func TestVerify(t *testing.T) {
+	key := newKey(t)
+	forged := newKey(t)
+	jwksServer := &testJWKS{keys: map[string]*rsa.PrivateKey{"key1": key}}
+	server := httptest.NewServer(jwksServer)
+	defer server.Close()
+
+	v := NewVerifier(Config{
+		JWKSURL:  server.URL,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}, server.Client())
+
+	withClaim := func(k string, val interface{}) jwt.MapClaims {
+		c := validClaims()
+		if val == nil {
+			delete(c, k)
+		} else {
+			c[k] = val
+		}
+		return c
+	}
+
+	none, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"valid", sign(t, "key1", key, validClaims()), false},
+		{"string audience", sign(t, "key1", key, withClaim("aud", testAudience)), false},
+		{"forged signature", sign(t, "key1", forged, validClaims()), true},
+		{"unknown kid", sign(t, "key2", forged, validClaims()), true},
+		{"expired", sign(t, "key1", key, withClaim("exp", time.Now().Add(-time.Minute).Unix())), true},
+		{"no expiry", sign(t, "key1", key, withClaim("exp", nil)), true},
+		{"wrong issuer", sign(t, "key1", key, withClaim("iss", "https://evil.test/")), true},
+		{"wrong audience", sign(t, "key1", key, withClaim("aud", "https://evil.test")), true},
+		{"alg none", none, true},
+		{"malformed", "not.a.token", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := v.Verify(tt.token)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, claims)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "1", claims["https://hubbedin/id"])
+		})
+	}
+}
+
+func TestVerifyKeyRotation(t *testing.T) {
+	oldKey := newKey(t)
+	newerKey := newKey(t)
+	jwksServer := &testJWKS{keys: map[string]*rsa.PrivateKey{"old": oldKey}}
+	server := httptest.NewServer(jwksServer)
+	defer server.Close()
+
+	now := time.Now()
+	v := NewVerifier(Config{JWKSURL: server.URL}, server.Client()).(*jwksVerifier)
+	v.now = func() time.Time { return now }
+
+	_, err := v.Verify(sign(t, "old", oldKey, validClaims()))
+	require.NoError(t, err)
+	_, err = v.Verify(sign(t, "old", oldKey, validClaims()))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&jwksServer.fetches), "keys should be cached")
+
+	// rotate keys at the identity provider
+	jwksServer.keys = map[string]*rsa.PrivateKey{"new": newerKey}
+	rotated := sign(t, "new", newerKey, validClaims())
+
+	// unknown kids do not refetch within the minimum refresh interval
+	_, err = v.Verify(rotated)
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&jwksServer.fetches))
+
+	now = now.Add(DefaultMinRefreshInterval + time.Second)
+	_, err = v.Verify(rotated)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&jwksServer.fetches))
+
+	// the old key is gone once the cache is refreshed
+	_, err = v.Verify(sign(t, "old", oldKey, validClaims()))
+	require.Error(t, err)
+}
@@ -0,0 +1,202 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultPageSize is the number of rows returned in a page when a request does not specify a page size
+	DefaultPageSize uint64 = 20
+	// MaxPageSize is the largest number of rows returned in a single page
+	MaxPageSize uint64 = 100
+)
+
+var (
+	// ErrInvalidPageToken is returned when a page token cannot be decoded
+	ErrInvalidPageToken = errors.New("Invalid page token")
+	// ErrPageTokenMismatch is returned when a page token was issued for a different sort order
+	ErrPageTokenMismatch = errors.New("Page token does not match the requested sort order")
+)
+
+// Params declares the paging and sorting options of a GetAll request.
+// A zero PageSize returns a page of DefaultPageSize rows
+type Params struct {
+	PageSize  uint64
+	PageToken string
+	SortBy    string
+	SortDesc  bool
+	WithTotal bool
+}
+
+// Info declares the paging details of a GetAll response.
+// NextPageToken is empty on the last page, and TotalCount is only set if requested
+type Info struct {
+	NextPageToken string
+	TotalCount    uint64
+}
+
+// Sort declares a field that results can be sorted by.
+// Column is an SQL expression that must not evaluate to null, as rows with null values
+// cannot be paged through, so nullable columns should be wrapped in coalesce
+type Sort struct {
+	Column string
+	Value  func(m interface{}) interface{}
+}
+
+// Sorts maps the sort fields accepted in requests to their columns
+type Sorts map[string]Sort
+
+// With returns a copy of s that also accepts the sort field name,
+// for fields such as search relevance that only apply to some requests
+func (s Sorts) With(name string, sort Sort) Sorts {
+	sorts := make(Sorts, len(s)+1)
+	for k, v := range s {
+		sorts[k] = v
+	}
+	sorts[name] = sort
+	return sorts
+}
+
+// cursor is the position after the last row of a page, encoded in page tokens
+type cursor struct {
+	SortBy   string      `json:"s"`
+	SortDesc bool        `json:"d"`
+	Value    interface{} `json:"v,omitempty"`
+	ID       uint64      `json:"id"`
+}
+
+// Page applies Params to queries using keyset pagination,
+// which orders by the sort column then by ID so that pages are stable
+type Page struct {
+	size      uint64
+	sortBy    string
+	sortDesc  bool
+	sort      Sort
+	idColumn  string
+	after     *cursor
+	withTotal bool
+}
+
+// New validates p against sorts and returns a new Page.
+// The default sort field and order are used when p does not specify a sort field
+func New(p Params, sorts Sorts, idColumn, defaultSortBy string, defaultSortDesc bool) (*Page, error) {
+	sortBy, sortDesc := p.SortBy, p.SortDesc
+	if sortBy == "" {
+		sortBy, sortDesc = defaultSortBy, defaultSortDesc
+	}
+	sort, ok := sorts[sortBy]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Cannot sort by %s", sortBy))
+	}
+
+	size := p.PageSize
+	if size == 0 {
+		size = DefaultPageSize
+	}
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	page := &Page{
+		size:      size,
+		sortBy:    sortBy,
+		sortDesc:  sortDesc,
+		sort:      sort,
+		idColumn:  idColumn,
+		withTotal: p.WithTotal,
+	}
+
+	if p.PageToken != "" {
+		c, err := decode(p.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if c.SortBy != sortBy || c.SortDesc != sortDesc {
+			return nil, ErrPageTokenMismatch
+		}
+		page.after = c
+	}
+	return page, nil
+}
+
+// Apply adds the ordering, the position of the page token and the limit to q.
+// One more row than the page size is fetched to tell whether there is a next page
+func (p *Page) Apply(q *orm.Query) *orm.Query {
+	dir, op := "asc", ">"
+	if p.sortDesc {
+		dir, op = "desc", "<"
+	}
+
+	if p.after != nil {
+		if p.sort.Column == p.idColumn {
+			q = q.Where(fmt.Sprintf("%s %s ?", p.idColumn, op), p.after.ID)
+		} else {
+			q = q.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", p.sort.Column, p.idColumn, op), p.after.Value, p.after.ID)
+		}
+	}
+
+	if p.sort.Column != p.idColumn {
+		q = q.OrderExpr(fmt.Sprintf("%s %s", p.sort.Column, dir))
+	}
+	q = q.OrderExpr(fmt.Sprintf("%s %s", p.idColumn, dir))
+
+	return q.Limit(int(p.size) + 1)
+}
+
+// Total returns the number of rows matching q if the total count was requested, otherwise zero.
+// It should be called with the filters applied but before Apply
+func (p *Page) Total(q *orm.Query) (uint64, error) {
+	if !p.withTotal {
+		return 0, nil
+	}
+	n, err := q.Clone().Count()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to count rows")
+	}
+	return uint64(n), nil
+}
+
+// Size returns the number of rows in a page
+func (p *Page) Size() uint64 {
+	return p.size
+}
+
+// HasNext returns whether n fetched rows are more than fit in the page
+func (p *Page) HasNext(n int) bool {
+	return uint64(n) > p.size
+}
+
+// NextToken returns the token of the page after last, the last row of the current page
+func (p *Page) NextToken(id uint64, last interface{}) (string, error) {
+	c := cursor{SortBy: p.sortBy, SortDesc: p.sortDesc, ID: id}
+	if p.sort.Value != nil {
+		c.Value = p.sort.Value(last)
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to encode page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decode decodes a page token into a cursor
+func decode(token string) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	// numbers are kept as literals so that large IDs and amounts are not rounded
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	c := &cursor{}
+	if err := d.Decode(c); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	return c, nil
+}
@@ -0,0 +1,101 @@
+package pagination
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	tableName struct{} `pg:"items,alias:i"`
+
+	ID   uint64
+	Name string
+}
+
+var sorts = Sorts{
+	"id":   {Column: "i.id"},
+	"name": {Column: "coalesce(i.name, '')", Value: func(m interface{}) interface{} { return m.(*item).Name }},
+}
+
+func query(t *testing.T, p *Page) string {
+	var m []*item
+	q := p.Apply(orm.NewQuery(nil, &m))
+	b, err := orm.NewSelectQuery(q).AppendQuery(orm.NewFormatter(), nil)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestNew(t *testing.T) {
+	var tests = []struct {
+		name    string
+		params  Params
+		size    uint64
+		wantErr error
+	}{
+		{"default", Params{}, DefaultPageSize, nil},
+		{"page size", Params{PageSize: 10}, 10, nil},
+		{"max page size", Params{PageSize: 1000}, MaxPageSize, nil},
+		{"sort field", Params{SortBy: "name"}, DefaultPageSize, nil},
+		{"invalid token", Params{PageToken: "???"}, 0, ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.params, sorts, "i.id", "id", false)
+			require.Equal(t, tt.wantErr, err)
+			if err == nil {
+				require.Equal(t, tt.size, got.Size())
+			}
+		})
+	}
+
+	_, err := New(Params{SortBy: "password"}, sorts, "i.id", "id", false)
+	require.Error(t, err)
+
+	_, err = New(Params{SortBy: "rank"}, sorts.With("rank", Sort{Column: "i.rank"}), "i.id", "id", false)
+	require.NoError(t, err)
+	_, err = New(Params{SortBy: "rank"}, sorts, "i.id", "id", false)
+	require.Error(t, err)
+}
+
+func TestApply(t *testing.T) {
+	p, err := New(Params{}, sorts, "i.id", "id", false)
+	require.NoError(t, err)
+	got := query(t, p)
+	require.True(t, strings.HasSuffix(got, `ORDER BY i.id asc LIMIT 21`), got)
+
+	p, err = New(Params{PageSize: 2, SortBy: "name", SortDesc: true}, sorts, "i.id", "id", false)
+	require.NoError(t, err)
+	got = query(t, p)
+	require.True(t, strings.HasSuffix(got, `ORDER BY coalesce(i.name, '') desc, i.id desc LIMIT 3`), got)
+}
+
+func TestNextToken(t *testing.T) {
+	p, err := New(Params{PageSize: 2, SortBy: "name"}, sorts, "i.id", "id", false)
+	require.NoError(t, err)
+	require.False(t, p.HasNext(2))
+	require.True(t, p.HasNext(3))
+
+	token, err := p.NextToken(7, &item{ID: 7, Name: "bob"})
+	require.NoError(t, err)
+
+	next, err := New(Params{PageSize: 2, SortBy: "name", PageToken: token}, sorts, "i.id", "id", false)
+	require.NoError(t, err)
+	got := query(t, next)
+	require.Contains(t, got, `WHERE ((coalesce(i.name, ''), i.id) > ('bob', 7))`)
+
+	// tokens cannot be reused with a different sort order
+	_, err = New(Params{PageSize: 2, SortBy: "name", SortDesc: true, PageToken: token}, sorts, "i.id", "id", false)
+	require.Equal(t, ErrPageTokenMismatch, err)
+
+	p, err = New(Params{PageSize: 2}, sorts, "i.id", "id", false)
+	require.NoError(t, err)
+	token, err = p.NextToken(7, &item{ID: 7})
+	require.NoError(t, err)
+	next, err = New(Params{PageSize: 2, PageToken: token}, sorts, "i.id", "id", false)
+	require.NoError(t, err)
+	require.Contains(t, query(t, next), `WHERE (i.id > 7)`)
+}
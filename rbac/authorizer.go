@@ -0,0 +1,187 @@
+package rbac
+
+import (
+	"context"
+	"crypto/subtle"
+	"reflect"
+	"strings"
+
+	"in-backend/auth"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// ErrForbidden is returned when the caller is not allowed to call a method
+var ErrForbidden = errors.New("Forbidden")
+
+// OwnerFunc returns the owners of the resources that a request reads or changes.
+// It is called for every signed in caller, and returns ErrForbidden when the request cannot be authorized
+// by anyone, such as when its resource does not exist
+type OwnerFunc func(ctx context.Context, request interface{}) ([]Owner, error)
+
+// Owners declares the OwnerFunc of each method whose rule grants access by ownership
+type Owners map[string]OwnerFunc
+
+// Authorizer authorizes the callers of a service by its Policy
+type Authorizer struct {
+	policy   *Policy
+	verifier auth.Verifier
+	owners   Owners
+
+	serviceToken string
+}
+
+// NewAuthorizer creates and returns a new Authorizer
+func NewAuthorizer(p *Policy, v auth.Verifier, owners Owners) *Authorizer {
+	return &Authorizer{
+		policy:   p,
+		verifier: v,
+		owners:   owners,
+	}
+}
+
+// WithServiceToken sets the token that other services authenticate with, and returns the Authorizer.
+// Callers with the token have the Service role. Without a token, no caller is authenticated as a service
+func (a *Authorizer) WithServiceToken(token string) *Authorizer {
+	a.serviceToken = token
+	return a
+}
+
+// Middleware returns a go-kit endpoint middleware that authorizes the requests of a method.
+// The Subject of the caller is added to the context of the requests that are allowed.
+// Methods without a rule are never allowed
+func (a *Authorizer) Middleware(method string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			rule, ok := a.policy.Rule(method)
+			if !ok {
+				return nil, ErrForbidden
+			}
+
+			s, err := a.subject(ctx)
+			if err != nil && !rule.Public {
+				return nil, err
+			}
+			if s != nil {
+				ctx = NewContext(ctx, s)
+			}
+			if rule.Public {
+				return next(ctx, request)
+			}
+
+			var owners []Owner
+			if fn := a.owners[method]; fn != nil && len(rule.Owners) > 0 {
+				owners, err = fn(ctx, request)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !allowed(rule, s, owners) {
+				return nil, ErrForbidden
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// Authorize authorizes an action that a service checks itself, such as seeing the resources of every owner
+func (a *Authorizer) Authorize(ctx context.Context, action string, owners ...Owner) error {
+	rule, ok := a.policy.Rule(action)
+	if !ok {
+		return ErrForbidden
+	}
+	if rule.Public {
+		return nil
+	}
+
+	s := FromContext(ctx)
+	if s == nil {
+		var err error
+		if s, err = a.subject(ctx); err != nil {
+			return err
+		}
+	}
+	if !allowed(rule, s, owners) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// Wrap wraps every endpoint of a struct of go-kit endpoints by the middleware of the method of the same name.
+// It fails if a method has no rule, or if its rule grants access by ownership without an OwnerFunc
+func (a *Authorizer) Wrap(endpoints interface{}) error {
+	v := reflect.ValueOf(endpoints)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return errors.New("Endpoints must be a pointer to a struct")
+	}
+	v = v.Elem()
+
+	epType := reflect.TypeOf(endpoint.Endpoint(nil))
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.Type != epType {
+			continue
+		}
+		rule, ok := a.policy.Rule(f.Name)
+		if !ok {
+			return errors.Errorf("No rule for %s", f.Name)
+		}
+		if len(rule.Owners) > 0 && !rule.Public && !rule.Authenticated && a.owners[f.Name] == nil {
+			return errors.Errorf("No owners of %s", f.Name)
+		}
+		ep := v.Field(i).Interface().(endpoint.Endpoint)
+		v.Field(i).Set(reflect.ValueOf(a.Middleware(f.Name)(ep)))
+	}
+	return nil
+}
+
+// subject returns the Subject of the access token or the service token of a request,
+// and ErrForbidden if it has no valid token
+func (a *Authorizer) subject(ctx context.Context) (*Subject, error) {
+	headers, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ErrForbidden
+	}
+	if len(headers[ServiceTokenKey]) > 0 {
+		token := headers[ServiceTokenKey][0]
+		if a.serviceToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(a.serviceToken)) != 1 {
+			return nil, ErrForbidden
+		}
+		return &Subject{Roles: []string{RoleService}}, nil
+	}
+	if len(headers["authorization"]) == 0 {
+		return nil, ErrForbidden
+	}
+	parts := strings.Split(headers["authorization"][0], " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return nil, ErrForbidden
+	}
+
+	claims, err := a.verifier.Verify(parts[1])
+	if err != nil {
+		return nil, ErrForbidden
+	}
+	return NewSubject(claims)
+}
+
+// allowed returns whether a rule allows a signed in Subject to access resources of the owners.
+// Access by ownership needs at least one owner, and every owner must be owned by the Subject
+func allowed(rule *Rule, s *Subject, owners []Owner) bool {
+	if s == nil {
+		return false
+	}
+	if rule.Authenticated || s.HasRole(rule.Roles...) {
+		return true
+	}
+	if len(rule.Owners) == 0 || len(owners) == 0 {
+		return false
+	}
+	for _, o := range owners {
+		if !s.Owns(o, rule.Owners...) {
+			return false
+		}
+	}
+	return true
+}
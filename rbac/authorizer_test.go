@@ -0,0 +1,178 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeVerifier returns the claims of a token by the token itself
+type fakeVerifier map[string]jwt.MapClaims
+
+func (v fakeVerifier) Verify(token string) (jwt.MapClaims, error) {
+	c, ok := v[token]
+	if !ok {
+		return nil, errors.New("Invalid token")
+	}
+	return c, nil
+}
+
+var verifier = fakeVerifier{
+	"admin":     {IDKey: "1", RolesKey: []interface{}{"Admin"}},
+	"company":   {IDKey: "2", CompanyIDKey: "10", RolesKey: []interface{}{"Company"}},
+	"other":     {IDKey: "3", CompanyIDKey: "20", RolesKey: []interface{}{"Company"}},
+	"candidate": {IDKey: "4", CandidateIDKey: "5"},
+	"malformed": {IDKey: 4},
+}
+
+func withToken(token string) context.Context {
+	if token == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func newAuthorizer(t *testing.T) *Authorizer {
+	p, err := NewPolicy([]*Rule{
+		{Methods: []string{"GetPost"}, Public: true},
+		{Methods: []string{"GetProfile"}, Authenticated: true},
+		{Methods: []string{"CreatePost"}, Roles: []string{"Admin"}, Owners: []string{OwnerCompany}},
+		{Methods: []string{"SeeAllPosts"}, Roles: []string{"Admin"}},
+		{Methods: []string{"LocalDeletePosts"}, Roles: []string{RoleService}},
+	})
+	require.NoError(t, err)
+
+	// a request is the company IDs of the posts it creates, and company 0 does not exist
+	return NewAuthorizer(p, verifier, Owners{
+		"CreatePost": func(_ context.Context, request interface{}) ([]Owner, error) {
+			var owners []Owner
+			for _, id := range request.([]uint64) {
+				if id == 0 {
+					return nil, ErrForbidden
+				}
+				owners = append(owners, Owner{CompanyID: id})
+			}
+			return owners, nil
+		},
+	}).WithServiceToken("secret")
+}
+
+func withServiceToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceTokenKey, token))
+}
+
+func TestMiddleware(t *testing.T) {
+	var tests = []struct {
+		name    string
+		method  string
+		caller  string
+		request []uint64
+		err     error
+	}{
+		{"public without token", "GetPost", "", nil, nil},
+		{"public with invalid token", "GetPost", "invalid", nil, nil},
+		{"authenticated", "GetProfile", "candidate", nil, nil},
+		{"authenticated without token", "GetProfile", "", nil, ErrForbidden},
+		{"authenticated with invalid token", "GetProfile", "invalid", nil, ErrForbidden},
+		{"malformed claims", "GetProfile", "malformed", nil, ErrForbidden},
+		{"role", "CreatePost", "admin", []uint64{10, 20}, nil},
+		{"owner", "CreatePost", "company", []uint64{10, 10}, nil},
+		{"owner of some", "CreatePost", "company", []uint64{10, 20}, ErrForbidden},
+		{"not owner", "CreatePost", "other", []uint64{10}, ErrForbidden},
+		{"no owners", "CreatePost", "company", []uint64{}, ErrForbidden},
+		{"owner func error", "CreatePost", "admin", []uint64{0}, ErrForbidden},
+		{"without role or owner", "CreatePost", "candidate", []uint64{10}, ErrForbidden},
+		{"without rule", "DeletePost", "admin", nil, ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called *Subject
+			next := func(ctx context.Context, request interface{}) (interface{}, error) {
+				called = FromContext(ctx)
+				return "ok", nil
+			}
+
+			res, err := newAuthorizer(t).Middleware(tt.method)(next)(withToken(tt.caller), tt.request)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				require.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "ok", res)
+			if tt.caller == "" || tt.caller == "invalid" {
+				require.Nil(t, called)
+			} else {
+				require.NotNil(t, called)
+			}
+		})
+	}
+}
+
+func TestServiceToken(t *testing.T) {
+	a := newAuthorizer(t)
+	next := func(ctx context.Context, request interface{}) (interface{}, error) {
+		return FromContext(ctx), nil
+	}
+
+	res, err := a.Middleware("LocalDeletePosts")(next)(withServiceToken("secret"), nil)
+	require.NoError(t, err)
+	require.Equal(t, &Subject{Roles: []string{RoleService}}, res)
+
+	_, err = a.Middleware("LocalDeletePosts")(next)(withServiceToken("guess"), nil)
+	require.Equal(t, ErrForbidden, err)
+	_, err = a.Middleware("LocalDeletePosts")(next)(withToken("admin"), nil)
+	require.Equal(t, ErrForbidden, err)
+
+	// services are not authenticated when no token is set
+	noToken := NewAuthorizer(a.policy, verifier, nil)
+	_, err = noToken.Middleware("LocalDeletePosts")(next)(withServiceToken(""), nil)
+	require.Equal(t, ErrForbidden, err)
+
+	md, err := ServiceCredentials("secret").GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{ServiceTokenKey: "secret"}, md)
+}
+
+func TestAuthorize(t *testing.T) {
+	a := newAuthorizer(t)
+
+	require.NoError(t, a.Authorize(withToken("admin"), "SeeAllPosts"))
+	require.Equal(t, ErrForbidden, a.Authorize(withToken("company"), "SeeAllPosts"))
+	require.Equal(t, ErrForbidden, a.Authorize(withToken(""), "SeeAllPosts"))
+	require.Equal(t, ErrForbidden, a.Authorize(withToken("admin"), "SeeNothing"))
+
+	// the Subject of the context is used when the middleware has authorized the request
+	ctx := NewContext(context.Background(), &Subject{CompanyID: 10})
+	require.NoError(t, a.Authorize(ctx, "CreatePost", Owner{CompanyID: 10}))
+	require.Equal(t, ErrForbidden, a.Authorize(ctx, "CreatePost", Owner{CompanyID: 20}))
+}
+
+func TestWrap(t *testing.T) {
+	a := newAuthorizer(t)
+	ok := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+	type endpoints struct {
+		GetPost    endpoint.Endpoint
+		CreatePost endpoint.Endpoint
+	}
+	eps := endpoints{GetPost: ok, CreatePost: ok}
+	require.Error(t, a.Wrap(eps))
+	require.NoError(t, a.Wrap(&eps))
+
+	_, err := eps.GetPost(withToken(""), nil)
+	require.NoError(t, err)
+	_, err = eps.CreatePost(withToken(""), []uint64{10})
+	require.Equal(t, ErrForbidden, err)
+
+	require.EqualError(t, a.Wrap(&struct{ DeletePost endpoint.Endpoint }{ok}), "No rule for DeletePost")
+
+	noOwners := NewAuthorizer(a.policy, verifier, nil)
+	require.EqualError(t, noOwners.Wrap(&endpoints{GetPost: ok, CreatePost: ok}), "No owners of CreatePost")
+}
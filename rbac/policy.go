@@ -0,0 +1,84 @@
+package rbac
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// FileName declares the file name of the policy file of a service
+const FileName = "policy"
+
+// Kinds of ownership that rules can grant access by
+const (
+	OwnerUser      = "User"
+	OwnerCandidate = "Candidate"
+	OwnerCompany   = "Company"
+)
+
+var (
+	readErr      string = "Failed to read policy"
+	unmarshalErr string = "Unable to decode policy"
+)
+
+// Rule declares who may call the methods of a service.
+// A caller is allowed when the methods are public, when any signed in caller is allowed,
+// when the caller has one of the roles, or when the caller owns every resource of the request
+type Rule struct {
+	Methods       []string `mapstructure:"methods"`
+	Public        bool     `mapstructure:"public"`
+	Authenticated bool     `mapstructure:"authenticated"`
+	Roles         []string `mapstructure:"roles"`
+	Owners        []string `mapstructure:"owners"`
+}
+
+// Policy declares the rules of the methods of a service, and of the actions that a service authorizes itself
+type Policy struct {
+	rules map[string]*Rule
+}
+
+// NewPolicy creates a Policy from its rules. Every method must have a single rule
+func NewPolicy(rules []*Rule) (*Policy, error) {
+	p := &Policy{rules: map[string]*Rule{}}
+	for _, r := range rules {
+		for _, o := range r.Owners {
+			if o != OwnerUser && o != OwnerCandidate && o != OwnerCompany {
+				return nil, errors.Errorf("Unknown owner %s", o)
+			}
+		}
+		for _, m := range r.Methods {
+			if _, ok := p.rules[m]; ok {
+				return nil, errors.Errorf("Duplicate rule for %s", m)
+			}
+			p.rules[m] = r
+		}
+	}
+	return p, nil
+}
+
+// LoadPolicy loads a Policy from a yaml file, which lists its rules under the rules key
+func LoadPolicy(fileName string) (*Policy, error) {
+	var cfg struct {
+		Rules []*Rule `mapstructure:"rules"`
+	}
+
+	v := viper.New()
+	v.SetConfigName(fileName)
+	v.SetConfigType("yaml")
+	v.AddConfigPath(".")
+	v.AddConfigPath("../configs")
+	v.AddConfigPath("../../configs")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrap(err, readErr)
+	}
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, errors.Wrap(err, unmarshalErr)
+	}
+	return NewPolicy(cfg.Rules)
+}
+
+// Rule returns the Rule of a method or an action
+func (p *Policy) Rule(method string) (*Rule, bool) {
+	r, ok := p.rules[method]
+	return r, ok
+}
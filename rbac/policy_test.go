@@ -0,0 +1,52 @@
+package rbac
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("testdata"))
+	defer os.Chdir(wd)
+
+	var tests = []struct {
+		fileName string
+		err      string
+	}{
+		{"missing", readErr},
+		{"duplicate", "Duplicate rule for GetPost"},
+		{"policy", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			p, err := LoadPolicy(tt.fileName)
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// method names keep their case
+			r, ok := p.Rule("CreatePost")
+			require.True(t, ok)
+			require.Equal(t, &Rule{
+				Methods: []string{"CreatePost", "UpdatePost"},
+				Roles:   []string{"Admin"},
+				Owners:  []string{OwnerCompany},
+			}, r)
+			_, ok = p.Rule("createpost")
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	_, err := NewPolicy([]*Rule{{Methods: []string{"GetPost"}, Owners: []string{"Recruiter"}}})
+	require.EqualError(t, err, "Unknown owner Recruiter")
+}
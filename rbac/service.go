@@ -0,0 +1,32 @@
+package rbac
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// RoleService is the role of other services of HubbedIn, which are the only callers of Local methods
+const RoleService = "Service"
+
+// ServiceTokenKey is the metadata key of the token that services authenticate their calls to each other with
+const ServiceTokenKey = "x-service-token"
+
+// serviceCredentials adds the service token to the metadata of every call of a grpc client
+type serviceCredentials string
+
+// ServiceCredentials returns the credentials of a grpc client that calls other services as a service,
+// to be used with grpc.WithPerRPCCredentials
+func ServiceCredentials(token string) credentials.PerRPCCredentials {
+	return serviceCredentials(token)
+}
+
+// GetRequestMetadata returns the service token as metadata of a call
+func (c serviceCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{ServiceTokenKey: string(c)}, nil
+}
+
+// RequireTransportSecurity returns false, as services call each other within their private network
+func (c serviceCredentials) RequireTransportSecurity() bool {
+	return false
+}
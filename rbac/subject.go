@@ -0,0 +1,107 @@
+package rbac
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// Claims of the access tokens of HubbedIn
+const (
+	IDKey          = "https://hubbedin/id"
+	CandidateIDKey = "https://hubbedin/candidateId"
+	CompanyIDKey   = "https://hubbedin/companyId"
+	RolesKey       = "https://hubbedin/roles"
+)
+
+type contextKey struct{}
+
+// Subject declares the caller of a request, as identified by its access token
+type Subject struct {
+	ID          uint64
+	CandidateID uint64
+	CompanyID   uint64
+	Roles       []string
+}
+
+// Owner declares who owns a resource. IDs that are zero are not owned by anyone
+type Owner struct {
+	UserID      uint64
+	CandidateID uint64
+	CompanyID   uint64
+}
+
+// NewSubject returns the Subject of the claims of an access token
+func NewSubject(claims jwt.MapClaims) (*Subject, error) {
+	s := &Subject{}
+	for key, id := range map[string]*uint64{
+		IDKey:          &s.ID,
+		CandidateIDKey: &s.CandidateID,
+		CompanyIDKey:   &s.CompanyID,
+	} {
+		if claims[key] == nil {
+			continue
+		}
+		v, ok := claims[key].(string)
+		if !ok {
+			return nil, ErrForbidden
+		}
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, ErrForbidden
+		}
+		*id = n
+	}
+
+	if claims[RolesKey] != nil {
+		roles, ok := claims[RolesKey].([]interface{})
+		if !ok {
+			return nil, ErrForbidden
+		}
+		for _, r := range roles {
+			if role, ok := r.(string); ok {
+				s.Roles = append(s.Roles, role)
+			}
+		}
+	}
+	return s, nil
+}
+
+// HasRole returns whether the Subject has any of the roles
+func (s *Subject) HasRole(roles ...string) bool {
+	for _, want := range roles {
+		for _, r := range s.Roles {
+			if r == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Owns returns whether the Subject owns a resource by any of the kinds of ownership
+func (s *Subject) Owns(o Owner, kinds ...string) bool {
+	for _, k := range kinds {
+		switch {
+		case k == OwnerUser && s.ID != 0 && o.UserID == s.ID:
+			return true
+		case k == OwnerCandidate && s.CandidateID != 0 && o.CandidateID == s.CandidateID:
+			return true
+		case k == OwnerCompany && s.CompanyID != 0 && o.CompanyID == s.CompanyID:
+			return true
+		}
+	}
+	return false
+}
+
+// NewContext returns a copy of the context that carries the Subject
+func NewContext(ctx context.Context, s *Subject) context.Context {
+	return context.WithValue(ctx, contextKey{}, s)
+}
+
+// FromContext returns the Subject of an authorized request, or nil if the caller is not signed in
+func FromContext(ctx context.Context) *Subject {
+	s, _ := ctx.Value(contextKey{}).(*Subject)
+	return s
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"in-backend/rbac"
 	assessmentPb "in-backend/services/assessment/pb"
+	projectPb "in-backend/services/project/pb"
 	"log"
 	"os"
 	"os/signal"
@@ -24,12 +26,25 @@ var redisPool = &redis.Pool{
 	},
 }
 
+var enqueuer = work.NewEnqueuer(appName, redisPool)
+
+// serviceCreds authenticates the calls of the worker to the Local methods of services
+var serviceCreds = grpc.WithPerRPCCredentials(rbac.ServiceCredentials(os.Getenv("AUTH_SERVICE_TOKEN")))
+
 type Context struct {
 }
 
 const (
 	appName           string = "hubbedin"
 	assessmentSvcAddr string = "assessment-service:50053"
+	projectSvcAddr    string = "project-service:50052"
+
+	// defaultRescanSchedule re-scans all projects every Sunday at 3am
+	defaultRescanSchedule string = "0 0 3 * * 0"
+	// defaultMetadataSchedule refreshes the metadata of all projects every day at 4am
+	defaultMetadataSchedule string = "0 0 4 * * *"
+	// rescanPageSize is the number of projects listed at a time when re-scanning
+	rescanPageSize uint64 = 100
 )
 
 func main() {
@@ -40,6 +55,24 @@ func main() {
 
 	// Map the name of jobs to handler functions
 	pool.Job("end_assessment_attempt", (*Context).EndAssessmentAttempt)
+	// scans clone and analyse whole repositories, so only a few run at a time
+	pool.JobWithOptions("scan_project", work.JobOptions{MaxConcurrency: 2, MaxFails: 3}, (*Context).ScanProject)
+	pool.JobWithOptions("rescan_projects", work.JobOptions{MaxConcurrency: 1, MaxFails: 1}, (*Context).RescanProjects)
+	// imports call the APIs of git hosts, which are rate limited
+	pool.JobWithOptions("import_project_metadata", work.JobOptions{MaxConcurrency: 2, MaxFails: 3}, (*Context).ImportProjectMetadata)
+	pool.JobWithOptions("refresh_projects_metadata", work.JobOptions{MaxConcurrency: 1, MaxFails: 1}, (*Context).RefreshProjectsMetadata)
+
+	// Enqueue periodic jobs, with cron schedules that include seconds
+	schedule := os.Getenv("PROJECT_RESCAN_SCHEDULE")
+	if schedule == "" {
+		schedule = defaultRescanSchedule
+	}
+	pool.PeriodicallyEnqueue(schedule, "rescan_projects")
+	metadataSchedule := os.Getenv("PROJECT_METADATA_SCHEDULE")
+	if metadataSchedule == "" {
+		metadataSchedule = defaultMetadataSchedule
+	}
+	pool.PeriodicallyEnqueue(metadataSchedule, "refresh_projects_metadata")
 
 	// Start processing jobs
 	pool.Start()
@@ -68,7 +101,7 @@ func (c *Context) EndAssessmentAttempt(job *work.Job) error {
 		return err
 	}
 
-	conn, err := grpc.Dial(assessmentSvcAddr, grpc.WithInsecure())
+	conn, err := grpc.Dial(assessmentSvcAddr, grpc.WithInsecure(), serviceCreds)
 	if err != nil {
 		log.Fatalf("Dial Failed: %v", err)
 	}
@@ -99,3 +132,101 @@ func (c *Context) EndAssessmentAttempt(job *work.Job) error {
 	}
 	return nil
 }
+
+// ScanProject scans a Project using sonarqube.
+// An error is returned if the scan fails so that it is retried
+func (c *Context) ScanProject(job *work.Job) error {
+	// Extract arguments:
+	projectID := job.ArgInt64("project_id")
+	if err := job.ArgError(); err != nil {
+		fmt.Println("Error parsing args: ", err)
+		return err
+	}
+
+	conn, err := grpc.Dial(projectSvcAddr, grpc.WithInsecure(), serviceCreds)
+	if err != nil {
+		log.Fatalf("Dial Failed: %v", err)
+	}
+	defer conn.Close()
+
+	client := projectPb.NewProjectServiceClient(conn)
+	req := projectPb.ScanProjectRequest{Id: uint64(projectID)}
+	_, err = client.LocalScanProject(context.Background(), &req)
+	if err != nil {
+		fmt.Println("Failed to scan project: ", err)
+		return err
+	}
+	return nil
+}
+
+// RescanProjects enqueues a scan of every Project, so that their ratings stay up to date.
+// Projects that already have a scan queued are skipped
+func (c *Context) RescanProjects(job *work.Job) error {
+	return enqueueAllProjects("scan_project")
+}
+
+// ImportProjectMetadata imports the metadata of a Project from the host of its repository,
+// and verifies the contributions of its Candidates.
+// An error is returned if the import fails so that it is retried
+func (c *Context) ImportProjectMetadata(job *work.Job) error {
+	// Extract arguments:
+	projectID := job.ArgInt64("project_id")
+	if err := job.ArgError(); err != nil {
+		fmt.Println("Error parsing args: ", err)
+		return err
+	}
+
+	conn, err := grpc.Dial(projectSvcAddr, grpc.WithInsecure(), serviceCreds)
+	if err != nil {
+		log.Fatalf("Dial Failed: %v", err)
+	}
+	defer conn.Close()
+
+	client := projectPb.NewProjectServiceClient(conn)
+	req := projectPb.RefreshProjectMetadataRequest{Id: uint64(projectID)}
+	_, err = client.LocalRefreshProjectMetadata(context.Background(), &req)
+	if err != nil {
+		fmt.Println("Failed to import project metadata: ", err)
+		return err
+	}
+	return nil
+}
+
+// RefreshProjectsMetadata enqueues an import of the metadata of every Project,
+// so that their metadata and the verified contributions of Candidates stay up to date.
+// Projects that already have an import queued are skipped
+func (c *Context) RefreshProjectsMetadata(job *work.Job) error {
+	return enqueueAllProjects("import_project_metadata")
+}
+
+// enqueueAllProjects enqueues a unique job with the name jobName for every Project
+func enqueueAllProjects(jobName string) error {
+	conn, err := grpc.Dial(projectSvcAddr, grpc.WithInsecure(), serviceCreds)
+	if err != nil {
+		log.Fatalf("Dial Failed: %v", err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+
+	client := projectPb.NewProjectServiceClient(conn)
+	req := projectPb.GetAllProjectsRequest{PageSize: rescanPageSize}
+	for {
+		res, err := client.LocalGetAllProjects(ctx, &req)
+		if err != nil {
+			fmt.Println("Failed to get projects: ", err)
+			return err
+		}
+		for _, p := range res.Projects {
+			_, err := enqueuer.EnqueueUnique(jobName, work.Q{"project_id": p.Id})
+			if err != nil {
+				fmt.Println("Failed to enqueue "+jobName+": ", err)
+				return err
+			}
+		}
+		if res.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
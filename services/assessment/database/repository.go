@@ -9,6 +9,7 @@ import (
 	"github.com/go-pg/pg/v10/orm"
 	"github.com/pkg/errors"
 
+	"in-backend/pagination"
 	"in-backend/services/assessment/interfaces"
 	"in-backend/services/assessment/models"
 )
@@ -49,7 +50,12 @@ func (r *repository) CreateAssessment(ctx context.Context, m *models.Assessment)
 }
 
 // GetAllAssessments returns all Assessments
-func (r *repository) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, error) {
+func (r *repository) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, *pagination.Info, error) {
+	page, err := pagination.New(f.Page, assessmentSorts, "a.id", "id", false)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var m []*models.Assessment
 	q := r.DB.WithContext(ctx).Model(&m)
 	if len(f.ID) > 0 {
@@ -74,6 +80,12 @@ func (r *repository) GetAllAssessments(ctx context.Context, f models.AssessmentF
 		q = q.Where("as.score >= ?", f.MinScore)
 	}
 
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	q = page.Apply(q)
+
 	if *role == "Admin" {
 		q = q.Relation(relAttempts)
 	}
@@ -82,10 +94,20 @@ func (r *repository) GetAllAssessments(ctx context.Context, f models.AssessmentF
 			return q.Where("aa.candidate_id = ?", *cid), nil
 		})
 	}
-	err := q.Relation(relQuestions).
-		Returning("*").
+	err = q.Returning("*").
 		Select()
-	return m, err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
 }
 
 // GetAssessmentByID returns a Assessment by ID
@@ -157,26 +179,31 @@ func (r *repository) CreateAssessmentAttempt(ctx context.Context, m *models.Asse
 		return nil, err
 	}
 
-	var aaqSlice []models.AttemptQuestion
-	for _, q := range m.Questions {
-		aaq := models.AttemptQuestion{
+	// questions are stored in the order they were drawn for this attempt
+	var aaqSlice []*models.AttemptQuestion
+	for i, q := range m.Questions {
+		aaq := &models.AttemptQuestion{
 			AttemptID:   m.ID,
 			QuestionID:  q.ID,
 			CandidateID: m.CandidateID,
 			Selection:   -1,
 			Score:       -1,
+			Position:    uint32(i),
 		}
 		aaqSlice = append(aaqSlice, aaq)
 	}
 
-	_, err = tx.Model(&aaqSlice).
-		Returning("*").
-		Insert()
-	if err != nil {
-		err = errors.Wrapf(err, "Failed to insert questions for assessment attempt")
-		tx.Rollback()
-		return nil, err
+	if len(aaqSlice) > 0 {
+		_, err = tx.Model(&aaqSlice).
+			Returning("*").
+			Insert()
+		if err != nil {
+			err = errors.Wrapf(err, "Failed to insert questions for assessment attempt")
+			tx.Rollback()
+			return nil, err
+		}
 	}
+	m.QuestionAttempts = aaqSlice
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
@@ -192,7 +219,9 @@ func (r *repository) GetAssessmentAttemptByID(ctx context.Context, id uint64) (*
 		Where("aa.id = ?", id).
 		Relation(relAssessment).
 		Relation(relQuestions).
-		Relation(relQuestionAttempts).
+		Relation(relQuestionAttempts, func(q *orm.Query) (*orm.Query, error) {
+			return q.Order("aaq.position asc"), nil
+		}).
 		Returning("*").
 		First()
 	//pg returns error when no rows in the result set
@@ -235,6 +264,58 @@ func (r *repository) UpdateAssessmentAttempt(ctx context.Context, m *models.Asse
 	return m, nil
 }
 
+// CompleteAssessmentAttempt updates a completed AssessmentAttempt together with the scores of
+// its AttemptQuestions in a single transaction
+func (r *repository) CompleteAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
+	if m == nil {
+		return nil, errors.New("AssessmentAttempt is nil")
+	}
+
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close()
+
+	for _, aq := range m.QuestionAttempts {
+		_, err = tx.Model(aq).WherePK().
+			Column("score").
+			Update()
+		if err != nil {
+			tx.Rollback()
+			return nil, errors.Wrap(err, fmt.Sprintf("Cannot update score of attempt question with id %v", aq.ID))
+		}
+	}
+
+	_, err = tx.Model(m).WherePK().
+		Update()
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot update assessment attempt with id %v", m.ID))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetCompletedAssessmentAttempts returns the completed AssessmentAttempts of Candidates
+func (r *repository) GetCompletedAssessmentAttempts(ctx context.Context, cids []uint64) ([]*models.AssessmentAttempt, error) {
+	var m []*models.AssessmentAttempt
+	if len(cids) == 0 {
+		return m, nil
+	}
+	err := r.DB.WithContext(ctx).Model(&m).
+		Where("aa.candidate_id in (?)", pg.In(cids)).
+		Where("aa.status = ?", "Completed").
+		Relation(relQuestions).
+		Returning("*").
+		Select()
+	return m, err
+}
+
 // DeleteAssessmentAttempt deletes a AssessmentAttempt by ID
 func (r *repository) DeleteAssessmentAttempt(ctx context.Context, id uint64) error {
 	m := &models.AssessmentAttempt{ID: id}
@@ -245,6 +326,48 @@ func (r *repository) DeleteAssessmentAttempt(ctx context.Context, id uint64) err
 	return nil
 }
 
+// GetCandidateAssessmentAttempts returns all AssessmentAttempts of a Candidate with their AttemptQuestions
+func (r *repository) GetCandidateAssessmentAttempts(ctx context.Context, cid uint64) ([]*models.AssessmentAttempt, error) {
+	var m []*models.AssessmentAttempt
+	err := r.DB.WithContext(ctx).Model(&m).
+		Where("aa.candidate_id = ?", cid).
+		Relation(relQuestionAttempts).
+		Order("aa.id").
+		Select()
+	return m, err
+}
+
+// DeleteCandidateAssessmentAttempts deletes all AssessmentAttempts and AttemptQuestions of a Candidate
+// and returns how many were deleted
+func (r *repository) DeleteCandidateAssessmentAttempts(ctx context.Context, cid uint64) (uint64, error) {
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Close()
+
+	aq, err := tx.Model((*models.AttemptQuestion)(nil)).
+		Where("candidate_id = ?", cid).
+		Delete()
+	if err != nil {
+		tx.Rollback()
+		return 0, errors.Wrap(err, fmt.Sprintf("Cannot delete attempt questions of candidate %v", cid))
+	}
+
+	aa, err := tx.Model((*models.AssessmentAttempt)(nil)).
+		Where("candidate_id = ?", cid).
+		Delete()
+	if err != nil {
+		tx.Rollback()
+		return 0, errors.Wrap(err, fmt.Sprintf("Cannot delete assessment attempts of candidate %v", cid))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return uint64(aq.RowsAffected() + aa.RowsAffected()), nil
+}
+
 /* --------------- Question --------------- */
 
 // CreateQuestion creates a new Question
@@ -362,8 +485,12 @@ func (r *repository) BulkCreateQuestion(ctx context.Context, m []*models.Questio
 		return empty, err
 	}
 
-	f := models.QuestionFilters{ID: qid}
-	questions, err := r.GetAllQuestions(ctx, f)
+	var questions []*models.Question
+	err = r.DB.WithContext(ctx).Model(&questions).
+		Where("q.id in (?)", pg.In(qid)).
+		Relation(relTags).
+		Order("q.id").
+		Select()
 	if err != nil {
 		return m, nil
 	}
@@ -372,7 +499,12 @@ func (r *repository) BulkCreateQuestion(ctx context.Context, m []*models.Questio
 }
 
 // GetAllQuestions returns all Questions
-func (r *repository) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, error) {
+func (r *repository) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, *pagination.Info, error) {
+	page, err := pagination.New(f.Page, questionSorts, "q.id", "id", false)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var m []*models.Question
 	q := r.DB.WithContext(ctx).Model(&m)
 	if len(f.ID) > 0 {
@@ -381,12 +513,45 @@ func (r *repository) GetAllQuestions(ctx context.Context, f models.QuestionFilte
 	if len(f.Tags) > 0 {
 		q = q.Where("t.name in (?)", pg.In(f.Tags))
 	}
-	err := q.Relation(relTags).
-		Relation(relAssessments).
-		Relation(relAttempts).
+	if f.AssessmentID > 0 {
+		q = q.Where("q.id in (select question_id from assessments_questions where assessment_id = ?)", f.AssessmentID)
+	}
+
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	err = page.Apply(q).Relation(relTags).
 		Returning("*").
 		Select()
-	return m, err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
+}
+
+// GetQuestionPool returns the IDs and Tags of all Questions of an Assessment,
+// which is all that is needed to draw the questions of an AssessmentAttempt
+func (r *repository) GetQuestionPool(ctx context.Context, aid uint64) ([]*models.Question, error) {
+	var m []*models.Question
+	err := r.DB.WithContext(ctx).Model(&m).
+		Column("q.id").
+		Where("q.id in (select question_id from assessments_questions where assessment_id = ?)", aid).
+		Relation(relTags).
+		Order("q.id").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 // GetQuestionByID returns a Question by ID
@@ -498,3 +663,48 @@ func (r *repository) UpdateAttemptQuestion(ctx context.Context, m *models.Attemp
 
 	return m, nil
 }
+
+// SubmitAttemptQuestion updates a AttemptQuestion and the progress of its AssessmentAttempt in a single
+// transaction. The AssessmentAttempt is only updated if its CurrentQuestion is still prev, so that
+// concurrent submissions cannot skip or repeat a question
+func (r *repository) SubmitAttemptQuestion(ctx context.Context, m *models.AttemptQuestion, aa *models.AssessmentAttempt, prev uint64) (*models.AttemptQuestion, error) {
+	if m == nil {
+		return nil, errors.New("AttemptQuestion is nil")
+	}
+	if aa == nil {
+		return nil, errors.New("AssessmentAttempt is nil")
+	}
+
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close()
+
+	res, err := tx.Model(aa).WherePK().
+		Where("current_question = ?", prev).
+		Column("current_question", "current_started_at").
+		Update()
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot update progress of assessment attempt with id %v", aa.ID))
+	}
+	if res.RowsAffected() == 0 {
+		tx.Rollback()
+		return nil, errors.New(fmt.Sprintf("Assessment attempt with id %v was updated concurrently", aa.ID))
+	}
+
+	_, err = tx.Model(m).WherePK().
+		Returning("*").
+		Update()
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.Wrapf(err, "Failed to update attempt question %v", m)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -139,7 +139,7 @@ func testGetAllAssessments(t *testing.T, r interfaces.Repository, db *pg.DB) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := r.GetAllAssessments(tt.args.ctx, *tt.args.f, &tt.args.role, &tt.args.cid)
+			got, _, err := r.GetAllAssessments(tt.args.ctx, *tt.args.f, &tt.args.role, &tt.args.cid)
 			assert.Equal(t, tt.exp.cnt, len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
@@ -449,7 +449,7 @@ func testGetAllQuestions(t *testing.T, r interfaces.Repository, db *pg.DB) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := r.GetAllQuestions(tt.args.ctx, *tt.args.f)
+			got, _, err := r.GetAllQuestions(tt.args.ctx, *tt.args.f)
 			assert.Equal(t, tt.exp.cnt, len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
@@ -0,0 +1,19 @@
+package database
+
+import (
+	"in-backend/pagination"
+	"in-backend/services/assessment/models"
+)
+
+// assessmentSorts declares the fields that Assessments can be sorted by
+var assessmentSorts = pagination.Sorts{
+	"id":         {Column: "a.id"},
+	"name":       {Column: "a.name", Value: func(m interface{}) interface{} { return m.(*models.Assessment).Name }},
+	"difficulty": {Column: "coalesce(a.difficulty, '')", Value: func(m interface{}) interface{} { return m.(*models.Assessment).Difficulty }},
+}
+
+// questionSorts declares the fields that Questions can be sorted by
+var questionSorts = pagination.Sorts{
+	"id":   {Column: "q.id"},
+	"type": {Column: "q.type", Value: func(m interface{}) interface{} { return m.(*models.Question).Type }},
+}
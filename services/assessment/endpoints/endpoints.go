@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-kit/kit/endpoint"
 
+	"in-backend/pagination"
 	"in-backend/services/assessment/interfaces"
 	"in-backend/services/assessment/models"
 )
@@ -23,6 +24,9 @@ type Endpoints struct {
 	UpdateAssessmentAttempt       endpoint.Endpoint
 	LocalUpdateAssessmentAttempt  endpoint.Endpoint
 	DeleteAssessmentAttempt       endpoint.Endpoint
+	LocalGetCandidateScores       endpoint.Endpoint
+	LocalExportCandidateData      endpoint.Endpoint
+	LocalEraseCandidateData       endpoint.Endpoint
 
 	CreateQuestion     endpoint.Endpoint
 	BulkCreateQuestion endpoint.Endpoint
@@ -52,6 +56,9 @@ func MakeEndpoints(s interfaces.Service) Endpoints {
 		UpdateAssessmentAttempt:       makeUpdateAssessmentAttemptEndpoint(s),
 		LocalUpdateAssessmentAttempt:  makeLocalUpdateAssessmentAttemptEndpoint(s),
 		DeleteAssessmentAttempt:       makeDeleteAssessmentAttemptEndpoint(s),
+		LocalGetCandidateScores:       makeLocalGetCandidateScoresEndpoint(s),
+		LocalExportCandidateData:      makeLocalExportCandidateDataEndpoint(s),
+		LocalEraseCandidateData:       makeLocalEraseCandidateDataEndpoint(s),
 
 		CreateQuestion:     makeCreateQuestionEndpoint(s),
 		BulkCreateQuestion: makeBulkCreateQuestionEndpoint(s),
@@ -92,8 +99,8 @@ func makeGetAllAssessmentsEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetAllAssessmentsRequest)
 		f := models.AssessmentFilters(req)
-		m, err := s.GetAllAssessments(ctx, f, nil, nil)
-		return GetAllAssessmentsResponse{Assessments: m, Err: err}, nil
+		m, page, err := s.GetAllAssessments(ctx, f, nil, nil)
+		return GetAllAssessmentsResponse{Assessments: m, Page: page, Err: err}, nil
 	}
 }
 
@@ -108,11 +115,14 @@ type GetAllAssessmentsRequest struct {
 	CandidateID uint64
 	Status      []string
 	MinScore    int64
+
+	Page pagination.Params
 }
 
 // GetAllAssessmentsResponse declares the outputs after attempting to get all assessments
 type GetAllAssessmentsResponse struct {
 	Assessments []*models.Assessment
+	Page        *pagination.Info
 	Err         error
 }
 
@@ -197,7 +207,7 @@ type CreateAssessmentAttemptResponse struct {
 func makeGetAssessmentAttemptByIDEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetAssessmentAttemptByIDRequest)
-		m, err := s.GetAssessmentAttemptByID(ctx, req.ID)
+		m, err := s.GetAssessmentAttemptByID(ctx, req.ID, nil, nil)
 		return GetAssessmentAttemptByIDResponse{AssessmentAttempt: m, Err: err}, nil
 	}
 }
@@ -224,7 +234,7 @@ type GetAssessmentAttemptByIDResponse struct {
 func makeUpdateAssessmentAttemptEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(UpdateAssessmentAttemptRequest)
-		m, err := s.UpdateAssessmentAttempt(ctx, req.AssessmentAttempt)
+		m, err := s.UpdateAssessmentAttempt(ctx, req.AssessmentAttempt, nil, nil)
 		return UpdateAssessmentAttemptResponse{AssessmentAttempt: m, Err: err}, nil
 	}
 }
@@ -267,6 +277,58 @@ type DeleteAssessmentAttemptResponse struct {
 	Err error
 }
 
+func makeLocalGetCandidateScoresEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetCandidateScoresRequest)
+		m, err := s.LocalGetCandidateScores(ctx, req.CandidateIDs)
+		return GetCandidateScoresResponse{Scores: m, Err: err}, nil
+	}
+}
+
+// GetCandidateScoresRequest declares the inputs required for getting the assessment scores of candidates
+type GetCandidateScoresRequest struct {
+	CandidateIDs []uint64
+}
+
+// GetCandidateScoresResponse declares the outputs after attempting to get the assessment scores of candidates
+type GetCandidateScoresResponse struct {
+	Scores []*models.CandidateScore
+	Err    error
+}
+
+func makeLocalExportCandidateDataEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CandidateDataRequest)
+		m, err := s.LocalExportCandidateData(ctx, req.CandidateID)
+		return LocalExportCandidateDataResponse{CandidateData: m, Err: err}, nil
+	}
+}
+
+// CandidateDataRequest declares the inputs required for exporting or erasing the data about a candidate
+type CandidateDataRequest struct {
+	CandidateID uint64
+}
+
+// LocalExportCandidateDataResponse declares the outputs after attempting to export the data about a candidate
+type LocalExportCandidateDataResponse struct {
+	CandidateData *models.CandidateData
+	Err           error
+}
+
+func makeLocalEraseCandidateDataEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CandidateDataRequest)
+		n, err := s.LocalEraseCandidateData(ctx, req.CandidateID)
+		return LocalEraseCandidateDataResponse{Erased: n, Err: err}, nil
+	}
+}
+
+// LocalEraseCandidateDataResponse declares the outputs after attempting to erase the data about a candidate
+type LocalEraseCandidateDataResponse struct {
+	Erased uint64
+	Err    error
+}
+
 /* -------------- Question -------------- */
 
 func makeCreateQuestionEndpoint(s interfaces.Service) endpoint.Endpoint {
@@ -311,20 +373,23 @@ func makeGetAllQuestionsEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetAllQuestionsRequest)
 		f := models.QuestionFilters(req)
-		m, err := s.GetAllQuestions(ctx, f)
-		return GetAllQuestionsResponse{Questions: m, Err: err}, nil
+		m, page, err := s.GetAllQuestions(ctx, f)
+		return GetAllQuestionsResponse{Questions: m, Page: page, Err: err}, nil
 	}
 }
 
 // GetAllQuestionsRequest declares the inputs required for getting all questions
 type GetAllQuestionsRequest struct {
-	ID   []uint64
-	Tags []string
+	ID           []uint64
+	Tags         []string
+	AssessmentID uint64
+	Page         pagination.Params
 }
 
 // GetAllQuestionsResponse declares the outputs after attempting to get all questions
 type GetAllQuestionsResponse struct {
 	Questions []*models.Question
+	Page      *pagination.Info
 	Err       error
 }
 
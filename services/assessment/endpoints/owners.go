@@ -0,0 +1,57 @@
+package endpoints
+
+import (
+	"context"
+
+	"in-backend/rbac"
+	"in-backend/services/assessment/interfaces"
+)
+
+// MakeOwners returns the owners of the resources of the requests that are authorized by ownership.
+// AssessmentAttempts and AttemptQuestions that do not exist cannot be read or changed by anyone
+func MakeOwners(r interfaces.Repository) rbac.Owners {
+	attempt := func(ctx context.Context, id uint64) ([]rbac.Owner, error) {
+		aa, err := r.GetAssessmentAttemptByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if aa == nil {
+			return nil, rbac.ErrForbidden
+		}
+		return []rbac.Owner{{UserID: aa.CandidateID}}, nil
+	}
+
+	return rbac.Owners{
+		"CreateAssessmentAttempt": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateAssessmentAttemptRequest).AssessmentAttempt
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return []rbac.Owner{{UserID: m.CandidateID}}, nil
+		},
+		"GetAssessmentAttemptByID": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return attempt(ctx, request.(GetAssessmentAttemptByIDRequest).ID)
+		},
+		"UpdateAssessmentAttempt": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateAssessmentAttemptRequest).AssessmentAttempt
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return attempt(ctx, m.ID)
+		},
+		"UpdateAttemptQuestion": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateAttemptQuestionRequest).AttemptQuestion
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			aq, err := r.GetAttemptQuestionByID(ctx, m.ID)
+			if err != nil {
+				return nil, err
+			}
+			if aq == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return []rbac.Owner{{UserID: aq.CandidateID}}, nil
+		},
+	}
+}
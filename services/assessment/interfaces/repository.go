@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/assessment/models"
 )
 
@@ -13,7 +14,7 @@ type Repository interface {
 	CreateAssessment(ctx context.Context, m *models.Assessment) (*models.Assessment, error)
 
 	// GetAllAssessments returns all Assessments
-	GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, error)
+	GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, *pagination.Info, error)
 
 	// GetAssessmentByID finds and returns a Assessment by ID
 	GetAssessmentByID(ctx context.Context, id uint64, role *string, cid *uint64) (*models.Assessment, error)
@@ -38,9 +39,22 @@ type Repository interface {
 	// UpdateAssessmentAttempt updates a AssessmentAttempt
 	UpdateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error)
 
+	// CompleteAssessmentAttempt updates a completed AssessmentAttempt together with the scores of its AttemptQuestions
+	CompleteAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error)
+
+	// GetCompletedAssessmentAttempts returns the completed AssessmentAttempts of Candidates
+	GetCompletedAssessmentAttempts(ctx context.Context, cids []uint64) ([]*models.AssessmentAttempt, error)
+
 	// DeleteAssessmentAttempt deletes a AssessmentAttempt by ID
 	DeleteAssessmentAttempt(ctx context.Context, id uint64) error
 
+	// GetCandidateAssessmentAttempts returns all AssessmentAttempts of a Candidate with their AttemptQuestions
+	GetCandidateAssessmentAttempts(ctx context.Context, cid uint64) ([]*models.AssessmentAttempt, error)
+
+	// DeleteCandidateAssessmentAttempts deletes all AssessmentAttempts and AttemptQuestions of a Candidate
+	// and returns how many were deleted
+	DeleteCandidateAssessmentAttempts(ctx context.Context, cid uint64) (uint64, error)
+
 	/* --------------- Question --------------- */
 
 	// CreateQuestion creates a new Question
@@ -50,7 +64,10 @@ type Repository interface {
 	BulkCreateQuestion(ctx context.Context, m []*models.Question) ([]*models.Question, error)
 
 	// GetAllQuestions returns all Questions
-	GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, error)
+	GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, *pagination.Info, error)
+
+	// GetQuestionPool returns the IDs and Tags of all Questions of an Assessment
+	GetQuestionPool(ctx context.Context, aid uint64) ([]*models.Question, error)
 
 	// GetQuestionByID finds and returns a Question by ID
 	GetQuestionByID(ctx context.Context, id uint64) (*models.Question, error)
@@ -76,4 +93,7 @@ type Repository interface {
 
 	// UpdateAttemptQuestion updates a AttemptQuestion
 	UpdateAttemptQuestion(ctx context.Context, m *models.AttemptQuestion) (*models.AttemptQuestion, error)
+
+	// SubmitAttemptQuestion updates a AttemptQuestion and the progress of its AssessmentAttempt
+	SubmitAttemptQuestion(ctx context.Context, m *models.AttemptQuestion, aa *models.AssessmentAttempt, prev uint64) (*models.AttemptQuestion, error)
 }
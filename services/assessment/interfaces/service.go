@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/assessment/models"
 )
 
@@ -13,7 +14,7 @@ type Service interface {
 	CreateAssessment(ctx context.Context, m *models.Assessment) (*models.Assessment, error)
 
 	// GetAllAssessments returns all Assessments
-	GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, error)
+	GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, *pagination.Info, error)
 
 	// GetAssessmentByID finds and returns a Assessment by ID
 	GetAssessmentByID(ctx context.Context, id uint64, role *string, cid *uint64) (*models.Assessment, error)
@@ -30,14 +31,14 @@ type Service interface {
 	CreateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error)
 
 	// GetAssessmentAttemptByID finds and returns a AssessmentAttempt by ID
-	GetAssessmentAttemptByID(ctx context.Context, id uint64) (*models.AssessmentAttempt, error)
+	GetAssessmentAttemptByID(ctx context.Context, id uint64, role *string, cid *uint64) (*models.AssessmentAttempt, error)
 
 	// LocalGetAssessmentAttemptByID returns a AssessmentAttempt by ID
 	// This method is only for local server to server communication
 	LocalGetAssessmentAttemptByID(ctx context.Context, id uint64) (*models.AssessmentAttempt, error)
 
 	// UpdateAssessmentAttempt updates a AssessmentAttempt
-	UpdateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error)
+	UpdateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt, role *string, cid *uint64) (*models.AssessmentAttempt, error)
 
 	// LocalUpdateAssessmentAttempt updates a AssessmentAttempt
 	// This method is only for local server to server communication
@@ -46,6 +47,18 @@ type Service interface {
 	// DeleteAssessmentAttempt deletes a AssessmentAttempt by ID
 	DeleteAssessmentAttempt(ctx context.Context, id uint64) error
 
+	// LocalGetCandidateScores returns the scores of Candidates on their completed AssessmentAttempts
+	// This method is only for local server to server communication
+	LocalGetCandidateScores(ctx context.Context, cids []uint64) ([]*models.CandidateScore, error)
+
+	// LocalExportCandidateData returns all data about a Candidate
+	// This method is only for local server to server communication
+	LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error)
+
+	// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased
+	// This method is only for local server to server communication
+	LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error)
+
 	/* --------------- Question --------------- */
 
 	// CreateQuestion creates a new Question
@@ -55,7 +68,7 @@ type Service interface {
 	BulkCreateQuestion(ctx context.Context, m []*models.Question) ([]*models.Question, error)
 
 	// GetAllQuestions returns all Questions
-	GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, error)
+	GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, *pagination.Info, error)
 
 	// GetQuestionByID finds and returns a Question by ID
 	GetQuestionByID(ctx context.Context, id uint64) (*models.Question, error)
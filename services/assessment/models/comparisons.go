@@ -19,7 +19,10 @@ func (m1 *Assessment) IsEqual(m2 interface{}) bool {
 		m1.Type != convertedM2.Type ||
 		m1.Randomise != convertedM2.Randomise ||
 		m1.NumQuestions != convertedM2.NumQuestions ||
-		m1.CanGoBack != convertedM2.CanGoBack {
+		m1.CanGoBack != convertedM2.CanGoBack ||
+		m1.NegativeMarking != convertedM2.NegativeMarking ||
+		m1.HideAnswers != convertedM2.HideAnswers ||
+		m1.StratifyByTag != convertedM2.StratifyByTag {
 		return false
 	}
 	return true
@@ -44,7 +47,8 @@ func (m1 *AssessmentAttempt) IsEqual(m2 interface{}) bool {
 		*m1.StartedAt != *convertedM2.StartedAt ||
 		*m1.CompletedAt != *convertedM2.CompletedAt ||
 		m1.CurrentQuestion != convertedM2.CurrentQuestion ||
-		m1.Score != convertedM2.Score {
+		m1.Score != convertedM2.Score ||
+		m1.Seed != convertedM2.Seed {
 		return false
 	}
 	return true
@@ -65,6 +69,9 @@ func (m1 *Question) IsEqual(m2 interface{}) bool {
 		m1.Code != convertedM2.Code ||
 		helpers.IsStringSliceEqual(m1.Options, convertedM2.Options) ||
 		m1.Answer != convertedM2.Answer ||
+		m1.Weight != convertedM2.Weight ||
+		m1.Penalty != convertedM2.Penalty ||
+		m1.Solution != convertedM2.Solution ||
 		m1.Type != convertedM2.Type {
 		return false
 	}
@@ -121,6 +128,7 @@ func (m1 *AttemptQuestion) IsEqual(m2 interface{}) bool {
 		m1.CMMode != convertedM2.CMMode ||
 		m1.Score != convertedM2.Score ||
 		m1.TimeTaken != convertedM2.TimeTaken ||
+		m1.Position != convertedM2.Position ||
 		*m1.CreatedAt != *convertedM2.CreatedAt ||
 		*m1.UpdatedAt != *convertedM2.UpdatedAt {
 		return false
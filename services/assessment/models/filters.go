@@ -1,5 +1,7 @@
 package models
 
+import "in-backend/pagination"
+
 // AssessmentFilters define filters for Assessment model
 type AssessmentFilters struct {
 	ID         []uint64
@@ -11,10 +13,14 @@ type AssessmentFilters struct {
 	CandidateID uint64
 	Status      []string
 	MinScore    int64
+
+	Page pagination.Params
 }
 
 // QuestionFilters define filters for Question model
 type QuestionFilters struct {
-	ID   []uint64
-	Tags []string
+	ID           []uint64
+	Tags         []string
+	AssessmentID uint64
+	Page         pagination.Params
 }
@@ -19,19 +19,22 @@ func init() {
 type Assessment struct {
 	tableName struct{} `pg:"assessments,alias:a"`
 
-	ID           uint64               `json:"id"`
-	Name         string               `json:"name" pg:",notnull,unique"`
-	Description  string               `json:"description"`
-	Notes        string               `json:"notes"`
-	ImageURL     string               `json:"image_url" pg:"image_url"`
-	Difficulty   string               `json:"difficulty"`
-	TimeAllowed  uint64               `json:"time_allowed"`
-	Type         string               `json:"type"`
-	Randomise    bool                 `json:"randomise"`
-	NumQuestions uint32               `json:"num_questions"`
-	CanGoBack    bool                 `json:"can_go_back"`
-	Questions    []*Question          `json:"questions,omitempty" pg:"many2many:assessments_questions"`
-	Attempts     []*AssessmentAttempt `json:"assessment_attempts,omitempty" pg:"rel:has-many"`
+	ID              uint64               `json:"id"`
+	Name            string               `json:"name" pg:",notnull,unique"`
+	Description     string               `json:"description"`
+	Notes           string               `json:"notes"`
+	ImageURL        string               `json:"image_url" pg:"image_url"`
+	Difficulty      string               `json:"difficulty"`
+	TimeAllowed     uint64               `json:"time_allowed"`
+	Type            string               `json:"type"`
+	Randomise       bool                 `json:"randomise"`
+	NumQuestions    uint32               `json:"num_questions"`
+	CanGoBack       bool                 `json:"can_go_back"`
+	NegativeMarking bool                 `json:"negative_marking" pg:",use_zero"`
+	HideAnswers     bool                 `json:"hide_answers" pg:",use_zero"`
+	StratifyByTag   bool                 `json:"stratify_by_tag" pg:",use_zero"`
+	Questions       []*Question          `json:"questions,omitempty" pg:"many2many:assessments_questions"`
+	Attempts        []*AssessmentAttempt `json:"assessment_attempts,omitempty" pg:"rel:has-many"`
 }
 
 // AssessmentAttempt declares the model for AssessmentAttempt
@@ -44,11 +47,13 @@ type AssessmentAttempt struct {
 	Status           string             `json:"string" pg:",notnull"`
 	StartedAt        *time.Time         `json:"started_at,omitempty"`
 	CompletedAt      *time.Time         `json:"completed_at,omitempty"`
-	CurrentQuestion  uint64             `json:"current_question"`
+	CurrentQuestion  uint64             `json:"current_question" pg:",use_zero"`
+	CurrentStartedAt *time.Time         `json:"current_started_at,omitempty"`
 	Score            int64              `json:"score,omitempty" pg:",use_zero"`
 	Assessment       *Assessment        `json:"assessment" pg:"rel:has-one"`
 	Questions        []*Question        `json:"questions" pg:",many2many:attempts_questions,fk:attempt_id,join_fk:question_id"`
 	QuestionAttempts []*AttemptQuestion `json:"question_attempts" pg:"rel:has-many,join_fk:attempt_id"`
+	Seed             int64              `json:"seed" pg:",use_zero"`
 }
 
 // Question declares the model for Question
@@ -63,6 +68,9 @@ type Question struct {
 	Code               string               `json:"code"`
 	Options            []string             `json:"options" pg:",array"`
 	Answer             int64                `json:"answer" pg:",use_zero"`
+	Weight             int64                `json:"weight" pg:"default:1"`
+	Penalty            int64                `json:"penalty" pg:",use_zero"`
+	Solution           string               `json:"solution"`
 	Tags               []*Tag               `json:"tags" pg:"many2many:questions_tags"`
 	Assessments        []*Assessment        `json:"assessments" pg:"many2many:assessments_questions"`
 	AssessmentAttempts []*AssessmentAttempt `json:"assessment_attempts" pg:",many2many:attempts_questions,fk:question_id,join_fk:attempt_id"`
@@ -84,6 +92,7 @@ type AttemptQuestion struct {
 	TimeTaken   uint64     `json:"time_taken,omitempty"`
 	CreatedAt   *time.Time `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at"`
+	Position    uint32     `json:"position" pg:",use_zero"`
 }
 
 // BeforeInsert handles the event before an AttemptQuestion is inserted into the DB
@@ -126,3 +135,19 @@ type AssessmentQuestion struct {
 	AssessmentID uint64 `json:"assessment_id" pg:"assessment_id,notnull"`
 	QuestionID   uint64 `json:"question_id" pg:"question_id,notnull"`
 }
+
+// CandidateScore declares the score obtained by a Candidate on a completed AssessmentAttempt
+type CandidateScore struct {
+	CandidateID  uint64     `json:"candidate_id"`
+	AssessmentID uint64     `json:"assessment_id"`
+	AttemptID    uint64     `json:"attempt_id"`
+	Score        int64      `json:"score"`
+	MaxScore     int64      `json:"max_score"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
+
+// CandidateData declares the data about a Candidate that is kept by the assessment service,
+// which is exported to the Candidate on request
+type CandidateData struct {
+	AssessmentAttempts []*AssessmentAttempt `json:"assessment_attempts"`
+}
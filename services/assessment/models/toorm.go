@@ -24,19 +24,22 @@ func AssessmentToORM(m *pb.Assessment) *Assessment {
 	}
 
 	return &Assessment{
-		ID:           m.Id,
-		Name:         m.Name,
-		Description:  m.Description,
-		Notes:        m.Notes,
-		ImageURL:     m.ImageUrl,
-		Difficulty:   m.Difficulty,
-		TimeAllowed:  m.TimeAllowed,
-		Type:         m.Type,
-		Randomise:    m.Randomise,
-		NumQuestions: m.NumQuestions,
-		CanGoBack:    m.CanGoBack,
-		Questions:    questions,
-		Attempts:     attempts,
+		ID:              m.Id,
+		Name:            m.Name,
+		Description:     m.Description,
+		Notes:           m.Notes,
+		ImageURL:        m.ImageUrl,
+		Difficulty:      m.Difficulty,
+		TimeAllowed:     m.TimeAllowed,
+		Type:            m.Type,
+		Randomise:       m.Randomise,
+		NumQuestions:    m.NumQuestions,
+		CanGoBack:       m.CanGoBack,
+		NegativeMarking: m.NegativeMarking,
+		HideAnswers:     m.HideAnswers,
+		StratifyByTag:   m.StratifyByTag,
+		Questions:       questions,
+		Attempts:        attempts,
 	}
 }
 
@@ -72,6 +75,7 @@ func AssessmentAttemptToORM(m *pb.AssessmentAttempt) *AssessmentAttempt {
 		Assessment:       AssessmentToORM(m.Assessment),
 		Questions:        questions,
 		QuestionAttempts: questionAttempts,
+		Seed:             m.Seed,
 	}
 }
 
@@ -114,6 +118,9 @@ func QuestionToORM(m *pb.Question) *Question {
 		Code:               m.Code,
 		Options:            m.Options,
 		Answer:             m.Answer,
+		Weight:             m.Weight,
+		Penalty:            m.Penalty,
+		Solution:           m.Solution,
 		Tags:               tags,
 		Assessments:        assessments,
 		AssessmentAttempts: assessmentAttempts,
@@ -163,6 +170,7 @@ func AttemptQuestionToORM(m *pb.AttemptQuestion) *AttemptQuestion {
 		CMMode:      m.CmMode,
 		Score:       m.Score,
 		TimeTaken:   m.TimeTaken,
+		Position:    m.Position,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 	}
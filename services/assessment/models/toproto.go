@@ -24,19 +24,22 @@ func (m *Assessment) ToProto() *pb.Assessment {
 	}
 
 	return &pb.Assessment{
-		Id:           m.ID,
-		Name:         m.Name,
-		Description:  m.Description,
-		Notes:        m.Notes,
-		ImageUrl:     m.ImageURL,
-		Difficulty:   m.Difficulty,
-		TimeAllowed:  m.TimeAllowed,
-		Type:         m.Type,
-		Randomise:    m.Randomise,
-		NumQuestions: m.NumQuestions,
-		CanGoBack:    m.CanGoBack,
-		Questions:    questions,
-		Attempts:     attempts,
+		Id:              m.ID,
+		Name:            m.Name,
+		Description:     m.Description,
+		Notes:           m.Notes,
+		ImageUrl:        m.ImageURL,
+		Difficulty:      m.Difficulty,
+		TimeAllowed:     m.TimeAllowed,
+		Type:            m.Type,
+		Randomise:       m.Randomise,
+		NumQuestions:    m.NumQuestions,
+		CanGoBack:       m.CanGoBack,
+		NegativeMarking: m.NegativeMarking,
+		HideAnswers:     m.HideAnswers,
+		StratifyByTag:   m.StratifyByTag,
+		Questions:       questions,
+		Attempts:        attempts,
 	}
 }
 
@@ -72,6 +75,7 @@ func (m *AssessmentAttempt) ToProto() *pb.AssessmentAttempt {
 		Assessment:       m.Assessment.ToProto(),
 		Questions:        questions,
 		QuestionAttempts: questionAttempts,
+		Seed:             m.Seed,
 	}
 }
 
@@ -114,6 +118,9 @@ func (m *Question) ToProto() *pb.Question {
 		Code:               m.Code,
 		Options:            m.Options,
 		Answer:             m.Answer,
+		Weight:             m.Weight,
+		Penalty:            m.Penalty,
+		Solution:           m.Solution,
 		Tags:               tags,
 		Assessments:        assessments,
 		AssessmentAttempts: assessmentAttempts,
@@ -163,6 +170,7 @@ func (m *AttemptQuestion) ToProto() *pb.AttemptQuestion {
 		CmMode:      m.CMMode,
 		Score:       m.Score,
 		TimeTaken:   m.TimeTaken,
+		Position:    m.Position,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 	}
@@ -179,3 +187,21 @@ func (m *AssessmentQuestion) ToProto() *pb.AssessmentQuestion {
 		QuestionId:   m.QuestionID,
 	}
 }
+
+// ToProto maps the CandidateScore model to the proto model
+func (m *CandidateScore) ToProto() *pb.CandidateScore {
+	if m == nil {
+		return nil
+	}
+
+	completedAt := helpers.TimeToProto(m.CompletedAt)
+
+	return &pb.CandidateScore{
+		CandidateId:  m.CandidateID,
+		AssessmentId: m.AssessmentID,
+		AttemptId:    m.AttemptID,
+		Score:        m.Score,
+		MaxScore:     m.MaxScore,
+		CompletedAt:  completedAt,
+	}
+}
@@ -19,7 +19,10 @@ func (m1 *Assessment) IsEqual(m2 interface{}) bool {
 		m1.Type != convertedM2.Type ||
 		m1.Randomise != convertedM2.Randomise ||
 		m1.NumQuestions != convertedM2.NumQuestions ||
-		m1.CanGoBack != convertedM2.CanGoBack {
+		m1.CanGoBack != convertedM2.CanGoBack ||
+		m1.NegativeMarking != convertedM2.NegativeMarking ||
+		m1.HideAnswers != convertedM2.HideAnswers ||
+		m1.StratifyByTag != convertedM2.StratifyByTag {
 		return false
 	}
 	return true
@@ -39,7 +42,8 @@ func (m1 *AssessmentAttempt) IsEqual(m2 interface{}) bool {
 		m1.StartedAt.AsTime() != convertedM2.StartedAt.AsTime() ||
 		m1.CompletedAt.AsTime() != convertedM2.CompletedAt.AsTime() ||
 		m1.CurrentQuestion != convertedM2.CurrentQuestion ||
-		m1.Score != convertedM2.Score {
+		m1.Score != convertedM2.Score ||
+		m1.Seed != convertedM2.Seed {
 		return false
 	}
 	return true
@@ -60,6 +64,9 @@ func (m1 *Question) IsEqual(m2 interface{}) bool {
 		m1.Code != convertedM2.Code ||
 		!helpers.IsStringSliceEqual(m1.Options, convertedM2.Options) ||
 		m1.Answer != convertedM2.Answer ||
+		m1.Weight != convertedM2.Weight ||
+		m1.Penalty != convertedM2.Penalty ||
+		m1.Solution != convertedM2.Solution ||
 		m1.Type != convertedM2.Type {
 		return false
 	}
@@ -111,6 +118,7 @@ func (m1 *AttemptQuestion) IsEqual(m2 interface{}) bool {
 		m1.CmMode != convertedM2.CmMode ||
 		m1.Score != convertedM2.Score ||
 		m1.TimeTaken != convertedM2.TimeTaken ||
+		m1.Position != convertedM2.Position ||
 		m1.CreatedAt.AsTime() != convertedM2.CreatedAt.AsTime() ||
 		m1.UpdatedAt.AsTime() != convertedM2.UpdatedAt.AsTime() {
 		return false
@@ -0,0 +1,6 @@
+alter table assessments
+drop column negative_marking;
+
+alter table questions
+drop column weight,
+drop column penalty;
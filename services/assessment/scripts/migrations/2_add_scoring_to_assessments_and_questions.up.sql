@@ -0,0 +1,6 @@
+alter table assessments
+add negative_marking boolean not null default false;
+
+alter table questions
+add weight bigint not null default 1,
+add penalty bigint not null default 0;
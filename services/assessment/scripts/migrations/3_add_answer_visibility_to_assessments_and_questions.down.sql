@@ -0,0 +1,5 @@
+alter table assessments
+drop column hide_answers;
+
+alter table questions
+drop column solution;
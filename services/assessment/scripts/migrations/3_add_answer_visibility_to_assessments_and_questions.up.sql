@@ -0,0 +1,5 @@
+alter table assessments
+add hide_answers boolean not null default false;
+
+alter table questions
+add solution text;
@@ -0,0 +1,8 @@
+alter table assessments
+drop column stratify_by_tag;
+
+alter table assessment_attempts
+drop column seed;
+
+alter table attempts_questions
+drop column position;
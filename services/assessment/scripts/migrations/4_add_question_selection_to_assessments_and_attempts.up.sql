@@ -0,0 +1,8 @@
+alter table assessments
+add stratify_by_tag boolean not null default false;
+
+alter table assessment_attempts
+add seed bigint not null default 0;
+
+alter table attempts_questions
+add position integer not null default 0;
@@ -0,0 +1,4 @@
+alter table assessment_attempts
+alter column current_question drop not null,
+alter column current_question drop default,
+drop column current_started_at;
@@ -0,0 +1,8 @@
+update assessment_attempts
+set current_question = 0
+where current_question is null;
+
+alter table assessment_attempts
+alter column current_question set default 0,
+alter column current_question set not null,
+add current_started_at timestamptz;
@@ -2,143 +2,68 @@ package middlewares
 
 import (
 	"context"
-	"errors"
+	"in-backend/pagination"
+	"in-backend/rbac"
 	"in-backend/services/assessment/interfaces"
 	"in-backend/services/assessment/models"
-	"strconv"
-	"strings"
-
-	"github.com/dgrijalva/jwt-go"
-	"google.golang.org/grpc/metadata"
 )
 
 type authMiddleware struct {
 	next       interfaces.Service
-	repository interfaces.Repository
+	authorizer *rbac.Authorizer
 }
 
-var (
-	errAuth = errors.New("Forbidden")
-
-	idKey    = "https://hubbedin/id"
-	rolesKey = "https://hubbedin/roles"
-)
-
-// NewAuthMiddleware creates and returns a new Auth Middleware that implements the assessment Service interface
-func NewAuthMiddleware(svc interfaces.Service, r interfaces.Repository) interfaces.Service {
+// NewAuthMiddleware creates and returns a new Auth Middleware that implements the assessment Service interface.
+// Callers are authorized by the policy of the service before their requests reach the service,
+// so this middleware only passes the scope of the caller to the methods that hide details from them
+func NewAuthMiddleware(svc interfaces.Service, a *rbac.Authorizer) interfaces.Service {
 	return &authMiddleware{
 		next:       svc,
-		repository: r,
+		authorizer: a,
 	}
 }
 
-func getRoleAndID(ctx context.Context, ownerID *uint64) (*string, *uint64, error) {
-	claims, err := getClaims(ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-
+// getRoleAndID returns the role and ID that the service scopes its results by.
+// Callers allowed to see every detail of assessments have the Admin role of the service
+func (mw authMiddleware) getRoleAndID(ctx context.Context) (*string, *uint64) {
 	var role string = ""
 	var id uint64 = 0
 
-	// this should come first so that role gets overwritten if owner is also an admin
-	if claims[idKey] != nil {
-		id, err = strconv.ParseUint(claims[idKey].(string), 10, 64)
-		if err != nil {
-			return nil, nil, err
-		}
-		if ownerID != nil && id == *ownerID {
-			role = "Owner"
-		}
-	}
-
-	if claims[rolesKey] != nil {
-		for _, r := range claims[rolesKey].([]interface{}) {
-			roleCast := r.(string)
-			if roleCast == "Admin" {
-				role = "Admin"
-			}
-		}
-	}
-
-	return &role, &id, nil
-}
-
-func getClaims(ctx context.Context) (jwt.MapClaims, error) {
-	headers, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, errAuth
-	}
-
-	if len(headers["authorization"]) == 0 {
-		return nil, errAuth
-	}
-	tokenString := strings.Split(headers["authorization"][0], " ")[1]
-	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
-	if err != nil {
-		return nil, errAuth
+	if mw.authorizer.Authorize(ctx, "ViewAllAssessmentDetails") == nil {
+		role = "Admin"
 	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, errAuth
+	if s := rbac.FromContext(ctx); s != nil {
+		id = s.ID
 	}
-	return claims, nil
+	return &role, &id
 }
 
 /* --------------- Assessment --------------- */
 
 // CreateAssessment creates a new Assessment
 func (mw authMiddleware) CreateAssessment(ctx context.Context, m *models.Assessment) (*models.Assessment, error) {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
 	return mw.next.CreateAssessment(ctx, m)
 }
 
 // GetAllAssessments returns all Assessments
-func (mw authMiddleware) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, _ *string, _ *uint64) ([]*models.Assessment, error) {
-	role, cid, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
+func (mw authMiddleware) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, _ *string, _ *uint64) ([]*models.Assessment, *pagination.Info, error) {
+	role, cid := mw.getRoleAndID(ctx)
 	return mw.next.GetAllAssessments(ctx, f, role, cid)
 }
 
 // GetAssessmentByID returns a Assessment by ID
 func (mw authMiddleware) GetAssessmentByID(ctx context.Context, id uint64, _ *string, _ *uint64) (*models.Assessment, error) {
-	role, cid, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
+	role, cid := mw.getRoleAndID(ctx)
 	return mw.next.GetAssessmentByID(ctx, id, role, cid)
 }
 
 // UpdateAssessment updates a Assessment
 func (mw authMiddleware) UpdateAssessment(ctx context.Context, m *models.Assessment) (*models.Assessment, error) {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
 	return mw.next.UpdateAssessment(ctx, m)
 }
 
 // DeleteAssessment deletes a Assessment by ID
 func (mw authMiddleware) DeleteAssessment(ctx context.Context, id uint64) error {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" {
-		return errAuth
-	}
 	return mw.next.DeleteAssessment(ctx, id)
 }
 
@@ -146,30 +71,13 @@ func (mw authMiddleware) DeleteAssessment(ctx context.Context, id uint64) error
 
 // CreateAssessmentAttempt creates a new AssessmentAttempt
 func (mw authMiddleware) CreateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
-	role, _, err := getRoleAndID(ctx, &m.CandidateID)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.CreateAssessmentAttempt(ctx, m)
 }
 
 // GetAssessmentAttemptByID returns a AssessmentAttempt by ID
-func (mw authMiddleware) GetAssessmentAttemptByID(ctx context.Context, id uint64) (*models.AssessmentAttempt, error) {
-	aa, err := mw.repository.GetAssessmentAttemptByID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-	role, _, err := getRoleAndID(ctx, &aa.CandidateID)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
-	return mw.next.GetAssessmentAttemptByID(ctx, id)
+func (mw authMiddleware) GetAssessmentAttemptByID(ctx context.Context, id uint64, _ *string, _ *uint64) (*models.AssessmentAttempt, error) {
+	role, cid := mw.getRoleAndID(ctx)
+	return mw.next.GetAssessmentAttemptByID(ctx, id, role, cid)
 }
 
 // LocalGetAssessmentAttemptByID returns a AssessmentAttempt by ID
@@ -179,19 +87,9 @@ func (mw authMiddleware) LocalGetAssessmentAttemptByID(ctx context.Context, id u
 }
 
 // UpdateAssessmentAttempt updates a AssessmentAttempt
-func (mw authMiddleware) UpdateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
-	aa, err := mw.repository.GetAssessmentAttemptByID(ctx, m.ID)
-	if err != nil {
-		return nil, err
-	}
-	role, _, err := getRoleAndID(ctx, &aa.CandidateID)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
-	return mw.next.UpdateAssessmentAttempt(ctx, m)
+func (mw authMiddleware) UpdateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt, _ *string, _ *uint64) (*models.AssessmentAttempt, error) {
+	role, cid := mw.getRoleAndID(ctx)
+	return mw.next.UpdateAssessmentAttempt(ctx, m, role, cid)
 }
 
 // LocalUpdateAssessmentAttempt updates a AssessmentAttempt
@@ -202,87 +100,56 @@ func (mw authMiddleware) LocalUpdateAssessmentAttempt(ctx context.Context, m *mo
 
 // DeleteAssessmentAttempt deletes a AssessmentAttempt by ID
 func (mw authMiddleware) DeleteAssessmentAttempt(ctx context.Context, id uint64) error {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" {
-		return errAuth
-	}
 	return mw.next.DeleteAssessmentAttempt(ctx, id)
 }
 
+// LocalGetCandidateScores returns the scores of Candidates on their completed AssessmentAttempts
+// This method is only for local server to server communication
+func (mw authMiddleware) LocalGetCandidateScores(ctx context.Context, cids []uint64) ([]*models.CandidateScore, error) {
+	return mw.next.LocalGetCandidateScores(ctx, cids)
+}
+
+// LocalExportCandidateData returns all data about a Candidate
+// This method is only for local server to server communication
+func (mw authMiddleware) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	return mw.next.LocalExportCandidateData(ctx, cid)
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased
+// This method is only for local server to server communication
+func (mw authMiddleware) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	return mw.next.LocalEraseCandidateData(ctx, cid)
+}
+
 /* --------------- Question --------------- */
 
 // CreateQuestion creates a new Question
 func (mw authMiddleware) CreateQuestion(ctx context.Context, m *models.Question) (*models.Question, error) {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
 	return mw.next.CreateQuestion(ctx, m)
 }
 
 // BulkCreateQuestion creates a new Question
 func (mw authMiddleware) BulkCreateQuestion(ctx context.Context, m []*models.Question) ([]*models.Question, error) {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
 	return mw.next.BulkCreateQuestion(ctx, m)
 }
 
 // GetAllQuestions returns all Questions
-func (mw authMiddleware) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, error) {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
+func (mw authMiddleware) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, *pagination.Info, error) {
 	return mw.next.GetAllQuestions(ctx, f)
 }
 
 // GetQuestionByID returns a Question by ID
 func (mw authMiddleware) GetQuestionByID(ctx context.Context, id uint64) (*models.Question, error) {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
 	return mw.next.GetQuestionByID(ctx, id)
 }
 
 // UpdateQuestion updates a Question
 func (mw authMiddleware) UpdateQuestion(ctx context.Context, m *models.Question) (*models.Question, error) {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
 	return mw.next.UpdateQuestion(ctx, m)
 }
 
 // DeleteQuestion deletes a Question by ID
 func (mw authMiddleware) DeleteQuestion(ctx context.Context, id uint64) error {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" {
-		return errAuth
-	}
 	return mw.next.DeleteQuestion(ctx, id)
 }
 
@@ -295,13 +162,6 @@ func (mw authMiddleware) CreateTag(ctx context.Context, m *models.Tag) (*models.
 
 // DeleteTag deletes a Tag by ID
 func (mw authMiddleware) DeleteTag(ctx context.Context, id uint64) error {
-	role, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" {
-		return errAuth
-	}
 	return mw.next.DeleteTag(ctx, id)
 }
 
@@ -309,16 +169,5 @@ func (mw authMiddleware) DeleteTag(ctx context.Context, id uint64) error {
 
 // UpdateAttemptQuestion updates a AttemptQuestion
 func (mw authMiddleware) UpdateAttemptQuestion(ctx context.Context, m *models.AttemptQuestion) (*models.AttemptQuestion, error) {
-	aq, err := mw.repository.GetAttemptQuestionByID(ctx, m.ID)
-	if err != nil {
-		return nil, err
-	}
-	role, _, err := getRoleAndID(ctx, &aq.CandidateID)
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.UpdateAttemptQuestion(ctx, m)
 }
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"context"
 	"fmt"
+	"in-backend/pagination"
 	"in-backend/services/assessment/interfaces"
 	"in-backend/services/assessment/models"
 	"time"
@@ -50,9 +51,9 @@ func (mw logMiddleware) CreateAssessment(ctx context.Context, input *models.Asse
 }
 
 // GetAllAssessments returns all Assessments
-func (mw logMiddleware) GetAllAssessments(ctx context.Context, input models.AssessmentFilters, role *string, cid *uint64) (output []*models.Assessment, err error) {
+func (mw logMiddleware) GetAllAssessments(ctx context.Context, input models.AssessmentFilters, role *string, cid *uint64) (output []*models.Assessment, page *pagination.Info, err error) {
 	defer mw.log("GetAllAssessments", time.Now(), input, &output, &err)
-	output, err = mw.next.GetAllAssessments(ctx, input, role, cid)
+	output, page, err = mw.next.GetAllAssessments(ctx, input, role, cid)
 	return
 }
 
@@ -87,9 +88,9 @@ func (mw logMiddleware) CreateAssessmentAttempt(ctx context.Context, input *mode
 }
 
 // GetAssessmentAttemptByID returns a AssessmentAttempt by ID
-func (mw logMiddleware) GetAssessmentAttemptByID(ctx context.Context, input uint64) (output *models.AssessmentAttempt, err error) {
+func (mw logMiddleware) GetAssessmentAttemptByID(ctx context.Context, input uint64, role *string, cid *uint64) (output *models.AssessmentAttempt, err error) {
 	defer mw.log("GetAssessmentAttemptByID", time.Now(), input, &output, &err)
-	output, err = mw.next.GetAssessmentAttemptByID(ctx, input)
+	output, err = mw.next.GetAssessmentAttemptByID(ctx, input, role, cid)
 	return
 }
 
@@ -102,9 +103,9 @@ func (mw logMiddleware) LocalGetAssessmentAttemptByID(ctx context.Context, input
 }
 
 // UpdateAssessmentAttempt updates a AssessmentAttempt
-func (mw logMiddleware) UpdateAssessmentAttempt(ctx context.Context, input *models.AssessmentAttempt) (output *models.AssessmentAttempt, err error) {
+func (mw logMiddleware) UpdateAssessmentAttempt(ctx context.Context, input *models.AssessmentAttempt, role *string, cid *uint64) (output *models.AssessmentAttempt, err error) {
 	defer mw.log("UpdateAssessmentAttempt", time.Now(), input, &output, &err)
-	output, err = mw.next.UpdateAssessmentAttempt(ctx, input)
+	output, err = mw.next.UpdateAssessmentAttempt(ctx, input, role, cid)
 	return
 }
 
@@ -123,6 +124,27 @@ func (mw logMiddleware) DeleteAssessmentAttempt(ctx context.Context, input uint6
 	return
 }
 
+// LocalGetCandidateScores returns the scores of Candidates on their completed AssessmentAttempts
+func (mw logMiddleware) LocalGetCandidateScores(ctx context.Context, input []uint64) (output []*models.CandidateScore, err error) {
+	defer mw.log("LocalGetCandidateScores", time.Now(), input, &output, &err)
+	output, err = mw.next.LocalGetCandidateScores(ctx, input)
+	return
+}
+
+// LocalExportCandidateData returns all data about a Candidate
+func (mw logMiddleware) LocalExportCandidateData(ctx context.Context, input uint64) (output *models.CandidateData, err error) {
+	defer mw.log("LocalExportCandidateData", time.Now(), input, nil, &err)
+	output, err = mw.next.LocalExportCandidateData(ctx, input)
+	return
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased
+func (mw logMiddleware) LocalEraseCandidateData(ctx context.Context, input uint64) (output uint64, err error) {
+	defer mw.log("LocalEraseCandidateData", time.Now(), input, &output, &err)
+	output, err = mw.next.LocalEraseCandidateData(ctx, input)
+	return
+}
+
 /* --------------- Question --------------- */
 
 // CreateQuestion creates a new Question
@@ -140,9 +162,9 @@ func (mw logMiddleware) BulkCreateQuestion(ctx context.Context, input []*models.
 }
 
 // GetAllQuestions returns all Questions
-func (mw logMiddleware) GetAllQuestions(ctx context.Context, input models.QuestionFilters) (output []*models.Question, err error) {
+func (mw logMiddleware) GetAllQuestions(ctx context.Context, input models.QuestionFilters) (output []*models.Question, page *pagination.Info, err error) {
 	defer mw.log("GetAllQuestions", time.Now(), input, &output, &err)
-	output, err = mw.next.GetAllQuestions(ctx, input)
+	output, page, err = mw.next.GetAllQuestions(ctx, input)
 	return
 }
 
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"time"
+
+	"in-backend/services/assessment/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// submissionGrace allows for network latency on answers submitted right before the time allowed runs out
+const submissionGrace = 5 * time.Second
+
+var (
+	errAttemptQuestionNotFound = status.Error(codes.NotFound, "Attempt question not found")
+	errAttemptCompleted        = status.Error(codes.FailedPrecondition, "Assessment attempt has already been completed")
+	errAttemptTimeUp           = status.Error(codes.DeadlineExceeded, "Time allowed for assessment attempt has passed")
+	errQuestionNotReached      = status.Error(codes.FailedPrecondition, "Question has not been reached yet")
+	errCannotGoBack            = status.Error(codes.FailedPrecondition, "Assessment does not allow going back to previous questions")
+)
+
+// checkSubmission returns an error if the answer to aq cannot be accepted for aa at time now
+func checkSubmission(aa *models.AssessmentAttempt, aq *models.AttemptQuestion, now time.Time) error {
+	if aa.Status == statusCompleted || aa.CompletedAt != nil {
+		return errAttemptCompleted
+	}
+
+	if aa.Assessment != nil && aa.Assessment.TimeAllowed > 0 && aa.StartedAt != nil {
+		deadline := aa.StartedAt.Add(time.Duration(aa.Assessment.TimeAllowed) * time.Second)
+		if now.After(deadline.Add(submissionGrace)) {
+			return errAttemptTimeUp
+		}
+	}
+
+	pos := uint64(aq.Position)
+	if pos > aa.CurrentQuestion {
+		return errQuestionNotReached
+	}
+	if pos < aa.CurrentQuestion && (aa.Assessment == nil || !aa.Assessment.CanGoBack) {
+		return errCannotGoBack
+	}
+	return nil
+}
+
+// recordSubmission records the time taken on aq and advances aa past it if aq is the current question.
+// Time spent revisiting earlier questions cannot be measured from the server and is not recorded
+func recordSubmission(aa *models.AssessmentAttempt, aq *models.AttemptQuestion, now time.Time) {
+	if uint64(aq.Position) != aa.CurrentQuestion {
+		return
+	}
+
+	start := aa.CurrentStartedAt
+	if start == nil {
+		start = aa.StartedAt
+	}
+	if start != nil && now.After(*start) {
+		aq.TimeTaken += uint64(now.Sub(*start) / time.Second)
+	}
+
+	aa.CurrentQuestion++
+	aa.CurrentStartedAt = &now
+}
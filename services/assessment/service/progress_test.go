@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"in-backend/services/assessment/models"
+	"in-backend/services/assessment/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/gocraft/work"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckSubmission(t *testing.T) {
+	now := time.Now()
+	started := now.Add(-time.Minute)
+	forward := &models.Assessment{TimeAllowed: 600}
+	back := &models.Assessment{TimeAllowed: 600, CanGoBack: true}
+
+	var tests = []struct {
+		name string
+		aa   *models.AssessmentAttempt
+		pos  uint32
+		code codes.Code
+	}{
+		{"current question", &models.AssessmentAttempt{Assessment: forward, StartedAt: &started, CurrentQuestion: 1}, 1, codes.OK},
+		{"skip ahead", &models.AssessmentAttempt{Assessment: back, StartedAt: &started, CurrentQuestion: 1}, 2, codes.FailedPrecondition},
+		{"go back not allowed", &models.AssessmentAttempt{Assessment: forward, StartedAt: &started, CurrentQuestion: 1}, 0, codes.FailedPrecondition},
+		{"go back allowed", &models.AssessmentAttempt{Assessment: back, StartedAt: &started, CurrentQuestion: 1}, 0, codes.OK},
+		{"completed", &models.AssessmentAttempt{Assessment: back, StartedAt: &started, Status: statusCompleted}, 0, codes.FailedPrecondition},
+		{"completion recorded", &models.AssessmentAttempt{Assessment: back, StartedAt: &started, CompletedAt: &now}, 0, codes.FailedPrecondition},
+		{"time up", &models.AssessmentAttempt{Assessment: &models.Assessment{TimeAllowed: 30}, StartedAt: &started}, 0, codes.DeadlineExceeded},
+		{"no time limit", &models.AssessmentAttempt{Assessment: &models.Assessment{}, StartedAt: &started}, 0, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSubmission(tt.aa, &models.AttemptQuestion{Position: tt.pos}, now)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestRecordSubmission(t *testing.T) {
+	now := time.Now()
+	started := now.Add(-90 * time.Second)
+	current := now.Add(-20 * time.Second)
+
+	aa := &models.AssessmentAttempt{StartedAt: &started, CurrentStartedAt: &current, CurrentQuestion: 1}
+
+	// revisiting an earlier question does not advance the attempt
+	earlier := &models.AttemptQuestion{Position: 0, TimeTaken: 70}
+	recordSubmission(aa, earlier, now)
+	require.Equal(t, uint64(70), earlier.TimeTaken)
+	require.Equal(t, uint64(1), aa.CurrentQuestion)
+
+	aq := &models.AttemptQuestion{Position: 1, TimeTaken: 999}
+	recordSubmission(aa, aq, now)
+	require.Equal(t, uint64(999+20), aq.TimeTaken)
+	require.Equal(t, uint64(2), aa.CurrentQuestion)
+	require.Equal(t, now, *aa.CurrentStartedAt)
+}
+
+func TestUpdateAttemptQuestionIgnoresClientProgress(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil)
+	ctx := context.Background()
+
+	started := time.Now().Add(-30 * time.Second)
+	aq := &models.AttemptQuestion{ID: 1, AttemptID: 2, Selection: -1, Score: -1}
+	aa := &models.AssessmentAttempt{
+		ID:         2,
+		Status:     "In Progress",
+		StartedAt:  &started,
+		Assessment: &models.Assessment{TimeAllowed: 600},
+	}
+	input := &models.AttemptQuestion{ID: 1, Selection: 2, TimeTaken: 1, Score: 10, Position: 5}
+
+	repo.On("GetAttemptQuestionByID", ctx, uint64(1)).Return(aq, nil)
+	repo.On("GetAssessmentAttemptByID", ctx, uint64(2)).Return(aa, nil)
+	repo.On("SubmitAttemptQuestion", ctx, aq, aa, uint64(0)).
+		Return(func(_ context.Context, m *models.AttemptQuestion, _ *models.AssessmentAttempt, _ uint64) *models.AttemptQuestion {
+			return m
+		}, nil)
+
+	got, err := s.UpdateAttemptQuestion(ctx, input)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), got.Selection)
+	require.Equal(t, int64(-1), got.Score)
+	require.Equal(t, uint32(0), got.Position)
+	require.GreaterOrEqual(t, got.TimeTaken, uint64(30))
+	require.Equal(t, uint64(1), aa.CurrentQuestion)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateAttemptQuestionNotFound(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil)
+	ctx := context.Background()
+
+	repo.On("GetAttemptQuestionByID", ctx, uint64(1)).Return(nil, nil)
+
+	_, err := s.UpdateAttemptQuestion(ctx, &models.AttemptQuestion{ID: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	repo.AssertNotCalled(t, "SubmitAttemptQuestion", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateAssessmentAttemptCannotReopen(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil)
+	ctx := context.Background()
+	candidate := "Candidate"
+	cid := uint64(3)
+
+	aa := &models.AssessmentAttempt{ID: 1, AssessmentID: 2, CandidateID: 3, Status: "Completed", Seed: 42}
+	repo.On("GetAssessmentAttemptByID", ctx, uint64(1)).Return(aa, nil)
+
+	input := &models.AssessmentAttempt{ID: 1, AssessmentID: 2, CandidateID: 3, Status: "In Progress"}
+	_, err := s.UpdateAssessmentAttempt(ctx, input, &candidate, &cid)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	repo.AssertNotCalled(t, "UpdateAssessmentAttempt", mock.Anything, mock.Anything)
+}
+
+func TestUpdateAssessmentAttemptKeepsIdentity(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil)
+	ctx := context.Background()
+	admin := "Admin"
+
+	aa := &models.AssessmentAttempt{ID: 1, AssessmentID: 2, CandidateID: 3, Status: "In Progress", Seed: 42}
+	input := &models.AssessmentAttempt{ID: 1, AssessmentID: 5, CandidateID: 6, Status: "In Progress", Seed: 7}
+
+	repo.On("GetAssessmentAttemptByID", ctx, uint64(1)).Return(aa, nil)
+	repo.On("UpdateAssessmentAttempt", ctx, input).
+		Return(func(_ context.Context, m *models.AssessmentAttempt) *models.AssessmentAttempt { return m }, nil)
+
+	got, err := s.UpdateAssessmentAttempt(ctx, input, &admin, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), got.AssessmentID)
+	require.Equal(t, uint64(3), got.CandidateID)
+	require.Equal(t, int64(42), got.Seed)
+	repo.AssertExpectations(t)
+}
+
+// newTestEnqueuer returns an Enqueuer whose jobs fail to be enqueued, as scheduling the end of an attempt is best effort
+func newTestEnqueuer() *work.Enqueuer {
+	return work.NewEnqueuer("test", &redis.Pool{
+		Dial: func() (redis.Conn, error) { return nil, errors.New("redis is down") },
+	})
+}
+
+func TestCreateAssessmentAttemptIgnoresClientCompletion(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, newTestEnqueuer(), nil)
+	ctx := context.Background()
+	candidate := "Candidate"
+	cid := uint64(3)
+
+	completedAt := time.Now()
+	a := &models.Assessment{ID: 2, TimeAllowed: 600}
+	input := &models.AssessmentAttempt{
+		AssessmentID:     2,
+		CandidateID:      3,
+		Status:           statusCompleted,
+		CompletedAt:      &completedAt,
+		Score:            100,
+		QuestionAttempts: []*models.AttemptQuestion{{QuestionID: 1, Selection: 2, Score: 1}},
+	}
+
+	repo.On("GetLatestAssessmentAttemptByCandidate", ctx, uint64(3)).Return(nil, nil)
+	repo.On("GetAssessmentByID", ctx, uint64(2), mock.Anything, (*uint64)(nil)).Return(a, nil)
+	repo.On("GetQuestionPool", ctx, uint64(2)).Return([]*models.Question{{ID: 1, Answer: 2}}, nil)
+	repo.On("CreateAssessmentAttempt", ctx, input).
+		Return(func(_ context.Context, m *models.AssessmentAttempt) *models.AssessmentAttempt {
+			m.ID = 1
+			m.Assessment = a
+			return m
+		}, nil)
+
+	got, err := s.CreateAssessmentAttempt(ctx, input)
+	require.NoError(t, err)
+	require.Equal(t, statusInProgress, got.Status)
+	require.Nil(t, got.CompletedAt)
+	require.Equal(t, int64(0), got.Score)
+	require.Nil(t, got.QuestionAttempts)
+
+	// the answers stay hidden from the candidate, as the attempt has not been completed
+	repo.On("GetAssessmentAttemptByID", ctx, uint64(1)).Return(got, nil)
+	aa, err := s.GetAssessmentAttemptByID(ctx, 1, &candidate, &cid)
+	require.NoError(t, err)
+	require.Equal(t, hiddenAnswer, aa.Questions[0].Answer)
+}
+
+func TestCreateAssessmentAttemptAssessmentNotFound(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, newTestEnqueuer(), nil)
+	ctx := context.Background()
+
+	repo.On("GetLatestAssessmentAttemptByCandidate", ctx, uint64(3)).Return(nil, nil)
+	repo.On("GetAssessmentByID", ctx, uint64(9), mock.Anything, (*uint64)(nil)).Return(nil, nil)
+
+	_, err := s.CreateAssessmentAttempt(ctx, &models.AssessmentAttempt{AssessmentID: 9, CandidateID: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	repo.AssertNotCalled(t, "CreateAssessmentAttempt", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"in-backend/services/assessment/models"
+)
+
+// hiddenAnswer replaces the answer of a Question that the caller is not allowed to see
+const hiddenAnswer int64 = -1
+
+func isAdmin(role *string) bool {
+	return role != nil && *role == "Admin"
+}
+
+// canViewAnswers tells whether the caller may see the answers to the questions of an AssessmentAttempt.
+// Admins can always see answers, while candidates only see them once the server has recorded the
+// completion of the attempt and the assessment does not hide its answers
+func canViewAnswers(role *string, aa *models.AssessmentAttempt) bool {
+	if aa == nil || isAdmin(role) {
+		return true
+	}
+	if aa.CompletedAt == nil {
+		return false
+	}
+	return aa.Assessment == nil || !aa.Assessment.HideAnswers
+}
+
+// redactQuestion strips the answer and code solution from a Question
+func redactQuestion(q *models.Question) {
+	if q == nil {
+		return
+	}
+	q.Answer = hiddenAnswer
+	q.Solution = ""
+}
+
+// redactAssessment strips answers and code solutions from the questions of an Assessment
+func redactAssessment(a *models.Assessment) {
+	if a == nil {
+		return
+	}
+	for _, q := range a.Questions {
+		redactQuestion(q)
+	}
+}
+
+// redactAssessmentAttempt strips answers and code solutions from the questions of an AssessmentAttempt
+func redactAssessmentAttempt(aa *models.AssessmentAttempt) {
+	if aa == nil {
+		return
+	}
+	redactAssessment(aa.Assessment)
+	for _, q := range aa.Questions {
+		redactQuestion(q)
+	}
+}
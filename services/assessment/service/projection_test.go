@@ -0,0 +1,58 @@
+package service
+
+import (
+	"in-backend/services/assessment/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanViewAnswers(t *testing.T) {
+	admin := "Admin"
+	owner := "Owner"
+
+	now := time.Now()
+	inProgress := &models.AssessmentAttempt{Status: "In Progress"}
+	completed := &models.AssessmentAttempt{Status: statusCompleted, CompletedAt: &now, Assessment: &models.Assessment{}}
+	hidden := &models.AssessmentAttempt{Status: statusCompleted, CompletedAt: &now, Assessment: &models.Assessment{HideAnswers: true}}
+	unrecorded := &models.AssessmentAttempt{Status: statusCompleted, Assessment: &models.Assessment{}}
+
+	var tests = []struct {
+		name string
+		role *string
+		aa   *models.AssessmentAttempt
+		exp  bool
+	}{
+		{"admin in progress", &admin, inProgress, true},
+		{"admin hidden", &admin, hidden, true},
+		{"owner in progress", &owner, inProgress, false},
+		{"owner completed", &owner, completed, true},
+		{"owner hidden", &owner, hidden, false},
+		{"owner completed without completion time", &owner, unrecorded, false},
+		{"nil role in progress", nil, inProgress, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp, canViewAnswers(tt.role, tt.aa))
+		})
+	}
+}
+
+func TestRedactAssessmentAttempt(t *testing.T) {
+	aa := &models.AssessmentAttempt{
+		Assessment: &models.Assessment{
+			Questions: []*models.Question{{ID: 1, Answer: 2, Solution: "code"}},
+		},
+		Questions: []*models.Question{{ID: 1, Answer: 2, Solution: "code", Options: []string{"a"}}},
+	}
+
+	redactAssessmentAttempt(aa)
+
+	require.Equal(t, hiddenAnswer, aa.Assessment.Questions[0].Answer)
+	require.Equal(t, "", aa.Assessment.Questions[0].Solution)
+	require.Equal(t, hiddenAnswer, aa.Questions[0].Answer)
+	require.Equal(t, "", aa.Questions[0].Solution)
+	require.Equal(t, []string{"a"}, aa.Questions[0].Options)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	"in-backend/services/assessment/models"
+)
+
+// ungraded is the score of an AttemptQuestion that cannot be graded automatically
+const ungraded int64 = -1
+
+// completeAssessmentAttempt grades every AttemptQuestion of the stored AssessmentAttempt aa and saves
+// model, its completed update, together with the per question and total scores in a single transaction
+// so that an attempt is never left completed but unscored
+func (s *service) completeAssessmentAttempt(ctx context.Context, aa, model *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
+	gradeAssessmentAttempt(aa)
+
+	model.Score = aa.Score
+	model.Assessment = aa.Assessment
+	model.Questions = aa.Questions
+	model.QuestionAttempts = aa.QuestionAttempts
+
+	m, err := s.repository.CompleteAssessmentAttempt(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// gradeAssessmentAttempt sets the score of every AttemptQuestion in aa by comparing its
+// Selection against the Question's Answer, and sets the total score of aa
+func gradeAssessmentAttempt(aa *models.AssessmentAttempt) {
+	negativeMarking := aa.Assessment != nil && aa.Assessment.NegativeMarking
+
+	questions := make(map[uint64]*models.Question)
+	for _, q := range aa.Questions {
+		questions[q.ID] = q
+	}
+
+	var total int64 = 0
+	for _, aq := range aa.QuestionAttempts {
+		score, graded := gradeAttemptQuestion(questions[aq.QuestionID], aq, negativeMarking)
+		if !graded {
+			aq.Score = ungraded
+			continue
+		}
+		aq.Score = score
+		total += score
+	}
+	aa.Score = total
+}
+
+// gradeAttemptQuestion returns the score of a single AttemptQuestion and whether it could be graded.
+// Questions without options (e.g. open ended questions) cannot be graded,
+// unanswered questions score zero, and wrong answers lose the question's
+// penalty only if negative marking is enabled
+func gradeAttemptQuestion(q *models.Question, aq *models.AttemptQuestion, negativeMarking bool) (int64, bool) {
+	if q == nil || len(q.Options) == 0 {
+		return 0, false
+	}
+	if aq.Selection < 0 {
+		return 0, true
+	}
+	if aq.Selection == q.Answer {
+		return q.Weight, true
+	}
+	if negativeMarking {
+		return -q.Penalty, true
+	}
+	return 0, true
+}
+
+// maxAssessmentAttemptScore returns the highest score obtainable on aa, which is the total weight
+// of the questions in aa that can be graded automatically
+func maxAssessmentAttemptScore(aa *models.AssessmentAttempt) int64 {
+	var max int64 = 0
+	for _, q := range aa.Questions {
+		if len(q.Options) > 0 {
+			max += q.Weight
+		}
+	}
+	return max
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"in-backend/services/assessment/models"
+	"in-backend/services/assessment/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGradeAttemptQuestion(t *testing.T) {
+	mcq := &models.Question{ID: 1, Options: []string{"a", "b", "c"}, Answer: 1, Weight: 2, Penalty: 1}
+	open := &models.Question{ID: 2, Type: "Open"}
+
+	var tests = []struct {
+		name     string
+		q        *models.Question
+		sel      int64
+		negative bool
+		exp      int64
+		graded   bool
+	}{
+		{"correct", mcq, 1, false, 2, true},
+		{"wrong", mcq, 0, false, 0, true},
+		{"wrong with negative marking", mcq, 2, true, -1, true},
+		{"unanswered with negative marking", mcq, -1, true, 0, true},
+		{"open question", open, 0, true, 0, false},
+		{"missing question", nil, 0, false, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aq := &models.AttemptQuestion{Selection: tt.sel}
+			score, graded := gradeAttemptQuestion(tt.q, aq, tt.negative)
+			require.Equal(t, tt.exp, score)
+			require.Equal(t, tt.graded, graded)
+		})
+	}
+}
+
+func TestGradeAssessmentAttempt(t *testing.T) {
+	aa := &models.AssessmentAttempt{
+		Assessment: &models.Assessment{NegativeMarking: true},
+		Questions: []*models.Question{
+			{ID: 1, Options: []string{"a", "b"}, Answer: 0, Weight: 3},
+			{ID: 2, Options: []string{"a", "b"}, Answer: 1, Weight: 1, Penalty: 1},
+			{ID: 3, Type: "Open"},
+		},
+		QuestionAttempts: []*models.AttemptQuestion{
+			{ID: 1, QuestionID: 1, Selection: 0, Score: -1},
+			{ID: 2, QuestionID: 2, Selection: 0, Score: -1},
+			{ID: 3, QuestionID: 3, Selection: -1, Score: -1},
+		},
+	}
+
+	gradeAssessmentAttempt(aa)
+
+	require.Equal(t, int64(3), aa.QuestionAttempts[0].Score)
+	require.Equal(t, int64(-1), aa.QuestionAttempts[1].Score)
+	require.Equal(t, ungraded, aa.QuestionAttempts[2].Score)
+	require.Equal(t, int64(2), aa.Score)
+}
+
+func TestUpdateAssessmentAttemptScoresOnCompletion(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil)
+	ctx := context.Background()
+	admin := "Admin"
+
+	existing := &models.AssessmentAttempt{
+		ID:     1,
+		Status: "In Progress",
+		Score:  0,
+		Questions: []*models.Question{
+			{ID: 1, Options: []string{"a", "b"}, Answer: 1, Weight: 1},
+		},
+		QuestionAttempts: []*models.AttemptQuestion{
+			{ID: 1, QuestionID: 1, Selection: 1, Score: -1},
+		},
+	}
+	input := &models.AssessmentAttempt{ID: 1, Status: "Completed", Score: 100}
+
+	repo.On("GetAssessmentAttemptByID", ctx, uint64(1)).Return(existing, nil)
+	repo.On("CompleteAssessmentAttempt", ctx, input).
+		Return(func(_ context.Context, m *models.AssessmentAttempt) *models.AssessmentAttempt { return m }, nil)
+
+	got, err := s.UpdateAssessmentAttempt(ctx, input, &admin, nil)
+	require.NoError(t, err)
+	// completion time is recorded by the server
+	require.NotNil(t, input.CompletedAt)
+	// client supplied score is replaced by the graded score
+	require.Equal(t, int64(1), got.Score)
+	require.Equal(t, int64(1), got.QuestionAttempts[0].Score)
+	require.Equal(t, "Completed", got.Status)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateAssessmentAttempt", mock.Anything, mock.Anything)
+}
+
+func TestLocalGetCandidateScores(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil)
+	ctx := context.Background()
+
+	aa := []*models.AssessmentAttempt{
+		{
+			ID:           1,
+			AssessmentID: 2,
+			CandidateID:  3,
+			Status:       "Completed",
+			Score:        3,
+			Questions: []*models.Question{
+				{ID: 1, Options: []string{"a", "b"}, Weight: 3},
+				{ID: 2, Options: []string{"a", "b"}, Weight: 2},
+				{ID: 3, Type: "Open", Weight: 5},
+			},
+		},
+	}
+	repo.On("GetCompletedAssessmentAttempts", ctx, []uint64{3}).Return(aa, nil)
+
+	got, err := s.LocalGetCandidateScores(ctx, []uint64{3})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, &models.CandidateScore{CandidateID: 3, AssessmentID: 2, AttemptID: 1, Score: 3, MaxScore: 5}, got[0])
+}
+
+func TestLocalEraseCandidateData(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil)
+	ctx := context.Background()
+
+	repo.On("DeleteCandidateAssessmentAttempts", ctx, uint64(3)).Return(uint64(4), nil)
+	repo.On("DeleteCandidateAssessmentAttempts", ctx, uint64(5)).Return(uint64(0), errors.New("Mock error"))
+
+	got, err := s.LocalEraseCandidateData(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), got)
+
+	_, err = s.LocalEraseCandidateData(ctx, 5)
+	require.Error(t, err)
+}
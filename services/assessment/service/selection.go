@@ -0,0 +1,122 @@
+package service
+
+import (
+	"math/rand"
+	"sort"
+
+	"in-backend/services/assessment/models"
+)
+
+// selectQuestions draws the questions for an AssessmentAttempt from the question pool of an Assessment.
+// If the assessment is randomised, a sample of NumQuestions is drawn using seed, optionally stratified by
+// tag so that every tag is represented in proportion to its share of the pool. Otherwise the first
+// NumQuestions questions by ID are used. The returned slice is in the order the questions should be shown
+func selectQuestions(a *models.Assessment, pool []*models.Question, seed int64) []*models.Question {
+	sorted := make([]*models.Question, len(pool))
+	copy(sorted, pool)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	n := int(a.NumQuestions)
+	if n == 0 || n > len(sorted) {
+		n = len(sorted)
+	}
+
+	if !a.Randomise {
+		return sorted[:n]
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	var selected []*models.Question
+	if a.StratifyByTag {
+		selected = stratifiedSample(r, sorted, n)
+	} else {
+		selected = sample(r, sorted, n)
+	}
+
+	r.Shuffle(len(selected), func(i, j int) { selected[i], selected[j] = selected[j], selected[i] })
+	return selected
+}
+
+// sample draws n questions from pool without replacement
+func sample(r *rand.Rand, pool []*models.Question, n int) []*models.Question {
+	shuffled := make([]*models.Question, len(pool))
+	copy(shuffled, pool)
+	r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return shuffled[:n]
+}
+
+// stratifiedSample draws n questions from pool, grouping questions by their first tag (alphabetically)
+// and allocating the draws across groups using the largest remainder method
+func stratifiedSample(r *rand.Rand, pool []*models.Question, n int) []*models.Question {
+	groups := make(map[string][]*models.Question)
+	var keys []string
+	for _, q := range pool {
+		k := stratum(q)
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], q)
+	}
+	sort.Strings(keys)
+
+	type allocation struct {
+		key       string
+		count     int
+		remainder float64
+	}
+	allocations := make([]*allocation, len(keys))
+	allocated := 0
+	for i, k := range keys {
+		exact := float64(n) * float64(len(groups[k])) / float64(len(pool))
+		count := int(exact)
+		allocations[i] = &allocation{key: k, count: count, remainder: exact - float64(count)}
+		allocated += count
+	}
+
+	byRemainder := make([]*allocation, len(allocations))
+	copy(byRemainder, allocations)
+	sort.SliceStable(byRemainder, func(i, j int) bool { return byRemainder[i].remainder > byRemainder[j].remainder })
+	for i := 0; allocated < n; i = (i + 1) % len(byRemainder) {
+		a := byRemainder[i]
+		if a.count < len(groups[a.key]) {
+			a.count++
+			allocated++
+		}
+	}
+
+	var selected []*models.Question
+	for _, a := range allocations {
+		selected = append(selected, sample(r, groups[a.key], a.count)...)
+	}
+	return selected
+}
+
+// stratum returns the tag a question is grouped under when stratifying
+func stratum(q *models.Question) string {
+	var names []string
+	for _, t := range q.Tags {
+		names = append(names, t.Name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	return names[0]
+}
+
+// orderQuestions sorts the Questions of an AssessmentAttempt by the position they were drawn in
+func orderQuestions(aa *models.AssessmentAttempt) {
+	if aa == nil {
+		return
+	}
+	positions := make(map[uint64]uint32)
+	for _, aq := range aa.QuestionAttempts {
+		positions[aq.QuestionID] = aq.Position
+	}
+	sort.SliceStable(aa.Questions, func(i, j int) bool {
+		return positions[aa.Questions[i].ID] < positions[aa.Questions[j].ID]
+	})
+	sort.SliceStable(aa.QuestionAttempts, func(i, j int) bool {
+		return aa.QuestionAttempts[i].Position < aa.QuestionAttempts[j].Position
+	})
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"in-backend/services/assessment/models"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func questionPool() []*models.Question {
+	var pool []*models.Question
+	tags := map[string]int{"go": 6, "sql": 3, "": 1}
+	id := uint64(1)
+	for _, name := range []string{"go", "sql", ""} {
+		for i := 0; i < tags[name]; i++ {
+			q := &models.Question{ID: id}
+			if name != "" {
+				q.Tags = []*models.Tag{{Name: name}}
+			}
+			pool = append(pool, q)
+			id++
+		}
+	}
+	return pool
+}
+
+func ids(qs []*models.Question) []uint64 {
+	var res []uint64
+	for _, q := range qs {
+		res = append(res, q.ID)
+	}
+	return res
+}
+
+func TestSelectQuestions(t *testing.T) {
+	pool := questionPool()
+
+	t.Run("not randomised", func(t *testing.T) {
+		a := &models.Assessment{NumQuestions: 3}
+		require.Equal(t, []uint64{1, 2, 3}, ids(selectQuestions(a, pool, 1)))
+	})
+
+	t.Run("all questions", func(t *testing.T) {
+		a := &models.Assessment{NumQuestions: 20}
+		require.Len(t, selectQuestions(a, pool, 1), len(pool))
+	})
+
+	t.Run("randomised is deterministic for a seed", func(t *testing.T) {
+		a := &models.Assessment{Randomise: true, NumQuestions: 5}
+		first := ids(selectQuestions(a, pool, 42))
+		require.Len(t, first, 5)
+		require.Equal(t, first, ids(selectQuestions(a, pool, 42)))
+
+		seen := make(map[uint64]bool)
+		for _, id := range first {
+			require.False(t, seen[id], "questions should not repeat")
+			seen[id] = true
+		}
+	})
+
+	t.Run("stratified by tag", func(t *testing.T) {
+		a := &models.Assessment{Randomise: true, StratifyByTag: true, NumQuestions: 5}
+		for seed := int64(0); seed < 20; seed++ {
+			counts := make(map[string]int)
+			for _, q := range selectQuestions(a, pool, seed) {
+				counts[stratum(q)]++
+			}
+			// 5 * 6/10 = 3, 5 * 3/10 = 1.5, 5 * 1/10 = 0.5
+			require.Equal(t, 3, counts["go"])
+			require.Equal(t, 1, counts["sql"])
+			require.Equal(t, 1, counts[""])
+		}
+	})
+}
+
+func TestOrderQuestions(t *testing.T) {
+	aa := &models.AssessmentAttempt{
+		Questions: []*models.Question{{ID: 1}, {ID: 2}, {ID: 3}},
+		QuestionAttempts: []*models.AttemptQuestion{
+			{QuestionID: 1, Position: 2},
+			{QuestionID: 2, Position: 0},
+			{QuestionID: 3, Position: 1},
+		},
+	}
+	orderQuestions(aa)
+	require.Equal(t, []uint64{2, 3, 1}, ids(aa.Questions))
+	require.Equal(t, uint64(2), aa.QuestionAttempts[0].QuestionID)
+}
@@ -6,11 +6,24 @@ import (
 	"time"
 
 	"in-backend/helpers"
+	"in-backend/pagination"
 	"in-backend/services/assessment/interfaces"
 	"in-backend/services/assessment/models"
 
 	"github.com/gocraft/work"
 	"github.com/microcosm-cc/bluemonday"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	statusInProgress string = "In Progress"
+	statusCompleted  string = "Completed"
+)
+
+var (
+	errAttemptNotFound    = errors.New("Assessment attempt not found")
+	errAssessmentNotFound = status.Error(codes.NotFound, "Assessment not found")
 )
 
 // Service implements the assessment Service interface
@@ -46,12 +59,17 @@ func (s *service) CreateAssessment(ctx context.Context, model *models.Assessment
 }
 
 // GetAllAssessments returns all Assessments
-func (s *service) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, error) {
-	m, err := s.repository.GetAllAssessments(ctx, f, role, cid)
+func (s *service) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, *pagination.Info, error) {
+	m, page, err := s.repository.GetAllAssessments(ctx, f, role, cid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return m, err
+	if !isAdmin(role) {
+		for _, a := range m {
+			redactAssessment(a)
+		}
+	}
+	return m, page, err
 }
 
 // GetAssessmentByID returns a Assessment by ID
@@ -60,6 +78,9 @@ func (s *service) GetAssessmentByID(ctx context.Context, id uint64, role *string
 	if err != nil {
 		return nil, err
 	}
+	if !isAdmin(role) {
+		redactAssessment(m)
+	}
 	return m, err
 }
 
@@ -94,21 +115,45 @@ func (s *service) CreateAssessmentAttempt(ctx context.Context, model *models.Ass
 		return nil, err
 	}
 
-	_, months, _, _, _, _ := helpers.TimeDiff(time.Now(), *aa.StartedAt)
-	if months < 3 {
-		return nil, errors.New("Minimum of 3 months between attempts")
+	if aa != nil && aa.StartedAt != nil {
+		_, months, _, _, _, _ := helpers.TimeDiff(time.Now(), *aa.StartedAt)
+		if months < 3 {
+			return nil, errors.New("Minimum of 3 months between attempts")
+		}
 	}
 
-	m, err := s.repository.CreateAssessmentAttempt(ctx, model)
+	role := "Admin"
+	a, err := s.repository.GetAssessmentByID(ctx, model.AssessmentID, &role, nil)
 	if err != nil {
 		return nil, err
 	}
+	if a == nil {
+		return nil, errAssessmentNotFound
+	}
 
-	role := "Admin"
-	a, err := s.repository.GetAssessmentByID(ctx, m.AssessmentID, &role, nil)
+	// questions are always drawn server side, any questions sent by the client are ignored
+	pool, err := s.repository.GetQuestionPool(ctx, model.AssessmentID)
 	if err != nil {
 		return nil, err
 	}
+	// the attempt always starts in progress, its completion and score are only ever recorded by the server
+	now := time.Now()
+	model.Status = statusInProgress
+	model.CompletedAt = nil
+	model.Score = 0
+	model.QuestionAttempts = nil
+	model.StartedAt = &now
+	model.CurrentQuestion = 0
+	model.CurrentStartedAt = &now
+	model.Seed = now.UnixNano()
+	model.Questions = selectQuestions(a, pool, model.Seed)
+
+	m, err := s.repository.CreateAssessmentAttempt(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	orderQuestions(m)
+
 	s.scheduleAssessmentAttemptEnd(int64(m.ID), int64(a.TimeAllowed))
 
 	return m, err
@@ -120,11 +165,15 @@ func (s *service) scheduleAssessmentAttemptEnd(id int64, ta int64) error {
 }
 
 // GetAssessmentAttemptByID returns a AssessmentAttempt by ID
-func (s *service) GetAssessmentAttemptByID(ctx context.Context, id uint64) (*models.AssessmentAttempt, error) {
+func (s *service) GetAssessmentAttemptByID(ctx context.Context, id uint64, role *string, cid *uint64) (*models.AssessmentAttempt, error) {
 	m, err := s.repository.GetAssessmentAttemptByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	orderQuestions(m)
+	if !canViewAnswers(role, m) {
+		redactAssessmentAttempt(m)
+	}
 	return m, err
 }
 
@@ -135,21 +184,60 @@ func (s *service) LocalGetAssessmentAttemptByID(ctx context.Context, id uint64)
 	if err != nil {
 		return nil, err
 	}
+	orderQuestions(m)
 	return m, err
 }
 
 // UpdateAssessmentAttempt updates a AssessmentAttempt
-func (s *service) UpdateAssessmentAttempt(ctx context.Context, model *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
-	m, err := s.repository.UpdateAssessmentAttempt(ctx, model)
+func (s *service) UpdateAssessmentAttempt(ctx context.Context, model *models.AssessmentAttempt, role *string, cid *uint64) (*models.AssessmentAttempt, error) {
+	m, err := s.updateAssessmentAttempt(ctx, model)
 	if err != nil {
 		return nil, err
 	}
+	if !canViewAnswers(role, m) {
+		redactAssessmentAttempt(m)
+	}
 	return m, err
 }
 
 // LocalUpdateAssessmentAttempt updates a AssessmentAttempt
 // This method is only for local server to server communication
 func (s *service) LocalUpdateAssessmentAttempt(ctx context.Context, model *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
+	return s.updateAssessmentAttempt(ctx, model)
+}
+
+// updateAssessmentAttempt updates a AssessmentAttempt and scores it if it has just been completed
+func (s *service) updateAssessmentAttempt(ctx context.Context, model *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
+	aa, err := s.repository.GetAssessmentAttemptByID(ctx, model.ID)
+	if err != nil {
+		return nil, err
+	}
+	if aa == nil {
+		return nil, errAttemptNotFound
+	}
+	// a completed attempt cannot be reopened, otherwise its answers could be viewed and resubmitted
+	if (aa.Status == statusCompleted || aa.CompletedAt != nil) && model.Status != statusCompleted {
+		return nil, errAttemptCompleted
+	}
+
+	// the attempt's identity, scores and progress are only ever set by the server
+	model.AssessmentID = aa.AssessmentID
+	model.CandidateID = aa.CandidateID
+	model.Seed = aa.Seed
+	model.Score = aa.Score
+	model.StartedAt = aa.StartedAt
+	model.CurrentQuestion = aa.CurrentQuestion
+	model.CurrentStartedAt = aa.CurrentStartedAt
+	model.CompletedAt = aa.CompletedAt
+	if model.Status == statusCompleted && model.CompletedAt == nil {
+		now := time.Now()
+		model.CompletedAt = &now
+	}
+
+	if model.Status == statusCompleted && aa.Status != statusCompleted {
+		return s.completeAssessmentAttempt(ctx, aa, model)
+	}
+
 	m, err := s.repository.UpdateAssessmentAttempt(ctx, model)
 	if err != nil {
 		return nil, err
@@ -166,6 +254,48 @@ func (s *service) DeleteAssessmentAttempt(ctx context.Context, id uint64) error
 	return err
 }
 
+// LocalGetCandidateScores returns the scores of Candidates on their completed AssessmentAttempts
+// This method is only for local server to server communication
+func (s *service) LocalGetCandidateScores(ctx context.Context, cids []uint64) ([]*models.CandidateScore, error) {
+	aa, err := s.repository.GetCompletedAssessmentAttempts(ctx, cids)
+	if err != nil {
+		return nil, err
+	}
+
+	var m []*models.CandidateScore
+	for _, a := range aa {
+		m = append(m, &models.CandidateScore{
+			CandidateID:  a.CandidateID,
+			AssessmentID: a.AssessmentID,
+			AttemptID:    a.ID,
+			Score:        a.Score,
+			MaxScore:     maxAssessmentAttemptScore(a),
+			CompletedAt:  a.CompletedAt,
+		})
+	}
+	return m, err
+}
+
+// LocalExportCandidateData returns all data about a Candidate
+// This method is only for local server to server communication
+func (s *service) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	aa, err := s.repository.GetCandidateAssessmentAttempts(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CandidateData{AssessmentAttempts: aa}, nil
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased
+// This method is only for local server to server communication
+func (s *service) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	n, err := s.repository.DeleteCandidateAssessmentAttempts(ctx, cid)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
 /* --------------- Question --------------- */
 
 // CreateQuestion creates a new Question
@@ -189,12 +319,12 @@ func (s *service) BulkCreateQuestion(ctx context.Context, m []*models.Question)
 }
 
 // GetAllQuestions returns all Questions
-func (s *service) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, error) {
-	m, err := s.repository.GetAllQuestions(ctx, f)
+func (s *service) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, *pagination.Info, error) {
+	m, page, err := s.repository.GetAllQuestions(ctx, f)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return m, err
+	return m, page, err
 }
 
 // GetQuestionByID returns a Question by ID
@@ -249,7 +379,36 @@ func (s *service) DeleteTag(ctx context.Context, id uint64) error {
 
 // UpdateAttemptQuestion updates a AttemptQuestion
 func (s *service) UpdateAttemptQuestion(ctx context.Context, model *models.AttemptQuestion) (*models.AttemptQuestion, error) {
-	m, err := s.repository.UpdateAttemptQuestion(ctx, model)
+	aq, err := s.repository.GetAttemptQuestionByID(ctx, model.ID)
+	if err != nil {
+		return nil, err
+	}
+	if aq == nil {
+		return nil, errAttemptQuestionNotFound
+	}
+
+	aa, err := s.repository.GetAssessmentAttemptByID(ctx, aq.AttemptID)
+	if err != nil {
+		return nil, err
+	}
+	if aa == nil {
+		return nil, errAttemptNotFound
+	}
+
+	now := time.Now()
+	if err := checkSubmission(aa, aq, now); err != nil {
+		return nil, err
+	}
+
+	// only the candidate's response is taken from the client, everything else is tracked by the server
+	aq.Selection = model.Selection
+	aq.Text = model.Text
+	aq.CMMode = model.CMMode
+
+	prev := aa.CurrentQuestion
+	recordSubmission(aa, aq, now)
+
+	m, err := s.repository.SubmitAttemptQuestion(ctx, aq, aa, prev)
 	if err != nil {
 		return nil, err
 	}
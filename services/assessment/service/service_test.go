@@ -136,9 +136,9 @@ func testGetAllAssessments(t *testing.T, s interfaces.Service) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r.On("GetAllAssessments", tt.args.ctx).Return(tt.exp.output, tt.exp.err)
+			r.On("GetAllAssessments", tt.args.ctx).Return(tt.exp.output, nil, tt.exp.err)
 
-			got, err := s.GetAllAssessments(tt.args.ctx, *tt.args.f, &tt.args.role, &tt.args.cid)
+			got, _, err := s.GetAllAssessments(tt.args.ctx, *tt.args.f, &tt.args.role, &tt.args.cid)
 			assert.Equal(t, len(tt.exp.output), len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
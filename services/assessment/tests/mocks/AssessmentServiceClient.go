@@ -437,6 +437,66 @@ func (_m *AssessmentServiceClient) GetQuestionByID(ctx context.Context, in *pb.G
 	return r0, r1
 }
 
+// LocalEraseCandidateData provides a mock function with given fields: ctx, in, opts
+func (_m *AssessmentServiceClient) LocalEraseCandidateData(ctx context.Context, in *pb.CandidateDataRequest, opts ...grpc.CallOption) (*pb.EraseCandidateDataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.EraseCandidateDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) *pb.EraseCandidateDataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.EraseCandidateDataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: ctx, in, opts
+func (_m *AssessmentServiceClient) LocalExportCandidateData(ctx context.Context, in *pb.CandidateDataRequest, opts ...grpc.CallOption) (*pb.CandidateData, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) *pb.CandidateData); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalGetAssessmentAttemptByID provides a mock function with given fields: ctx, in, opts
 func (_m *AssessmentServiceClient) LocalGetAssessmentAttemptByID(ctx context.Context, in *pb.GetAssessmentAttemptByIDRequest, opts ...grpc.CallOption) (*pb.AssessmentAttempt, error) {
 	_va := make([]interface{}, len(opts))
@@ -467,6 +527,36 @@ func (_m *AssessmentServiceClient) LocalGetAssessmentAttemptByID(ctx context.Con
 	return r0, r1
 }
 
+// LocalGetCandidateScores provides a mock function with given fields: ctx, in, opts
+func (_m *AssessmentServiceClient) LocalGetCandidateScores(ctx context.Context, in *pb.GetCandidateScoresRequest, opts ...grpc.CallOption) (*pb.GetCandidateScoresResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetCandidateScoresResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetCandidateScoresRequest, ...grpc.CallOption) *pb.GetCandidateScoresResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetCandidateScoresResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetCandidateScoresRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalUpdateAssessmentAttempt provides a mock function with given fields: ctx, in, opts
 func (_m *AssessmentServiceClient) LocalUpdateAssessmentAttempt(ctx context.Context, in *pb.UpdateAssessmentAttemptRequest, opts ...grpc.CallOption) (*pb.AssessmentAttempt, error) {
 	_va := make([]interface{}, len(opts))
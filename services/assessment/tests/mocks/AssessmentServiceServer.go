@@ -336,6 +336,52 @@ func (_m *AssessmentServiceServer) GetQuestionByID(_a0 context.Context, _a1 *pb.
 	return r0, r1
 }
 
+// LocalEraseCandidateData provides a mock function with given fields: _a0, _a1
+func (_m *AssessmentServiceServer) LocalEraseCandidateData(_a0 context.Context, _a1 *pb.CandidateDataRequest) (*pb.EraseCandidateDataResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.EraseCandidateDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest) *pb.EraseCandidateDataResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.EraseCandidateDataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: _a0, _a1
+func (_m *AssessmentServiceServer) LocalExportCandidateData(_a0 context.Context, _a1 *pb.CandidateDataRequest) (*pb.CandidateData, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest) *pb.CandidateData); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalGetAssessmentAttemptByID provides a mock function with given fields: _a0, _a1
 func (_m *AssessmentServiceServer) LocalGetAssessmentAttemptByID(_a0 context.Context, _a1 *pb.GetAssessmentAttemptByIDRequest) (*pb.AssessmentAttempt, error) {
 	ret := _m.Called(_a0, _a1)
@@ -359,6 +405,29 @@ func (_m *AssessmentServiceServer) LocalGetAssessmentAttemptByID(_a0 context.Con
 	return r0, r1
 }
 
+// LocalGetCandidateScores provides a mock function with given fields: _a0, _a1
+func (_m *AssessmentServiceServer) LocalGetCandidateScores(_a0 context.Context, _a1 *pb.GetCandidateScoresRequest) (*pb.GetCandidateScoresResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetCandidateScoresResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetCandidateScoresRequest) *pb.GetCandidateScoresResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetCandidateScoresResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetCandidateScoresRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalUpdateAssessmentAttempt provides a mock function with given fields: _a0, _a1
 func (_m *AssessmentServiceServer) LocalUpdateAssessmentAttempt(_a0 context.Context, _a1 *pb.UpdateAssessmentAttemptRequest) (*pb.AssessmentAttempt, error) {
 	ret := _m.Called(_a0, _a1)
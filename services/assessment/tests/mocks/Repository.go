@@ -7,6 +7,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "in-backend/pagination"
 	models "in-backend/services/assessment/models"
 )
 
@@ -38,6 +39,29 @@ func (_m *Repository) BulkCreateQuestion(ctx context.Context, m []*models.Questi
 	return r0, r1
 }
 
+// CompleteAssessmentAttempt provides a mock function with given fields: ctx, m
+func (_m *Repository) CompleteAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.AssessmentAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AssessmentAttempt) *models.AssessmentAttempt); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AssessmentAttempt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.AssessmentAttempt) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAssessment provides a mock function with given fields: ctx, m
 func (_m *Repository) CreateAssessment(ctx context.Context, m *models.Assessment) (*models.Assessment, error) {
 	ret := _m.Called(ctx, m)
@@ -158,6 +182,27 @@ func (_m *Repository) DeleteAssessmentAttempt(ctx context.Context, id uint64) er
 	return r0
 }
 
+// DeleteCandidateAssessmentAttempts provides a mock function with given fields: ctx, cid
+func (_m *Repository) DeleteCandidateAssessmentAttempts(ctx context.Context, cid uint64) (uint64, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) uint64); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteQuestion provides a mock function with given fields: ctx, id
 func (_m *Repository) DeleteQuestion(ctx context.Context, id uint64) error {
 	ret := _m.Called(ctx, id)
@@ -187,7 +232,7 @@ func (_m *Repository) DeleteTag(ctx context.Context, id uint64) error {
 }
 
 // GetAllAssessments provides a mock function with given fields: ctx, f, role, cid
-func (_m *Repository) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, error) {
+func (_m *Repository) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, *pagination.Info, error) {
 	ret := _m.Called(ctx, f, role, cid)
 
 	var r0 []*models.Assessment
@@ -199,18 +244,27 @@ func (_m *Repository) GetAllAssessments(ctx context.Context, f models.Assessment
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.AssessmentFilters, *string, *uint64) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.AssessmentFilters, *string, *uint64) *pagination.Info); ok {
 		r1 = rf(ctx, f, role, cid)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.AssessmentFilters, *string, *uint64) error); ok {
+		r2 = rf(ctx, f, role, cid)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetAllQuestions provides a mock function with given fields: ctx, f
-func (_m *Repository) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, error) {
+func (_m *Repository) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.Question
@@ -222,14 +276,23 @@ func (_m *Repository) GetAllQuestions(ctx context.Context, f models.QuestionFilt
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.QuestionFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.QuestionFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.QuestionFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetAssessmentAttemptByID provides a mock function with given fields: ctx, id
@@ -301,6 +364,52 @@ func (_m *Repository) GetAttemptQuestionByID(ctx context.Context, id uint64) (*m
 	return r0, r1
 }
 
+// GetCandidateAssessmentAttempts provides a mock function with given fields: ctx, cid
+func (_m *Repository) GetCandidateAssessmentAttempts(ctx context.Context, cid uint64) ([]*models.AssessmentAttempt, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 []*models.AssessmentAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*models.AssessmentAttempt); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AssessmentAttempt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCompletedAssessmentAttempts provides a mock function with given fields: ctx, cids
+func (_m *Repository) GetCompletedAssessmentAttempts(ctx context.Context, cids []uint64) ([]*models.AssessmentAttempt, error) {
+	ret := _m.Called(ctx, cids)
+
+	var r0 []*models.AssessmentAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []*models.AssessmentAttempt); ok {
+		r0 = rf(ctx, cids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AssessmentAttempt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, cids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatestAssessmentAttemptByCandidate provides a mock function with given fields: ctx, cid
 func (_m *Repository) GetLatestAssessmentAttemptByCandidate(ctx context.Context, cid uint64) (*models.AssessmentAttempt, error) {
 	ret := _m.Called(ctx, cid)
@@ -347,6 +456,52 @@ func (_m *Repository) GetQuestionByID(ctx context.Context, id uint64) (*models.Q
 	return r0, r1
 }
 
+// GetQuestionPool provides a mock function with given fields: ctx, aid
+func (_m *Repository) GetQuestionPool(ctx context.Context, aid uint64) ([]*models.Question, error) {
+	ret := _m.Called(ctx, aid)
+
+	var r0 []*models.Question
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*models.Question); ok {
+		r0 = rf(ctx, aid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Question)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, aid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubmitAttemptQuestion provides a mock function with given fields: ctx, m, aa, prev
+func (_m *Repository) SubmitAttemptQuestion(ctx context.Context, m *models.AttemptQuestion, aa *models.AssessmentAttempt, prev uint64) (*models.AttemptQuestion, error) {
+	ret := _m.Called(ctx, m, aa, prev)
+
+	var r0 *models.AttemptQuestion
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AttemptQuestion, *models.AssessmentAttempt, uint64) *models.AttemptQuestion); ok {
+		r0 = rf(ctx, m, aa, prev)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AttemptQuestion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.AttemptQuestion, *models.AssessmentAttempt, uint64) error); ok {
+		r1 = rf(ctx, m, aa, prev)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateAssessment provides a mock function with given fields: ctx, m
 func (_m *Repository) UpdateAssessment(ctx context.Context, m *models.Assessment) (*models.Assessment, error) {
 	ret := _m.Called(ctx, m)
@@ -7,6 +7,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "in-backend/pagination"
 	models "in-backend/services/assessment/models"
 )
 
@@ -187,7 +188,7 @@ func (_m *Service) DeleteTag(ctx context.Context, id uint64) error {
 }
 
 // GetAllAssessments provides a mock function with given fields: ctx, f, role, cid
-func (_m *Service) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, error) {
+func (_m *Service) GetAllAssessments(ctx context.Context, f models.AssessmentFilters, role *string, cid *uint64) ([]*models.Assessment, *pagination.Info, error) {
 	ret := _m.Called(ctx, f, role, cid)
 
 	var r0 []*models.Assessment
@@ -199,18 +200,27 @@ func (_m *Service) GetAllAssessments(ctx context.Context, f models.AssessmentFil
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.AssessmentFilters, *string, *uint64) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.AssessmentFilters, *string, *uint64) *pagination.Info); ok {
 		r1 = rf(ctx, f, role, cid)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.AssessmentFilters, *string, *uint64) error); ok {
+		r2 = rf(ctx, f, role, cid)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetAllQuestions provides a mock function with given fields: ctx, f
-func (_m *Service) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, error) {
+func (_m *Service) GetAllQuestions(ctx context.Context, f models.QuestionFilters) ([]*models.Question, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.Question
@@ -222,23 +232,32 @@ func (_m *Service) GetAllQuestions(ctx context.Context, f models.QuestionFilters
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.QuestionFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.QuestionFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.QuestionFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetAssessmentAttemptByID provides a mock function with given fields: ctx, id
-func (_m *Service) GetAssessmentAttemptByID(ctx context.Context, id uint64) (*models.AssessmentAttempt, error) {
-	ret := _m.Called(ctx, id)
+// GetAssessmentAttemptByID provides a mock function with given fields: ctx, id, role, cid
+func (_m *Service) GetAssessmentAttemptByID(ctx context.Context, id uint64, role *string, cid *uint64) (*models.AssessmentAttempt, error) {
+	ret := _m.Called(ctx, id, role, cid)
 
 	var r0 *models.AssessmentAttempt
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.AssessmentAttempt); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *string, *uint64) *models.AssessmentAttempt); ok {
+		r0 = rf(ctx, id, role, cid)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.AssessmentAttempt)
@@ -246,8 +265,8 @@ func (_m *Service) GetAssessmentAttemptByID(ctx context.Context, id uint64) (*mo
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *string, *uint64) error); ok {
+		r1 = rf(ctx, id, role, cid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -301,6 +320,50 @@ func (_m *Service) GetQuestionByID(ctx context.Context, id uint64) (*models.Ques
 	return r0, r1
 }
 
+// LocalEraseCandidateData provides a mock function with given fields: ctx, cid
+func (_m *Service) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) uint64); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: ctx, cid
+func (_m *Service) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 *models.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.CandidateData); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalGetAssessmentAttemptByID provides a mock function with given fields: ctx, id
 func (_m *Service) LocalGetAssessmentAttemptByID(ctx context.Context, id uint64) (*models.AssessmentAttempt, error) {
 	ret := _m.Called(ctx, id)
@@ -324,6 +387,29 @@ func (_m *Service) LocalGetAssessmentAttemptByID(ctx context.Context, id uint64)
 	return r0, r1
 }
 
+// LocalGetCandidateScores provides a mock function with given fields: ctx, cids
+func (_m *Service) LocalGetCandidateScores(ctx context.Context, cids []uint64) ([]*models.CandidateScore, error) {
+	ret := _m.Called(ctx, cids)
+
+	var r0 []*models.CandidateScore
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []*models.CandidateScore); ok {
+		r0 = rf(ctx, cids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CandidateScore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, cids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalUpdateAssessmentAttempt provides a mock function with given fields: ctx, model
 func (_m *Service) LocalUpdateAssessmentAttempt(ctx context.Context, model *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
 	ret := _m.Called(ctx, model)
@@ -370,13 +456,13 @@ func (_m *Service) UpdateAssessment(ctx context.Context, m *models.Assessment) (
 	return r0, r1
 }
 
-// UpdateAssessmentAttempt provides a mock function with given fields: ctx, m
-func (_m *Service) UpdateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt) (*models.AssessmentAttempt, error) {
-	ret := _m.Called(ctx, m)
+// UpdateAssessmentAttempt provides a mock function with given fields: ctx, m, role, cid
+func (_m *Service) UpdateAssessmentAttempt(ctx context.Context, m *models.AssessmentAttempt, role *string, cid *uint64) (*models.AssessmentAttempt, error) {
+	ret := _m.Called(ctx, m, role, cid)
 
 	var r0 *models.AssessmentAttempt
-	if rf, ok := ret.Get(0).(func(context.Context, *models.AssessmentAttempt) *models.AssessmentAttempt); ok {
-		r0 = rf(ctx, m)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AssessmentAttempt, *string, *uint64) *models.AssessmentAttempt); ok {
+		r0 = rf(ctx, m, role, cid)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.AssessmentAttempt)
@@ -384,8 +470,8 @@ func (_m *Service) UpdateAssessmentAttempt(ctx context.Context, m *models.Assess
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *models.AssessmentAttempt) error); ok {
-		r1 = rf(ctx, m)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.AssessmentAttempt, *string, *uint64) error); ok {
+		r1 = rf(ctx, m, role, cid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2,6 +2,8 @@ package transport
 
 import (
 	"context"
+	"encoding/json"
+	"in-backend/pagination"
 	"in-backend/services/assessment/endpoints"
 	"in-backend/services/assessment/models"
 	"in-backend/services/assessment/pb"
@@ -26,6 +28,9 @@ type grpcServer struct {
 	updateAssessmentAttempt       kitgrpc.Handler
 	localUpdateAssessmentAttempt  kitgrpc.Handler
 	deleteAssessmentAttempt       kitgrpc.Handler
+	localGetCandidateScores       kitgrpc.Handler
+	localExportCandidateData      kitgrpc.Handler
+	localEraseCandidateData       kitgrpc.Handler
 
 	createQuestion     kitgrpc.Handler
 	bulkCreateQuestion kitgrpc.Handler
@@ -119,6 +124,24 @@ func NewGRPCServer(
 			encodeDeleteAssessmentAttemptResponse,
 			options...,
 		),
+		localGetCandidateScores: kitgrpc.NewServer(
+			endpoints.LocalGetCandidateScores,
+			decodeGetCandidateScoresRequest,
+			encodeGetCandidateScoresResponse,
+			options...,
+		),
+		localExportCandidateData: kitgrpc.NewServer(
+			endpoints.LocalExportCandidateData,
+			decodeCandidateDataRequest,
+			encodeLocalExportCandidateDataResponse,
+			options...,
+		),
+		localEraseCandidateData: kitgrpc.NewServer(
+			endpoints.LocalEraseCandidateData,
+			decodeCandidateDataRequest,
+			encodeLocalEraseCandidateDataResponse,
+			options...,
+		),
 
 		createQuestion: kitgrpc.NewServer(
 			endpoints.CreateQuestion,
@@ -228,6 +251,13 @@ func decodeGetAllAssessmentsRequest(_ context.Context, request interface{}) (int
 		CandidateID: req.CandidateId,
 		Status:      req.Status,
 		MinScore:    req.MinScore,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
 	}
 	return decoded, nil
 }
@@ -241,7 +271,15 @@ func encodeGetAllAssessmentsResponse(_ context.Context, response interface{}) (i
 		for _, assessment := range res.Assessments {
 			assessments = append(assessments, assessment.ToProto())
 		}
-		return &pb.GetAllAssessmentsResponse{Assessments: assessments}, nil
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllAssessmentsResponse{
+			Assessments:   assessments,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
 	}
 	return nil, err
 }
@@ -441,6 +479,84 @@ func encodeDeleteAssessmentAttemptResponse(_ context.Context, response interface
 	return nil, err
 }
 
+// LocalGetCandidateScores returns the scores of completed AssessmentAttempts of candidates, for internal use
+func (s *grpcServer) LocalGetCandidateScores(ctx context.Context, req *pb.GetCandidateScoresRequest) (*pb.GetCandidateScoresResponse, error) {
+	_, rep, err := s.localGetCandidateScores.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetCandidateScoresResponse), nil
+}
+
+// decodeGetCandidateScoresRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetCandidateScoresRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetCandidateScoresRequest)
+	return endpoints.GetCandidateScoresRequest{CandidateIDs: req.CandidateId}, nil
+}
+
+// encodeGetCandidateScoresResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetCandidateScoresResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetCandidateScoresResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var scores []*pb.CandidateScore
+		for _, m := range res.Scores {
+			scores = append(scores, m.ToProto())
+		}
+		return &pb.GetCandidateScoresResponse{Scores: scores}, nil
+	}
+	return nil, err
+}
+
+// LocalExportCandidateData returns all data about a candidate, for internal use
+func (s *grpcServer) LocalExportCandidateData(ctx context.Context, req *pb.CandidateDataRequest) (*pb.CandidateData, error) {
+	_, rep, err := s.localExportCandidateData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.CandidateData), nil
+}
+
+// LocalEraseCandidateData deletes all data about a candidate, for internal use
+func (s *grpcServer) LocalEraseCandidateData(ctx context.Context, req *pb.CandidateDataRequest) (*pb.EraseCandidateDataResponse, error) {
+	_, rep, err := s.localEraseCandidateData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.EraseCandidateDataResponse), nil
+}
+
+// decodeCandidateDataRequest decodes the incoming grpc payload to our go kit payload
+func decodeCandidateDataRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CandidateDataRequest)
+	return endpoints.CandidateDataRequest{CandidateID: req.CandidateId}, nil
+}
+
+// encodeLocalExportCandidateDataResponse encodes the outgoing go kit payload to the grpc payload,
+// with the data about the candidate encoded as JSON
+func encodeLocalExportCandidateDataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.LocalExportCandidateDataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		data, err := json.Marshal(res.CandidateData)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CandidateData{Data: data}, nil
+	}
+	return nil, err
+}
+
+// encodeLocalEraseCandidateDataResponse encodes the outgoing go kit payload to the grpc payload
+func encodeLocalEraseCandidateDataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.LocalEraseCandidateDataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.EraseCandidateDataResponse{Erased: res.Erased}, nil
+	}
+	return nil, err
+}
+
 /* --------------- Question --------------- */
 
 // CreateQuestion creates a new Question
@@ -514,8 +630,16 @@ func (s *grpcServer) GetAllQuestions(ctx context.Context, req *pb.GetAllQuestion
 func decodeGetAllQuestionsRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(*pb.GetAllQuestionsRequest)
 	decoded := endpoints.GetAllQuestionsRequest{
-		ID:   req.Id,
-		Tags: req.Tags,
+		ID:           req.Id,
+		Tags:         req.Tags,
+		AssessmentID: req.AssessmentId,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
 	}
 	return decoded, nil
 }
@@ -529,7 +653,15 @@ func encodeGetAllQuestionsResponse(_ context.Context, response interface{}) (int
 		for _, question := range res.Questions {
 			questions = append(questions, question.ToProto())
 		}
-		return &pb.GetAllQuestionsResponse{Questions: questions}, nil
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllQuestionsResponse{
+			Questions:     questions,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
 	}
 	return nil, err
 }
@@ -693,6 +825,10 @@ func getError(err error) error {
 	case nil:
 		return nil
 	default:
+		// errors that already carry a grpc status code are passed through as is
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
 		return status.Error(codes.Unknown, err.Error())
 	}
 }
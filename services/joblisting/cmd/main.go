@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"in-backend/auth"
+	"in-backend/rbac"
 	"in-backend/services/joblisting/configs"
 	"in-backend/services/joblisting/database"
 	"in-backend/services/joblisting/endpoints"
@@ -10,6 +12,7 @@ import (
 	"in-backend/services/joblisting/service/middlewares"
 	"in-backend/services/joblisting/transport"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -76,11 +79,29 @@ func main() {
 	// business logic service; then, the set of endpoints that wrap the service;
 	// and finally, a series of concrete transport adapters
 
+	client := &http.Client{}
+	verifier := auth.NewVerifier(auth.Config{
+		JWKSURL:  cfg.Auth.JWKSURL,
+		Issuer:   cfg.Auth.Issuer,
+		Audience: cfg.Auth.Audience,
+	}, client)
+
+	policy, err := rbac.LoadPolicy(rbac.FileName)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to load policy", "err", err)
+		os.Exit(-1)
+	}
+
 	repo := database.NewRepository(db)
+	authorizer := rbac.NewAuthorizer(policy, verifier, endpoints.MakeOwners(repo)).WithServiceToken(cfg.Auth.ServiceToken)
 	svc := service.New(repo, p)
-	svc = middlewares.NewAuthMiddleware(svc, repo)
+	svc = middlewares.NewAuthMiddleware(svc, authorizer)
 	svc = middlewares.NewLogMiddleware(logger, svc)
 	endpoints := endpoints.MakeEndpoints(svc)
+	if err := authorizer.Wrap(&endpoints); err != nil {
+		level.Error(logger).Log("msg", "Failed to authorize endpoints", "err", err)
+		os.Exit(-1)
+	}
 
 	// set-up grpc transport
 	var (
@@ -24,6 +24,7 @@ type Config struct {
 	AppName     string       `mapstructure:"appname"`
 	Server      ServerConfig `mapstructure:",squash"`
 	Database    DbConfig     `mapstructure:",squash"`
+	Auth        Auth         `mapstructure:",squash"`
 	Auth0       Auth0        `mapstructure:",squash"`
 	Klenty      Klenty       `mapstructure:",squash"`
 	HubbedLearn HubbedLearn  `mapstructure:",squash"`
@@ -63,6 +64,16 @@ type HubbedLearn struct {
 	ApiKey string `mapstructure:"hubbedlearn_api_key"`
 }
 
+// Auth declares variables for verifying access tokens
+type Auth struct {
+	JWKSURL  string `mapstructure:"auth_jwks_url"`
+	Issuer   string `mapstructure:"auth_issuer"`
+	Audience string `mapstructure:"auth_audience"`
+
+	// ServiceToken authenticates the calls of services to each other
+	ServiceToken string `mapstructure:"auth_service_token"`
+}
+
 // LoadConfig load config from file
 func LoadConfig(fileName string) (Config, error) {
 	var result map[string]interface{}
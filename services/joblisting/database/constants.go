@@ -8,6 +8,7 @@ const (
 	relIndustries    string = "Industries"
 	relHiringManager string = "HiringManager"
 	relHRContact     string = "HRContact"
+	relJobPost       string = "JobPost"
 	relJobPosts      string = "JobPosts"
 	relKeyPersons    string = "KeyPersons"
 )
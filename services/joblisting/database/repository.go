@@ -9,6 +9,7 @@ import (
 	pg "github.com/go-pg/pg/v10"
 	"github.com/pkg/errors"
 
+	"in-backend/pagination"
 	"in-backend/services/joblisting/interfaces"
 	"in-backend/services/joblisting/models"
 )
@@ -133,7 +134,20 @@ func (r *repository) BulkCreateJobPost(ctx context.Context, m []*models.JobPost)
 }
 
 // GetAllJobPosts returns all JobPosts that match the filters
-func (r *repository) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, error) {
+func (r *repository) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, *pagination.Info, error) {
+	sorts, sortBy := jobPostSorts, "updated_at"
+	if f.Query != "" {
+		sorts = jobPostSorts.With("relevance", pagination.Sort{
+			Column: jobPostRank(f.QuerySkillID),
+			Value:  func(m interface{}) interface{} { return m.(*models.JobPost).Rank },
+		})
+		sortBy = "relevance"
+	}
+	page, err := pagination.New(f.Page, sorts, "jp.id", sortBy, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var m []*models.JobPost
 	q := r.DB.WithContext(ctx).Model(&m)
 	if len(f.ID) > 0 {
@@ -191,14 +205,47 @@ func (r *repository) GetAllJobPosts(ctx context.Context, f models.JobPostFilters
 	if f.ExpireAt != nil {
 		q = q.Where("jp.expire_at >= ?", f.ExpireAt.Format("2006-01-02"))
 	}
-	err := q.Where("expire_at >= ?", time.Now().Format("2006-01-02")).
-		Relation(relCompany).
+	if f.Query != "" {
+		q = q.Join(tsQueryJoin, f.Query)
+		if len(f.QuerySkillID) > 0 {
+			q = q.Where("(jp.search || coalesce(company.search, '')) @@ tsq or jp.skill_id && ?", pg.Array(f.QuerySkillID))
+		} else {
+			q = q.Where("(jp.search || coalesce(company.search, '')) @@ tsq")
+		}
+	}
+	// the company is joined before counting as the search refers to its name
+	q = q.Where("jp.expire_at >= ?", time.Now().Format("2006-01-02")).
+		Relation(relCompany)
+
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	err = page.Apply(q).
 		Relation(relFunction).
 		Relation(relIndustry).
 		Returning("*").
-		Order("jp.updated_at desc").
 		Select()
-	return m, err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasNext := page.HasNext(len(m))
+	if hasNext {
+		m = m[:page.Size()]
+	}
+	if f.Query != "" {
+		if err = r.setJobPostRanks(ctx, m, f); err != nil {
+			return nil, nil, err
+		}
+	}
+	if hasNext {
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
 }
 
 // GetJobPostByID finds and returns a JobPost by ID
@@ -469,7 +516,12 @@ func (r *repository) BulkCreateKeyPerson(ctx context.Context, m []*models.KeyPer
 }
 
 // GetAllKeyPersons returns all KeyPersons that match the filters
-func (r *repository) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, error) {
+func (r *repository) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, *pagination.Info, error) {
+	page, err := pagination.New(f.Page, keyPersonSorts, "kp.id", "id", false)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var m []*models.KeyPerson
 	q := r.DB.WithContext(ctx).Model(&m)
 	if len(f.ID) > 0 {
@@ -490,8 +542,23 @@ func (r *repository) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFil
 	if len(f.JobTitle) > 0 {
 		q = q.Where("lower(kp.job_title) like ?", "%"+strings.ToLower(f.JobTitle)+"%")
 	}
-	err := q.Returning("*").Select()
-	return m, err
+
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	if err = page.Apply(q).Returning("*").Select(); err != nil {
+		return nil, nil, err
+	}
+
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
 }
 
 // GetKeyPersonByID finds and returns a KeyPerson by ID
@@ -580,3 +647,101 @@ func (r *repository) DeleteJobPlatform(ctx context.Context, id uint64) error {
 	}
 	return nil
 }
+
+/* --------------- Application --------------- */
+
+// CreateApplication creates a new Application
+func (r *repository) CreateApplication(ctx context.Context, m *models.Application) (*models.Application, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter application is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).
+		Returning("*").
+		Insert()
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to insert application %v", m)
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetAllApplications returns all Applications that match the filters
+func (r *repository) GetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error) {
+	var m []*models.Application
+	q := r.DB.WithContext(ctx).Model(&m)
+	if len(f.ID) > 0 {
+		q = q.Where("ap.id in (?)", pg.In(f.ID))
+	}
+	if len(f.JobPostID) > 0 {
+		q = q.Where("ap.job_post_id in (?)", pg.In(f.JobPostID))
+	}
+	if len(f.CandidateID) > 0 {
+		q = q.Where("ap.candidate_id in (?)", pg.In(f.CandidateID))
+	}
+	if len(f.CompanyID) > 0 {
+		q = q.Where("ap.company_id in (?)", pg.In(f.CompanyID))
+	}
+	if len(f.Status) > 0 {
+		q = q.Where("ap.status in (?)", pg.In(f.Status))
+	}
+	err := q.Relation(relJobPost).
+		Returning("*").
+		Order("ap.updated_at desc").
+		Select()
+	return m, err
+}
+
+// GetApplicationByID finds and returns an Application by ID
+func (r *repository) GetApplicationByID(ctx context.Context, id uint64) (*models.Application, error) {
+	m := models.Application{ID: id}
+	err := r.DB.WithContext(ctx).Model(&m).
+		Where("ap.id = ?", id).
+		Relation(relJobPost).
+		Returning("*").
+		First()
+	//pg returns error when no rows in the result set
+	if err == pg.ErrNoRows {
+		return nil, nil
+	}
+	return &m, err
+}
+
+// UpdateApplicationStatus updates the Status of an Application
+func (r *repository) UpdateApplicationStatus(ctx context.Context, m *models.Application) (*models.Application, error) {
+	if m == nil {
+		return nil, errors.New("Application is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).WherePK().
+		Column("status", "updated_at").
+		Returning("*").
+		Update()
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot update application with id %v", m.ID))
+	}
+
+	return m, nil
+}
+
+// DeleteApplication deletes an Application by ID
+func (r *repository) DeleteApplication(ctx context.Context, id uint64) error {
+	m := &models.Application{ID: id}
+	_, err := r.DB.WithContext(ctx).Model(m).WherePK().Delete()
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Cannot delete application with id %v", id))
+	}
+	return nil
+}
+
+// DeleteCandidateApplications deletes all Applications of a Candidate and returns how many were deleted
+func (r *repository) DeleteCandidateApplications(ctx context.Context, cid uint64) (uint64, error) {
+	res, err := r.DB.WithContext(ctx).Model((*models.Application)(nil)).
+		Where("candidate_id = ?", cid).
+		Delete()
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("Cannot delete applications of candidate %v", cid))
+	}
+	return uint64(res.RowsAffected()), nil
+}
@@ -119,7 +119,7 @@ func testGetAllJobPosts(t *testing.T, r interfaces.Repository, db *pg.DB) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := r.GetAllJobPosts(tt.args.ctx, *tt.args.f)
+			got, _, err := r.GetAllJobPosts(tt.args.ctx, *tt.args.f)
 			assert.Equal(t, tt.exp.cnt, len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
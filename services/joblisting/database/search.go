@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pg "github.com/go-pg/pg/v10"
+
+	"in-backend/services/joblisting/models"
+)
+
+// tsQueryJoin parses a free text search query once so that filters and ranks can refer to it as tsq
+const tsQueryJoin = "cross join websearch_to_tsquery('english', ?) as tsq"
+
+// skillMatchRank is added to the rank of JobPosts requiring a skill named in the search query
+const skillMatchRank = 0.5
+
+// jobPostRank returns the expression ranking JobPosts against tsq by their title, description and company name,
+// ranking JobPosts that require any of skillIDs higher
+func jobPostRank(skillIDs []uint64) string {
+	rank := "ts_rank(jp.search || coalesce(company.search, ''), tsq)"
+	if len(skillIDs) == 0 {
+		return rank
+	}
+
+	ids := make([]string, len(skillIDs))
+	for i, id := range skillIDs {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+	return fmt.Sprintf("(%s + case when jp.skill_id && '{%s}'::bigint[] then %v else 0 end)", rank, strings.Join(ids, ","), skillMatchRank)
+}
+
+// setJobPostRanks sets the search rank of each JobPost.
+// Ranks are not stored so they are selected separately from the JobPosts
+func (r *repository) setJobPostRanks(ctx context.Context, m []*models.JobPost, f models.JobPostFilters) error {
+	if len(m) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, len(m))
+	for i, jp := range m {
+		ids[i] = jp.ID
+	}
+
+	var ranks []struct {
+		ID   uint64
+		Rank float32
+	}
+	_, err := r.DB.WithContext(ctx).Query(&ranks, `
+		select jp.id, `+jobPostRank(f.QuerySkillID)+` as rank
+		from job_posts as jp
+		left join companies as company on company.id = jp.company_id
+		`+tsQueryJoin+`
+		where jp.id in (?)`, f.Query, pg.In(ids))
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[uint64]float32, len(ranks))
+	for _, rk := range ranks {
+		byID[rk.ID] = rk.Rank
+	}
+	for _, jp := range m {
+		jp.Rank = byID[jp.ID]
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	"in-backend/pagination"
+	"in-backend/services/joblisting/models"
+)
+
+// jobPostSorts declares the fields that JobPosts can be sorted by
+var jobPostSorts = pagination.Sorts{
+	"id":               {Column: "jp.id"},
+	"created_at":       {Column: "jp.created_at", Value: func(m interface{}) interface{} { return m.(*models.JobPost).CreatedAt }},
+	"updated_at":       {Column: "jp.updated_at", Value: func(m interface{}) interface{} { return m.(*models.JobPost).UpdatedAt }},
+	"expire_at":        {Column: "jp.expire_at", Value: func(m interface{}) interface{} { return m.(*models.JobPost).ExpireAt }},
+	"title":            {Column: "jp.title", Value: func(m interface{}) interface{} { return m.(*models.JobPost).Title }},
+	"years_experience": {Column: "coalesce(jp.years_experience, 0)", Value: func(m interface{}) interface{} { return m.(*models.JobPost).YearsExperience }},
+	"min_salary":       {Column: "coalesce(jp.min_salary, 0)", Value: func(m interface{}) interface{} { return m.(*models.JobPost).MinSalary }},
+	"max_salary":       {Column: "coalesce(jp.max_salary, 0)", Value: func(m interface{}) interface{} { return m.(*models.JobPost).MaxSalary }},
+}
+
+// keyPersonSorts declares the fields that KeyPersons can be sorted by
+var keyPersonSorts = pagination.Sorts{
+	"id":   {Column: "kp.id"},
+	"name": {Column: "kp.name", Value: func(m interface{}) interface{} { return m.(*models.KeyPerson).Name }},
+}
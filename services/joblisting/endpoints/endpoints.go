@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-kit/kit/endpoint"
 
+	"in-backend/pagination"
 	"in-backend/services/joblisting/interfaces"
 	"in-backend/services/joblisting/models"
 )
@@ -44,6 +45,16 @@ type Endpoints struct {
 	CreateJobPlatform  endpoint.Endpoint
 	GetAllJobPlatforms endpoint.Endpoint
 	DeleteJobPlatform  endpoint.Endpoint
+
+	CreateApplication       endpoint.Endpoint
+	GetAllApplications      endpoint.Endpoint
+	LocalGetAllApplications endpoint.Endpoint
+	GetApplicationByID      endpoint.Endpoint
+	UpdateApplicationStatus endpoint.Endpoint
+	DeleteApplication       endpoint.Endpoint
+
+	LocalExportCandidateData endpoint.Endpoint
+	LocalEraseCandidateData  endpoint.Endpoint
 }
 
 // MakeEndpoints initializes all Go kit endpoints for the joblisting service.
@@ -81,6 +92,16 @@ func MakeEndpoints(s interfaces.Service) Endpoints {
 		CreateJobPlatform:  makeCreateJobPlatformEndpoint(s),
 		GetAllJobPlatforms: makeGetAllJobPlatformsEndpoint(s),
 		DeleteJobPlatform:  makeDeleteJobPlatformEndpoint(s),
+
+		CreateApplication:       makeCreateApplicationEndpoint(s),
+		GetAllApplications:      makeGetAllApplicationsEndpoint(s),
+		LocalGetAllApplications: makeLocalGetAllApplicationsEndpoint(s),
+		GetApplicationByID:      makeGetApplicationByIDEndpoint(s),
+		UpdateApplicationStatus: makeUpdateApplicationStatusEndpoint(s),
+		DeleteApplication:       makeDeleteApplicationEndpoint(s),
+
+		LocalExportCandidateData: makeLocalExportCandidateDataEndpoint(s),
+		LocalEraseCandidateData:  makeLocalEraseCandidateDataEndpoint(s),
 	}
 }
 
@@ -128,8 +149,8 @@ func makeGetAllJobPostsEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetAllJobPostsRequest)
 		f := models.JobPostFilters(req)
-		m, err := s.GetAllJobPosts(ctx, f)
-		return GetAllJobPostsResponse{JobPosts: m, Err: err}, nil
+		m, page, err := s.GetAllJobPosts(ctx, f)
+		return GetAllJobPostsResponse{JobPosts: m, Page: page, Err: err}, nil
 	}
 }
 
@@ -152,11 +173,15 @@ type GetAllJobPostsRequest struct {
 	Salary             uint64
 	UpdatedAt          *time.Time
 	ExpireAt           *time.Time
+	Query              string
+	QuerySkillID       []uint64
+	Page               pagination.Params
 }
 
 // GetAllJobPostsResponse declares the outputs after attempting to get all joblistings
 type GetAllJobPostsResponse struct {
 	JobPosts []*models.JobPost
+	Page     *pagination.Info
 	Err      error
 }
 
@@ -477,8 +502,8 @@ func makeGetAllKeyPersonsEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetAllKeyPersonsRequest)
 		f := models.KeyPersonFilters(req)
-		m, err := s.GetAllKeyPersons(ctx, f)
-		return GetAllKeyPersonsResponse{KeyPersons: m, Err: err}, nil
+		m, page, err := s.GetAllKeyPersons(ctx, f)
+		return GetAllKeyPersonsResponse{KeyPersons: m, Page: page, Err: err}, nil
 	}
 }
 
@@ -490,11 +515,13 @@ type GetAllKeyPersonsRequest struct {
 	ContactNumber string
 	Email         string
 	JobTitle      string
+	Page          pagination.Params
 }
 
 // GetAllKeyPersonsResponse declares the outputs after attempting to get all joblistings
 type GetAllKeyPersonsResponse struct {
 	KeyPersons []*models.KeyPerson
+	Page       *pagination.Info
 	Err        error
 }
 
@@ -614,3 +641,149 @@ type DeleteJobPlatformRequest struct {
 type DeleteJobPlatformResponse struct {
 	Err error
 }
+
+/* -------------- Application -------------- */
+
+func makeCreateApplicationEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateApplicationRequest)
+		m, err := s.CreateApplication(ctx, req.Application)
+		return CreateApplicationResponse{Application: m, Err: err}, nil
+	}
+}
+
+// CreateApplicationRequest declares the inputs required for creating an application
+type CreateApplicationRequest struct {
+	Application *models.Application
+}
+
+// CreateApplicationResponse declares the outputs after attempting to create an application
+type CreateApplicationResponse struct {
+	Application *models.Application
+	Err         error
+}
+
+func makeGetAllApplicationsEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAllApplicationsRequest)
+		f := models.ApplicationFilters(req)
+		m, err := s.GetAllApplications(ctx, f)
+		return GetAllApplicationsResponse{Applications: m, Err: err}, nil
+	}
+}
+
+func makeLocalGetAllApplicationsEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAllApplicationsRequest)
+		f := models.ApplicationFilters(req)
+		m, err := s.LocalGetAllApplications(ctx, f)
+		return GetAllApplicationsResponse{Applications: m, Err: err}, nil
+	}
+}
+
+// GetAllApplicationsRequest declares the inputs required for getting all applications
+type GetAllApplicationsRequest struct {
+	ID          []uint64
+	JobPostID   []uint64
+	CandidateID []uint64
+	CompanyID   []uint64
+	Status      []string
+}
+
+// GetAllApplicationsResponse declares the outputs after attempting to get all applications
+type GetAllApplicationsResponse struct {
+	Applications []*models.Application
+	Err          error
+}
+
+func makeGetApplicationByIDEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetApplicationByIDRequest)
+		m, err := s.GetApplicationByID(ctx, req.ID)
+		return GetApplicationByIDResponse{Application: m, Err: err}, nil
+	}
+}
+
+// GetApplicationByIDRequest declares the inputs required for getting a single application by ID
+type GetApplicationByIDRequest struct {
+	ID uint64
+}
+
+// GetApplicationByIDResponse declares the outputs after attempting to get a single application by ID
+type GetApplicationByIDResponse struct {
+	Application *models.Application
+	Err         error
+}
+
+func makeUpdateApplicationStatusEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateApplicationStatusRequest)
+		m, err := s.UpdateApplicationStatus(ctx, req.ID, req.Status)
+		return UpdateApplicationStatusResponse{Application: m, Err: err}, nil
+	}
+}
+
+// UpdateApplicationStatusRequest declares the inputs required for updating the status of an application
+type UpdateApplicationStatusRequest struct {
+	ID     uint64
+	Status string
+}
+
+// UpdateApplicationStatusResponse declares the outputs after attempting to update the status of an application
+type UpdateApplicationStatusResponse struct {
+	Application *models.Application
+	Err         error
+}
+
+func makeDeleteApplicationEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteApplicationRequest)
+		err := s.DeleteApplication(ctx, req.ID)
+		return DeleteApplicationResponse{Err: err}, nil
+	}
+}
+
+// DeleteApplicationRequest declares the inputs required for deleting an application
+type DeleteApplicationRequest struct {
+	ID uint64
+}
+
+// DeleteApplicationResponse declares the outputs after attempting to delete an application
+type DeleteApplicationResponse struct {
+	Err error
+}
+
+/* -------------- Candidate Data -------------- */
+
+func makeLocalExportCandidateDataEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CandidateDataRequest)
+		m, err := s.LocalExportCandidateData(ctx, req.CandidateID)
+		return LocalExportCandidateDataResponse{CandidateData: m, Err: err}, nil
+	}
+}
+
+// CandidateDataRequest declares the inputs required for exporting or erasing the data about a candidate
+type CandidateDataRequest struct {
+	CandidateID uint64
+}
+
+// LocalExportCandidateDataResponse declares the outputs after attempting to export the data about a candidate
+type LocalExportCandidateDataResponse struct {
+	CandidateData *models.CandidateData
+	Err           error
+}
+
+func makeLocalEraseCandidateDataEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CandidateDataRequest)
+		n, err := s.LocalEraseCandidateData(ctx, req.CandidateID)
+		return LocalEraseCandidateDataResponse{Erased: n, Err: err}, nil
+	}
+}
+
+// LocalEraseCandidateDataResponse declares the outputs after attempting to erase the data about a candidate
+type LocalEraseCandidateDataResponse struct {
+	Erased uint64
+	Err    error
+}
@@ -0,0 +1,159 @@
+package endpoints
+
+import (
+	"context"
+
+	"in-backend/rbac"
+	"in-backend/services/joblisting/interfaces"
+	"in-backend/services/joblisting/models"
+)
+
+// MakeOwners returns the owners of the resources of the requests that are authorized by ownership.
+// JobPosts and KeyPersons that do not exist cannot be changed by anyone,
+// and the owners of an update are both the existing and the updated company
+func MakeOwners(r interfaces.Repository) rbac.Owners {
+	jobPost := func(ctx context.Context, id uint64) (rbac.Owner, error) {
+		j, err := r.GetJobPostByID(ctx, id)
+		if err != nil {
+			return rbac.Owner{}, err
+		}
+		if j == nil {
+			return rbac.Owner{}, rbac.ErrForbidden
+		}
+		return rbac.Owner{CompanyID: j.CompanyID}, nil
+	}
+	keyPerson := func(ctx context.Context, id uint64) (rbac.Owner, error) {
+		kp, err := r.GetKeyPersonByID(ctx, id)
+		if err != nil {
+			return rbac.Owner{}, err
+		}
+		if kp == nil {
+			return rbac.Owner{}, rbac.ErrForbidden
+		}
+		return rbac.Owner{CompanyID: kp.CompanyID}, nil
+	}
+	application := func(ctx context.Context, id uint64) ([]rbac.Owner, error) {
+		a, err := r.GetApplicationByID(ctx, id)
+		if err != nil || a == nil {
+			return nil, err
+		}
+		return []rbac.Owner{{CandidateID: a.CandidateID, CompanyID: a.CompanyID}}, nil
+	}
+
+	return rbac.Owners{
+		"CreateJobPost": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateJobPostRequest).JobPost
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return []rbac.Owner{{CompanyID: m.CompanyID}}, nil
+		},
+		"BulkCreateJobPost": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return jobPostOwners(request.(BulkCreateJobPostRequest).JobPosts)
+		},
+		"UpdateJobPost": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateJobPostRequest).JobPost
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			o, err := jobPost(ctx, m.ID)
+			if err != nil {
+				return nil, err
+			}
+			return []rbac.Owner{o, {CompanyID: m.CompanyID}}, nil
+		},
+		"DeleteJobPost": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			o, err := jobPost(ctx, request.(DeleteJobPostRequest).ID)
+			if err != nil {
+				return nil, err
+			}
+			return []rbac.Owner{o}, nil
+		},
+
+		"UpdateCompany": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateCompanyRequest).Company
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return []rbac.Owner{{CompanyID: m.ID}}, nil
+		},
+		"DeleteCompany": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return []rbac.Owner{{CompanyID: request.(DeleteCompanyRequest).ID}}, nil
+		},
+
+		"CreateKeyPerson": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateKeyPersonRequest).KeyPerson
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return []rbac.Owner{{CompanyID: m.CompanyID}}, nil
+		},
+		"BulkCreateKeyPerson": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return keyPersonOwners(request.(BulkCreateKeyPersonRequest).KeyPersons)
+		},
+		"GetKeyPersonByID": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			o, err := keyPerson(ctx, request.(GetKeyPersonByIDRequest).ID)
+			if err != nil {
+				return nil, err
+			}
+			return []rbac.Owner{o}, nil
+		},
+		"UpdateKeyPerson": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateKeyPersonRequest).KeyPerson
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			o, err := keyPerson(ctx, m.ID)
+			if err != nil {
+				return nil, err
+			}
+			return []rbac.Owner{o, {CompanyID: m.CompanyID}}, nil
+		},
+		"DeleteKeyPerson": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			o, err := keyPerson(ctx, request.(DeleteKeyPersonRequest).ID)
+			if err != nil {
+				return nil, err
+			}
+			return []rbac.Owner{o}, nil
+		},
+
+		"CreateApplication": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateApplicationRequest).Application
+			if m == nil {
+				return nil, nil
+			}
+			return []rbac.Owner{{CandidateID: m.CandidateID}}, nil
+		},
+		"GetApplicationByID": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return application(ctx, request.(GetApplicationByIDRequest).ID)
+		},
+		"UpdateApplicationStatus": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return application(ctx, request.(UpdateApplicationStatusRequest).ID)
+		},
+		"DeleteApplication": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return application(ctx, request.(DeleteApplicationRequest).ID)
+		},
+	}
+}
+
+func jobPostOwners(m []*models.JobPost) ([]rbac.Owner, error) {
+	owners := make([]rbac.Owner, len(m))
+	for i, j := range m {
+		if j == nil {
+			return nil, rbac.ErrForbidden
+		}
+		owners[i] = rbac.Owner{CompanyID: j.CompanyID}
+	}
+	return owners, nil
+}
+
+func keyPersonOwners(m []*models.KeyPerson) ([]rbac.Owner, error) {
+	owners := make([]rbac.Owner, len(m))
+	for i, kp := range m {
+		if kp == nil {
+			return nil, rbac.ErrForbidden
+		}
+		owners[i] = rbac.Owner{CompanyID: kp.CompanyID}
+	}
+	return owners, nil
+}
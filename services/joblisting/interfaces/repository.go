@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/joblisting/models"
 )
 
@@ -16,7 +17,7 @@ type Repository interface {
 	BulkCreateJobPost(ctx context.Context, m []*models.JobPost) ([]*models.JobPost, error)
 
 	// GetAllJobPosts returns all JobPosts that match the filters
-	GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, error)
+	GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, *pagination.Info, error)
 
 	// GetJobPostByID finds and returns a JobPost by ID
 	GetJobPostByID(ctx context.Context, id uint64) (*models.JobPost, error)
@@ -72,7 +73,7 @@ type Repository interface {
 	BulkCreateKeyPerson(ctx context.Context, m []*models.KeyPerson) ([]*models.KeyPerson, error)
 
 	// GetAllKeyPersons returns all KeyPersons that match the filters
-	GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, error)
+	GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, *pagination.Info, error)
 
 	// GetKeyPersonByID finds and returns a KeyPerson by ID
 	GetKeyPersonByID(ctx context.Context, id uint64) (*models.KeyPerson, error)
@@ -93,4 +94,24 @@ type Repository interface {
 
 	// DeleteJobPlatform deletes a JobPlatform by ID
 	DeleteJobPlatform(ctx context.Context, id uint64) error
+
+	/* --------------- Application --------------- */
+
+	// CreateApplication creates a new Application
+	CreateApplication(ctx context.Context, m *models.Application) (*models.Application, error)
+
+	// GetAllApplications returns all Applications that match the filters
+	GetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error)
+
+	// GetApplicationByID finds and returns an Application by ID
+	GetApplicationByID(ctx context.Context, id uint64) (*models.Application, error)
+
+	// UpdateApplicationStatus updates the Status of an Application
+	UpdateApplicationStatus(ctx context.Context, m *models.Application) (*models.Application, error)
+
+	// DeleteApplication deletes an Application by ID
+	DeleteApplication(ctx context.Context, id uint64) error
+
+	// DeleteCandidateApplications deletes all Applications of a Candidate and returns how many were deleted
+	DeleteCandidateApplications(ctx context.Context, cid uint64) (uint64, error)
 }
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/joblisting/models"
 )
 
@@ -16,7 +17,7 @@ type Service interface {
 	BulkCreateJobPost(ctx context.Context, m []*models.JobPost) ([]*models.JobPost, error)
 
 	// GetAllJobPosts returns all JobPosts that match the filters
-	GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, error)
+	GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, *pagination.Info, error)
 
 	// GetJobPostByID finds and returns a JobPost by ID
 	GetJobPostByID(ctx context.Context, id uint64) (*models.JobPost, error)
@@ -78,7 +79,7 @@ type Service interface {
 	BulkCreateKeyPerson(ctx context.Context, m []*models.KeyPerson) ([]*models.KeyPerson, error)
 
 	// GetAllKeyPersons returns all KeyPersons that match the filters
-	GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, error)
+	GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, *pagination.Info, error)
 
 	// GetKeyPersonByID finds and returns a KeyPerson by ID
 	GetKeyPersonByID(ctx context.Context, id uint64) (*models.KeyPerson, error)
@@ -99,4 +100,32 @@ type Service interface {
 
 	// DeleteJobPlatform deletes a JobPlatform by ID
 	DeleteJobPlatform(ctx context.Context, id uint64) error
+
+	/* --------------- Application --------------- */
+
+	// CreateApplication creates a new Application for a JobPost
+	CreateApplication(ctx context.Context, m *models.Application) (*models.Application, error)
+
+	// GetAllApplications returns all Applications that match the filters
+	GetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error)
+
+	// LocalGetAllApplications returns all Applications that match the filters, for other services
+	LocalGetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error)
+
+	// GetApplicationByID finds and returns an Application by ID
+	GetApplicationByID(ctx context.Context, id uint64) (*models.Application, error)
+
+	// UpdateApplicationStatus moves an Application to the next status in the hiring pipeline
+	UpdateApplicationStatus(ctx context.Context, id uint64, status string) (*models.Application, error)
+
+	// DeleteApplication deletes an Application by ID
+	DeleteApplication(ctx context.Context, id uint64) error
+
+	/* --------------- Candidate Data --------------- */
+
+	// LocalExportCandidateData returns all data about a Candidate, for other services
+	LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error)
+
+	// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased, for other services
+	LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error)
 }
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"in-backend/pagination"
+)
 
 // JobPostFilters define filters for JobPost model
 type JobPostFilters struct {
@@ -21,6 +25,9 @@ type JobPostFilters struct {
 	Salary             uint64
 	UpdatedAt          *time.Time
 	ExpireAt           *time.Time
+	Query              string
+	QuerySkillID       []uint64 // set by the service to the skills named in Query
+	Page               pagination.Params
 }
 
 // CompanyFilters define filters for Company model
@@ -43,6 +50,7 @@ type KeyPersonFilters struct {
 	ContactNumber string
 	Email         string
 	JobTitle      string
+	Page          pagination.Params
 }
 
 // JobPlatformFilters define filters for JobPlatform model
@@ -56,3 +64,12 @@ type JobFunctionFilters struct {
 	ID   []uint64
 	Name string
 }
+
+// ApplicationFilters define filters for Application model
+type ApplicationFilters struct {
+	ID          []uint64
+	JobPostID   []uint64
+	CandidateID []uint64
+	CompanyID   []uint64
+	Status      []string
+}
@@ -15,7 +15,7 @@ func init() {
 
 // JobPost declares the model for JobPost
 type JobPost struct {
-	tableName struct{} `pg:"job_posts,alias:jp"`
+	tableName struct{} `pg:"job_posts,alias:jp,discard_unknown_columns"`
 
 	ID              uint64       `json:"id"`
 	CompanyID       uint64       `json:"company_id" pg:",notnull"`
@@ -46,6 +46,7 @@ type JobPost struct {
 	HRContact       *KeyPerson   `json:"hr_contact" pg:"hr_contact,rel:has-one"`
 	HiringManager   *KeyPerson   `json:"hiring_manager" pg:"hiring_manager,rel:has-one"`
 	Skills          []*Skill     `json:"skills" pg:"-"`
+	Rank            float32      `json:"rank,omitempty" pg:"-"`
 }
 
 // BeforeInsert handles the event before a JobPost is inserted into the DB
@@ -71,7 +72,7 @@ func (m *JobPost) BeforeUpdate(ctx context.Context) (context.Context, error) {
 
 // Company declares the model for Company
 type Company struct {
-	tableName struct{} `pg:"companies,alias:co"`
+	tableName struct{} `pg:"companies,alias:co,discard_unknown_columns"`
 
 	ID         uint64       `json:"id"`
 	Name       string       `json:"name" pg:",notnull,unique"`
@@ -147,6 +148,52 @@ type JobPlatform struct {
 	JobPosts []*JobPost `json:"job_posts" pg:"rel:has-many"`
 }
 
+// Application statuses, in the order of the hiring pipeline
+const (
+	ApplicationApplied   string = "Applied"
+	ApplicationScreening string = "Screening"
+	ApplicationInterview string = "Interview"
+	ApplicationOffer     string = "Offer"
+	ApplicationHired     string = "Hired"
+	ApplicationRejected  string = "Rejected"
+)
+
+// Application declares the model for Application
+type Application struct {
+	tableName struct{} `pg:"applications,alias:ap"`
+
+	ID          uint64     `json:"id"`
+	JobPostID   uint64     `json:"job_post_id" pg:",notnull"`
+	CandidateID uint64     `json:"candidate_id" pg:",notnull"`
+	CompanyID   uint64     `json:"company_id" pg:",notnull"`
+	Status      string     `json:"status" pg:",notnull"`
+	CoverLetter string     `json:"cover_letter"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	JobPost     *JobPost   `json:"job_post" pg:"rel:has-one"`
+}
+
+// BeforeInsert handles the event before an Application is inserted into the DB
+func (m *Application) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+// BeforeUpdate handles the event before an Application is updated in the DB
+func (m *Application) BeforeUpdate(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+// CandidateData declares the data about a Candidate that is kept by the joblisting service,
+// which is exported to the Candidate on request
+type CandidateData struct {
+	Applications []*Application `json:"applications"`
+}
+
 // Skill replicates the model for Skill in Profile Service
 type Skill struct {
 	ID   uint64 `json:"id"`
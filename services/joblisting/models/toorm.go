@@ -179,3 +179,25 @@ func ProfileSkillToORM(m *pb.ProfileSkill) *Skill {
 		Name: m.Name,
 	}
 }
+
+// ApplicationToORM maps the proto Application model to the ORM model
+func ApplicationToORM(m *pb.Application) *Application {
+	if m == nil {
+		return nil
+	}
+
+	createdAt := helpers.ProtoTimeToTime(m.CreatedAt)
+	updatedAt := helpers.ProtoTimeToTime(m.UpdatedAt)
+
+	return &Application{
+		ID:          m.Id,
+		JobPostID:   m.JobPostId,
+		CandidateID: m.CandidateId,
+		CompanyID:   m.CompanyId,
+		Status:      m.Status,
+		CoverLetter: m.CoverLetter,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+		JobPost:     JobPostToORM(m.JobPost),
+	}
+}
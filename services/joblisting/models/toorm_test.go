@@ -606,3 +606,34 @@ func TestJobPlatformToORM(t *testing.T) {
 	got := JobPlatformToORM(input)
 	require.EqualValues(t, expect, got)
 }
+
+func TestApplicationToORM(t *testing.T) {
+	testPbTime := ptypes.TimestampNow()
+	testTime, err := ptypes.Timestamp(testPbTime)
+	require.NoError(t, err)
+
+	input := &pb.Application{
+		Id:          1,
+		JobPostId:   2,
+		CandidateId: 3,
+		CompanyId:   4,
+		Status:      "Screening",
+		CoverLetter: "cover letter",
+		CreatedAt:   testPbTime,
+		UpdatedAt:   testPbTime,
+	}
+
+	expect := &Application{
+		ID:          1,
+		JobPostID:   2,
+		CandidateID: 3,
+		CompanyID:   4,
+		Status:      "Screening",
+		CoverLetter: "cover letter",
+		CreatedAt:   &testTime,
+		UpdatedAt:   &testTime,
+	}
+
+	got := ApplicationToORM(input)
+	require.EqualValues(t, expect, got)
+}
@@ -52,6 +52,7 @@ func (m *JobPost) ToProto() *pb.JobPost {
 		HrContact:       m.HRContact.ToProto(),
 		HiringManager:   m.HiringManager.ToProto(),
 		Skills:          skills,
+		Rank:            m.Rank,
 	}
 }
 
@@ -179,3 +180,25 @@ func (m *Skill) ToProto() *pb.ProfileSkill {
 		Name: m.Name,
 	}
 }
+
+// ToProto maps the ORM Application model to the proto model
+func (m *Application) ToProto() *pb.Application {
+	if m == nil {
+		return nil
+	}
+
+	createdAt := helpers.TimeToProto(m.CreatedAt)
+	updatedAt := helpers.TimeToProto(m.UpdatedAt)
+
+	return &pb.Application{
+		Id:          m.ID,
+		JobPostId:   m.JobPostID,
+		CandidateId: m.CandidateID,
+		CompanyId:   m.CompanyID,
+		Status:      m.Status,
+		CoverLetter: m.CoverLetter,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+		JobPost:     m.JobPost.ToProto(),
+	}
+}
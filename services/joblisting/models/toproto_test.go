@@ -594,3 +594,34 @@ func TestJobPlatformToProto(t *testing.T) {
 	got := input.ToProto()
 	require.EqualValues(t, expect, got)
 }
+
+func TestApplicationToProto(t *testing.T) {
+	testPbTime := ptypes.TimestampNow()
+	testTime, err := ptypes.Timestamp(testPbTime)
+	require.NoError(t, err)
+
+	input := &Application{
+		ID:          1,
+		JobPostID:   2,
+		CandidateID: 3,
+		CompanyID:   4,
+		Status:      "Screening",
+		CoverLetter: "cover letter",
+		CreatedAt:   &testTime,
+		UpdatedAt:   &testTime,
+	}
+
+	expect := &pb.Application{
+		Id:          1,
+		JobPostId:   2,
+		CandidateId: 3,
+		CompanyId:   4,
+		Status:      "Screening",
+		CoverLetter: "cover letter",
+		CreatedAt:   testPbTime,
+		UpdatedAt:   testPbTime,
+	}
+
+	got := input.ToProto()
+	require.EqualValues(t, expect, got)
+}
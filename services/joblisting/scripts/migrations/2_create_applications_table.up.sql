@@ -0,0 +1,16 @@
+create table if not exists applications (
+    id bigserial not null primary key,
+	job_post_id bigint not null,
+	candidate_id bigint not null,
+	company_id bigint not null,
+	status text not null default 'Applied',
+	cover_letter text,
+	created_at timestamptz,
+	updated_at timestamptz,
+	constraint fk_job_posts foreign key(job_post_id) references job_posts(id) on delete cascade on update cascade,
+	constraint fk_companies foreign key(company_id) references companies(id) on delete cascade on update cascade,
+	constraint uq_applications_job_post_candidate unique(job_post_id, candidate_id)
+);
+
+create index on applications (candidate_id);
+create index on applications (company_id, status);
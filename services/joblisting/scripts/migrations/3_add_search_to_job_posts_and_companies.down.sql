@@ -0,0 +1,5 @@
+alter table job_posts
+drop column search;
+
+alter table companies
+drop column search;
@@ -0,0 +1,12 @@
+alter table companies
+add search tsvector generated always as (to_tsvector('english', name)) stored;
+
+create index on companies using gin (search);
+
+alter table job_posts
+add search tsvector generated always as (
+	setweight(to_tsvector('english', title), 'A') ||
+	setweight(to_tsvector('english', description), 'B')
+) stored;
+
+create index on job_posts using gin (search);
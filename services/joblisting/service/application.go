@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"in-backend/services/joblisting/models"
+)
+
+var (
+	errApplicationInvalid    = errors.New("Application must have a job post and candidate")
+	errApplicationNotFound   = errors.New("Application not found")
+	errJobPostNotFound       = errors.New("Job post not found")
+	errJobPostExpired        = errors.New("Job post is no longer accepting applications")
+	errApplicationTransition = errors.New("Application cannot be moved to this status")
+)
+
+// applicationTransitions lists the statuses an Application can move to from each status.
+// An application can be rejected at any stage until it is hired, and hired or rejected applications are final
+var applicationTransitions = map[string][]string{
+	models.ApplicationApplied:   {models.ApplicationScreening, models.ApplicationRejected},
+	models.ApplicationScreening: {models.ApplicationInterview, models.ApplicationRejected},
+	models.ApplicationInterview: {models.ApplicationOffer, models.ApplicationRejected},
+	models.ApplicationOffer:     {models.ApplicationHired, models.ApplicationRejected},
+	models.ApplicationHired:     {},
+	models.ApplicationRejected:  {},
+}
+
+// canTransition returns whether an Application can move from one status to another
+func canTransition(from, to string) bool {
+	for _, s := range applicationTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+/* --------------- Application --------------- */
+
+// CreateApplication creates a new Application for a JobPost.
+// Applications always start as Applied and belong to the company of the JobPost
+func (s *service) CreateApplication(ctx context.Context, model *models.Application) (*models.Application, error) {
+	if model == nil || model.JobPostID == 0 || model.CandidateID == 0 {
+		return nil, errApplicationInvalid
+	}
+
+	jp, err := s.repository.GetJobPostByID(ctx, model.JobPostID)
+	if err != nil {
+		return nil, err
+	}
+	if jp == nil {
+		return nil, errJobPostNotFound
+	}
+	if jp.ExpireAt != nil && jp.ExpireAt.Before(time.Now()) {
+		return nil, errJobPostExpired
+	}
+
+	model.CompanyID = jp.CompanyID
+	model.Status = models.ApplicationApplied
+	model.CoverLetter = s.sanitizer.Sanitize(model.CoverLetter)
+
+	m, err := s.repository.CreateApplication(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetAllApplications returns all Applications that match the filters
+func (s *service) GetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error) {
+	m, err := s.repository.GetAllApplications(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// LocalGetAllApplications returns all Applications that match the filters, for other services
+func (s *service) LocalGetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error) {
+	m, err := s.repository.GetAllApplications(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetApplicationByID finds and returns an Application by ID
+func (s *service) GetApplicationByID(ctx context.Context, id uint64) (*models.Application, error) {
+	m, err := s.repository.GetApplicationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// UpdateApplicationStatus moves an Application to the next status in the hiring pipeline
+func (s *service) UpdateApplicationStatus(ctx context.Context, id uint64, status string) (*models.Application, error) {
+	a, err := s.repository.GetApplicationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return nil, errApplicationNotFound
+	}
+	if !canTransition(a.Status, status) {
+		return nil, errApplicationTransition
+	}
+
+	a.Status = status
+	m, err := s.repository.UpdateApplicationStatus(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// DeleteApplication deletes an Application by ID
+func (s *service) DeleteApplication(ctx context.Context, id uint64) error {
+	err := s.repository.DeleteApplication(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+/* --------------- Candidate Data --------------- */
+
+// LocalExportCandidateData returns all data about a Candidate, for other services
+func (s *service) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	applications, err := s.repository.GetAllApplications(ctx, models.ApplicationFilters{CandidateID: []uint64{cid}})
+	if err != nil {
+		return nil, err
+	}
+	return &models.CandidateData{Applications: applications}, nil
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased, for other services
+func (s *service) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	n, err := s.repository.DeleteCandidateApplications(ctx, cid)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
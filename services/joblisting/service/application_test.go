@@ -0,0 +1,122 @@
+package service
+
+import (
+	"in-backend/services/joblisting/models"
+	"in-backend/services/joblisting/tests/mocks"
+	"testing"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransition(t *testing.T) {
+	var tests = []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{models.ApplicationApplied, models.ApplicationScreening, true},
+		{models.ApplicationScreening, models.ApplicationInterview, true},
+		{models.ApplicationInterview, models.ApplicationOffer, true},
+		{models.ApplicationOffer, models.ApplicationHired, true},
+		{models.ApplicationInterview, models.ApplicationRejected, true},
+		{models.ApplicationApplied, models.ApplicationOffer, false},
+		{models.ApplicationScreening, models.ApplicationApplied, false},
+		{models.ApplicationHired, models.ApplicationRejected, false},
+		{models.ApplicationRejected, models.ApplicationScreening, false},
+		{models.ApplicationApplied, "Unknown", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			require.Equal(t, tt.want, canTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func TestCreateApplication(t *testing.T) {
+	future := now.AddDate(0, 0, 1)
+	past := now.AddDate(0, 0, -1)
+
+	var tests = []struct {
+		name  string
+		input *models.Application
+		jp    *models.JobPost
+		err   error
+	}{
+		{"valid", &models.Application{JobPostID: 1, CandidateID: 2, CompanyID: 9, Status: models.ApplicationHired}, &models.JobPost{ID: 1, CompanyID: 3, ExpireAt: &future}, nil},
+		{"no job post", &models.Application{CandidateID: 2}, nil, errApplicationInvalid},
+		{"no candidate", &models.Application{JobPostID: 1}, nil, errApplicationInvalid},
+		{"nil", nil, nil, errApplicationInvalid},
+		{"job post not found", &models.Application{JobPostID: 1, CandidateID: 2}, nil, errJobPostNotFound},
+		{"job post expired", &models.Application{JobPostID: 1, CandidateID: 2}, &models.JobPost{ID: 1, CompanyID: 3, ExpireAt: &past}, errJobPostExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.Repository{}
+			s := New(repo, bluemonday.UGCPolicy())
+			repo.On("GetJobPostByID", ctx, uint64(1)).Return(tt.jp, nil)
+			repo.On("CreateApplication", ctx, tt.input).Return(tt.input, nil)
+
+			got, err := s.CreateApplication(ctx, tt.input)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				repo.AssertNotCalled(t, "CreateApplication", mock.Anything, mock.Anything)
+				return
+			}
+			// company and status are always set by the server
+			require.Equal(t, uint64(3), got.CompanyID)
+			require.Equal(t, models.ApplicationApplied, got.Status)
+		})
+	}
+}
+
+func TestUpdateApplicationStatus(t *testing.T) {
+	var tests = []struct {
+		name   string
+		status string
+		err    error
+	}{
+		{"next stage", models.ApplicationInterview, nil},
+		{"reject", models.ApplicationRejected, nil},
+		{"skip stage", models.ApplicationHired, errApplicationTransition},
+		{"go back", models.ApplicationApplied, errApplicationTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.Repository{}
+			s := New(repo, nil)
+			existing := &models.Application{ID: 1, Status: models.ApplicationScreening}
+			repo.On("GetApplicationByID", ctx, uint64(1)).Return(existing, nil)
+			repo.On("UpdateApplicationStatus", ctx, existing).Return(existing, nil)
+
+			got, err := s.UpdateApplicationStatus(ctx, 1, tt.status)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				repo.AssertNotCalled(t, "UpdateApplicationStatus", mock.Anything, mock.Anything)
+				return
+			}
+			require.Equal(t, tt.status, got.Status)
+		})
+	}
+
+	repo := &mocks.Repository{}
+	s := New(repo, nil)
+	repo.On("GetApplicationByID", ctx, uint64(2)).Return(nil, nil)
+	_, err := s.UpdateApplicationStatus(ctx, 2, models.ApplicationScreening)
+	require.Equal(t, errApplicationNotFound, err)
+}
+
+func TestLocalExportCandidateData(t *testing.T) {
+	applications := []*models.Application{{ID: 1, CandidateID: 2, CoverLetter: "Hello"}}
+	repo := &mocks.Repository{}
+	repo.On("GetAllApplications", ctx, models.ApplicationFilters{CandidateID: []uint64{2}}).Return(applications, nil)
+
+	s := New(repo, bluemonday.UGCPolicy())
+	got, err := s.LocalExportCandidateData(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, &models.CandidateData{Applications: applications}, got)
+}
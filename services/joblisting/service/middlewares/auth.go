@@ -2,125 +2,43 @@ package middlewares
 
 import (
 	"context"
-	"errors"
-	"in-backend/helpers"
+	"in-backend/pagination"
+	"in-backend/rbac"
 	"in-backend/services/joblisting/interfaces"
 	"in-backend/services/joblisting/models"
-	"strconv"
-	"strings"
-
-	"github.com/dgrijalva/jwt-go"
-	"google.golang.org/grpc/metadata"
 )
 
 type authMiddleware struct {
 	next       interfaces.Service
-	repository interfaces.Repository
+	authorizer *rbac.Authorizer
 }
 
-var (
-	errAuth = errors.New("Forbidden")
-
-	idKey        = "https://hubbedin/id"
-	companyIDKey = "https://hubbedin/companyId"
-	rolesKey     = "https://hubbedin/roles"
-)
+var errAuth = rbac.ErrForbidden
 
-// NewAuthMiddleware creates and returns a new Auth Middleware that implements the joblisting Service interface
-func NewAuthMiddleware(svc interfaces.Service, r interfaces.Repository) interfaces.Service {
+// NewAuthMiddleware creates and returns a new Auth Middleware that implements the joblisting Service interface.
+// Callers are authorized by the policy of the service before their requests reach the service,
+// so this middleware only scopes the results of the methods that return resources of many owners
+func NewAuthMiddleware(svc interfaces.Service, a *rbac.Authorizer) interfaces.Service {
 	return &authMiddleware{
 		next:       svc,
-		repository: r,
+		authorizer: a,
 	}
 }
 
-func getRoleAndID(ctx context.Context, ownerID *uint64) (roles []string, id uint64, owns bool, err error) {
-	roles = []string{}
-	id = 0
-	owns = false
-	var companyID uint64 = 0
-
-	claims, err := getClaims(ctx)
-	if err != nil {
-		return
-	}
-
-	if claims[rolesKey] != nil {
-		for _, r := range claims[rolesKey].([]interface{}) {
-			roles = append(roles, r.(string))
-		}
-	}
-
-	if claims[companyIDKey] != nil {
-		companyID, err = strconv.ParseUint(claims[companyIDKey].(string), 10, 64)
-		if err != nil {
-			return
-		}
-		if ownerID != nil && companyID == *ownerID {
-			owns = true
-		}
-	}
-
-	if claims[idKey] != nil {
-		id, err = strconv.ParseUint(claims[idKey].(string), 10, 64)
-		if err != nil {
-			return
-		}
-	}
-
-	return
-}
-
-func getClaims(ctx context.Context) (jwt.MapClaims, error) {
-	headers, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, errAuth
-	}
-
-	if len(headers["authorization"]) == 0 {
-		return nil, errAuth
-	}
-	tokenString := strings.Split(headers["authorization"][0], " ")[1]
-	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
-	if err != nil {
-		return nil, errAuth
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, errAuth
-	}
-	return claims, nil
-}
-
 /* --------------- Job Post --------------- */
 
 // CreateJobPost creates a new JobPost
 func (mw authMiddleware) CreateJobPost(ctx context.Context, model *models.JobPost) (*models.JobPost, error) {
-	roles, _, owns, err := getRoleAndID(ctx, &model.CompanyID)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return nil, errAuth
-	}
 	return mw.next.CreateJobPost(ctx, model)
 }
 
 // BulkCreateJobPost creates multiple JobPosts
 func (mw authMiddleware) BulkCreateJobPost(ctx context.Context, models []*models.JobPost) ([]*models.JobPost, error) {
-	roles, _, owns, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return nil, errAuth
-	}
 	return mw.next.BulkCreateJobPost(ctx, models)
 }
 
 // GetAllJobPosts returns all JobPosts that match the filters
-func (mw authMiddleware) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, error) {
+func (mw authMiddleware) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, *pagination.Info, error) {
 	return mw.next.GetAllJobPosts(ctx, f)
 }
 
@@ -131,43 +49,11 @@ func (mw authMiddleware) GetJobPostByID(ctx context.Context, id uint64) (*models
 
 // UpdateJobPost updates a JobPost
 func (mw authMiddleware) UpdateJobPost(ctx context.Context, model *models.JobPost) (*models.JobPost, error) {
-	j, err := mw.repository.GetJobPostByID(ctx, model.ID)
-	if err != nil {
-		return nil, err
-	}
-
-	if j.CompanyID != model.CompanyID {
-		return nil, errAuth
-	}
-
-	roles, _, owns, err := getRoleAndID(ctx, &j.CompanyID)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return nil, errAuth
-	}
 	return mw.next.UpdateJobPost(ctx, model)
 }
 
 // DeleteJobPost deletes a JobPost by ID
 func (mw authMiddleware) DeleteJobPost(ctx context.Context, id uint64) error {
-	j, err := mw.repository.GetJobPostByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	if j.CompanyID != id {
-		return errAuth
-	}
-
-	roles, _, owns, err := getRoleAndID(ctx, &j.CompanyID)
-	if err != nil {
-		return err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return errAuth
-	}
 	return mw.next.DeleteJobPost(ctx, id)
 }
 
@@ -175,13 +61,6 @@ func (mw authMiddleware) DeleteJobPost(ctx context.Context, id uint64) error {
 
 // CreateCompany creates a new Company
 func (mw authMiddleware) CreateCompany(ctx context.Context, model *models.Company) (*models.Company, error) {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && !helpers.IsStringInSlice("Company", roles) {
-		return nil, errAuth
-	}
 	return mw.next.CreateCompany(ctx, model)
 }
 
@@ -197,13 +76,6 @@ func (mw authMiddleware) GetAllCompanies(ctx context.Context, f models.CompanyFi
 
 // UpdateCompany updates a Company
 func (mw authMiddleware) UpdateCompany(ctx context.Context, model *models.Company) (*models.Company, error) {
-	roles, _, owns, err := getRoleAndID(ctx, &model.ID)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return nil, errAuth
-	}
 	return mw.next.UpdateCompany(ctx, model)
 }
 
@@ -214,13 +86,6 @@ func (mw authMiddleware) LocalUpdateCompany(ctx context.Context, model *models.C
 
 // DeleteCompany deletes a Company by ID
 func (mw authMiddleware) DeleteCompany(ctx context.Context, id uint64) error {
-	roles, _, owns, err := getRoleAndID(ctx, &id)
-	if err != nil {
-		return err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return errAuth
-	}
 	return mw.next.DeleteCompany(ctx, id)
 }
 
@@ -228,13 +93,6 @@ func (mw authMiddleware) DeleteCompany(ctx context.Context, id uint64) error {
 
 // CreateIndustry creates a new Industry
 func (mw authMiddleware) CreateIndustry(ctx context.Context, model *models.Industry) (*models.Industry, error) {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && !helpers.IsStringInSlice("Company", roles) {
-		return nil, errAuth
-	}
 	return mw.next.CreateIndustry(ctx, model)
 }
 
@@ -245,13 +103,6 @@ func (mw authMiddleware) GetAllIndustries(ctx context.Context, f models.Industry
 
 // DeleteIndustry deletes a Industry by ID
 func (mw authMiddleware) DeleteIndustry(ctx context.Context, id uint64) error {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) {
-		return errAuth
-	}
 	return mw.next.DeleteIndustry(ctx, id)
 }
 
@@ -259,13 +110,6 @@ func (mw authMiddleware) DeleteIndustry(ctx context.Context, id uint64) error {
 
 // CreateJobFunction creates a new JobFunction
 func (mw authMiddleware) CreateJobFunction(ctx context.Context, model *models.JobFunction) (*models.JobFunction, error) {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && !helpers.IsStringInSlice("Company", roles) {
-		return nil, errAuth
-	}
 	return mw.next.CreateJobFunction(ctx, model)
 }
 
@@ -276,13 +120,6 @@ func (mw authMiddleware) GetAllJobFunctions(ctx context.Context, f models.JobFun
 
 // DeleteJobFunction deletes a JobFunction by ID
 func (mw authMiddleware) DeleteJobFunction(ctx context.Context, id uint64) error {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) {
-		return errAuth
-	}
 	return mw.next.DeleteJobFunction(ctx, id)
 }
 
@@ -290,88 +127,39 @@ func (mw authMiddleware) DeleteJobFunction(ctx context.Context, id uint64) error
 
 // CreateKeyPerson creates a new KeyPerson
 func (mw authMiddleware) CreateKeyPerson(ctx context.Context, model *models.KeyPerson) (*models.KeyPerson, error) {
-	roles, _, owns, err := getRoleAndID(ctx, &model.CompanyID)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return nil, errAuth
-	}
 	return mw.next.CreateKeyPerson(ctx, model)
 }
 
 // BulkCreateKeyPerson creates multiple KeyPersons
 func (mw authMiddleware) BulkCreateKeyPerson(ctx context.Context, models []*models.KeyPerson) ([]*models.KeyPerson, error) {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) {
-		return nil, errAuth
-	}
 	return mw.next.BulkCreateKeyPerson(ctx, models)
 }
 
 // GetAllKeyPersons returns all KeyPersons that match the filters
-func (mw authMiddleware) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, error) {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) {
-		return nil, errAuth
+// Company users only see the KeyPersons of their own company
+func (mw authMiddleware) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, *pagination.Info, error) {
+	if mw.authorizer.Authorize(ctx, "GetAllKeyPersonsUnscoped") != nil {
+		s := rbac.FromContext(ctx)
+		if s == nil || s.CompanyID == 0 {
+			return nil, nil, errAuth
+		}
+		f.CompanyID = []uint64{s.CompanyID}
 	}
 	return mw.next.GetAllKeyPersons(ctx, f)
 }
 
 // GetKeyPersonByID finds and returns a KeyPerson by ID
 func (mw authMiddleware) GetKeyPersonByID(ctx context.Context, id uint64) (*models.KeyPerson, error) {
-	kp, err := mw.repository.GetKeyPersonByID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	roles, _, owns, err := getRoleAndID(ctx, &kp.CompanyID)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return nil, errAuth
-	}
 	return mw.next.GetKeyPersonByID(ctx, id)
 }
 
 // UpdateKeyPerson updates a KeyPerson
 func (mw authMiddleware) UpdateKeyPerson(ctx context.Context, model *models.KeyPerson) (*models.KeyPerson, error) {
-	kp, err := mw.repository.GetKeyPersonByID(ctx, model.ID)
-	if err != nil {
-		return nil, err
-	}
-
-	roles, _, owns, err := getRoleAndID(ctx, &kp.CompanyID)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return nil, errAuth
-	}
 	return mw.next.UpdateKeyPerson(ctx, model)
 }
 
 // DeleteKeyPerson deletes a KeyPerson by ID
 func (mw authMiddleware) DeleteKeyPerson(ctx context.Context, id uint64) error {
-	kp, err := mw.repository.GetKeyPersonByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	roles, _, owns, err := getRoleAndID(ctx, &kp.CompanyID)
-	if err != nil {
-		return err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) && owns == false {
-		return errAuth
-	}
 	return mw.next.DeleteKeyPerson(ctx, id)
 }
 
@@ -379,13 +167,6 @@ func (mw authMiddleware) DeleteKeyPerson(ctx context.Context, id uint64) error {
 
 // CreateJobPlatform creates a new JobPlatform
 func (mw authMiddleware) CreateJobPlatform(ctx context.Context, model *models.JobPlatform) (*models.JobPlatform, error) {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) {
-		return nil, errAuth
-	}
 	return mw.next.CreateJobPlatform(ctx, model)
 }
 
@@ -396,12 +177,63 @@ func (mw authMiddleware) GetAllJobPlatforms(ctx context.Context, f models.JobPla
 
 // DeleteJobPlatform deletes a JobPlatform by ID
 func (mw authMiddleware) DeleteJobPlatform(ctx context.Context, id uint64) error {
-	roles, _, _, err := getRoleAndID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if !helpers.IsStringInSlice("Admin", roles) {
-		return errAuth
-	}
 	return mw.next.DeleteJobPlatform(ctx, id)
 }
+
+/* --------------- Application --------------- */
+
+// CreateApplication creates a new Application for a JobPost
+func (mw authMiddleware) CreateApplication(ctx context.Context, model *models.Application) (*models.Application, error) {
+	return mw.next.CreateApplication(ctx, model)
+}
+
+// GetAllApplications returns all Applications that match the filters
+// Candidates only see their own applications and company users only see applications to their company
+func (mw authMiddleware) GetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error) {
+	if mw.authorizer.Authorize(ctx, "GetAllApplicationsUnscoped") != nil {
+		s := rbac.FromContext(ctx)
+		switch {
+		case s == nil:
+			return nil, errAuth
+		case s.CompanyID != 0:
+			f.CompanyID = []uint64{s.CompanyID}
+		case s.CandidateID != 0:
+			f.CandidateID = []uint64{s.CandidateID}
+		default:
+			return nil, errAuth
+		}
+	}
+	return mw.next.GetAllApplications(ctx, f)
+}
+
+// LocalGetAllApplications returns all Applications that match the filters, for other services
+func (mw authMiddleware) LocalGetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error) {
+	return mw.next.LocalGetAllApplications(ctx, f)
+}
+
+// GetApplicationByID finds and returns an Application by ID
+func (mw authMiddleware) GetApplicationByID(ctx context.Context, id uint64) (*models.Application, error) {
+	return mw.next.GetApplicationByID(ctx, id)
+}
+
+// UpdateApplicationStatus moves an Application to the next status in the hiring pipeline
+func (mw authMiddleware) UpdateApplicationStatus(ctx context.Context, id uint64, status string) (*models.Application, error) {
+	return mw.next.UpdateApplicationStatus(ctx, id, status)
+}
+
+// DeleteApplication deletes an Application by ID
+func (mw authMiddleware) DeleteApplication(ctx context.Context, id uint64) error {
+	return mw.next.DeleteApplication(ctx, id)
+}
+
+/* --------------- Candidate Data --------------- */
+
+// LocalExportCandidateData returns all data about a Candidate, for other services
+func (mw authMiddleware) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	return mw.next.LocalExportCandidateData(ctx, cid)
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased, for other services
+func (mw authMiddleware) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	return mw.next.LocalEraseCandidateData(ctx, cid)
+}
@@ -0,0 +1,438 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"in-backend/rbac"
+	"in-backend/services/joblisting/endpoints"
+	"in-backend/services/joblisting/models"
+	"in-backend/services/joblisting/tests/mocks"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeVerifier returns the claims of a token by the token itself
+type fakeVerifier map[string]jwt.MapClaims
+
+func (v fakeVerifier) Verify(token string) (jwt.MapClaims, error) {
+	c, ok := v[token]
+	if !ok {
+		return nil, errors.New("Invalid token")
+	}
+	return c, nil
+}
+
+var verifier = fakeVerifier{
+	"admin":     {rbac.IDKey: "1", rbac.RolesKey: []interface{}{"Admin"}},
+	"company":   {rbac.IDKey: "2", rbac.CompanyIDKey: "10", rbac.RolesKey: []interface{}{"Company"}},
+	"other":     {rbac.IDKey: "3", rbac.CompanyIDKey: "20", rbac.RolesKey: []interface{}{"Company"}},
+	"candidate": {rbac.IDKey: "4", rbac.CandidateIDKey: "5", rbac.RolesKey: []interface{}{"Candidate"}},
+}
+
+// callers are the tokens of the callers of each test case, and none for a caller without a token
+const (
+	admin     = "admin"
+	company   = "company"
+	other     = "other"
+	candidate = "candidate"
+	none      = ""
+)
+
+func withToken(token string) context.Context {
+	if token == none {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// newAuthMiddleware returns the endpoints of the middleware, authorized by the policy of the service,
+// with a repository that has JobPost 1 and KeyPerson 1 of company 10, and Application 1 of candidate 5 to company 10
+func newAuthMiddleware(t *testing.T) (endpoints.Endpoints, *mocks.Service) {
+	svc := &mocks.Service{}
+	r := &mocks.Repository{}
+	r.On("GetJobPostByID", mock.Anything, uint64(1)).Return(&models.JobPost{ID: 1, CompanyID: 10}, nil)
+	r.On("GetJobPostByID", mock.Anything, uint64(2)).Return(nil, nil)
+	r.On("GetKeyPersonByID", mock.Anything, uint64(1)).Return(&models.KeyPerson{ID: 1, CompanyID: 10}, nil)
+	r.On("GetKeyPersonByID", mock.Anything, uint64(2)).Return(nil, nil)
+	r.On("GetApplicationByID", mock.Anything, uint64(1)).Return(&models.Application{ID: 1, CandidateID: 5, CompanyID: 10}, nil)
+	r.On("GetApplicationByID", mock.Anything, uint64(2)).Return(nil, nil)
+
+	policy, err := rbac.LoadPolicy(rbac.FileName)
+	require.NoError(t, err)
+	a := rbac.NewAuthorizer(policy, verifier, endpoints.MakeOwners(r))
+	eps := endpoints.MakeEndpoints(NewAuthMiddleware(svc, a))
+	require.NoError(t, a.Wrap(&eps))
+	return eps, svc
+}
+
+// call calls an endpoint and returns the error of the endpoint or of its response
+func call(ep endpoint.Endpoint, ctx context.Context, request interface{}) error {
+	res, err := ep(ctx, request)
+	if err != nil {
+		return err
+	}
+	if err, ok := reflect.ValueOf(res).FieldByName("Err").Interface().(error); ok {
+		return err
+	}
+	return nil
+}
+
+// authTest is a case of a table driven test of an RPC, where allowed reports whether the call reaches the service
+type authTest struct {
+	name    string
+	caller  string
+	allowed bool
+}
+
+func requireAllowed(t *testing.T, tt authTest, svc *mocks.Service, method string, err error) {
+	if tt.allowed {
+		require.NoError(t, err)
+		svc.AssertCalled(t, method, mock.Anything, mock.Anything)
+		return
+	}
+	require.Equal(t, rbac.ErrForbidden, err)
+	svc.AssertNotCalled(t, method, mock.Anything, mock.Anything)
+}
+
+func TestCreateJobPost(t *testing.T) {
+	var tests = []struct {
+		authTest
+		input *models.JobPost
+	}{
+		{authTest{"admin", admin, true}, &models.JobPost{CompanyID: 20}},
+		{authTest{"own company", company, true}, &models.JobPost{CompanyID: 10}},
+		{authTest{"other company", other, false}, &models.JobPost{CompanyID: 10}},
+		{authTest{"candidate", candidate, false}, &models.JobPost{CompanyID: 10}},
+		{authTest{"no token", none, false}, &models.JobPost{CompanyID: 10}},
+		{authTest{"nil", company, false}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("CreateJobPost", mock.Anything, tt.input).Return(tt.input, nil)
+
+			err := call(eps.CreateJobPost, withToken(tt.caller), endpoints.CreateJobPostRequest{JobPost: tt.input})
+			requireAllowed(t, tt.authTest, svc, "CreateJobPost", err)
+		})
+	}
+}
+
+func TestBulkCreateJobPost(t *testing.T) {
+	var tests = []struct {
+		authTest
+		input []*models.JobPost
+	}{
+		{authTest{"admin", admin, true}, []*models.JobPost{{CompanyID: 10}, {CompanyID: 20}}},
+		{authTest{"own company", company, true}, []*models.JobPost{{CompanyID: 10}, {CompanyID: 10}}},
+		{authTest{"mixed companies", company, false}, []*models.JobPost{{CompanyID: 10}, {CompanyID: 20}}},
+		{authTest{"empty", company, false}, []*models.JobPost{}},
+		{authTest{"candidate", candidate, false}, []*models.JobPost{{CompanyID: 10}}},
+		{authTest{"no token", none, false}, []*models.JobPost{{CompanyID: 10}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("BulkCreateJobPost", mock.Anything, tt.input).Return(tt.input, nil)
+
+			err := call(eps.BulkCreateJobPost, withToken(tt.caller), endpoints.BulkCreateJobPostRequest{JobPosts: tt.input})
+			requireAllowed(t, tt.authTest, svc, "BulkCreateJobPost", err)
+		})
+	}
+}
+
+func TestGetAllJobPosts(t *testing.T) {
+	var tests = []authTest{
+		{"admin", admin, true},
+		{"company", company, true},
+		{"candidate", candidate, true},
+		{"no token", none, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			f := models.JobPostFilters{CompanyID: []uint64{20}}
+			svc.On("GetAllJobPosts", mock.Anything, f).Return(nil, nil, nil)
+
+			err := call(eps.GetAllJobPosts, withToken(tt.caller), endpoints.GetAllJobPostsRequest(f))
+			requireAllowed(t, tt, svc, "GetAllJobPosts", err)
+		})
+	}
+}
+
+func TestGetJobPostByID(t *testing.T) {
+	var tests = []authTest{
+		{"admin", admin, true},
+		{"other company", other, true},
+		{"candidate", candidate, true},
+		{"no token", none, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetJobPostByID", mock.Anything, uint64(1)).Return(&models.JobPost{ID: 1}, nil)
+
+			err := call(eps.GetJobPostByID, withToken(tt.caller), endpoints.GetJobPostByIDRequest{ID: 1})
+			requireAllowed(t, tt, svc, "GetJobPostByID", err)
+		})
+	}
+}
+
+func TestUpdateJobPost(t *testing.T) {
+	var tests = []struct {
+		authTest
+		input *models.JobPost
+	}{
+		{authTest{"admin", admin, true}, &models.JobPost{ID: 1, CompanyID: 20}},
+		{authTest{"own company", company, true}, &models.JobPost{ID: 1, CompanyID: 10}},
+		{authTest{"move to other company", company, false}, &models.JobPost{ID: 1, CompanyID: 20}},
+		{authTest{"other company", other, false}, &models.JobPost{ID: 1, CompanyID: 20}},
+		{authTest{"candidate", candidate, false}, &models.JobPost{ID: 1, CompanyID: 10}},
+		{authTest{"no token", none, false}, &models.JobPost{ID: 1, CompanyID: 10}},
+		{authTest{"not found", company, false}, &models.JobPost{ID: 2, CompanyID: 10}},
+		{authTest{"nil", company, false}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("UpdateJobPost", mock.Anything, tt.input).Return(tt.input, nil)
+
+			err := call(eps.UpdateJobPost, withToken(tt.caller), endpoints.UpdateJobPostRequest{JobPost: tt.input})
+			requireAllowed(t, tt.authTest, svc, "UpdateJobPost", err)
+		})
+	}
+}
+
+func TestDeleteJobPost(t *testing.T) {
+	var tests = []struct {
+		authTest
+		id uint64
+	}{
+		{authTest{"admin", admin, true}, 1},
+		{authTest{"own company", company, true}, 1},
+		{authTest{"other company", other, false}, 1},
+		{authTest{"candidate", candidate, false}, 1},
+		{authTest{"no token", none, false}, 1},
+		{authTest{"not found", company, false}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("DeleteJobPost", mock.Anything, tt.id).Return(nil)
+
+			err := call(eps.DeleteJobPost, withToken(tt.caller), endpoints.DeleteJobPostRequest{ID: tt.id})
+			requireAllowed(t, tt.authTest, svc, "DeleteJobPost", err)
+		})
+	}
+}
+
+func TestCreateKeyPerson(t *testing.T) {
+	var tests = []struct {
+		authTest
+		input *models.KeyPerson
+	}{
+		{authTest{"admin", admin, true}, &models.KeyPerson{CompanyID: 20}},
+		{authTest{"own company", company, true}, &models.KeyPerson{CompanyID: 10}},
+		{authTest{"other company", other, false}, &models.KeyPerson{CompanyID: 10}},
+		{authTest{"candidate", candidate, false}, &models.KeyPerson{CompanyID: 10}},
+		{authTest{"no token", none, false}, &models.KeyPerson{CompanyID: 10}},
+		{authTest{"nil", company, false}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("CreateKeyPerson", mock.Anything, tt.input).Return(tt.input, nil)
+
+			err := call(eps.CreateKeyPerson, withToken(tt.caller), endpoints.CreateKeyPersonRequest{KeyPerson: tt.input})
+			requireAllowed(t, tt.authTest, svc, "CreateKeyPerson", err)
+		})
+	}
+}
+
+func TestBulkCreateKeyPerson(t *testing.T) {
+	var tests = []struct {
+		authTest
+		input []*models.KeyPerson
+	}{
+		{authTest{"admin", admin, true}, []*models.KeyPerson{{CompanyID: 10}, {CompanyID: 20}}},
+		{authTest{"own company", company, true}, []*models.KeyPerson{{CompanyID: 10}, {CompanyID: 10}}},
+		{authTest{"mixed companies", company, false}, []*models.KeyPerson{{CompanyID: 10}, {CompanyID: 20}}},
+		{authTest{"empty", company, false}, []*models.KeyPerson{}},
+		{authTest{"nil", company, false}, []*models.KeyPerson{nil}},
+		{authTest{"candidate", candidate, false}, []*models.KeyPerson{{CompanyID: 10}}},
+		{authTest{"no token", none, false}, []*models.KeyPerson{{CompanyID: 10}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("BulkCreateKeyPerson", mock.Anything, tt.input).Return(tt.input, nil)
+
+			err := call(eps.BulkCreateKeyPerson, withToken(tt.caller), endpoints.BulkCreateKeyPersonRequest{KeyPersons: tt.input})
+			requireAllowed(t, tt.authTest, svc, "BulkCreateKeyPerson", err)
+		})
+	}
+}
+
+func TestGetAllKeyPersons(t *testing.T) {
+	var tests = []struct {
+		authTest
+		input models.KeyPersonFilters
+		want  models.KeyPersonFilters
+	}{
+		{authTest{"admin", admin, true}, models.KeyPersonFilters{CompanyID: []uint64{20}}, models.KeyPersonFilters{CompanyID: []uint64{20}}},
+		{authTest{"company is scoped to own company", company, true}, models.KeyPersonFilters{Name: "Jane"}, models.KeyPersonFilters{Name: "Jane", CompanyID: []uint64{10}}},
+		{authTest{"company asking for other company", company, true}, models.KeyPersonFilters{CompanyID: []uint64{20}}, models.KeyPersonFilters{CompanyID: []uint64{10}}},
+		{authTest{"candidate", candidate, false}, models.KeyPersonFilters{}, models.KeyPersonFilters{}},
+		{authTest{"no token", none, false}, models.KeyPersonFilters{}, models.KeyPersonFilters{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetAllKeyPersons", mock.Anything, mock.Anything).Return(nil, nil, nil)
+
+			err := call(eps.GetAllKeyPersons, withToken(tt.caller), endpoints.GetAllKeyPersonsRequest(tt.input))
+			requireAllowed(t, tt.authTest, svc, "GetAllKeyPersons", err)
+			if tt.allowed {
+				svc.AssertCalled(t, "GetAllKeyPersons", mock.Anything, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetKeyPersonByID(t *testing.T) {
+	var tests = []struct {
+		authTest
+		id uint64
+	}{
+		{authTest{"admin", admin, true}, 1},
+		{authTest{"own company", company, true}, 1},
+		{authTest{"other company", other, false}, 1},
+		{authTest{"candidate", candidate, false}, 1},
+		{authTest{"no token", none, false}, 1},
+		{authTest{"not found", admin, false}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetKeyPersonByID", mock.Anything, tt.id).Return(&models.KeyPerson{ID: tt.id}, nil)
+
+			err := call(eps.GetKeyPersonByID, withToken(tt.caller), endpoints.GetKeyPersonByIDRequest{ID: tt.id})
+			requireAllowed(t, tt.authTest, svc, "GetKeyPersonByID", err)
+		})
+	}
+}
+
+func TestUpdateKeyPerson(t *testing.T) {
+	var tests = []struct {
+		authTest
+		input *models.KeyPerson
+	}{
+		{authTest{"admin", admin, true}, &models.KeyPerson{ID: 1, CompanyID: 20}},
+		{authTest{"own company", company, true}, &models.KeyPerson{ID: 1, CompanyID: 10}},
+		{authTest{"move to other company", company, false}, &models.KeyPerson{ID: 1, CompanyID: 20}},
+		{authTest{"other company", other, false}, &models.KeyPerson{ID: 1, CompanyID: 20}},
+		{authTest{"candidate", candidate, false}, &models.KeyPerson{ID: 1, CompanyID: 10}},
+		{authTest{"no token", none, false}, &models.KeyPerson{ID: 1, CompanyID: 10}},
+		{authTest{"not found", company, false}, &models.KeyPerson{ID: 2, CompanyID: 10}},
+		{authTest{"nil", company, false}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("UpdateKeyPerson", mock.Anything, tt.input).Return(tt.input, nil)
+
+			err := call(eps.UpdateKeyPerson, withToken(tt.caller), endpoints.UpdateKeyPersonRequest{KeyPerson: tt.input})
+			requireAllowed(t, tt.authTest, svc, "UpdateKeyPerson", err)
+		})
+	}
+}
+
+func TestDeleteKeyPerson(t *testing.T) {
+	var tests = []struct {
+		authTest
+		id uint64
+	}{
+		{authTest{"admin", admin, true}, 1},
+		{authTest{"own company", company, true}, 1},
+		{authTest{"other company", other, false}, 1},
+		{authTest{"candidate", candidate, false}, 1},
+		{authTest{"no token", none, false}, 1},
+		{authTest{"not found", company, false}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("DeleteKeyPerson", mock.Anything, tt.id).Return(nil)
+
+			err := call(eps.DeleteKeyPerson, withToken(tt.caller), endpoints.DeleteKeyPersonRequest{ID: tt.id})
+			requireAllowed(t, tt.authTest, svc, "DeleteKeyPerson", err)
+		})
+	}
+}
+
+func TestGetAllApplications(t *testing.T) {
+	var tests = []struct {
+		authTest
+		want models.ApplicationFilters
+	}{
+		{authTest{"admin", admin, true}, models.ApplicationFilters{JobPostID: []uint64{1}}},
+		{authTest{"company is scoped to own company", company, true}, models.ApplicationFilters{JobPostID: []uint64{1}, CompanyID: []uint64{10}}},
+		{authTest{"candidate is scoped to own applications", candidate, true}, models.ApplicationFilters{JobPostID: []uint64{1}, CandidateID: []uint64{5}}},
+		{authTest{"no token", none, false}, models.ApplicationFilters{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetAllApplications", mock.Anything, mock.Anything).Return(nil, nil)
+
+			err := call(eps.GetAllApplications, withToken(tt.caller), endpoints.GetAllApplicationsRequest{JobPostID: []uint64{1}})
+			requireAllowed(t, tt.authTest, svc, "GetAllApplications", err)
+			if tt.allowed {
+				svc.AssertCalled(t, "GetAllApplications", mock.Anything, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetApplicationByID(t *testing.T) {
+	var tests = []struct {
+		authTest
+		id uint64
+	}{
+		{authTest{"admin", admin, true}, 1},
+		{authTest{"company applied to", company, true}, 1},
+		{authTest{"candidate who applied", candidate, true}, 1},
+		{authTest{"other company", other, false}, 1},
+		{authTest{"no token", none, false}, 1},
+		{authTest{"not found", company, false}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetApplicationByID", mock.Anything, tt.id).Return(&models.Application{ID: tt.id}, nil)
+
+			err := call(eps.GetApplicationByID, withToken(tt.caller), endpoints.GetApplicationByIDRequest{ID: tt.id})
+			requireAllowed(t, tt.authTest, svc, "GetApplicationByID", err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"context"
 	"fmt"
+	"in-backend/pagination"
 	"in-backend/services/joblisting/interfaces"
 	"in-backend/services/joblisting/models"
 	"time"
@@ -57,9 +58,9 @@ func (mw logMiddleware) BulkCreateJobPost(ctx context.Context, input []*models.J
 }
 
 // GetAllJobPosts returns all JobPosts that match the filters
-func (mw logMiddleware) GetAllJobPosts(ctx context.Context, input models.JobPostFilters) (output []*models.JobPost, err error) {
+func (mw logMiddleware) GetAllJobPosts(ctx context.Context, input models.JobPostFilters) (output []*models.JobPost, page *pagination.Info, err error) {
 	defer mw.log("GetAllJobPosts", time.Now(), input, output, &err)
-	output, err = mw.next.GetAllJobPosts(ctx, input)
+	output, page, err = mw.next.GetAllJobPosts(ctx, input)
 	return
 }
 
@@ -191,9 +192,9 @@ func (mw logMiddleware) BulkCreateKeyPerson(ctx context.Context, input []*models
 }
 
 // GetAllKeyPersons returns all KeyPersons that match the filters
-func (mw logMiddleware) GetAllKeyPersons(ctx context.Context, input models.KeyPersonFilters) (output []*models.KeyPerson, err error) {
+func (mw logMiddleware) GetAllKeyPersons(ctx context.Context, input models.KeyPersonFilters) (output []*models.KeyPerson, page *pagination.Info, err error) {
 	defer mw.log("GetAllKeyPersons", time.Now(), input, output, &err)
-	output, err = mw.next.GetAllKeyPersons(ctx, input)
+	output, page, err = mw.next.GetAllKeyPersons(ctx, input)
 	return
 }
 
@@ -240,3 +241,63 @@ func (mw logMiddleware) DeleteJobPlatform(ctx context.Context, input uint64) (er
 	err = mw.next.DeleteJobPlatform(ctx, input)
 	return
 }
+
+/* --------------- Application --------------- */
+
+// CreateApplication creates a new Application for a JobPost
+func (mw logMiddleware) CreateApplication(ctx context.Context, input *models.Application) (output *models.Application, err error) {
+	defer mw.log("CreateApplication", time.Now(), input, output, &err)
+	output, err = mw.next.CreateApplication(ctx, input)
+	return
+}
+
+// GetAllApplications returns all Applications that match the filters
+func (mw logMiddleware) GetAllApplications(ctx context.Context, input models.ApplicationFilters) (output []*models.Application, err error) {
+	defer mw.log("GetAllApplications", time.Now(), input, output, &err)
+	output, err = mw.next.GetAllApplications(ctx, input)
+	return
+}
+
+// LocalGetAllApplications returns all Applications that match the filters, for other services
+func (mw logMiddleware) LocalGetAllApplications(ctx context.Context, input models.ApplicationFilters) (output []*models.Application, err error) {
+	defer mw.log("LocalGetAllApplications", time.Now(), input, output, &err)
+	output, err = mw.next.LocalGetAllApplications(ctx, input)
+	return
+}
+
+// GetApplicationByID finds and returns an Application by ID
+func (mw logMiddleware) GetApplicationByID(ctx context.Context, input uint64) (output *models.Application, err error) {
+	defer mw.log("GetApplicationByID", time.Now(), input, output, &err)
+	output, err = mw.next.GetApplicationByID(ctx, input)
+	return
+}
+
+// UpdateApplicationStatus moves an Application to the next status in the hiring pipeline
+func (mw logMiddleware) UpdateApplicationStatus(ctx context.Context, id uint64, status string) (output *models.Application, err error) {
+	defer mw.log("UpdateApplicationStatus", time.Now(), []interface{}{id, status}, output, &err)
+	output, err = mw.next.UpdateApplicationStatus(ctx, id, status)
+	return
+}
+
+// DeleteApplication deletes an Application by ID
+func (mw logMiddleware) DeleteApplication(ctx context.Context, input uint64) (err error) {
+	defer mw.log("DeleteApplication", time.Now(), input, nil, &err)
+	err = mw.next.DeleteApplication(ctx, input)
+	return
+}
+
+/* --------------- Candidate Data --------------- */
+
+// LocalExportCandidateData returns all data about a Candidate, for other services
+func (mw logMiddleware) LocalExportCandidateData(ctx context.Context, input uint64) (output *models.CandidateData, err error) {
+	defer mw.log("LocalExportCandidateData", time.Now(), input, nil, &err)
+	output, err = mw.next.LocalExportCandidateData(ctx, input)
+	return
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased, for other services
+func (mw logMiddleware) LocalEraseCandidateData(ctx context.Context, input uint64) (output uint64, err error) {
+	defer mw.log("LocalEraseCandidateData", time.Now(), input, output, &err)
+	output, err = mw.next.LocalEraseCandidateData(ctx, input)
+	return
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+
+	"in-backend/services/joblisting/models"
+)
+
+// searchTerms lower cases text and splits it into terms, keeping symbols used in skill names such as C++ and C#
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(",;()\"", r)
+	})
+}
+
+// skillsInQuery returns the IDs of the skills named in a search query.
+// Skills with names of several words only match if all of their words appear together
+func skillsInQuery(query string, skills []*models.Skill) []uint64 {
+	q := " " + strings.Join(searchTerms(query), " ") + " "
+
+	var ids []uint64
+	for _, s := range skills {
+		name := strings.Join(searchTerms(s.Name), " ")
+		if name != "" && strings.Contains(q, " "+name+" ") {
+			ids = append(ids, s.ID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"in-backend/services/joblisting/models"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkillsInQuery(t *testing.T) {
+	skills := []*models.Skill{
+		{ID: 1, Name: "Go"},
+		{ID: 2, Name: "C++"},
+		{ID: 3, Name: "Machine Learning"},
+		{ID: 4, Name: "Java"},
+	}
+
+	var tests = []struct {
+		query string
+		want  []uint64
+	}{
+		{"go developer", []uint64{1}},
+		{"Senior C++, machine learning", []uint64{2, 3}},
+		{"javascript", nil},
+		{"learning machine", nil},
+		{"\"java\" or go", []uint64{1, 4}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.want, skillsInQuery(tt.query, skills))
+		})
+	}
+}
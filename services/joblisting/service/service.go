@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"in-backend/pagination"
 	"in-backend/services/joblisting/interfaces"
 	"in-backend/services/joblisting/models"
 	profilePb "in-backend/services/profile/pb"
@@ -57,16 +58,22 @@ func (s *service) BulkCreateJobPost(ctx context.Context, models []*models.JobPos
 	return m, err
 }
 
-// GetAllJobPosts returns all JobPosts that match the filters
-func (s *service) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, error) {
-	m, err := s.repository.GetAllJobPosts(ctx, f)
+// GetAllJobPosts returns all JobPosts that match the filters.
+// Skills are stored by the profile service, so the skills named in a search query are looked up here
+func (s *service) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, *pagination.Info, error) {
+	skills, err := getAllSkills(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	skills, err := getAllSkills(ctx)
+	f.QuerySkillID = nil
+	if f.Query != "" {
+		f.QuerySkillID = skillsInQuery(f.Query, skills)
+	}
+
+	m, page, err := s.repository.GetAllJobPosts(ctx, f)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for _, jp := range m {
@@ -81,7 +88,7 @@ func (s *service) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) (
 		jp.Skills = jobSkills
 	}
 
-	return m, err
+	return m, page, err
 }
 
 func getAllSkills(ctx context.Context) ([]*models.Skill, error) {
@@ -291,12 +298,12 @@ func (s *service) BulkCreateKeyPerson(ctx context.Context, models []*models.KeyP
 }
 
 // GetAllKeyPersons returns all KeyPersons that match the filters
-func (s *service) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, error) {
-	m, err := s.repository.GetAllKeyPersons(ctx, f)
+func (s *service) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, *pagination.Info, error) {
+	m, page, err := s.repository.GetAllKeyPersons(ctx, f)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return m, err
+	return m, page, err
 }
 
 // GetKeyPersonByID finds and returns a KeyPerson by ID
@@ -118,9 +118,9 @@ func testGetAllJobPosts(t *testing.T, s interfaces.Service) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r.On("GetAllJobPosts", tt.args.ctx).Return(tt.exp.output, tt.exp.err)
+			r.On("GetAllJobPosts", tt.args.ctx).Return(tt.exp.output, nil, tt.exp.err)
 
-			got, err := s.GetAllJobPosts(tt.args.ctx, *tt.args.f)
+			got, _, err := s.GetAllJobPosts(tt.args.ctx, *tt.args.f)
 			assert.Equal(t, len(tt.exp.output), len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
@@ -77,6 +77,36 @@ func (_m *JoblistingServiceClient) BulkCreateKeyPerson(ctx context.Context, in *
 	return r0, r1
 }
 
+// CreateApplication provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) CreateApplication(ctx context.Context, in *pb.CreateApplicationRequest, opts ...grpc.CallOption) (*pb.Application, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.Application
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateApplicationRequest, ...grpc.CallOption) *pb.Application); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateApplicationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCompany provides a mock function with given fields: ctx, in, opts
 func (_m *JoblistingServiceClient) CreateCompany(ctx context.Context, in *pb.CreateJobCompanyRequest, opts ...grpc.CallOption) (*pb.JobCompany, error) {
 	_va := make([]interface{}, len(opts))
@@ -257,6 +287,36 @@ func (_m *JoblistingServiceClient) CreateKeyPerson(ctx context.Context, in *pb.C
 	return r0, r1
 }
 
+// DeleteApplication provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) DeleteApplication(ctx context.Context, in *pb.DeleteApplicationRequest, opts ...grpc.CallOption) (*pb.DeleteApplicationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.DeleteApplicationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteApplicationRequest, ...grpc.CallOption) *pb.DeleteApplicationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteApplicationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.DeleteApplicationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteCompany provides a mock function with given fields: ctx, in, opts
 func (_m *JoblistingServiceClient) DeleteCompany(ctx context.Context, in *pb.DeleteJobCompanyRequest, opts ...grpc.CallOption) (*pb.DeleteJobCompanyResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -437,6 +497,36 @@ func (_m *JoblistingServiceClient) DeleteKeyPerson(ctx context.Context, in *pb.D
 	return r0, r1
 }
 
+// GetAllApplications provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) GetAllApplications(ctx context.Context, in *pb.GetAllApplicationsRequest, opts ...grpc.CallOption) (*pb.GetAllApplicationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAllApplicationsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllApplicationsRequest, ...grpc.CallOption) *pb.GetAllApplicationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllApplicationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllApplicationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCompanies provides a mock function with given fields: ctx, in, opts
 func (_m *JoblistingServiceClient) GetAllCompanies(ctx context.Context, in *pb.GetAllJobCompaniesRequest, opts ...grpc.CallOption) (*pb.GetAllJobCompaniesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -617,6 +707,36 @@ func (_m *JoblistingServiceClient) GetAllKeyPersons(ctx context.Context, in *pb.
 	return r0, r1
 }
 
+// GetApplicationByID provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) GetApplicationByID(ctx context.Context, in *pb.GetApplicationByIDRequest, opts ...grpc.CallOption) (*pb.Application, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.Application
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetApplicationByIDRequest, ...grpc.CallOption) *pb.Application); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetApplicationByIDRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobPostByID provides a mock function with given fields: ctx, in, opts
 func (_m *JoblistingServiceClient) GetJobPostByID(ctx context.Context, in *pb.GetJobPostByIDRequest, opts ...grpc.CallOption) (*pb.JobPost, error) {
 	_va := make([]interface{}, len(opts))
@@ -707,6 +827,96 @@ func (_m *JoblistingServiceClient) LocalCreateCompany(ctx context.Context, in *p
 	return r0, r1
 }
 
+// LocalEraseCandidateData provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) LocalEraseCandidateData(ctx context.Context, in *pb.CandidateDataRequest, opts ...grpc.CallOption) (*pb.EraseCandidateDataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.EraseCandidateDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) *pb.EraseCandidateDataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.EraseCandidateDataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) LocalExportCandidateData(ctx context.Context, in *pb.CandidateDataRequest, opts ...grpc.CallOption) (*pb.CandidateData, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) *pb.CandidateData); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetAllApplications provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) LocalGetAllApplications(ctx context.Context, in *pb.GetAllApplicationsRequest, opts ...grpc.CallOption) (*pb.GetAllApplicationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAllApplicationsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllApplicationsRequest, ...grpc.CallOption) *pb.GetAllApplicationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllApplicationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllApplicationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalUpdateCompany provides a mock function with given fields: ctx, in, opts
 func (_m *JoblistingServiceClient) LocalUpdateCompany(ctx context.Context, in *pb.UpdateJobCompanyRequest, opts ...grpc.CallOption) (*pb.JobCompany, error) {
 	_va := make([]interface{}, len(opts))
@@ -737,6 +947,36 @@ func (_m *JoblistingServiceClient) LocalUpdateCompany(ctx context.Context, in *p
 	return r0, r1
 }
 
+// UpdateApplicationStatus provides a mock function with given fields: ctx, in, opts
+func (_m *JoblistingServiceClient) UpdateApplicationStatus(ctx context.Context, in *pb.UpdateApplicationStatusRequest, opts ...grpc.CallOption) (*pb.Application, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.Application
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UpdateApplicationStatusRequest, ...grpc.CallOption) *pb.Application); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UpdateApplicationStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateCompany provides a mock function with given fields: ctx, in, opts
 func (_m *JoblistingServiceClient) UpdateCompany(ctx context.Context, in *pb.UpdateJobCompanyRequest, opts ...grpc.CallOption) (*pb.JobCompany, error) {
 	_va := make([]interface{}, len(opts))
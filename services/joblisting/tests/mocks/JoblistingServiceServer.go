@@ -60,6 +60,29 @@ func (_m *JoblistingServiceServer) BulkCreateKeyPerson(_a0 context.Context, _a1
 	return r0, r1
 }
 
+// CreateApplication provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) CreateApplication(_a0 context.Context, _a1 *pb.CreateApplicationRequest) (*pb.Application, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.Application
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateApplicationRequest) *pb.Application); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateApplicationRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCompany provides a mock function with given fields: _a0, _a1
 func (_m *JoblistingServiceServer) CreateCompany(_a0 context.Context, _a1 *pb.CreateJobCompanyRequest) (*pb.JobCompany, error) {
 	ret := _m.Called(_a0, _a1)
@@ -198,6 +221,29 @@ func (_m *JoblistingServiceServer) CreateKeyPerson(_a0 context.Context, _a1 *pb.
 	return r0, r1
 }
 
+// DeleteApplication provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) DeleteApplication(_a0 context.Context, _a1 *pb.DeleteApplicationRequest) (*pb.DeleteApplicationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.DeleteApplicationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteApplicationRequest) *pb.DeleteApplicationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteApplicationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.DeleteApplicationRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteCompany provides a mock function with given fields: _a0, _a1
 func (_m *JoblistingServiceServer) DeleteCompany(_a0 context.Context, _a1 *pb.DeleteJobCompanyRequest) (*pb.DeleteJobCompanyResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -336,6 +382,29 @@ func (_m *JoblistingServiceServer) DeleteKeyPerson(_a0 context.Context, _a1 *pb.
 	return r0, r1
 }
 
+// GetAllApplications provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) GetAllApplications(_a0 context.Context, _a1 *pb.GetAllApplicationsRequest) (*pb.GetAllApplicationsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAllApplicationsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllApplicationsRequest) *pb.GetAllApplicationsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllApplicationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllApplicationsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCompanies provides a mock function with given fields: _a0, _a1
 func (_m *JoblistingServiceServer) GetAllCompanies(_a0 context.Context, _a1 *pb.GetAllJobCompaniesRequest) (*pb.GetAllJobCompaniesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -474,6 +543,29 @@ func (_m *JoblistingServiceServer) GetAllKeyPersons(_a0 context.Context, _a1 *pb
 	return r0, r1
 }
 
+// GetApplicationByID provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) GetApplicationByID(_a0 context.Context, _a1 *pb.GetApplicationByIDRequest) (*pb.Application, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.Application
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetApplicationByIDRequest) *pb.Application); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetApplicationByIDRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobPostByID provides a mock function with given fields: _a0, _a1
 func (_m *JoblistingServiceServer) GetJobPostByID(_a0 context.Context, _a1 *pb.GetJobPostByIDRequest) (*pb.JobPost, error) {
 	ret := _m.Called(_a0, _a1)
@@ -543,6 +635,75 @@ func (_m *JoblistingServiceServer) LocalCreateCompany(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
+// LocalEraseCandidateData provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) LocalEraseCandidateData(_a0 context.Context, _a1 *pb.CandidateDataRequest) (*pb.EraseCandidateDataResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.EraseCandidateDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest) *pb.EraseCandidateDataResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.EraseCandidateDataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) LocalExportCandidateData(_a0 context.Context, _a1 *pb.CandidateDataRequest) (*pb.CandidateData, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest) *pb.CandidateData); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetAllApplications provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) LocalGetAllApplications(_a0 context.Context, _a1 *pb.GetAllApplicationsRequest) (*pb.GetAllApplicationsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAllApplicationsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllApplicationsRequest) *pb.GetAllApplicationsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllApplicationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllApplicationsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalUpdateCompany provides a mock function with given fields: _a0, _a1
 func (_m *JoblistingServiceServer) LocalUpdateCompany(_a0 context.Context, _a1 *pb.UpdateJobCompanyRequest) (*pb.JobCompany, error) {
 	ret := _m.Called(_a0, _a1)
@@ -566,6 +727,29 @@ func (_m *JoblistingServiceServer) LocalUpdateCompany(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
+// UpdateApplicationStatus provides a mock function with given fields: _a0, _a1
+func (_m *JoblistingServiceServer) UpdateApplicationStatus(_a0 context.Context, _a1 *pb.UpdateApplicationStatusRequest) (*pb.Application, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.Application
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UpdateApplicationStatusRequest) *pb.Application); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UpdateApplicationStatusRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateCompany provides a mock function with given fields: _a0, _a1
 func (_m *JoblistingServiceServer) UpdateCompany(_a0 context.Context, _a1 *pb.UpdateJobCompanyRequest) (*pb.JobCompany, error) {
 	ret := _m.Called(_a0, _a1)
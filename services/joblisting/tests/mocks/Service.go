@@ -7,6 +7,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "in-backend/pagination"
 	models "in-backend/services/joblisting/models"
 )
 
@@ -61,6 +62,29 @@ func (_m *Service) BulkCreateKeyPerson(ctx context.Context, m []*models.KeyPerso
 	return r0, r1
 }
 
+// CreateApplication provides a mock function with given fields: ctx, m
+func (_m *Service) CreateApplication(ctx context.Context, m *models.Application) (*models.Application, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.Application
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Application) *models.Application); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Application) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCompany provides a mock function with given fields: ctx, m
 func (_m *Service) CreateCompany(ctx context.Context, m *models.Company) (*models.Company, error) {
 	ret := _m.Called(ctx, m)
@@ -199,6 +223,20 @@ func (_m *Service) CreateKeyPerson(ctx context.Context, m *models.KeyPerson) (*m
 	return r0, r1
 }
 
+// DeleteApplication provides a mock function with given fields: ctx, id
+func (_m *Service) DeleteApplication(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteCompany provides a mock function with given fields: ctx, id
 func (_m *Service) DeleteCompany(ctx context.Context, id uint64) error {
 	ret := _m.Called(ctx, id)
@@ -283,6 +321,29 @@ func (_m *Service) DeleteKeyPerson(ctx context.Context, id uint64) error {
 	return r0
 }
 
+// GetAllApplications provides a mock function with given fields: ctx, f
+func (_m *Service) GetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.Application
+	if rf, ok := ret.Get(0).(func(context.Context, models.ApplicationFilters) []*models.Application); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, models.ApplicationFilters) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCompanies provides a mock function with given fields: ctx, f
 func (_m *Service) GetAllCompanies(ctx context.Context, f models.CompanyFilters) ([]*models.Company, error) {
 	ret := _m.Called(ctx, f)
@@ -376,7 +437,7 @@ func (_m *Service) GetAllJobPlatforms(ctx context.Context, f models.JobPlatformF
 }
 
 // GetAllJobPosts provides a mock function with given fields: ctx, f
-func (_m *Service) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, error) {
+func (_m *Service) GetAllJobPosts(ctx context.Context, f models.JobPostFilters) ([]*models.JobPost, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.JobPost
@@ -388,18 +449,27 @@ func (_m *Service) GetAllJobPosts(ctx context.Context, f models.JobPostFilters)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.JobPostFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.JobPostFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.JobPostFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetAllKeyPersons provides a mock function with given fields: ctx, f
-func (_m *Service) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, error) {
+func (_m *Service) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilters) ([]*models.KeyPerson, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.KeyPerson
@@ -411,9 +481,41 @@ func (_m *Service) GetAllKeyPersons(ctx context.Context, f models.KeyPersonFilte
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.KeyPersonFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.KeyPersonFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.KeyPersonFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetApplicationByID provides a mock function with given fields: ctx, id
+func (_m *Service) GetApplicationByID(ctx context.Context, id uint64) (*models.Application, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.Application
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.Application); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -490,6 +592,73 @@ func (_m *Service) LocalCreateCompany(ctx context.Context, m *models.Company) (*
 	return r0, r1
 }
 
+// LocalEraseCandidateData provides a mock function with given fields: ctx, cid
+func (_m *Service) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) uint64); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: ctx, cid
+func (_m *Service) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 *models.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.CandidateData); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetAllApplications provides a mock function with given fields: ctx, f
+func (_m *Service) LocalGetAllApplications(ctx context.Context, f models.ApplicationFilters) ([]*models.Application, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.Application
+	if rf, ok := ret.Get(0).(func(context.Context, models.ApplicationFilters) []*models.Application); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, models.ApplicationFilters) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalUpdateCompany provides a mock function with given fields: ctx, m
 func (_m *Service) LocalUpdateCompany(ctx context.Context, m *models.Company) (*models.Company, error) {
 	ret := _m.Called(ctx, m)
@@ -513,6 +682,29 @@ func (_m *Service) LocalUpdateCompany(ctx context.Context, m *models.Company) (*
 	return r0, r1
 }
 
+// UpdateApplicationStatus provides a mock function with given fields: ctx, id, status
+func (_m *Service) UpdateApplicationStatus(ctx context.Context, id uint64, status string) (*models.Application, error) {
+	ret := _m.Called(ctx, id, status)
+
+	var r0 *models.Application
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) *models.Application); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, id, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateCompany provides a mock function with given fields: ctx, m
 func (_m *Service) UpdateCompany(ctx context.Context, m *models.Company) (*models.Company, error) {
 	ret := _m.Called(ctx, m)
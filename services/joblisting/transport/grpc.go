@@ -2,7 +2,9 @@ package transport
 
 import (
 	"context"
+	"encoding/json"
 	"in-backend/helpers"
+	"in-backend/pagination"
 	"in-backend/services/joblisting/endpoints"
 	"in-backend/services/joblisting/models"
 	"in-backend/services/joblisting/pb"
@@ -48,6 +50,16 @@ type grpcServer struct {
 	getAllJobPlatforms kitgrpc.Handler
 	deleteJobPlatform  kitgrpc.Handler
 
+	createApplication       kitgrpc.Handler
+	getAllApplications      kitgrpc.Handler
+	localGetAllApplications kitgrpc.Handler
+	getApplicationByID      kitgrpc.Handler
+	updateApplicationStatus kitgrpc.Handler
+	deleteApplication       kitgrpc.Handler
+
+	localExportCandidateData kitgrpc.Handler
+	localEraseCandidateData  kitgrpc.Handler
+
 	logger log.Logger
 }
 
@@ -229,6 +241,56 @@ func NewGRPCServer(
 			options...,
 		),
 
+		createApplication: kitgrpc.NewServer(
+			endpoints.CreateApplication,
+			decodeCreateApplicationRequest,
+			encodeCreateApplicationResponse,
+			options...,
+		),
+		getAllApplications: kitgrpc.NewServer(
+			endpoints.GetAllApplications,
+			decodeGetAllApplicationsRequest,
+			encodeGetAllApplicationsResponse,
+			options...,
+		),
+		localGetAllApplications: kitgrpc.NewServer(
+			endpoints.LocalGetAllApplications,
+			decodeGetAllApplicationsRequest,
+			encodeGetAllApplicationsResponse,
+			options...,
+		),
+		getApplicationByID: kitgrpc.NewServer(
+			endpoints.GetApplicationByID,
+			decodeGetApplicationByIDRequest,
+			encodeGetApplicationByIDResponse,
+			options...,
+		),
+		updateApplicationStatus: kitgrpc.NewServer(
+			endpoints.UpdateApplicationStatus,
+			decodeUpdateApplicationStatusRequest,
+			encodeUpdateApplicationStatusResponse,
+			options...,
+		),
+		deleteApplication: kitgrpc.NewServer(
+			endpoints.DeleteApplication,
+			decodeDeleteApplicationRequest,
+			encodeDeleteApplicationResponse,
+			options...,
+		),
+
+		localExportCandidateData: kitgrpc.NewServer(
+			endpoints.LocalExportCandidateData,
+			decodeCandidateDataRequest,
+			encodeLocalExportCandidateDataResponse,
+			options...,
+		),
+		localEraseCandidateData: kitgrpc.NewServer(
+			endpoints.LocalEraseCandidateData,
+			decodeCandidateDataRequest,
+			encodeLocalEraseCandidateDataResponse,
+			options...,
+		),
+
 		logger: logger,
 	}
 }
@@ -323,6 +385,14 @@ func decodeGetAllJobPostsRequest(_ context.Context, request interface{}) (interf
 		Salary:             req.Salary,
 		UpdatedAt:          helpers.ProtoTimeToTime(req.UpdatedAt),
 		ExpireAt:           helpers.ProtoTimeToTime(req.ExpireAt),
+		Query:              req.Query,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
 	}
 	return decoded, nil
 }
@@ -336,7 +406,15 @@ func encodeGetAllJobPostsResponse(_ context.Context, response interface{}) (inte
 		for _, jobPost := range res.JobPosts {
 			jobPosts = append(jobPosts, jobPost.ToProto())
 		}
-		return &pb.GetAllJobPostsResponse{JobPosts: jobPosts}, nil
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllJobPostsResponse{
+			JobPosts:      jobPosts,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
 	}
 	return nil, err
 }
@@ -793,6 +871,13 @@ func decodeGetAllKeyPersonsRequest(_ context.Context, request interface{}) (inte
 		ContactNumber: req.ContactNumber,
 		Email:         req.Email,
 		JobTitle:      req.JobTitle,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
 	}
 	return decoded, nil
 }
@@ -806,7 +891,15 @@ func encodeGetAllKeyPersonsResponse(_ context.Context, response interface{}) (in
 		for _, keyPerson := range res.KeyPersons {
 			keyPersons = append(keyPersons, keyPerson.ToProto())
 		}
-		return &pb.GetAllKeyPersonsResponse{KeyPersons: keyPersons}, nil
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllKeyPersonsResponse{
+			KeyPersons:    keyPersons,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
 	}
 	return nil, err
 }
@@ -971,6 +1064,202 @@ func encodeDeleteJobPlatformResponse(_ context.Context, response interface{}) (i
 	return nil, err
 }
 
+/* --------------- Application --------------- */
+
+// CreateApplication creates a new Application
+func (s *grpcServer) CreateApplication(ctx context.Context, req *pb.CreateApplicationRequest) (*pb.Application, error) {
+	_, rep, err := s.createApplication.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Application), nil
+}
+
+// decodeCreateApplicationRequest decodes the incoming grpc payload to our go kit payload
+func decodeCreateApplicationRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CreateApplicationRequest)
+	return endpoints.CreateApplicationRequest{Application: models.ApplicationToORM(req.Application)}, nil
+}
+
+// encodeCreateApplicationResponse encodes the outgoing go kit payload to the grpc payload
+func encodeCreateApplicationResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.CreateApplicationResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Application.ToProto(), nil
+	}
+	return nil, err
+}
+
+// GetAllApplications returns all Applications
+func (s *grpcServer) GetAllApplications(ctx context.Context, req *pb.GetAllApplicationsRequest) (*pb.GetAllApplicationsResponse, error) {
+	_, rep, err := s.getAllApplications.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetAllApplicationsResponse), nil
+}
+
+// LocalGetAllApplications returns all Applications, for other services
+func (s *grpcServer) LocalGetAllApplications(ctx context.Context, req *pb.GetAllApplicationsRequest) (*pb.GetAllApplicationsResponse, error) {
+	_, rep, err := s.localGetAllApplications.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetAllApplicationsResponse), nil
+}
+
+// decodeGetAllApplicationsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetAllApplicationsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetAllApplicationsRequest)
+	decoded := endpoints.GetAllApplicationsRequest{
+		ID:          req.Id,
+		JobPostID:   req.JobPostId,
+		CandidateID: req.CandidateId,
+		CompanyID:   req.CompanyId,
+		Status:      req.Status,
+	}
+	return decoded, nil
+}
+
+// encodeGetAllApplicationsResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetAllApplicationsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetAllApplicationsResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var applications []*pb.Application
+		for _, application := range res.Applications {
+			applications = append(applications, application.ToProto())
+		}
+		return &pb.GetAllApplicationsResponse{Applications: applications}, nil
+	}
+	return nil, err
+}
+
+// GetApplicationByID returns an Application by ID
+func (s *grpcServer) GetApplicationByID(ctx context.Context, req *pb.GetApplicationByIDRequest) (*pb.Application, error) {
+	_, rep, err := s.getApplicationByID.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Application), nil
+}
+
+// decodeGetApplicationByIDRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetApplicationByIDRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetApplicationByIDRequest)
+	return endpoints.GetApplicationByIDRequest{ID: req.Id}, nil
+}
+
+// encodeGetApplicationByIDResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetApplicationByIDResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetApplicationByIDResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Application.ToProto(), nil
+	}
+	return nil, err
+}
+
+// UpdateApplicationStatus updates the status of an Application
+func (s *grpcServer) UpdateApplicationStatus(ctx context.Context, req *pb.UpdateApplicationStatusRequest) (*pb.Application, error) {
+	_, rep, err := s.updateApplicationStatus.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Application), nil
+}
+
+// decodeUpdateApplicationStatusRequest decodes the incoming grpc payload to our go kit payload
+func decodeUpdateApplicationStatusRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.UpdateApplicationStatusRequest)
+	return endpoints.UpdateApplicationStatusRequest{ID: req.Id, Status: req.Status}, nil
+}
+
+// encodeUpdateApplicationStatusResponse encodes the outgoing go kit payload to the grpc payload
+func encodeUpdateApplicationStatusResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.UpdateApplicationStatusResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Application.ToProto(), nil
+	}
+	return nil, err
+}
+
+// DeleteApplication deletes an Application by ID
+func (s *grpcServer) DeleteApplication(ctx context.Context, req *pb.DeleteApplicationRequest) (*pb.DeleteApplicationResponse, error) {
+	_, rep, err := s.deleteApplication.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.DeleteApplicationResponse), nil
+}
+
+// decodeDeleteApplicationRequest decodes the incoming grpc payload to our go kit payload
+func decodeDeleteApplicationRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.DeleteApplicationRequest)
+	return endpoints.DeleteApplicationRequest{ID: req.Id}, nil
+}
+
+// encodeDeleteApplicationResponse encodes the outgoing go kit payload to the grpc payload
+func encodeDeleteApplicationResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.DeleteApplicationResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.DeleteApplicationResponse{}, nil
+	}
+	return nil, err
+}
+
+// LocalExportCandidateData returns all data about a Candidate, for other services
+func (s *grpcServer) LocalExportCandidateData(ctx context.Context, req *pb.CandidateDataRequest) (*pb.CandidateData, error) {
+	_, rep, err := s.localExportCandidateData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.CandidateData), nil
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate, for other services
+func (s *grpcServer) LocalEraseCandidateData(ctx context.Context, req *pb.CandidateDataRequest) (*pb.EraseCandidateDataResponse, error) {
+	_, rep, err := s.localEraseCandidateData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.EraseCandidateDataResponse), nil
+}
+
+// decodeCandidateDataRequest decodes the incoming grpc payload to our go kit payload
+func decodeCandidateDataRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CandidateDataRequest)
+	return endpoints.CandidateDataRequest{CandidateID: req.CandidateId}, nil
+}
+
+// encodeLocalExportCandidateDataResponse encodes the outgoing go kit payload to the grpc payload,
+// with the data about the candidate encoded as JSON
+func encodeLocalExportCandidateDataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.LocalExportCandidateDataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		data, err := json.Marshal(res.CandidateData)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CandidateData{Data: data}, nil
+	}
+	return nil, err
+}
+
+// encodeLocalEraseCandidateDataResponse encodes the outgoing go kit payload to the grpc payload
+func encodeLocalEraseCandidateDataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.LocalEraseCandidateDataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.EraseCandidateDataResponse{Erased: res.Erased}, nil
+	}
+	return nil, err
+}
+
 func getError(err error) error {
 	switch err {
 	case nil:
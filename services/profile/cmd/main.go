@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"in-backend/auth"
+	"in-backend/rbac"
+	assessmentPb "in-backend/services/assessment/pb"
 	joblistingPb "in-backend/services/joblisting/pb"
 	"in-backend/services/profile/configs"
 	"in-backend/services/profile/database"
@@ -11,6 +15,7 @@ import (
 	"in-backend/services/profile/service"
 	"in-backend/services/profile/service/middlewares"
 	"in-backend/services/profile/transport"
+	projectPb "in-backend/services/project/pb"
 	"net"
 	"net/http"
 	"os"
@@ -21,6 +26,8 @@ import (
 	"github.com/go-kit/kit/log/level"
 	kitoc "github.com/go-kit/kit/tracing/opencensus"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"github.com/gocraft/work"
+	"github.com/gomodule/redigo/redis"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/oklog/oklog/pkg/group"
 	"google.golang.org/grpc"
@@ -29,8 +36,28 @@ import (
 
 var (
 	joblistingSvcAddr string = "joblisting-service:50054"
+	assessmentSvcAddr string = "assessment-service:50053"
+	projectSvcAddr    string = "project-service:50052"
 )
 
+// Make a redis pool
+var redisPool = &redis.Pool{
+	MaxActive: 5,
+	MaxIdle:   5,
+	Wait:      true,
+	Dial: func() (redis.Conn, error) {
+		return redis.Dial("tcp", "redis:6379")
+	},
+}
+
+const (
+	appName string = "hubbedin"
+	// dispatchOutboxJob delivers the side effects recorded in the outbox
+	dispatchOutboxJob string = "dispatch_outbox"
+)
+
+type workerContext struct{}
+
 func main() {
 	// load configs
 	cfg, err := configs.LoadConfig(configs.FileName)
@@ -60,11 +87,26 @@ func main() {
 	defer db.Close()
 
 	client := &http.Client{}
-	auth0 := providers.NewAuth0(cfg, client)
-	klenty := providers.NewKlenty(cfg, client)
+	identity, err := providers.NewIdentityProvider(cfg, client)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to create identity provider", "err", err)
+		os.Exit(-1)
+	}
+	crm, err := providers.NewCRMProvider(cfg, client)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to create CRM provider", "err", err)
+		os.Exit(-1)
+	}
 	p := bluemonday.UGCPolicy()
-
-	conn, err := grpc.Dial(joblistingSvcAddr, grpc.WithInsecure())
+	verifier := auth.NewVerifier(auth.Config{
+		JWKSURL:  cfg.Auth.JWKSURL,
+		Issuer:   cfg.Auth.Issuer,
+		Audience: cfg.Auth.Audience,
+	}, client)
+
+	// calls to other services are authenticated as a service, as they may call Local methods
+	creds := grpc.WithPerRPCCredentials(rbac.ServiceCredentials(cfg.Auth.ServiceToken))
+	conn, err := grpc.Dial(joblistingSvcAddr, grpc.WithInsecure(), creds)
 	if err != nil {
 		level.Error(logger).Log("msg", "Failed to get Joblisting Service connection")
 	}
@@ -72,21 +114,74 @@ func main() {
 
 	jlClient := joblistingPb.NewJoblistingServiceClient(conn)
 
+	asConn, err := grpc.Dial(assessmentSvcAddr, grpc.WithInsecure(), creds)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to get Assessment Service connection")
+	}
+	defer asConn.Close()
+
+	asClient := assessmentPb.NewAssessmentServiceClient(asConn)
+
+	pjConn, err := grpc.Dial(projectSvcAddr, grpc.WithInsecure(), creds)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to get Project Service connection")
+	}
+	defer pjConn.Close()
+
+	pjClient := projectPb.NewProjectServiceClient(pjConn)
+
 	// Build the layers of the service "onion" from the inside out. First, the
 	// business logic service; then, the set of endpoints that wrap the service;
 	// and finally, a series of concrete transport adapters
 
-	repo := database.NewRepository(db, auth0, klenty, jlClient)
-	svc := service.New(repo, p)
-	svc = middlewares.NewAuthMiddleware(svc, repo)
+	policy, err := rbac.LoadPolicy(rbac.FileName)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to load policy", "err", err)
+		os.Exit(-1)
+	}
+
+	repo := database.NewRepository(db, jlClient, asClient, pjClient)
+	authorizer := rbac.NewAuthorizer(policy, verifier, endpoints.MakeOwners(repo)).WithServiceToken(cfg.Auth.ServiceToken)
+	if cfg.Erasure.EmailHashKey == "" {
+		level.Error(logger).Log("msg", "Missing the key that the emails of erased users are hashed with")
+		os.Exit(-1)
+	}
+	svc := service.New(repo, identity, p, []byte(cfg.Erasure.EmailHashKey))
+	svc = middlewares.NewAuthMiddleware(svc, repo, authorizer)
 	svc = middlewares.NewLogMiddleware(logger, svc)
-	endpoints := endpoints.MakeEndpoints(svc)
+	eps := endpoints.MakeEndpoints(svc)
+	if err := authorizer.Wrap(&eps); err != nil {
+		level.Error(logger).Log("msg", "Failed to authorize endpoints", "err", err)
+		os.Exit(-1)
+	}
+
+	locationRepo := database.NewLocationRepository(db)
+	locationAuthorizer := rbac.NewAuthorizer(policy, verifier, endpoints.MakeLocationOwners(locationRepo))
+	locationSvc := service.NewLocationService(locationRepo)
+	locationSvc = middlewares.NewLocationLogMiddleware(logger, locationSvc)
+	locationEps := endpoints.MakeLocationEndpoints(locationSvc)
+	if err := locationAuthorizer.Wrap(&locationEps); err != nil {
+		level.Error(logger).Log("msg", "Failed to authorize location endpoints", "err", err)
+		os.Exit(-1)
+	}
+
+	outboxRepo := database.NewOutboxRepository(db)
+	dispatcher := service.NewOutboxDispatcher(outboxRepo, identity, crm, logger)
+
+	// dispatch the outbox every 10 seconds, one dispatcher at a time.
+	// Failed deliveries are retried by the dispatcher so the job itself is not retried
+	workerPool := work.NewWorkerPool(workerContext{}, 1, appName, redisPool)
+	workerPool.PeriodicallyEnqueue("*/10 * * * * *", dispatchOutboxJob)
+	workerPool.JobWithOptions(dispatchOutboxJob, work.JobOptions{MaxFails: 1, MaxConcurrency: 1}, func(job *work.Job) error {
+		return dispatcher.Dispatch(context.Background())
+	})
 
 	// set-up grpc transport
 	var (
 		ocTracing               = kitoc.GRPCServerTrace()
 		serverOptions           = []kitgrpc.ServerOption{ocTracing}
-		profileService          = transport.NewGRPCServer(endpoints, serverOptions, logger)
+		profileService          = transport.NewGRPCServer(eps, serverOptions, logger)
+		locationService         = transport.NewLocationGRPCServer(locationEps, serverOptions, logger)
 		grpcListener, listenErr = net.Listen("tcp", fmt.Sprintf(":%s", cfg.Server.Port))
 		grpcServer              = grpc.NewServer()
 	)
@@ -108,6 +203,7 @@ func main() {
 		g.Add(func() error {
 			logger.Log("transport", "gRPC", "addr", cfg.Server.Port)
 			pb.RegisterProfileServiceServer(grpcServer, profileService)
+			pb.RegisterLocationServiceServer(grpcServer, locationService)
 			// Register reflection service on gRPC server.
 			reflection.Register(grpcServer)
 			return grpcServer.Serve(grpcListener)
@@ -116,6 +212,20 @@ func main() {
 		})
 	}
 
+	{
+		// Set-up the outbox worker.
+		stopWorker := make(chan struct{})
+		g.Add(func() error {
+			logger.Log("worker", "outbox", "job", dispatchOutboxJob)
+			workerPool.Start()
+			<-stopWorker
+			return nil
+		}, func(error) {
+			workerPool.Stop()
+			close(stopWorker)
+		})
+	}
+
 	{
 		// Set-up our signal handler.
 		var (
@@ -21,11 +21,16 @@ var (
 
 // Config declares the application configuration variables
 type Config struct {
-	AppName  string       `mapstructure:"appname"`
-	Server   ServerConfig `mapstructure:",squash"`
-	Database DbConfig     `mapstructure:",squash"`
-	Auth0    Auth0        `mapstructure:",squash"`
-	Klenty   Klenty       `mapstructure:",squash"`
+	AppName          string       `mapstructure:"appname"`
+	Server           ServerConfig `mapstructure:",squash"`
+	Database         DbConfig     `mapstructure:",squash"`
+	Auth             Auth         `mapstructure:",squash"`
+	IdentityProvider string       `mapstructure:"identity_provider"`
+	Auth0            Auth0        `mapstructure:",squash"`
+	CRMProvider      string       `mapstructure:"crm_provider"`
+	Klenty           Klenty       `mapstructure:",squash"`
+	CRMWebhook       CRMWebhook   `mapstructure:",squash"`
+	Erasure          Erasure      `mapstructure:",squash"`
 }
 
 // ServerConfig declares server variables
@@ -45,19 +50,50 @@ type DbConfig struct {
 	Drivername string `mapstructure:"database_drivername"`
 }
 
-// Auth0 declares variables for connecting to Auth0
+// Auth0 declares variables for connecting to Auth0.
+// The role IDs are the IDs of the Auth0 roles assigned to users with each role
 type Auth0 struct {
+	URL              string `mapstructure:"auth0_url"`
 	MgmtClientID     string `mapstructure:"auth0_mgmt_client_id"`
 	MgmtClientSecret string `mapstructure:"auth0_mgmt_client_secret"`
+	CandidateRoleID  string `mapstructure:"auth0_candidate_role_id"`
+	CompanyRoleID    string `mapstructure:"auth0_company_role_id"`
+	AdminRoleID      string `mapstructure:"auth0_admin_role_id"`
+	RecruiterRoleID  string `mapstructure:"auth0_recruiter_role_id"`
 }
 
-// Klenty declares variables for connecting to Klenty
+// Klenty declares variables for connecting to Klenty.
+// User is the Klenty account that prospects are created under
 type Klenty struct {
+	User                   string `mapstructure:"klenty_user"`
 	APIKey                 string `mapstructure:"klenty_api_key"`
 	CandidateSignupCadence string `mapstructure:"klenty_candidate_signup_cadence"`
 	CompanySignupCadence   string `mapstructure:"klenty_company_signup_cadence"`
 }
 
+// CRMWebhook declares variables for sending CRM events to a webhook.
+// Requests are signed with Secret so that the receiver can verify them
+type CRMWebhook struct {
+	URL    string `mapstructure:"crm_webhook_url"`
+	Secret string `mapstructure:"crm_webhook_secret"`
+}
+
+// Erasure declares variables for erasing users.
+// EmailHashKey is the secret that the emails in erasure receipts are hashed with
+type Erasure struct {
+	EmailHashKey string `mapstructure:"erasure_email_hash_key"`
+}
+
+// Auth declares variables for verifying access tokens
+type Auth struct {
+	JWKSURL  string `mapstructure:"auth_jwks_url"`
+	Issuer   string `mapstructure:"auth_issuer"`
+	Audience string `mapstructure:"auth_audience"`
+
+	// ServiceToken authenticates the calls of services to each other
+	ServiceToken string `mapstructure:"auth_service_token"`
+}
+
 // LoadConfig load config from file
 func LoadConfig(fileName string) (Config, error) {
 	var result map[string]interface{}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	pg "github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+
+	joblistingPb "in-backend/services/joblisting/pb"
+	"in-backend/services/profile/models"
+)
+
+/* --------------- Contact Consent --------------- */
+
+// CreateContactConsent records the consent of a Candidate to show their personal details to a Company.
+// Consenting again returns the existing consent
+func (r *repository) CreateContactConsent(ctx context.Context, m *models.ContactConsent) (*models.ContactConsent, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter contact consent is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).
+		OnConflict("(candidate_id, company_id) DO UPDATE").
+		Set("candidate_id = EXCLUDED.candidate_id").
+		Returning("*").
+		Insert()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to insert contact consent %v", m)
+	}
+	return m, nil
+}
+
+// GetAllContactConsents returns all ContactConsents that match the filters
+func (r *repository) GetAllContactConsents(ctx context.Context, f models.ContactConsentFilters) ([]*models.ContactConsent, error) {
+	var m []*models.ContactConsent
+	q := r.DB.WithContext(ctx).Model(&m)
+	if len(f.CandidateID) > 0 {
+		q = q.Where("cc.candidate_id in (?)", pg.In(f.CandidateID))
+	}
+	if len(f.CompanyID) > 0 {
+		q = q.Where("cc.company_id in (?)", pg.In(f.CompanyID))
+	}
+
+	err := q.Order("cc.id").Select()
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DeleteContactConsent withdraws the consent of a Candidate to show their personal details to a Company
+func (r *repository) DeleteContactConsent(ctx context.Context, cid, coid uint64) error {
+	_, err := r.DB.WithContext(ctx).Model((*models.ContactConsent)(nil)).
+		Where("candidate_id = ?", cid).
+		Where("company_id = ?", coid).
+		Delete()
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Cannot delete consent of candidate %v for company %v", cid, coid))
+	}
+	return nil
+}
+
+// GetApplicantIDs returns the IDs of the Candidates that applied to a Company in the joblisting service
+func (r *repository) GetApplicantIDs(ctx context.Context, coid uint64, cids []uint64) ([]uint64, error) {
+	res, err := r.jlClient.LocalGetAllApplications(ctx, &joblistingPb.GetAllApplicationsRequest{
+		CandidateId: cids,
+		CompanyId:   []uint64{coid},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot get applications to company %v", coid))
+	}
+
+	var ids []uint64
+	for _, a := range res.Applications {
+		ids = append(ids, a.CandidateId)
+	}
+	return ids, nil
+}
@@ -18,6 +18,13 @@ const (
 	relJobsCompany          string = "Jobs.Company"
 	relJobsDepartment       string = "Jobs.Department"
 
+	relShortlistCandidates string = "Candidates"
+	relShortlistShares     string = "Shares"
+
+	relCity             string = "City"
+	relCityState        string = "City.State"
+	relCityStateCountry string = "City.State.Country"
+
 	filUserID        string = "u.id = ?"
 	filUserEmail     string = "u.email = ?"
 	filSkillID       string = "s.id = ?"
@@ -28,13 +35,19 @@ const (
 	filCompanyID     string = "co.id = ?"
 	filDepartmentID  string = "d.id = ?"
 	filJobID         string = "jh.id = ?"
+	filAddressID     string = "ad.id = ?"
+	filShortlistID   string = "sl.id = ?"
+
+	filRegionID  string = "region_id = ?"
+	filCountryID string = "country_id = ?"
+	filStateID   string = "state_id = ?"
 
 	filNameIn    string = "lower(name) in (?)"
 	filLevelIn   string = "lower(level) in (?)"
 	filCountryIn string = "lower(country) in (?)"
 	filIDIn      string = "id in (?)"
 
-	filNameEquals    string = "lower(name) = ?"
-	filLevelEquals   string = "lower(level) = ?"
-	filCountryEquals string = "lower(country) = ?"
+	filNameEquals    string = "lower(name) = lower(?)"
+	filLevelEquals   string = "lower(coalesce(level, '')) = lower(?)"
+	filCountryEquals string = "lower(coalesce(country, '')) = lower(?)"
 )
@@ -0,0 +1,289 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	pg "github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
+
+	assessmentPb "in-backend/services/assessment/pb"
+	joblistingPb "in-backend/services/joblisting/pb"
+	"in-backend/services/profile/models"
+	projectPb "in-backend/services/project/pb"
+)
+
+// Services that keep data about Candidates, which are the keys of exported and erased data
+const (
+	joblistingService = "joblisting"
+	assessmentService = "assessment"
+	projectService    = "project"
+)
+
+/* --------------- Personal Data --------------- */
+
+// GetPersonalData returns a User with their Candidate profile, addresses, contact consents and shortlists
+func (r *repository) GetPersonalData(ctx context.Context, id uint64) (*models.PersonalData, error) {
+	u, err := r.GetUserByID(ctx, id)
+	if err != nil || u == nil {
+		return nil, err
+	}
+	m := &models.PersonalData{User: u}
+
+	if u.CandidateID > 0 {
+		err = r.DB.WithContext(ctx).Model(&m.Addresses).
+			Where("ad.candidate_id = ?", u.CandidateID).
+			Relation("City").
+			Order("ad.id").
+			Select()
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("Cannot get addresses of candidate %v", u.CandidateID))
+		}
+
+		m.ContactConsents, err = r.GetAllContactConsents(ctx, models.ContactConsentFilters{CandidateID: []uint64{u.CandidateID}})
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("Cannot get contact consents of candidate %v", u.CandidateID))
+		}
+	}
+
+	err = r.DB.WithContext(ctx).Model(&m.Shortlists).
+		Where("sl.user_id = ?", id).
+		Relation("Candidates").
+		Relation("Shares").
+		Order("sl.id").
+		Select()
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot get shortlists of user %v", id))
+	}
+	return m, nil
+}
+
+// ExportCandidateData returns the data about a Candidate that is kept by the joblisting, assessment and project services.
+// Applications are kept by Candidate ID, while assessment attempts and projects are kept by the ID of the User
+func (r *repository) ExportCandidateData(ctx context.Context, uid, cid uint64) (map[string][]byte, error) {
+	m := make(map[string][]byte)
+
+	jl, err := r.jlClient.LocalExportCandidateData(ctx, &joblistingPb.CandidateDataRequest{CandidateId: cid})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot export data of candidate %v from %s", cid, joblistingService))
+	}
+	m[joblistingService] = jl.Data
+
+	as, err := r.asClient.LocalExportCandidateData(ctx, &assessmentPb.CandidateDataRequest{CandidateId: uid})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot export data of user %v from %s", uid, assessmentService))
+	}
+	m[assessmentService] = as.Data
+
+	pj, err := r.pjClient.LocalExportCandidateData(ctx, &projectPb.CandidateDataRequest{CandidateId: uid})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot export data of user %v from %s", uid, projectService))
+	}
+	m[projectService] = pj.Data
+	return m, nil
+}
+
+// GetUserWithDeleted returns a User by ID without relations, including a User that was soft deleted
+func (r *repository) GetUserWithDeleted(ctx context.Context, id uint64) (*models.User, error) {
+	m := &models.User{ID: id}
+	err := r.DB.WithContext(ctx).Model(m).
+		Where(filUserID, id).
+		AllWithDeleted().
+		First()
+	//pg returns error when no rows in the result set
+	if err == pg.ErrNoRows {
+		return nil, nil
+	}
+	return m, err
+}
+
+// EraseCandidateData erases the data about a Candidate that is kept by the joblisting, assessment and project services.
+// Applications are kept by Candidate ID, while assessment attempts and projects are kept by the ID of the User.
+// It stops at the first service that fails, and can be retried as erasing data that is already erased does nothing
+func (r *repository) EraseCandidateData(ctx context.Context, uid, cid uint64) (map[string]uint64, error) {
+	m := make(map[string]uint64)
+
+	jl, err := r.jlClient.LocalEraseCandidateData(ctx, &joblistingPb.CandidateDataRequest{CandidateId: cid})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot erase data of candidate %v from %s", cid, joblistingService))
+	}
+	m[joblistingService] = jl.Erased
+
+	as, err := r.asClient.LocalEraseCandidateData(ctx, &assessmentPb.CandidateDataRequest{CandidateId: uid})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot erase data of user %v from %s", uid, assessmentService))
+	}
+	m[assessmentService] = as.Erased
+
+	pj, err := r.pjClient.LocalEraseCandidateData(ctx, &projectPb.CandidateDataRequest{CandidateId: uid})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot erase data of user %v from %s", uid, projectService))
+	}
+	m[projectService] = pj.Erased
+	return m, nil
+}
+
+// erasure declares the rows of a table that are hard deleted when a User is erased
+type erasure struct {
+	table  string
+	model  interface{}
+	column string
+	id     uint64
+}
+
+// EraseUser hard deletes a User, their Candidate profile and their shortlists, including rows that were soft deleted.
+// OutboxMessages about the User that are not delivered yet are deleted, and the payloads of the others are removed.
+// The number of rows deleted from each table is added to the records of the receipt, which is inserted
+// with the OutboxMessages that delete the User from the identity provider and the CRM
+func (r *repository) EraseUser(ctx context.Context, u *models.User, receipt *models.ErasureReceipt) (*models.ErasureReceipt, error) {
+	if u == nil || receipt == nil {
+		return nil, errors.New("Input parameter user or erasure receipt is nil")
+	}
+	if receipt.Records == nil {
+		receipt.Records = make(map[string]uint64)
+	}
+
+	var erasures []erasure
+	if u.CandidateID > 0 {
+		erasures = append(erasures,
+			erasure{"users_skills", (*models.UserSkill)(nil), "candidate_id", u.CandidateID},
+			erasure{"academic_histories", (*models.AcademicHistory)(nil), "candidate_id", u.CandidateID},
+			erasure{"job_histories", (*models.JobHistory)(nil), "candidate_id", u.CandidateID},
+			erasure{"addresses", (*models.Address)(nil), "candidate_id", u.CandidateID},
+			erasure{"contact_consents", (*models.ContactConsent)(nil), "candidate_id", u.CandidateID},
+			erasure{"shortlists_candidates", (*models.ShortlistCandidate)(nil), "candidate_id", u.CandidateID},
+			erasure{"candidates", (*models.Candidate)(nil), "id", u.CandidateID},
+		)
+	}
+	erasures = append(erasures,
+		erasure{"shortlists_shares", (*models.ShortlistShare)(nil), "user_id", u.ID},
+		erasure{"shortlists", (*models.Shortlist)(nil), "user_id", u.ID},
+		erasure{"users", (*models.User)(nil), "id", u.ID},
+	)
+
+	msgs, err := erasureMessages(u)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		receipt.ExternalSystems = append(receipt.ExternalSystems, msg.Kind)
+	}
+
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close()
+
+	for _, e := range erasures {
+		res, err := tx.Model(e.model).
+			Where("? = ?", pg.Ident(e.column), e.id).
+			ForceDelete()
+		if err != nil {
+			tx.Rollback()
+			return nil, errors.Wrap(err, fmt.Sprintf("Cannot erase %s of user %v", e.table, u.ID))
+		}
+		receipt.Records["profile."+e.table] += uint64(res.RowsAffected())
+	}
+
+	erased, err := eraseOutboxMessages(tx, u)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	receipt.Records["profile.outbox"] += erased
+
+	if len(msgs) > 0 {
+		_, err = tx.Model(&msgs).Insert()
+		if err != nil {
+			tx.Rollback()
+			return nil, errors.Wrapf(err, "Failed to insert outbox messages for erasure of user %v", u.ID)
+		}
+	}
+
+	_, err = tx.Model(receipt).Returning("*").Insert()
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.Wrapf(err, "Failed to insert erasure receipt of user %v", u.ID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// eraseOutboxMessages deletes the OutboxMessages about a User that are not delivered yet, so that the User is not
+// synced to the identity provider or the CRM again once they are erased, and removes the payloads of the
+// OutboxMessages that were delivered or given up on. OutboxMessages are found by the email or the identity provider ID
+// in their payloads. Earlier erasure OutboxMessages are left alone. Returns how many OutboxMessages were erased
+func eraseOutboxMessages(tx *pg.Tx, u *models.User) (uint64, error) {
+	if u.Email == "" && u.AuthID == "" {
+		return 0, nil
+	}
+	aboutUser := func(q *orm.Query) (*orm.Query, error) {
+		if u.Email != "" {
+			q = q.WhereOr("lower(ob.payload->>'email') = lower(?)", u.Email)
+		}
+		if u.AuthID != "" {
+			q = q.WhereOr("ob.payload->>'auth_id' = ?", u.AuthID)
+		}
+		return q, nil
+	}
+	erasureKinds := pg.In([]string{models.OutboxIdentityDeleteUser, models.OutboxCRMDeleteProspect})
+
+	deleted, err := tx.Model((*models.OutboxMessage)(nil)).
+		Where("ob.status = ?", models.OutboxPending).
+		Where("ob.kind not in (?)", erasureKinds).
+		WhereGroup(aboutUser).
+		Delete()
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot delete outbox messages of user %v", u.ID)
+	}
+
+	scrubbed, err := tx.Model((*models.OutboxMessage)(nil)).
+		Set("payload = '{}'").
+		Where("ob.status in (?)", pg.In([]string{models.OutboxDelivered, models.OutboxDead})).
+		Where("ob.kind not in (?)", erasureKinds).
+		Where("ob.payload <> '{}'").
+		WhereGroup(aboutUser).
+		Update()
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot remove payloads of outbox messages of user %v", u.ID)
+	}
+	return uint64(deleted.RowsAffected() + scrubbed.RowsAffected()), nil
+}
+
+// erasureMessages returns the OutboxMessages that delete an erased User from the identity provider and the CRM
+func erasureMessages(u *models.User) ([]*models.OutboxMessage, error) {
+	var msgs []*models.OutboxMessage
+	if u.AuthID != "" {
+		msg, err := models.NewOutboxMessage(models.OutboxIdentityDeleteUser, &models.ErasurePayload{AuthID: u.AuthID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to encode outbox message %s", models.OutboxIdentityDeleteUser)
+		}
+		msgs = append(msgs, msg)
+	}
+	if u.Email != "" {
+		msg, err := models.NewOutboxMessage(models.OutboxCRMDeleteProspect, &models.ErasurePayload{Email: u.Email})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to encode outbox message %s", models.OutboxCRMDeleteProspect)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// GetErasureReceipt returns the ErasureReceipt of an erased User
+func (r *repository) GetErasureReceipt(ctx context.Context, uid uint64) (*models.ErasureReceipt, error) {
+	m := &models.ErasureReceipt{}
+	err := r.DB.WithContext(ctx).Model(m).
+		Where("er.user_id = ?", uid).
+		First()
+	//pg returns error when no rows in the result set
+	if err == pg.ErrNoRows {
+		return nil, nil
+	}
+	return m, err
+}
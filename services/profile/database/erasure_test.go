@@ -0,0 +1,46 @@
+package database
+
+import (
+	assessmentPb "in-backend/services/assessment/pb"
+	asMocks "in-backend/services/assessment/tests/mocks"
+	joblistingPb "in-backend/services/joblisting/pb"
+	jlMocks "in-backend/services/joblisting/tests/mocks"
+	projectPb "in-backend/services/project/pb"
+	pjMocks "in-backend/services/project/tests/mocks"
+	"testing"
+
+	pg "github.com/go-pg/pg/v10"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Applications are kept by Candidate ID, while assessment attempts and projects are kept by User ID
+func TestCandidateDataByService(t *testing.T) {
+	uid, cid := uint64(5), uint64(50)
+	jl := &jlMocks.JoblistingServiceClient{}
+	as := &asMocks.AssessmentServiceClient{}
+	pj := &pjMocks.ProjectServiceClient{}
+	r := NewRepository(&pg.DB{}, jl, as, pj)
+
+	byCandidate := mock.MatchedBy(func(req *joblistingPb.CandidateDataRequest) bool { return req.CandidateId == cid })
+	asByUser := mock.MatchedBy(func(req *assessmentPb.CandidateDataRequest) bool { return req.CandidateId == uid })
+	pjByUser := mock.MatchedBy(func(req *projectPb.CandidateDataRequest) bool { return req.CandidateId == uid })
+
+	jl.On("LocalExportCandidateData", ctx, byCandidate).Return(&joblistingPb.CandidateData{Data: []byte(`{"applications":[]}`)}, nil)
+	as.On("LocalExportCandidateData", ctx, asByUser).Return(&assessmentPb.CandidateData{Data: []byte(`{"assessment_attempts":[]}`)}, nil)
+	pj.On("LocalExportCandidateData", ctx, pjByUser).Return(&projectPb.CandidateData{Data: []byte(`{"projects":[]}`)}, nil)
+	exported, err := r.ExportCandidateData(ctx, uid, cid)
+	require.NoError(t, err)
+	require.Len(t, exported, 3)
+
+	jl.On("LocalEraseCandidateData", ctx, byCandidate).Return(&joblistingPb.EraseCandidateDataResponse{Erased: 2}, nil)
+	as.On("LocalEraseCandidateData", ctx, asByUser).Return(&assessmentPb.EraseCandidateDataResponse{Erased: 3}, nil)
+	pj.On("LocalEraseCandidateData", ctx, pjByUser).Return(&projectPb.EraseCandidateDataResponse{Erased: 1}, nil)
+	erased, err := r.EraseCandidateData(ctx, uid, cid)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{joblistingService: 2, assessmentService: 3, projectService: 1}, erased)
+
+	jl.AssertExpectations(t)
+	as.AssertExpectations(t)
+	pj.AssertExpectations(t)
+}
@@ -0,0 +1,215 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	pg "github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+)
+
+// locationRepository implements the profile LocationRepository interface
+type locationRepository struct {
+	DB *pg.DB
+}
+
+// NewLocationRepository declares a new LocationRepository that implements profile LocationRepository
+func NewLocationRepository(db *pg.DB) interfaces.LocationRepository {
+	return &locationRepository{
+		DB: db,
+	}
+}
+
+/* --------------- Region --------------- */
+
+// GetAllRegions returns all Regions
+func (r *locationRepository) GetAllRegions(ctx context.Context) ([]*models.Region, error) {
+	var m []*models.Region
+	err := r.DB.WithContext(ctx).Model(&m).Order("name asc").Select()
+	return m, err
+}
+
+/* --------------- Country --------------- */
+
+// GetAllCountries returns all Countries
+func (r *locationRepository) GetAllCountries(ctx context.Context, f models.CountryFilters) ([]*models.Country, error) {
+	var m []*models.Country
+	q := r.DB.WithContext(ctx).Model(&m)
+	if f.RegionID > 0 {
+		q = q.Where(filRegionID, f.RegionID)
+	}
+	err := q.Order("name asc").Select()
+	return m, err
+}
+
+// SeedCountries creates or updates Countries and their Regions by ISO code in a single transaction
+func (r *locationRepository) SeedCountries(ctx context.Context, m []*models.Country) error {
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close()
+
+	regions := make(map[string]*models.Region)
+	for _, c := range m {
+		if c.Region == nil {
+			tx.Rollback()
+			return errors.New(fmt.Sprintf("Country %s has no region", c.ISOCode))
+		}
+
+		rg, ok := regions[c.Region.Name]
+		if !ok {
+			rg = &models.Region{Name: c.Region.Name}
+			_, err = tx.Model(rg).
+				OnConflict("(name) DO UPDATE").
+				Set("name = EXCLUDED.name").
+				Returning("*").
+				Insert()
+			if err != nil {
+				tx.Rollback()
+				return errors.Wrapf(err, "Failed to upsert region %v", rg)
+			}
+			regions[rg.Name] = rg
+		}
+
+		c.RegionID = rg.ID
+		c.Region = rg
+		_, err = tx.Model(c).
+			OnConflict("(iso_code) DO UPDATE").
+			Set("region_id = EXCLUDED.region_id").
+			Set("name = EXCLUDED.name").
+			Set("calling_code = EXCLUDED.calling_code").
+			Set("currency = EXCLUDED.currency").
+			Returning("*").
+			Insert()
+		if err != nil {
+			tx.Rollback()
+			return errors.Wrapf(err, "Failed to upsert country %v", c)
+		}
+	}
+
+	return tx.Commit()
+}
+
+/* --------------- State --------------- */
+
+// CreateState creates a new State
+func (r *locationRepository) CreateState(ctx context.Context, m *models.State) (*models.State, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter state is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).Returning("*").
+		Where(filCountryID, m.CountryID).
+		Where(filNameEquals, m.Name).
+		OnConflict("DO NOTHING").
+		SelectOrInsert()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to insert state %v", m)
+	}
+
+	return m, nil
+}
+
+// GetAllStates returns all States
+func (r *locationRepository) GetAllStates(ctx context.Context, f models.StateFilters) ([]*models.State, error) {
+	var m []*models.State
+	q := r.DB.WithContext(ctx).Model(&m)
+	if f.CountryID > 0 {
+		q = q.Where(filCountryID, f.CountryID)
+	}
+	err := q.Order("name asc").Select()
+	return m, err
+}
+
+/* --------------- City --------------- */
+
+// CreateCity creates a new City
+func (r *locationRepository) CreateCity(ctx context.Context, m *models.City) (*models.City, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter city is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).Returning("*").
+		Where(filStateID, m.StateID).
+		Where(filNameEquals, m.Name).
+		OnConflict("DO NOTHING").
+		SelectOrInsert()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to insert city %v", m)
+	}
+
+	return m, nil
+}
+
+// GetAllCities returns all Cities
+func (r *locationRepository) GetAllCities(ctx context.Context, f models.CityFilters) ([]*models.City, error) {
+	var m []*models.City
+	q := r.DB.WithContext(ctx).Model(&m)
+	if f.StateID > 0 {
+		q = q.Where(filStateID, f.StateID)
+	}
+	err := q.Order("name asc").Select()
+	return m, err
+}
+
+/* --------------- Address --------------- */
+
+// CreateAddress creates a new Address
+func (r *locationRepository) CreateAddress(ctx context.Context, m *models.Address) (*models.Address, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter address is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).Returning("*").Insert()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to insert address %v", m)
+	}
+
+	return r.GetAddress(ctx, m.ID)
+}
+
+// GetAddress returns an Address by ID
+func (r *locationRepository) GetAddress(ctx context.Context, id uint64) (*models.Address, error) {
+	m := models.Address{ID: id}
+	err := r.DB.WithContext(ctx).Model(&m).
+		Where(filAddressID, id).
+		Relation(relCity).
+		Relation(relCityState).
+		Relation(relCityStateCountry).
+		First()
+	//pg returns error when no rows in the result set
+	if err == pg.ErrNoRows {
+		return nil, nil
+	}
+	return &m, err
+}
+
+// UpdateAddress updates an Address
+func (r *locationRepository) UpdateAddress(ctx context.Context, m *models.Address) (*models.Address, error) {
+	if m == nil {
+		return nil, errors.New("Address is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).WherePK().
+		Column("street_address", "city_id", "postcode", "updated_at").
+		Update()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to update address %v", m)
+	}
+
+	return r.GetAddress(ctx, m.ID)
+}
+
+// DeleteAddress deletes an Address by ID
+func (r *locationRepository) DeleteAddress(ctx context.Context, id uint64) error {
+	m := &models.Address{ID: id}
+	_, err := r.DB.WithContext(ctx).Model(m).WherePK().Delete()
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Cannot delete address with id %v", id))
+	}
+	return nil
+}
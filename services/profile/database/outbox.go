@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pg "github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+)
+
+// outboxRepository implements the profile OutboxRepository interface
+type outboxRepository struct {
+	DB *pg.DB
+}
+
+// NewOutboxRepository declares a new OutboxRepository that implements profile OutboxRepository
+func NewOutboxRepository(db *pg.DB) interfaces.OutboxRepository {
+	return &outboxRepository{
+		DB: db,
+	}
+}
+
+/* --------------- Outbox --------------- */
+
+// ClaimOutboxMessages returns up to limit Pending OutboxMessages that are due, oldest first.
+// Claimed messages are not due again until the lease has passed, so that concurrent
+// dispatchers do not deliver them twice while they are being delivered
+func (r *outboxRepository) ClaimOutboxMessages(ctx context.Context, limit int, lease time.Duration) ([]*models.OutboxMessage, error) {
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var m []*models.OutboxMessage
+	err = tx.Model(&m).
+		Where("ob.status = ?", models.OutboxPending).
+		Where("ob.next_attempt_at <= now()").
+		OrderExpr("ob.id asc").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED").
+		Select()
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.Wrap(err, "Failed to select outbox messages")
+	}
+	if len(m) == 0 {
+		tx.Rollback()
+		return m, nil
+	}
+
+	ids := make([]uint64, len(m))
+	for i, msg := range m {
+		ids[i] = msg.ID
+	}
+	_, err = tx.Model((*models.OutboxMessage)(nil)).
+		Set("next_attempt_at = now() + ?::interval", fmt.Sprintf("%d seconds", int(lease.Seconds()))).
+		Where("id in (?)", pg.In(ids)).
+		Update()
+	if err != nil {
+		tx.Rollback()
+		return nil, errors.Wrap(err, "Failed to lease outbox messages")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UpdateOutboxMessage records the outcome of delivering an OutboxMessage
+func (r *outboxRepository) UpdateOutboxMessage(ctx context.Context, m *models.OutboxMessage) error {
+	if m == nil {
+		return errors.New("Input parameter outbox message is nil")
+	}
+	_, err := r.DB.WithContext(ctx).Model(m).
+		Column("status", "attempts", "last_error", "next_attempt_at", "payload", "updated_at").
+		WherePK().
+		Update()
+	if err != nil {
+		return errors.Wrapf(err, "Failed to update outbox message %v", m.ID)
+	}
+	return nil
+}
@@ -3,40 +3,43 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	pg "github.com/go-pg/pg/v10"
 	"github.com/go-pg/pg/v10/orm"
 	"github.com/pkg/errors"
 
+	"in-backend/pagination"
+	assessmentPb "in-backend/services/assessment/pb"
 	joblistingPb "in-backend/services/joblisting/pb"
 	"in-backend/services/profile/interfaces"
 	"in-backend/services/profile/models"
-	"in-backend/services/profile/providers"
+	projectPb "in-backend/services/project/pb"
 )
 
 // Repository implements the profile Repository interface
 type repository struct {
 	DB       *pg.DB
-	auth0    providers.Auth0Provider
-	klenty   providers.KlentyProvider
 	jlClient joblistingPb.JoblistingServiceClient
+	asClient assessmentPb.AssessmentServiceClient
+	pjClient projectPb.ProjectServiceClient
 }
 
 // NewRepository declares a new Repository that implements profile Repository
-func NewRepository(db *pg.DB, a providers.Auth0Provider, k providers.KlentyProvider, c joblistingPb.JoblistingServiceClient) interfaces.Repository {
+func NewRepository(db *pg.DB, c joblistingPb.JoblistingServiceClient, ac assessmentPb.AssessmentServiceClient, pc projectPb.ProjectServiceClient) interfaces.Repository {
 	return &repository{
 		DB:       db,
-		auth0:    a,
-		klenty:   k,
 		jlClient: c,
+		asClient: ac,
+		pjClient: pc,
 	}
 }
 
 /* --------------- User --------------- */
 
-// CreateUser creates a new User
+// CreateUser creates a new User.
+// Updating the identity provider account and starting the CRM workflow are recorded in the outbox
+// in the same transaction, so they are delivered if and only if the User is committed
 func (r *repository) CreateUser(ctx context.Context, m *models.User) (*models.User, error) {
 	if m == nil {
 		return nil, errors.New("Input parameter user is nil")
@@ -96,7 +99,7 @@ func (r *repository) CreateUser(ctx context.Context, m *models.User) (*models.Us
 				}
 				m.JobCompanyID = c.Id
 			}
-		case "Admin":
+		case "Admin", "Recruiter":
 			// Do nothing
 		}
 	}
@@ -115,63 +118,44 @@ func (r *repository) CreateUser(ctx context.Context, m *models.User) (*models.Us
 		return nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
+	msgs, err := registrationMessages(m)
+	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
-
-	err = r.updateAuth0User(m)
+	_, err = tx.Model(&msgs).Insert()
 	if err != nil {
-		err = errors.Wrapf(err, "Failed to update auth0 user %v", m.AuthID)
+		tx.Rollback()
+		err = errors.Wrapf(err, "Failed to insert outbox messages for user %v", m.AuthID)
 		return nil, err
 	}
 
-	err = r.triggerCRMRegistrationWorkflow(m)
-	if err != nil {
-		err = errors.Wrapf(err, "Failed to trigger CRM workflow %v", m.Email)
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
 	return m, nil
 }
 
-func (r *repository) updateAuth0User(m *models.User) error {
-	token, err := r.auth0.GetToken()
-	if err != nil {
-		return err
-	}
-	t := token["access_token"].(string)
-
-	go func(t string, m *models.User) {
-		err = r.auth0.UpdateUser(t, m)
+// registrationMessages returns the OutboxMessages that sync a newly created User to the identity provider and the CRM
+func registrationMessages(m *models.User) ([]*models.OutboxMessage, error) {
+	kinds := []string{models.OutboxIdentityUpdateUser, models.OutboxIdentitySetRoles, models.OutboxCRMProspect}
+	var msgs []*models.OutboxMessage
+	for _, kind := range kinds {
+		msg, err := models.NewOutboxMessage(kind, m)
 		if err != nil {
-			log.Printf("Failed to update auth0 user %s\n", m.Email)
+			return nil, errors.Wrapf(err, "Failed to encode outbox message %s", kind)
 		}
-	}(t, m)
-
-	go func(t string, m *models.User) {
-		err = r.auth0.SetUserRole(t, m.AuthID, m.Roles)
-		if err != nil {
-			log.Printf("Failed to set auth0 roles for user %s\n", m.Email)
-		}
-	}(t, m)
-
-	return nil
-}
-
-func (r *repository) triggerCRMRegistrationWorkflow(m *models.User) error {
-	err := r.klenty.UpdateOrCreateProspect(m)
-	if err != nil {
-		return err
+		msgs = append(msgs, msg)
 	}
 	for _, role := range m.Roles {
-		go func(m *models.User, role string) {
-			err = r.klenty.StartCadence(m.Email, role)
-			if err != nil {
-				log.Printf("Failed to start cadence for %s, %s\n", m.Email, role)
-			}
-		}(m, role)
+		msg, err := models.NewOutboxMessage(models.OutboxCRMCadence, &models.CadencePayload{Email: m.Email, Role: role})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to encode outbox message %s", models.OutboxCRMCadence)
+		}
+		msgs = append(msgs, msg)
 	}
-	return nil
+	return msgs, nil
 }
 
 // GetUserByID returns a User by ID
@@ -300,7 +284,7 @@ func (r *repository) UpdateUser(ctx context.Context, m *models.User) (*models.Us
 				}
 				m.JobCompanyID = c.Id
 			}
-		case "Admin":
+		case "Admin", "Recruiter":
 			// Do nothing
 		}
 	}
@@ -356,12 +340,28 @@ func (r *repository) CreateCandidate(ctx context.Context, tx *pg.Tx, m *models.C
 }
 
 // GetAllCandidates returns all Candidates
-func (r *repository) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, error) {
+func (r *repository) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, *pagination.Info, error) {
+	sorts, sortBy, sortDesc := candidateSorts, "id", false
+	if f.Query != "" {
+		sorts = candidateSorts.With("relevance", pagination.Sort{
+			Column: candidateRank,
+			Value:  func(m interface{}) interface{} { return m.(*models.User).Rank },
+		})
+		sortBy, sortDesc = "relevance", true
+	}
+	page, err := pagination.New(f.Page, sorts, "u.id", sortBy, sortDesc)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var m []*models.User
 	q := r.DB.WithContext(ctx).Model(&m)
 	if len(f.ID) > 0 {
 		q = q.Where("u.id in (?)", pg.In(f.ID))
 	}
+	if len(f.CandidateID) > 0 {
+		q = q.Where("u.candidate_id in (?)", pg.In(f.CandidateID))
+	}
 	if f.FirstName != "" {
 		q = q.Where("lower(u.first_name) like ?", "%"+strings.ToLower(f.FirstName)+"%")
 	}
@@ -395,12 +395,40 @@ func (r *repository) GetAllCandidates(ctx context.Context, f models.CandidateFil
 	if f.MaxNoticePeriod > 0 {
 		q = q.Where("c.notice_period <= ?", f.MaxNoticePeriod)
 	}
-	err := q.Relation(relCandidate).Relation(relCandidateSkills).
-		Relation(relCandidateAcademics).Relation(relCandidateAcademicsInstitution).Relation(relCandidateAcademicsCourse).
-		Relation(relCandidateJobs).Relation(relCandidateJobsCompany).Relation(relCandidateJobsDepartment).
+	if f.Query != "" {
+		q = q.Join(tsQueryJoin, f.Query).Where(candidateSearch)
+	}
+	// the candidate is joined before counting as the filters refer to its columns
+	q = q.Relation(relCandidate)
+
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	// academic and job histories are only loaded with a single Candidate
+	err = page.Apply(q).Relation(relCandidateSkills).
 		Returning("*").
 		Select()
-	return m, err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasNext := page.HasNext(len(m))
+	if hasNext {
+		m = m[:page.Size()]
+	}
+	if f.Query != "" {
+		if err = r.setCandidateRanks(ctx, m, f.Query); err != nil {
+			return nil, nil, err
+		}
+	}
+	if hasNext {
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
 }
 
 // GetCandidateByID returns a Candidate by ID
@@ -834,3 +862,107 @@ func (r *repository) DeleteJobHistory(ctx context.Context, cid, jhid uint64) err
 	}
 	return nil
 }
+
+/* --------------- Matching --------------- */
+
+// GetAllJobPosts returns a page of open JobPosts from the joblisting service
+func (r *repository) GetAllJobPosts(ctx context.Context, p pagination.Params) ([]*models.JobPost, *pagination.Info, error) {
+	res, err := r.jlClient.GetAllJobPosts(ctx, &joblistingPb.GetAllJobPostsRequest{
+		PageSize:  p.PageSize,
+		PageToken: p.PageToken,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Cannot get job posts")
+	}
+
+	var m []*models.JobPost
+	for _, jp := range res.JobPosts {
+		m = append(m, jobPostFromProto(jp))
+	}
+	return m, &pagination.Info{NextPageToken: res.NextPageToken}, nil
+}
+
+// GetMatchCandidates returns a page of the Users whose Candidates have any of the skills sids,
+// or of all Candidates if sids is empty, with the skills and job histories needed to match them
+func (r *repository) GetMatchCandidates(ctx context.Context, sids []uint64, p pagination.Params) ([]*models.User, *pagination.Info, error) {
+	page, err := pagination.New(p, candidateSorts, "u.id", "id", false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m []*models.User
+	q := r.DB.WithContext(ctx).Model(&m).
+		Relation(relCandidate).
+		Where("candidate.id is not null")
+	if len(sids) > 0 {
+		q = q.Where("candidate.id in (select candidate_id from users_skills where skill_id in (?))", pg.In(sids))
+	}
+	err = page.Apply(q).Relation(relCandidateSkills).
+		Relation(relCandidateJobs).
+		Select()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := &pagination.Info{}
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
+}
+
+// GetJobPostByID returns a JobPost by ID from the joblisting service
+func (r *repository) GetJobPostByID(ctx context.Context, id uint64) (*models.JobPost, error) {
+	res, err := r.jlClient.GetJobPostByID(ctx, &joblistingPb.GetJobPostByIDRequest{Id: id})
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Cannot get job post with id %v", id))
+	}
+	return jobPostFromProto(res), nil
+}
+
+// GetAssessmentScores returns the scores of completed assessments of the Candidates of Users from the assessment service,
+// which keeps attempts by User ID
+func (r *repository) GetAssessmentScores(ctx context.Context, uids []uint64) ([]*models.AssessmentScore, error) {
+	res, err := r.asClient.LocalGetCandidateScores(ctx, &assessmentPb.GetCandidateScoresRequest{CandidateId: uids})
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get assessment scores")
+	}
+
+	var m []*models.AssessmentScore
+	for _, s := range res.Scores {
+		m = append(m, &models.AssessmentScore{
+			UserID:       s.CandidateId,
+			AssessmentID: s.AssessmentId,
+			Score:        s.Score,
+			MaxScore:     s.MaxScore,
+		})
+	}
+	return m, nil
+}
+
+func jobPostFromProto(jp *joblistingPb.JobPost) *models.JobPost {
+	if jp == nil {
+		return nil
+	}
+
+	m := &models.JobPost{
+		ID:              jp.Id,
+		CompanyID:       jp.CompanyId,
+		Title:           jp.Title,
+		YearsExperience: jp.YearsExperience,
+		Location:        jp.Location,
+		Remote:          jp.Remote,
+		SalaryCurrency:  jp.SalaryCurrency,
+		MinSalary:       jp.MinSalary,
+		MaxSalary:       jp.MaxSalary,
+		SkillID:         jp.SkillId,
+	}
+	if jp.Function != nil {
+		m.Function = jp.Function.Name
+	}
+	return m
+}
@@ -2,11 +2,12 @@ package database
 
 import (
 	"context"
+	asMocks "in-backend/services/assessment/tests/mocks"
 	jlMocks "in-backend/services/joblisting/tests/mocks"
 	"in-backend/services/profile/configs"
 	"in-backend/services/profile/interfaces"
 	"in-backend/services/profile/models"
-	"in-backend/services/profile/tests/mocks"
+	pjMocks "in-backend/services/project/tests/mocks"
 	"strings"
 	"testing"
 	"time"
@@ -20,20 +21,20 @@ import (
 var (
 	ctx      context.Context                  = context.Background()
 	now      time.Time                        = time.Now()
-	a        *mocks.Auth0Provider             = &mocks.Auth0Provider{}
-	k        *mocks.KlentyProvider            = &mocks.KlentyProvider{}
 	jlClient *jlMocks.JoblistingServiceClient = &jlMocks.JoblistingServiceClient{}
+	asClient *asMocks.AssessmentServiceClient = &asMocks.AssessmentServiceClient{}
+	pjClient *pjMocks.ProjectServiceClient    = &pjMocks.ProjectServiceClient{}
 )
 
 func TestNewRepository(t *testing.T) {
 	want := &repository{
 		DB:       &pg.DB{},
-		auth0:    a,
-		klenty:   k,
 		jlClient: jlClient,
+		asClient: asClient,
+		pjClient: pjClient,
 	}
 
-	got := NewRepository(&pg.DB{}, a, k, jlClient)
+	got := NewRepository(&pg.DB{}, jlClient, asClient, pjClient)
 
 	require.EqualValues(t, want, got)
 }
@@ -52,7 +53,7 @@ func TestAllCRUD(t *testing.T) {
 	defer cleanContainer(c)
 	require.NoError(t, err)
 
-	r := NewRepository(db, a, k, jlClient)
+	r := NewRepository(db, jlClient, asClient, pjClient)
 
 	testCreateCandidate(t, r, db)
 	testGetAllCandidates(t, r, db)
@@ -115,22 +116,16 @@ func testCreateUser(t *testing.T, r interfaces.Repository, db *pg.DB) {
 	test2.AuthID = "authId2"
 	test2.Email = "test@test.com"
 	test2.ContactNumber = "+6587654321"
-
-	auth0GetOutput := make(map[string]interface{})
-	auth0GetOutput["access_token"] = "test"
-	auth0UpdateInput := "test"
+	test2.Roles = []string{"Admin"}
 
 	type args struct {
-		ctx              context.Context
-		input            *models.User
-		auth0UpdateInput string
+		ctx   context.Context
+		input *models.User
 	}
 
 	type expect struct {
-		output            *models.User
-		err               error
-		auth0GetOutput    map[string]interface{}
-		auth0UpdateOutput error
+		output *models.User
+		err    error
 	}
 
 	var tests = []struct {
@@ -138,17 +133,15 @@ func testCreateUser(t *testing.T, r interfaces.Repository, db *pg.DB) {
 		args args
 		exp  expect
 	}{
-		{"nil", args{ctx, nil, auth0UpdateInput}, expect{nil, errors.New("Input parameter user is nil"), auth0GetOutput, nil}},
-		{"failed not null", args{ctx, testNoAuthID, auth0UpdateInput}, expect{nil, errors.New("Failed to insert user"), auth0GetOutput, nil}},
-		{"valid", args{ctx, &test, auth0UpdateInput}, expect{&test, nil, auth0GetOutput, nil}},
-		{"failed unique", args{ctx, &testDupEmail, auth0UpdateInput}, expect{nil, errors.New("Failed to insert user"), auth0GetOutput, nil}},
-		{"invalid auth0", args{ctx, &test2, auth0UpdateInput}, expect{nil, errors.New("Failed to update auth0 user"), auth0GetOutput, errors.New("Failed to update auth0 user")}},
+		{"nil", args{ctx, nil}, expect{nil, errors.New("Input parameter user is nil")}},
+		{"failed not null", args{ctx, testNoAuthID}, expect{nil, errors.New("Failed to insert user")}},
+		{"valid", args{ctx, &test}, expect{&test, nil}},
+		{"failed unique", args{ctx, &testDupEmail}, expect{nil, errors.New("Failed to insert user")}},
+		{"valid with roles", args{ctx, &test2}, expect{&test2, nil}},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			a.On("GetAuth0Token").Return(tt.exp.auth0GetOutput, nil)
-			a.On("UpdateAuth0User", tt.args.auth0UpdateInput, tt.args.input).Return(tt.exp.auth0UpdateOutput)
 			got, err := r.CreateUser(tt.args.ctx, tt.args.input)
 			assert.Condition(t, func() bool { return tt.exp.output.IsEqual(got) })
 			if tt.exp.err != nil && err != nil {
@@ -158,6 +151,14 @@ func testCreateUser(t *testing.T, r interfaces.Repository, db *pg.DB) {
 			}
 		})
 	}
+
+	// side effects are only recorded in the outbox for committed users
+	var msgs []*models.OutboxMessage
+	err := db.Model(&msgs).Order("id asc").Select()
+	require.NoError(t, err)
+	require.Len(t, msgs, 7)
+	require.Equal(t, models.OutboxCRMCadence, msgs[6].Kind)
+	require.Equal(t, models.OutboxPending, msgs[6].Status)
 }
 
 /* --------------- Candidate --------------- */
@@ -229,7 +230,7 @@ func testGetAllCandidates(t *testing.T, r interfaces.Repository, db *pg.DB) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := r.GetAllCandidates(tt.args.ctx, *tt.args.f)
+			got, _, err := r.GetAllCandidates(tt.args.ctx, *tt.args.f)
 			assert.Equal(t, tt.exp.cnt, len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+
+	pg "github.com/go-pg/pg/v10"
+
+	"in-backend/services/profile/models"
+)
+
+// tsQueryJoin parses a free text search query once so that filters and ranks can refer to it as tsq
+const tsQueryJoin = "cross join websearch_to_tsquery('english', ?) as tsq"
+
+// candidateSearch matches Candidates whose summary, job titles or skills match tsq
+const candidateSearch = `(candidate.search @@ tsq
+	or exists (select 1 from job_histories jh where jh.candidate_id = candidate.id and jh.deleted_at is null and jh.search @@ tsq)
+	or exists (select 1 from users_skills us join skills s on s.id = us.skill_id where us.candidate_id = candidate.id and s.search @@ tsq))`
+
+// candidateRank ranks Candidates against tsq by their summary, best matching job title and best matching skill
+const candidateRank = `(ts_rank(candidate.search, tsq)
+	+ coalesce((select max(ts_rank(jh.search, tsq)) from job_histories jh where jh.candidate_id = candidate.id and jh.deleted_at is null), 0)
+	+ coalesce((select max(ts_rank(s.search, tsq)) from users_skills us join skills s on s.id = us.skill_id where us.candidate_id = candidate.id), 0))`
+
+// setCandidateRanks sets the search rank of each User.
+// Ranks are not stored so they are selected separately from the Users
+func (r *repository) setCandidateRanks(ctx context.Context, m []*models.User, query string) error {
+	if len(m) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, len(m))
+	for i, u := range m {
+		ids[i] = u.ID
+	}
+
+	var ranks []struct {
+		ID   uint64
+		Rank float32
+	}
+	_, err := r.DB.WithContext(ctx).Query(&ranks, `
+		select u.id, `+candidateRank+` as rank
+		from users as u
+		join candidates as candidate on candidate.id = u.candidate_id
+		`+tsQueryJoin+`
+		where u.id in (?)`, query, pg.In(ids))
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[uint64]float32, len(ranks))
+	for _, rk := range ranks {
+		byID[rk.ID] = rk.Rank
+	}
+	for _, u := range m {
+		u.Rank = byID[u.ID]
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+)
+
+// countryColumns are the columns expected in the ISO country seed file
+var countryColumns = []string{"iso_code", "name", "region", "calling_code", "currency"}
+
+// SeedCountries loads ISO 3166 countries and their regions from a csv into the db.
+// Existing countries are updated by ISO code so the seed can be safely re-run
+func SeedCountries(ctx context.Context, r interfaces.LocationRepository, src io.Reader) (int, error) {
+	m, err := ParseCountries(src)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.SeedCountries(ctx, m); err != nil {
+		return 0, err
+	}
+	return len(m), nil
+}
+
+// ParseCountries parses a csv of ISO 3166 countries with the columns in countryColumns
+func ParseCountries(src io.Reader) ([]*models.Country, error) {
+	reader := csv.NewReader(src)
+	reader.FieldsPerRecord = len(countryColumns)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read country seed header")
+	}
+	for i, col := range countryColumns {
+		if strings.TrimSpace(header[i]) != col {
+			return nil, errors.New(fmt.Sprintf("Unexpected country seed column %s, expected %s", header[i], col))
+		}
+	}
+
+	var m []*models.Country
+	seen := make(map[string]bool)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to read country seed")
+		}
+
+		c := &models.Country{
+			ISOCode:     strings.ToUpper(strings.TrimSpace(record[0])),
+			Name:        strings.TrimSpace(record[1]),
+			Region:      &models.Region{Name: strings.TrimSpace(record[2])},
+			CallingCode: strings.TrimSpace(record[3]),
+			Currency:    strings.ToUpper(strings.TrimSpace(record[4])),
+		}
+		if len(c.ISOCode) != 2 || c.Name == "" || c.Region.Name == "" {
+			return nil, errors.New(fmt.Sprintf("Invalid country seed record %v", record))
+		}
+		if seen[c.ISOCode] {
+			return nil, errors.New(fmt.Sprintf("Duplicate country seed record %s", c.ISOCode))
+		}
+		seen[c.ISOCode] = true
+		m = append(m, c)
+	}
+	return m, nil
+}
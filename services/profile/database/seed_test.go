@@ -0,0 +1,58 @@
+package database
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCountries(t *testing.T) {
+	header := "iso_code,name,region,calling_code,currency\n"
+
+	var tests = []struct {
+		name    string
+		input   string
+		count   int
+		wantErr bool
+	}{
+		{"valid", header + "sg,Singapore,Asia,+65,sgd\nMY,Malaysia,Asia,+60,MYR\n", 2, false},
+		{"quoted name", header + "KR,\"Korea, Republic of\",Asia,+82,KRW\n", 1, false},
+		{"no records", header, 0, false},
+		{"wrong header", "code,name,region,calling_code,currency\n", 0, true},
+		{"missing column", header + "SG,Singapore,Asia,+65\n", 0, true},
+		{"invalid iso code", header + "SGP,Singapore,Asia,+65,SGD\n", 0, true},
+		{"missing region", header + "SG,Singapore,,+65,SGD\n", 0, true},
+		{"duplicate", header + "SG,Singapore,Asia,+65,SGD\nSG,Singapore,Asia,+65,SGD\n", 0, true},
+		{"empty", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCountries(strings.NewReader(tt.input))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got, tt.count)
+		})
+	}
+
+	got, err := ParseCountries(strings.NewReader(header + "sg,Singapore,Asia,+65,sgd\n"))
+	require.NoError(t, err)
+	require.Equal(t, "SG", got[0].ISOCode)
+	require.Equal(t, "SGD", got[0].Currency)
+	require.Equal(t, "Asia", got[0].Region.Name)
+}
+
+func TestParseCountriesSeedFile(t *testing.T) {
+	f, err := os.Open("../scripts/seeds/countries.csv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	got, err := ParseCountries(f)
+	require.NoError(t, err)
+	require.Len(t, got, 249)
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	pg "github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+
+	"in-backend/services/profile/models"
+)
+
+// shortlistShared matches the Shortlists of a User and the Shortlists shared with them
+const shortlistShared = `(sl.user_id = ?0
+	or exists (select 1 from shortlists_shares ss where ss.shortlist_id = sl.id and ss.user_id = ?0))`
+
+/* --------------- Shortlist --------------- */
+
+// CreateShortlist creates a new Shortlist
+func (r *repository) CreateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter shortlist is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).Returning("*").Insert()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to insert shortlist %v", m)
+	}
+
+	return r.GetShortlistByID(ctx, m.ID)
+}
+
+// GetAllShortlists returns all Shortlists that match the filters
+func (r *repository) GetAllShortlists(ctx context.Context, f models.ShortlistFilters) ([]*models.Shortlist, error) {
+	var m []*models.Shortlist
+	q := r.DB.WithContext(ctx).Model(&m).
+		Relation(relShortlistCandidates).Relation(relShortlistShares)
+	if f.UserID != 0 {
+		q = q.Where(shortlistShared, f.UserID)
+	}
+
+	err := q.Order("sl.id").Select()
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetShortlistByID returns a Shortlist by ID
+func (r *repository) GetShortlistByID(ctx context.Context, id uint64) (*models.Shortlist, error) {
+	m := models.Shortlist{ID: id}
+	err := r.DB.WithContext(ctx).Model(&m).
+		Where(filShortlistID, id).
+		Relation(relShortlistCandidates).Relation(relShortlistShares).
+		First()
+	//pg returns error when no rows in the result set
+	if err == pg.ErrNoRows {
+		return nil, nil
+	}
+	return &m, err
+}
+
+// UpdateShortlist updates the name of a Shortlist
+func (r *repository) UpdateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	if m == nil {
+		return nil, errors.New("Shortlist is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).WherePK().
+		Column("name", "updated_at").
+		Update()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to update shortlist %v", m)
+	}
+
+	return r.GetShortlistByID(ctx, m.ID)
+}
+
+// DeleteShortlist deletes a Shortlist by ID, with its Candidates and shares
+func (r *repository) DeleteShortlist(ctx context.Context, id uint64) error {
+	m := &models.Shortlist{ID: id}
+	_, err := r.DB.WithContext(ctx).Model(m).WherePK().Delete()
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Cannot delete shortlist with id %v", id))
+	}
+	return nil
+}
+
+/* --------------- Shortlist Candidate --------------- */
+
+// CreateShortlistCandidate adds a Candidate to a Shortlist, or updates the notes of a Candidate already in it
+func (r *repository) CreateShortlistCandidate(ctx context.Context, m *models.ShortlistCandidate) (*models.ShortlistCandidate, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter shortlist candidate is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).
+		OnConflict("(shortlist_id, candidate_id) DO UPDATE").
+		Set("notes = EXCLUDED.notes, updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Insert()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to insert shortlist candidate %v", m)
+	}
+	return m, nil
+}
+
+// DeleteShortlistCandidate removes a Candidate from a Shortlist
+func (r *repository) DeleteShortlistCandidate(ctx context.Context, sid, cid uint64) error {
+	_, err := r.DB.WithContext(ctx).Model((*models.ShortlistCandidate)(nil)).
+		Where("shortlist_id = ?", sid).
+		Where("candidate_id = ?", cid).
+		Delete()
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Cannot delete candidate %v from shortlist %v", cid, sid))
+	}
+	return nil
+}
+
+/* --------------- Shortlist Share --------------- */
+
+// CreateShortlistShare shares a Shortlist with a User.
+// Sharing a Shortlist again returns the existing share
+func (r *repository) CreateShortlistShare(ctx context.Context, m *models.ShortlistShare) (*models.ShortlistShare, error) {
+	if m == nil {
+		return nil, errors.New("Input parameter shortlist share is nil")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).
+		OnConflict("(shortlist_id, user_id) DO UPDATE").
+		Set("shortlist_id = EXCLUDED.shortlist_id").
+		Returning("*").
+		Insert()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to insert shortlist share %v", m)
+	}
+	return m, nil
+}
+
+// DeleteShortlistShare stops sharing a Shortlist with a User
+func (r *repository) DeleteShortlistShare(ctx context.Context, sid, uid uint64) error {
+	_, err := r.DB.WithContext(ctx).Model((*models.ShortlistShare)(nil)).
+		Where("shortlist_id = ?", sid).
+		Where("user_id = ?", uid).
+		Delete()
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Cannot delete share of shortlist %v with user %v", sid, uid))
+	}
+	return nil
+}
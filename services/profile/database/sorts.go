@@ -0,0 +1,20 @@
+package database
+
+import (
+	"in-backend/pagination"
+	"in-backend/services/profile/models"
+)
+
+// candidateSorts declares the fields that Candidates can be sorted by
+var candidateSorts = pagination.Sorts{
+	"id":         {Column: "u.id"},
+	"created_at": {Column: "u.created_at", Value: func(m interface{}) interface{} { return m.(*models.User).CreatedAt }},
+	"first_name": {Column: "coalesce(u.first_name, '')", Value: func(m interface{}) interface{} { return m.(*models.User).FirstName }},
+	"last_name":  {Column: "coalesce(u.last_name, '')", Value: func(m interface{}) interface{} { return m.(*models.User).LastName }},
+	"expected_salary": {Column: "coalesce(candidate.expected_salary, 0)", Value: func(m interface{}) interface{} {
+		if c := m.(*models.User).Candidate; c != nil {
+			return c.ExpectedSalary
+		}
+		return 0
+	}},
+}
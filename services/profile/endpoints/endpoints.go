@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-kit/kit/endpoint"
 
+	"in-backend/pagination"
 	"in-backend/services/profile/interfaces"
 	"in-backend/services/profile/models"
 )
@@ -21,6 +22,11 @@ type Endpoints struct {
 	GetCandidateByID endpoint.Endpoint
 	UpdateCandidate  endpoint.Endpoint
 	DeleteCandidate  endpoint.Endpoint
+	ImportResume     endpoint.Endpoint
+
+	ExportCandidateProfile endpoint.Endpoint
+
+	LocalGetSCMLogins endpoint.Endpoint
 
 	CreateSkill  endpoint.Endpoint
 	GetSkill     endpoint.Endpoint
@@ -54,6 +60,27 @@ type Endpoints struct {
 	GetJobHistory    endpoint.Endpoint
 	UpdateJobHistory endpoint.Endpoint
 	DeleteJobHistory endpoint.Endpoint
+
+	GetMatchingJobPosts   endpoint.Endpoint
+	GetMatchingCandidates endpoint.Endpoint
+
+	CreateShortlist          endpoint.Endpoint
+	GetAllShortlists         endpoint.Endpoint
+	GetShortlistByID         endpoint.Endpoint
+	UpdateShortlist          endpoint.Endpoint
+	DeleteShortlist          endpoint.Endpoint
+	AddShortlistCandidate    endpoint.Endpoint
+	RemoveShortlistCandidate endpoint.Endpoint
+	ShareShortlist           endpoint.Endpoint
+	UnshareShortlist         endpoint.Endpoint
+
+	GrantContactConsent   endpoint.Endpoint
+	GetAllContactConsents endpoint.Endpoint
+	RevokeContactConsent  endpoint.Endpoint
+
+	ExportMyData      endpoint.Endpoint
+	EraseUser         endpoint.Endpoint
+	GetErasureReceipt endpoint.Endpoint
 }
 
 // MakeEndpoints initializes all Go kit endpoints for the Profile service.
@@ -69,6 +96,11 @@ func MakeEndpoints(s interfaces.Service) Endpoints {
 		GetCandidateByID: makeGetCandidateByIDEndpoint(s),
 		UpdateCandidate:  makeUpdateCandidateEndpoint(s),
 		DeleteCandidate:  makeDeleteCandidateEndpoint(s),
+		ImportResume:     makeImportResumeEndpoint(s),
+
+		ExportCandidateProfile: makeExportCandidateProfileEndpoint(s),
+
+		LocalGetSCMLogins: makeLocalGetSCMLoginsEndpoint(s),
 
 		CreateSkill:  makeCreateSkillEndpoint(s),
 		GetSkill:     makeGetSkillEndpoint(s),
@@ -102,6 +134,27 @@ func MakeEndpoints(s interfaces.Service) Endpoints {
 		GetJobHistory:    makeGetJobHistoryEndpoint(s),
 		UpdateJobHistory: makeUpdateJobHistoryEndpoint(s),
 		DeleteJobHistory: makeDeleteJobHistoryEndpoint(s),
+
+		GetMatchingJobPosts:   makeGetMatchingJobPostsEndpoint(s),
+		GetMatchingCandidates: makeGetMatchingCandidatesEndpoint(s),
+
+		CreateShortlist:          makeCreateShortlistEndpoint(s),
+		GetAllShortlists:         makeGetAllShortlistsEndpoint(s),
+		GetShortlistByID:         makeGetShortlistByIDEndpoint(s),
+		UpdateShortlist:          makeUpdateShortlistEndpoint(s),
+		DeleteShortlist:          makeDeleteShortlistEndpoint(s),
+		AddShortlistCandidate:    makeAddShortlistCandidateEndpoint(s),
+		RemoveShortlistCandidate: makeRemoveShortlistCandidateEndpoint(s),
+		ShareShortlist:           makeShareShortlistEndpoint(s),
+		UnshareShortlist:         makeUnshareShortlistEndpoint(s),
+
+		GrantContactConsent:   makeGrantContactConsentEndpoint(s),
+		GetAllContactConsents: makeGetAllContactConsentsEndpoint(s),
+		RevokeContactConsent:  makeRevokeContactConsentEndpoint(s),
+
+		ExportMyData:      makeExportMyDataEndpoint(s),
+		EraseUser:         makeEraseUserEndpoint(s),
+		GetErasureReceipt: makeGetErasureReceiptEndpoint(s),
 	}
 }
 
@@ -208,14 +261,15 @@ func makeGetAllCandidatesEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetAllCandidatesRequest)
 		f := models.CandidateFilters(req)
-		c, err := s.GetAllCandidates(ctx, f)
-		return GetAllCandidatesResponse{Candidates: c, Err: err}, nil
+		c, page, err := s.GetAllCandidates(ctx, f)
+		return GetAllCandidatesResponse{Candidates: c, Page: page, Err: err}, nil
 	}
 }
 
 // GetAllCandidatesRequest declares the inputs required for getting all candidates
 type GetAllCandidatesRequest struct {
 	ID              []uint64
+	CandidateID     []uint64
 	FirstName       string
 	LastName        string
 	Email           string
@@ -228,11 +282,14 @@ type GetAllCandidatesRequest struct {
 	EducationLevel  []string
 	MaxNoticePeriod uint32
 	Roles           []string
+	Query           string
+	Page            pagination.Params
 }
 
 // GetAllCandidatesResponse declares the outputs after attempting to get all candidates
 type GetAllCandidatesResponse struct {
 	Candidates []*models.User
+	Page       *pagination.Info
 	Err        error
 }
 
@@ -255,6 +312,25 @@ type GetCandidateByIDResponse struct {
 	Err       error
 }
 
+func makeLocalGetSCMLoginsEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetSCMLoginsRequest)
+		l, err := s.LocalGetSCMLogins(ctx, req.UserID)
+		return GetSCMLoginsResponse{Logins: l, Err: err}, nil
+	}
+}
+
+// GetSCMLoginsRequest declares the inputs required for getting the git host logins of a user
+type GetSCMLoginsRequest struct {
+	UserID uint64
+}
+
+// GetSCMLoginsResponse declares the outputs after attempting to get the git host logins of a user
+type GetSCMLoginsResponse struct {
+	Logins map[string]string
+	Err    error
+}
+
 func makeUpdateCandidateEndpoint(s interfaces.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(UpdateCandidateRequest)
@@ -293,6 +369,46 @@ type DeleteCandidateResponse struct {
 	Err error
 }
 
+func makeImportResumeEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ImportResumeRequest)
+		c, err := s.ImportResume(ctx, req.ID, req.Content)
+		return ImportResumeResponse{Candidate: c, Err: err}, nil
+	}
+}
+
+// ImportResumeRequest declares the inputs required for importing a resume
+type ImportResumeRequest struct {
+	ID      uint64
+	Content []byte
+}
+
+// ImportResumeResponse declares the outputs after attempting to import a resume
+type ImportResumeResponse struct {
+	Candidate *models.Candidate
+	Err       error
+}
+
+func makeExportCandidateProfileEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportCandidateProfileRequest)
+		e, err := s.ExportCandidateProfile(ctx, req.ID, req.Options)
+		return ExportCandidateProfileResponse{Export: e, Err: err}, nil
+	}
+}
+
+// ExportCandidateProfileRequest declares the inputs required for exporting a candidate profile
+type ExportCandidateProfileRequest struct {
+	ID      uint64
+	Options models.ExportOptions
+}
+
+// ExportCandidateProfileResponse declares the outputs after attempting to export a candidate profile
+type ExportCandidateProfileResponse struct {
+	Export *models.ProfileExport
+	Err    error
+}
+
 /* -------------- Skill -------------- */
 
 func makeCreateSkillEndpoint(s interfaces.Service) endpoint.Endpoint {
@@ -791,3 +907,326 @@ type DeleteJobHistoryRequest struct {
 type DeleteJobHistoryResponse struct {
 	Err error
 }
+
+/* -------------- Matching -------------- */
+
+func makeGetMatchingJobPostsEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetMatchingJobPostsRequest)
+		m, err := s.GetMatchingJobPosts(ctx, req.CandidateID, req.Filters)
+		return GetMatchesResponse{Matches: m, Err: err}, nil
+	}
+}
+
+// GetMatchingJobPostsRequest declares the inputs required for ranking JobPosts for a Candidate
+type GetMatchingJobPostsRequest struct {
+	CandidateID uint64
+	Filters     models.MatchFilters
+}
+
+func makeGetMatchingCandidatesEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetMatchingCandidatesRequest)
+		m, err := s.GetMatchingCandidates(ctx, req.JobPostID, req.Filters)
+		return GetMatchesResponse{Matches: m, Err: err}, nil
+	}
+}
+
+// GetMatchingCandidatesRequest declares the inputs required for ranking Candidates for a JobPost
+type GetMatchingCandidatesRequest struct {
+	JobPostID uint64
+	Filters   models.MatchFilters
+}
+
+// GetMatchesResponse declares the outputs after attempting to rank matches
+type GetMatchesResponse struct {
+	Matches []*models.Match
+	Err     error
+}
+
+/* -------------- Shortlist -------------- */
+
+func makeCreateShortlistEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateShortlistRequest)
+		m, err := s.CreateShortlist(ctx, req.Shortlist)
+		return CreateShortlistResponse{Shortlist: m, Err: err}, nil
+	}
+}
+
+// CreateShortlistRequest declares the inputs required for creating a Shortlist
+type CreateShortlistRequest struct {
+	Shortlist *models.Shortlist
+}
+
+// CreateShortlistResponse declares the outputs after attempting to create a Shortlist
+type CreateShortlistResponse struct {
+	Shortlist *models.Shortlist
+	Err       error
+}
+
+func makeGetAllShortlistsEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAllShortlistsRequest)
+		m, err := s.GetAllShortlists(ctx, models.ShortlistFilters(req))
+		return GetAllShortlistsResponse{Shortlists: m, Err: err}, nil
+	}
+}
+
+// GetAllShortlistsRequest declares the inputs required for getting all Shortlists
+type GetAllShortlistsRequest struct {
+	UserID uint64
+}
+
+// GetAllShortlistsResponse declares the outputs after attempting to get all Shortlists
+type GetAllShortlistsResponse struct {
+	Shortlists []*models.Shortlist
+	Err        error
+}
+
+func makeGetShortlistByIDEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetShortlistByIDRequest)
+		m, err := s.GetShortlistByID(ctx, req.ID)
+		return GetShortlistByIDResponse{Shortlist: m, Err: err}, nil
+	}
+}
+
+// GetShortlistByIDRequest declares the inputs required for getting a single Shortlist by ID
+type GetShortlistByIDRequest struct {
+	ID uint64
+}
+
+// GetShortlistByIDResponse declares the outputs after attempting to get a single Shortlist by ID
+type GetShortlistByIDResponse struct {
+	Shortlist *models.Shortlist
+	Err       error
+}
+
+func makeUpdateShortlistEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateShortlistRequest)
+		m, err := s.UpdateShortlist(ctx, req.Shortlist)
+		return UpdateShortlistResponse{Shortlist: m, Err: err}, nil
+	}
+}
+
+// UpdateShortlistRequest declares the inputs required for updating a Shortlist
+type UpdateShortlistRequest struct {
+	Shortlist *models.Shortlist
+}
+
+// UpdateShortlistResponse declares the outputs after attempting to update a Shortlist
+type UpdateShortlistResponse struct {
+	Shortlist *models.Shortlist
+	Err       error
+}
+
+func makeDeleteShortlistEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteShortlistRequest)
+		err := s.DeleteShortlist(ctx, req.ID)
+		return DeleteShortlistResponse{Err: err}, nil
+	}
+}
+
+// DeleteShortlistRequest declares the inputs required for deleting a Shortlist
+type DeleteShortlistRequest struct {
+	ID uint64
+}
+
+// DeleteShortlistResponse declares the outputs after attempting to delete a Shortlist
+type DeleteShortlistResponse struct {
+	Err error
+}
+
+func makeAddShortlistCandidateEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AddShortlistCandidateRequest)
+		m, err := s.AddShortlistCandidate(ctx, req.ShortlistCandidate)
+		return AddShortlistCandidateResponse{ShortlistCandidate: m, Err: err}, nil
+	}
+}
+
+// AddShortlistCandidateRequest declares the inputs required for adding a Candidate to a Shortlist
+type AddShortlistCandidateRequest struct {
+	ShortlistCandidate *models.ShortlistCandidate
+}
+
+// AddShortlistCandidateResponse declares the outputs after attempting to add a Candidate to a Shortlist
+type AddShortlistCandidateResponse struct {
+	ShortlistCandidate *models.ShortlistCandidate
+	Err                error
+}
+
+func makeRemoveShortlistCandidateEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RemoveShortlistCandidateRequest)
+		err := s.RemoveShortlistCandidate(ctx, req.ShortlistID, req.CandidateID)
+		return RemoveShortlistCandidateResponse{Err: err}, nil
+	}
+}
+
+// RemoveShortlistCandidateRequest declares the inputs required for removing a Candidate from a Shortlist
+type RemoveShortlistCandidateRequest struct {
+	ShortlistID uint64
+	CandidateID uint64
+}
+
+// RemoveShortlistCandidateResponse declares the outputs after attempting to remove a Candidate from a Shortlist
+type RemoveShortlistCandidateResponse struct {
+	Err error
+}
+
+func makeShareShortlistEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ShareShortlistRequest)
+		m, err := s.ShareShortlist(ctx, req.ShortlistID, req.UserID)
+		return ShareShortlistResponse{ShortlistShare: m, Err: err}, nil
+	}
+}
+
+// ShareShortlistRequest declares the inputs required for sharing a Shortlist with a company user
+type ShareShortlistRequest struct {
+	ShortlistID uint64
+	UserID      uint64
+}
+
+// ShareShortlistResponse declares the outputs after attempting to share a Shortlist with a company user
+type ShareShortlistResponse struct {
+	ShortlistShare *models.ShortlistShare
+	Err            error
+}
+
+func makeUnshareShortlistEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UnshareShortlistRequest)
+		err := s.UnshareShortlist(ctx, req.ShortlistID, req.UserID)
+		return UnshareShortlistResponse{Err: err}, nil
+	}
+}
+
+// UnshareShortlistRequest declares the inputs required for no longer sharing a Shortlist with a company user
+type UnshareShortlistRequest struct {
+	ShortlistID uint64
+	UserID      uint64
+}
+
+// UnshareShortlistResponse declares the outputs after attempting to no longer share a Shortlist with a company user
+type UnshareShortlistResponse struct {
+	Err error
+}
+
+/* -------------- Contact Consent -------------- */
+
+func makeGrantContactConsentEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GrantContactConsentRequest)
+		m, err := s.GrantContactConsent(ctx, &models.ContactConsent{CandidateID: req.CandidateID, CompanyID: req.CompanyID})
+		return GrantContactConsentResponse{ContactConsent: m, Err: err}, nil
+	}
+}
+
+// GrantContactConsentRequest declares the inputs required for letting a company see the personal details of a candidate
+type GrantContactConsentRequest struct {
+	CandidateID uint64
+	CompanyID   uint64
+}
+
+// GrantContactConsentResponse declares the outputs after attempting to let a company see the personal details of a candidate
+type GrantContactConsentResponse struct {
+	ContactConsent *models.ContactConsent
+	Err            error
+}
+
+func makeGetAllContactConsentsEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAllContactConsentsRequest)
+		f := models.ContactConsentFilters{CandidateID: []uint64{req.CandidateID}}
+		m, err := s.GetAllContactConsents(ctx, f)
+		return GetAllContactConsentsResponse{ContactConsents: m, Err: err}, nil
+	}
+}
+
+// GetAllContactConsentsRequest declares the inputs required for getting all contact consents of a candidate
+type GetAllContactConsentsRequest struct {
+	CandidateID uint64
+}
+
+// GetAllContactConsentsResponse declares the outputs after attempting to get all contact consents of a candidate
+type GetAllContactConsentsResponse struct {
+	ContactConsents []*models.ContactConsent
+	Err             error
+}
+
+func makeRevokeContactConsentEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RevokeContactConsentRequest)
+		err := s.RevokeContactConsent(ctx, req.CandidateID, req.CompanyID)
+		return RevokeContactConsentResponse{Err: err}, nil
+	}
+}
+
+// RevokeContactConsentRequest declares the inputs required for no longer letting a company see the personal details of a candidate
+type RevokeContactConsentRequest struct {
+	CandidateID uint64
+	CompanyID   uint64
+}
+
+// RevokeContactConsentResponse declares the outputs after attempting to no longer let a company see the personal details of a candidate
+type RevokeContactConsentResponse struct {
+	Err error
+}
+
+/* -------------- Personal Data -------------- */
+
+func makeExportMyDataEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportMyDataRequest)
+		e, err := s.ExportMyData(ctx, req.ID)
+		return ExportMyDataResponse{Export: e, Err: err}, nil
+	}
+}
+
+// ExportMyDataRequest declares the inputs required for exporting the data about a user
+type ExportMyDataRequest struct {
+	ID uint64
+}
+
+// ExportMyDataResponse declares the outputs after attempting to export the data about a user
+type ExportMyDataResponse struct {
+	Export *models.ProfileExport
+	Err    error
+}
+
+func makeEraseUserEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(EraseUserRequest)
+		m, err := s.EraseUser(ctx, req.ID)
+		return ErasureReceiptResponse{ErasureReceipt: m, Err: err}, nil
+	}
+}
+
+// EraseUserRequest declares the inputs required for erasing the data about a user
+type EraseUserRequest struct {
+	ID uint64
+}
+
+func makeGetErasureReceiptEndpoint(s interfaces.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetErasureReceiptRequest)
+		m, err := s.GetErasureReceipt(ctx, req.UserID)
+		return ErasureReceiptResponse{ErasureReceipt: m, Err: err}, nil
+	}
+}
+
+// GetErasureReceiptRequest declares the inputs required for getting the erasure receipt of a user
+type GetErasureReceiptRequest struct {
+	UserID uint64
+}
+
+// ErasureReceiptResponse declares the outputs after attempting to erase a user or get their erasure receipt
+type ErasureReceiptResponse struct {
+	ErasureReceipt *models.ErasureReceipt
+	Err            error
+}
@@ -0,0 +1,249 @@
+package endpoints
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+)
+
+// LocationEndpoints holds all Go kit endpoints for the Location Service.
+type LocationEndpoints struct {
+	GetRegions endpoint.Endpoint
+
+	GetCountries endpoint.Endpoint
+
+	CreateState endpoint.Endpoint
+	GetStates   endpoint.Endpoint
+
+	CreateCity endpoint.Endpoint
+	GetCities  endpoint.Endpoint
+
+	CreateAddress endpoint.Endpoint
+	GetAddress    endpoint.Endpoint
+	UpdateAddress endpoint.Endpoint
+	DeleteAddress endpoint.Endpoint
+}
+
+// MakeLocationEndpoints initializes all Go kit endpoints for the Location service.
+func MakeLocationEndpoints(s interfaces.LocationService) LocationEndpoints {
+	return LocationEndpoints{
+		GetRegions: makeGetRegionsEndpoint(s),
+
+		GetCountries: makeGetCountriesEndpoint(s),
+
+		CreateState: makeCreateStateEndpoint(s),
+		GetStates:   makeGetStatesEndpoint(s),
+
+		CreateCity: makeCreateCityEndpoint(s),
+		GetCities:  makeGetCitiesEndpoint(s),
+
+		CreateAddress: makeCreateAddressEndpoint(s),
+		GetAddress:    makeGetAddressEndpoint(s),
+		UpdateAddress: makeUpdateAddressEndpoint(s),
+		DeleteAddress: makeDeleteAddressEndpoint(s),
+	}
+}
+
+/* -------------- Region -------------- */
+
+func makeGetRegionsEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(GetRegionsRequest)
+		m, err := s.GetRegions(ctx)
+		return GetRegionsResponse{Regions: m, Err: err}, nil
+	}
+}
+
+// GetRegionsRequest declares the inputs required for getting all regions
+type GetRegionsRequest struct{}
+
+// GetRegionsResponse declares the outputs after attempting to get all regions
+type GetRegionsResponse struct {
+	Regions []*models.Region
+	Err     error
+}
+
+/* -------------- Country -------------- */
+
+func makeGetCountriesEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetCountriesRequest)
+		f := models.CountryFilters(req)
+		m, err := s.GetCountries(ctx, f)
+		return GetCountriesResponse{Countries: m, Err: err}, nil
+	}
+}
+
+// GetCountriesRequest declares the inputs required for getting all countries
+type GetCountriesRequest struct {
+	RegionID uint64
+}
+
+// GetCountriesResponse declares the outputs after attempting to get all countries
+type GetCountriesResponse struct {
+	Countries []*models.Country
+	Err       error
+}
+
+/* -------------- State -------------- */
+
+func makeCreateStateEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateStateRequest)
+		m, err := s.CreateState(ctx, req.State)
+		return CreateStateResponse{State: m, Err: err}, nil
+	}
+}
+
+// CreateStateRequest declares the inputs required for creating a state
+type CreateStateRequest struct {
+	State *models.State
+}
+
+// CreateStateResponse declares the outputs after attempting to create a state
+type CreateStateResponse struct {
+	State *models.State
+	Err   error
+}
+
+func makeGetStatesEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetStatesRequest)
+		f := models.StateFilters(req)
+		m, err := s.GetStates(ctx, f)
+		return GetStatesResponse{States: m, Err: err}, nil
+	}
+}
+
+// GetStatesRequest declares the inputs required for getting all states
+type GetStatesRequest struct {
+	CountryID uint64
+}
+
+// GetStatesResponse declares the outputs after attempting to get all states
+type GetStatesResponse struct {
+	States []*models.State
+	Err    error
+}
+
+/* -------------- City -------------- */
+
+func makeCreateCityEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateCityRequest)
+		m, err := s.CreateCity(ctx, req.City)
+		return CreateCityResponse{City: m, Err: err}, nil
+	}
+}
+
+// CreateCityRequest declares the inputs required for creating a city
+type CreateCityRequest struct {
+	City *models.City
+}
+
+// CreateCityResponse declares the outputs after attempting to create a city
+type CreateCityResponse struct {
+	City *models.City
+	Err  error
+}
+
+func makeGetCitiesEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetCitiesRequest)
+		f := models.CityFilters(req)
+		m, err := s.GetCities(ctx, f)
+		return GetCitiesResponse{Cities: m, Err: err}, nil
+	}
+}
+
+// GetCitiesRequest declares the inputs required for getting all cities
+type GetCitiesRequest struct {
+	StateID uint64
+}
+
+// GetCitiesResponse declares the outputs after attempting to get all cities
+type GetCitiesResponse struct {
+	Cities []*models.City
+	Err    error
+}
+
+/* -------------- Address -------------- */
+
+func makeCreateAddressEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateAddressRequest)
+		m, err := s.CreateAddress(ctx, req.Address)
+		return CreateAddressResponse{Address: m, Err: err}, nil
+	}
+}
+
+// CreateAddressRequest declares the inputs required for creating an address
+type CreateAddressRequest struct {
+	Address *models.Address
+}
+
+// CreateAddressResponse declares the outputs after attempting to create an address
+type CreateAddressResponse struct {
+	Address *models.Address
+	Err     error
+}
+
+func makeGetAddressEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAddressRequest)
+		m, err := s.GetAddress(ctx, req.ID)
+		return GetAddressResponse{Address: m, Err: err}, nil
+	}
+}
+
+// GetAddressRequest declares the inputs required for getting a single address by ID
+type GetAddressRequest struct {
+	ID uint64
+}
+
+// GetAddressResponse declares the outputs after attempting to get a single address by ID
+type GetAddressResponse struct {
+	Address *models.Address
+	Err     error
+}
+
+func makeUpdateAddressEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateAddressRequest)
+		m, err := s.UpdateAddress(ctx, req.Address)
+		return UpdateAddressResponse{Address: m, Err: err}, nil
+	}
+}
+
+// UpdateAddressRequest declares the inputs required for updating an address
+type UpdateAddressRequest struct {
+	ID      uint64
+	Address *models.Address
+}
+
+// UpdateAddressResponse declares the outputs after attempting to update an address
+type UpdateAddressResponse struct {
+	Address *models.Address
+	Err     error
+}
+
+func makeDeleteAddressEndpoint(s interfaces.LocationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteAddressRequest)
+		err := s.DeleteAddress(ctx, req.ID)
+		return DeleteAddressResponse{Err: err}, nil
+	}
+}
+
+// DeleteAddressRequest declares the inputs required for deleting an address
+type DeleteAddressRequest struct {
+	ID uint64
+}
+
+// DeleteAddressResponse declares the outputs after attempting to delete an address
+type DeleteAddressResponse struct {
+	Err error
+}
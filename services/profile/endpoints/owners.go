@@ -0,0 +1,240 @@
+package endpoints
+
+import (
+	"context"
+
+	"in-backend/rbac"
+	"in-backend/services/profile/interfaces"
+)
+
+// MakeOwners returns the owners of the resources of the requests that are authorized by ownership.
+// Academic and job histories and shortlists that do not exist cannot be read or changed by anyone,
+// and shortlists are read by the company users that they are shared with
+func MakeOwners(r interfaces.Repository) rbac.Owners {
+	user := func(id uint64) ([]rbac.Owner, error) {
+		return []rbac.Owner{{UserID: id}}, nil
+	}
+	candidate := func(id uint64) ([]rbac.Owner, error) {
+		return []rbac.Owner{{CandidateID: id}}, nil
+	}
+	academicHistory := func(ctx context.Context, id uint64) ([]rbac.Owner, error) {
+		ah, err := r.GetAcademicHistory(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if ah == nil {
+			return nil, rbac.ErrForbidden
+		}
+		return candidate(ah.CandidateID)
+	}
+	jobHistory := func(ctx context.Context, id uint64) ([]rbac.Owner, error) {
+		jh, err := r.GetJobHistory(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if jh == nil {
+			return nil, rbac.ErrForbidden
+		}
+		return candidate(jh.CandidateID)
+	}
+	shortlist := func(ctx context.Context, id uint64, read bool) ([]rbac.Owner, error) {
+		sl, err := r.GetShortlistByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if sl == nil {
+			return nil, rbac.ErrForbidden
+		}
+		if s := rbac.FromContext(ctx); read && s != nil && sl.IsSharedWith(s.ID) {
+			return user(s.ID)
+		}
+		return user(sl.UserID)
+	}
+
+	return rbac.Owners{
+		"UpdateUser": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateUserRequest).User
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return user(m.ID)
+		},
+		"DeleteUser": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return user(request.(DeleteUserRequest).ID)
+		},
+		"ExportMyData": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return user(request.(ExportMyDataRequest).ID)
+		},
+		"EraseUser": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return user(request.(EraseUserRequest).ID)
+		},
+		"GetCandidateByID": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return user(request.(GetCandidateByIDRequest).ID)
+		},
+
+		"UpdateCandidate": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateCandidateRequest).Candidate
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return candidate(m.ID)
+		},
+		"DeleteCandidate": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(DeleteCandidateRequest).ID)
+		},
+		"ImportResume": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(ImportResumeRequest).ID)
+		},
+		"ExportCandidateProfile": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(ExportCandidateProfileRequest).ID)
+		},
+
+		"CreateUserSkill": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateUserSkillRequest).UserSkill
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return candidate(m.CandidateID)
+		},
+		"DeleteUserSkill": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(DeleteUserSkillRequest).CandidateID)
+		},
+
+		"CreateAcademicHistory": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateAcademicHistoryRequest).AcademicHistory
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return candidate(m.CandidateID)
+		},
+		"GetAcademicHistory": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return academicHistory(ctx, request.(GetAcademicHistoryRequest).ID)
+		},
+		"UpdateAcademicHistory": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateAcademicHistoryRequest).AcademicHistory
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return academicHistory(ctx, m.ID)
+		},
+		"DeleteAcademicHistory": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(DeleteAcademicHistoryRequest).CandidateID)
+		},
+
+		"CreateJobHistory": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateJobHistoryRequest).JobHistory
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return candidate(m.CandidateID)
+		},
+		"GetJobHistory": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return jobHistory(ctx, request.(GetJobHistoryRequest).ID)
+		},
+		"UpdateJobHistory": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateJobHistoryRequest).JobHistory
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return jobHistory(ctx, m.ID)
+		},
+		"DeleteJobHistory": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(DeleteJobHistoryRequest).CandidateID)
+		},
+
+		"GetMatchingJobPosts": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(GetMatchingJobPostsRequest).CandidateID)
+		},
+		"GetMatchingCandidates": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			jp, err := r.GetJobPostByID(ctx, request.(GetMatchingCandidatesRequest).JobPostID)
+			if err != nil || jp == nil {
+				return nil, err
+			}
+			return []rbac.Owner{{CompanyID: jp.CompanyID}}, nil
+		},
+
+		"CreateShortlist": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateShortlistRequest).Shortlist
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return user(m.UserID)
+		},
+		"GetShortlistByID": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return shortlist(ctx, request.(GetShortlistByIDRequest).ID, true)
+		},
+		"UpdateShortlist": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateShortlistRequest).Shortlist
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return shortlist(ctx, m.ID, false)
+		},
+		"DeleteShortlist": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return shortlist(ctx, request.(DeleteShortlistRequest).ID, false)
+		},
+		"AddShortlistCandidate": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(AddShortlistCandidateRequest).ShortlistCandidate
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return shortlist(ctx, m.ShortlistID, false)
+		},
+		"RemoveShortlistCandidate": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return shortlist(ctx, request.(RemoveShortlistCandidateRequest).ShortlistID, false)
+		},
+		"ShareShortlist": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return shortlist(ctx, request.(ShareShortlistRequest).ShortlistID, false)
+		},
+		"UnshareShortlist": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return shortlist(ctx, request.(UnshareShortlistRequest).ShortlistID, false)
+		},
+		"GrantContactConsent": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(GrantContactConsentRequest).CandidateID)
+		},
+		"GetAllContactConsents": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(GetAllContactConsentsRequest).CandidateID)
+		},
+		"RevokeContactConsent": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(RevokeContactConsentRequest).CandidateID)
+		},
+	}
+}
+
+// MakeLocationOwners returns the owners of the resources of the location requests that are authorized by ownership.
+// Addresses that do not exist cannot be read or changed by anyone
+func MakeLocationOwners(r interfaces.LocationRepository) rbac.Owners {
+	address := func(ctx context.Context, id uint64) ([]rbac.Owner, error) {
+		a, err := r.GetAddress(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if a == nil {
+			return nil, rbac.ErrForbidden
+		}
+		return []rbac.Owner{{CandidateID: a.CandidateID}}, nil
+	}
+
+	return rbac.Owners{
+		"CreateAddress": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateAddressRequest).Address
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return []rbac.Owner{{CandidateID: m.CandidateID}}, nil
+		},
+		"GetAddress": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return address(ctx, request.(GetAddressRequest).ID)
+		},
+		"UpdateAddress": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateAddressRequest).Address
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return address(ctx, m.ID)
+		},
+		"DeleteAddress": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return address(ctx, request.(DeleteAddressRequest).ID)
+		},
+	}
+}
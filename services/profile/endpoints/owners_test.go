@@ -0,0 +1,88 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"in-backend/rbac"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+)
+
+func TestPolicy(t *testing.T) {
+	policy, err := rbac.LoadPolicy(rbac.FileName)
+	require.NoError(t, err)
+
+	// every endpoint has a rule, and every rule by ownership has the owners of its requests
+	eps := MakeEndpoints(svc)
+	assert.NoError(t, rbac.NewAuthorizer(policy, nil, MakeOwners(&mocks.Repository{})).Wrap(&eps))
+	locationEps := MakeLocationEndpoints(&mocks.LocationService{})
+	assert.NoError(t, rbac.NewAuthorizer(policy, nil, MakeLocationOwners(&mocks.LocationRepository{})).Wrap(&locationEps))
+}
+
+func TestMakeOwners(t *testing.T) {
+	r := &mocks.Repository{}
+	r.On("GetAcademicHistory", mockCtx, uint64(1)).Return(&models.AcademicHistory{ID: 1, CandidateID: 5}, nil)
+	r.On("GetAcademicHistory", mockCtx, uint64(2)).Return(nil, nil)
+	r.On("GetJobPostByID", mockCtx, uint64(1)).Return(&models.JobPost{ID: 1, CompanyID: 10}, nil)
+	r.On("GetJobPostByID", mockCtx, uint64(2)).Return(nil, nil)
+	owners := MakeOwners(r)
+
+	var tests = []struct {
+		name    string
+		method  string
+		request interface{}
+		want    []rbac.Owner
+		err     error
+	}{
+		{"user", "DeleteUser", DeleteUserRequest{ID: 1}, []rbac.Owner{{UserID: 1}}, nil},
+		{"candidate", "DeleteCandidate", DeleteCandidateRequest{ID: 5}, []rbac.Owner{{CandidateID: 5}}, nil},
+		{"nil candidate", "UpdateCandidate", UpdateCandidateRequest{}, nil, rbac.ErrForbidden},
+		{"academic history", "GetAcademicHistory", GetAcademicHistoryRequest{ID: 1}, []rbac.Owner{{CandidateID: 5}}, nil},
+		{"academic history not found", "GetAcademicHistory", GetAcademicHistoryRequest{ID: 2}, nil, rbac.ErrForbidden},
+		{"job post", "GetMatchingCandidates", GetMatchingCandidatesRequest{JobPostID: 1}, []rbac.Owner{{CompanyID: 10}}, nil},
+		{"job post not found", "GetMatchingCandidates", GetMatchingCandidatesRequest{JobPostID: 2}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := owners[tt.method](ctx, tt.request)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMakeOwnersShortlist(t *testing.T) {
+	r := &mocks.Repository{}
+	r.On("GetShortlistByID", mockCtx, uint64(1)).Return(&models.Shortlist{ID: 1, UserID: 3, Shares: []*models.ShortlistShare{{UserID: 7}}}, nil)
+	r.On("GetShortlistByID", mockCtx, uint64(2)).Return(nil, nil)
+	owners := MakeOwners(r)
+	shared := rbac.NewContext(ctx, &rbac.Subject{ID: 7})
+
+	var tests = []struct {
+		name    string
+		ctx     context.Context
+		method  string
+		request interface{}
+		want    []rbac.Owner
+		err     error
+	}{
+		{"recruiter", ctx, "GetShortlistByID", GetShortlistByIDRequest{ID: 1}, []rbac.Owner{{UserID: 3}}, nil},
+		{"shared read", shared, "GetShortlistByID", GetShortlistByIDRequest{ID: 1}, []rbac.Owner{{UserID: 7}}, nil},
+		{"shared write", shared, "DeleteShortlist", DeleteShortlistRequest{ID: 1}, []rbac.Owner{{UserID: 3}}, nil},
+		{"not found", ctx, "GetShortlistByID", GetShortlistByIDRequest{ID: 2}, nil, rbac.ErrForbidden},
+		{"create", ctx, "CreateShortlist", CreateShortlistRequest{Shortlist: &models.Shortlist{UserID: 3}}, []rbac.Owner{{UserID: 3}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := owners[tt.method](tt.ctx, tt.request)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package export
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/resume"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newUser() *models.User {
+	date := func(y int, m time.Month) *time.Time {
+		t := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	updatedAt := time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)
+	return &models.User{
+		ID:            1,
+		FirstName:     "Jane",
+		LastName:      "Tan",
+		Email:         "jane@example.com",
+		ContactNumber: "+6591234567",
+		UpdatedAt:     &updatedAt,
+		Candidate: &models.Candidate{
+			ID:                     1,
+			ResidenceCity:          "Singapore",
+			ExpectedSalaryCurrency: "SGD",
+			ExpectedSalary:         8000,
+			LinkedInURL:            "https://www.linkedin.com/in/janetan/",
+			SCMURL:                 "https://github.com/janetan",
+			Summary:                "Backend engineer (payments)",
+			Skills:                 []*models.Skill{{ID: 1, Name: "Go"}, {ID: 2, Name: "PostgreSQL"}},
+			Jobs: []*models.JobHistory{
+				{
+					Company:        &models.Company{Name: "Globex Corporation"},
+					Country:        "Singapore",
+					Title:          "Software Developer",
+					StartDate:      date(2016, time.March),
+					EndDate:        date(2018, time.December),
+					SalaryCurrency: "SGD",
+					Salary:         5000,
+				},
+				{
+					Company:     &models.Company{Name: "Acme Pte Ltd"},
+					Country:     "Singapore",
+					City:        "Singapore",
+					Title:       "Senior Software Engineer",
+					StartDate:   date(2019, time.January),
+					Description: "Built payment services",
+				},
+			},
+			Academics: []*models.AcademicHistory{
+				{
+					Institution:  &models.Institution{Name: "National University of Singapore"},
+					Course:       &models.Course{Name: "Bachelor of Computing", Level: "bachelor"},
+					YearObtained: 2016,
+					Grade:        "First Class Honours",
+				},
+			},
+		},
+	}
+}
+
+func TestJSONResume(t *testing.T) {
+	full := &Resume{
+		Schema: Schema,
+		Basics: &Basics{
+			Name:     "Jane Tan",
+			Label:    "Senior Software Engineer",
+			Email:    "jane@example.com",
+			Phone:    "+6591234567",
+			Summary:  "Backend engineer (payments)",
+			Location: &Location{City: "Singapore"},
+			Profiles: []*Profile{
+				{Network: "LinkedIn", Username: "janetan", URL: "https://www.linkedin.com/in/janetan/"},
+				{Network: "GitHub", Username: "janetan", URL: "https://github.com/janetan"},
+			},
+			ExpectedSalary: &Salary{Currency: "SGD", Amount: 8000},
+		},
+		Work: []*Work{
+			{
+				Name:      "Acme Pte Ltd",
+				Position:  "Senior Software Engineer",
+				Location:  "Singapore, Singapore",
+				StartDate: "2019-01-01",
+				Summary:   "Built payment services",
+			},
+			{
+				Name:      "Globex Corporation",
+				Position:  "Software Developer",
+				Location:  "Singapore",
+				StartDate: "2016-03-01",
+				EndDate:   "2018-12-01",
+				Salary:    &Salary{Currency: "SGD", Amount: 5000},
+			},
+		},
+		Education: []*Education{
+			{
+				Institution: "National University of Singapore",
+				Area:        "Bachelor of Computing",
+				StudyType:   "bachelor",
+				EndDate:     "2016",
+				Score:       "First Class Honours",
+			},
+		},
+		Skills: []*Skill{{Name: "Go"}, {Name: "PostgreSQL"}},
+		Meta:   &Meta{Version: "v1.0.0", LastModified: "2020-12-01T10:00:00Z"},
+	}
+
+	masked := *full
+	maskedBasics := *full.Basics
+	maskedBasics.Email, maskedBasics.Phone, maskedBasics.ExpectedSalary = "", "", nil
+	masked.Basics = &maskedBasics
+	masked.Work = []*Work{full.Work[0], func() *Work {
+		w := *full.Work[1]
+		w.Salary = nil
+		return &w
+	}()}
+
+	var tests = []struct {
+		name string
+		user *models.User
+		opts models.ExportOptions
+		want *Resume
+	}{
+		{"full", newUser(), models.ExportOptions{}, full},
+		{"masked", newUser(), models.ExportOptions{HideEmail: true, HideContactNumber: true, HideSalary: true}, &masked},
+		{"without candidate", &models.User{FirstName: "Jane"}, models.ExportOptions{}, &Resume{
+			Schema: Schema,
+			Basics: &Basics{Name: "Jane"},
+			Meta:   &Meta{Version: "v1.0.0"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, JSONResume(tt.user, tt.opts))
+		})
+	}
+}
+
+func TestPDF(t *testing.T) {
+	u := newUser()
+	// a long description is wrapped and continues on a second page
+	u.Candidate.Jobs[1].Description = strings.Repeat("Built payment services in Go for banks. ", 400)
+
+	doc := PDF(JSONResume(u, models.ExportOptions{HideContactNumber: true, HideSalary: true}))
+
+	// every offset of the cross reference table points to its object
+	xref := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllSubmatch(doc, -1)
+	require.NotEmpty(t, xref)
+	for i, m := range xref {
+		off, _ := strconv.Atoi(string(m[1]))
+		require.True(t, bytes.HasPrefix(doc[off:], []byte(strconv.Itoa(i+1)+" 0 obj")))
+	}
+	require.Regexp(t, `/Count [2-9]`, string(doc))
+
+	text, err := resume.ExtractText(doc)
+	require.NoError(t, err)
+	require.Contains(t, text, "Jane Tan")
+	require.Contains(t, text, "jane@example.com")
+	require.Contains(t, text, "Backend engineer (payments)")
+	require.Contains(t, text, "Senior Software Engineer, Acme Pte Ltd")
+	require.Contains(t, text, "Jan 2019 - Present")
+	require.Contains(t, text, "Bachelor of Computing (bachelor), National University of Singapore")
+	require.Contains(t, text, "Go, PostgreSQL")
+	require.NotContains(t, text, "+6591234567")
+	require.NotContains(t, text, "8000")
+	require.NotContains(t, text, "5000")
+}
+
+func TestEncode(t *testing.T) {
+	require.Equal(t, "Caf\xe9 \x96 \x95 ?", encode("Café – • 日"))
+	require.Equal(t, `\(a\\b\)`, escape(`(a\b)`))
+}
@@ -0,0 +1,233 @@
+package export
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"in-backend/services/profile/models"
+)
+
+// Schema is the version of the JSON Resume schema that profiles are exported in
+const Schema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// dateLayout is the ISO 8601 layout of dates in the JSON Resume schema
+const dateLayout = "2006-01-02"
+
+// Resume declares a profile in the JSON Resume schema.
+// Salaries are not part of the schema, so they are added as extensions that readers of the schema ignore
+type Resume struct {
+	Schema    string       `json:"$schema"`
+	Basics    *Basics      `json:"basics"`
+	Work      []*Work      `json:"work,omitempty"`
+	Education []*Education `json:"education,omitempty"`
+	Skills    []*Skill     `json:"skills,omitempty"`
+	Meta      *Meta        `json:"meta,omitempty"`
+}
+
+// Basics declares the basic details of a JSON Resume
+type Basics struct {
+	Name           string     `json:"name"`
+	Label          string     `json:"label,omitempty"`
+	Image          string     `json:"image,omitempty"`
+	Email          string     `json:"email,omitempty"`
+	Phone          string     `json:"phone,omitempty"`
+	URL            string     `json:"url,omitempty"`
+	Summary        string     `json:"summary,omitempty"`
+	Location       *Location  `json:"location,omitempty"`
+	Profiles       []*Profile `json:"profiles,omitempty"`
+	ExpectedSalary *Salary    `json:"expectedSalary,omitempty"`
+}
+
+// Location declares the location of a JSON Resume
+type Location struct {
+	City string `json:"city,omitempty"`
+}
+
+// Profile declares a social network profile of a JSON Resume
+type Profile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+// Salary declares a salary, which is an extension of the JSON Resume schema
+type Salary struct {
+	Currency string `json:"currency,omitempty"`
+	Amount   uint32 `json:"amount"`
+}
+
+// Work declares a job of a JSON Resume
+type Work struct {
+	Name      string  `json:"name"`
+	Position  string  `json:"position"`
+	Location  string  `json:"location,omitempty"`
+	StartDate string  `json:"startDate,omitempty"`
+	EndDate   string  `json:"endDate,omitempty"`
+	Summary   string  `json:"summary,omitempty"`
+	Salary    *Salary `json:"salary,omitempty"`
+}
+
+// Education declares an academic history of a JSON Resume
+type Education struct {
+	Institution string `json:"institution"`
+	Area        string `json:"area,omitempty"`
+	StudyType   string `json:"studyType,omitempty"`
+	EndDate     string `json:"endDate,omitempty"`
+	Score       string `json:"score,omitempty"`
+}
+
+// Skill declares a skill of a JSON Resume
+type Skill struct {
+	Name string `json:"name"`
+}
+
+// Meta declares the metadata of a JSON Resume
+type Meta struct {
+	Version      string `json:"version"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// JSONResume maps a User and their Candidate profile to the JSON Resume schema, masking the fields hidden by the options.
+// Jobs and academic histories are ordered from the most recent
+func JSONResume(u *models.User, o models.ExportOptions) *Resume {
+	r := &Resume{
+		Schema: Schema,
+		Basics: &Basics{
+			Name:  strings.TrimSpace(u.FirstName + " " + u.LastName),
+			Image: u.Picture,
+			Email: u.Email,
+			Phone: u.ContactNumber,
+		},
+		Meta: &Meta{Version: "v1.0.0"},
+	}
+	if o.HideEmail {
+		r.Basics.Email = ""
+	}
+	if o.HideContactNumber {
+		r.Basics.Phone = ""
+	}
+	if u.UpdatedAt != nil {
+		r.Meta.LastModified = u.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	c := u.Candidate
+	if c == nil {
+		return r
+	}
+
+	r.Basics.URL = c.WebsiteURL
+	r.Basics.Summary = c.Summary
+	if c.ResidenceCity != "" {
+		r.Basics.Location = &Location{City: c.ResidenceCity}
+	}
+	for _, p := range []string{c.LinkedInURL, c.SCMURL} {
+		if p != "" {
+			r.Basics.Profiles = append(r.Basics.Profiles, newProfile(p))
+		}
+	}
+	if c.ExpectedSalary > 0 && !o.HideSalary {
+		r.Basics.ExpectedSalary = &Salary{Currency: c.ExpectedSalaryCurrency, Amount: c.ExpectedSalary}
+	}
+
+	jobs := append([]*models.JobHistory{}, c.Jobs...)
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return timeOf(jobs[i].StartDate).After(timeOf(jobs[j].StartDate))
+	})
+	for _, j := range jobs {
+		w := &Work{
+			Position:  j.Title,
+			Location:  joinNonEmpty(", ", j.City, j.Country),
+			StartDate: formatDate(j.StartDate),
+			EndDate:   formatDate(j.EndDate),
+			Summary:   j.Description,
+		}
+		if j.Company != nil {
+			w.Name = j.Company.Name
+		}
+		if j.Salary > 0 && !o.HideSalary {
+			w.Salary = &Salary{Currency: j.SalaryCurrency, Amount: j.Salary}
+		}
+		r.Work = append(r.Work, w)
+	}
+	if len(r.Work) > 0 && r.Work[0].EndDate == "" {
+		r.Basics.Label = r.Work[0].Position
+	}
+
+	academics := append([]*models.AcademicHistory{}, c.Academics...)
+	sort.SliceStable(academics, func(i, j int) bool {
+		return academics[i].YearObtained > academics[j].YearObtained
+	})
+	for _, a := range academics {
+		e := &Education{Score: a.Grade}
+		if a.Institution != nil {
+			e.Institution = a.Institution.Name
+		}
+		if a.Course != nil {
+			e.Area = a.Course.Name
+			e.StudyType = a.Course.Level
+		}
+		if a.YearObtained > 0 {
+			e.EndDate = strconv.FormatUint(uint64(a.YearObtained), 10)
+		}
+		r.Education = append(r.Education, e)
+	}
+
+	for _, s := range c.Skills {
+		r.Skills = append(r.Skills, &Skill{Name: s.Name})
+	}
+	return r
+}
+
+// newProfile returns the Profile of a social network URL, named after its host
+func newProfile(raw string) *Profile {
+	p := &Profile{URL: raw}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return p
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch {
+	case strings.HasSuffix(host, "linkedin.com"):
+		p.Network = "LinkedIn"
+	case host == "github.com":
+		p.Network = "GitHub"
+	case host == "gitlab.com":
+		p.Network = "GitLab"
+	case host == "bitbucket.org":
+		p.Network = "Bitbucket"
+	default:
+		p.Network = host
+	}
+	if parts := strings.Split(strings.Trim(u.Path, "/"), "/"); parts[len(parts)-1] != "" {
+		p.Username = parts[len(parts)-1]
+	}
+	return p
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
+
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func joinNonEmpty(sep string, ss ...string) string {
+	var out []string
+	for _, s := range ss {
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return strings.Join(out, sep)
+}
@@ -0,0 +1,278 @@
+package export
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A4 page size and margins in points
+const (
+	pageWidth  = 595
+	pageHeight = 842
+	margin     = 50
+)
+
+// fonts of the PDF are the standard Helvetica fonts, which viewers provide so that they need not be embedded
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+)
+
+// helveticaWidths are the widths of the printable ASCII characters of Helvetica in thousandths of the font size
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// helveticaBoldWidths are the widths of the printable ASCII characters of Helvetica-Bold
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
+
+// winAnsi maps the characters outside of Latin-1 that WinAnsiEncoding supports
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// pdfWriter lays out lines of text on pages
+type pdfWriter struct {
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	y     float64
+}
+
+// PDF renders a JSON Resume as a PDF document
+func PDF(r *Resume) []byte {
+	w := &pdfWriter{}
+	w.newPage()
+
+	b := r.Basics
+	w.text(b.Name, fontBold, 20, 0)
+	w.text(b.Label, fontRegular, 12, 0)
+	contacts := []string{b.Email, b.Phone, b.URL}
+	if b.Location != nil {
+		contacts = append(contacts, b.Location.City)
+	}
+	for _, p := range b.Profiles {
+		contacts = append(contacts, p.URL)
+	}
+	w.paragraph(joinNonEmpty("  |  ", contacts...), fontRegular, 9, 0)
+	if b.ExpectedSalary != nil {
+		w.text("Expected salary: "+formatSalary(b.ExpectedSalary), fontRegular, 9, 0)
+	}
+
+	if b.Summary != "" {
+		w.heading("Summary")
+		w.paragraph(b.Summary, fontRegular, 10, 0)
+	}
+
+	if len(r.Work) > 0 {
+		w.heading("Experience")
+		for _, j := range r.Work {
+			w.space(6)
+			w.paragraph(joinNonEmpty(", ", j.Position, j.Name), fontBold, 11, 0)
+			period := formatPeriod(j.StartDate, j.EndDate)
+			w.text(joinNonEmpty("  |  ", period, j.Location), fontRegular, 9, 0)
+			if j.Salary != nil {
+				w.text("Salary: "+formatSalary(j.Salary), fontRegular, 9, 0)
+			}
+			w.paragraph(j.Summary, fontRegular, 10, 0)
+		}
+	}
+
+	if len(r.Education) > 0 {
+		w.heading("Education")
+		for _, e := range r.Education {
+			w.space(6)
+			course := e.Area
+			if e.StudyType != "" && course != "" {
+				course += " (" + e.StudyType + ")"
+			}
+			w.paragraph(joinNonEmpty(", ", course, e.Institution), fontBold, 11, 0)
+			score := ""
+			if e.Score != "" {
+				score = "Grade: " + e.Score
+			}
+			w.text(joinNonEmpty("  |  ", e.EndDate, score), fontRegular, 9, 0)
+		}
+	}
+
+	if len(r.Skills) > 0 {
+		w.heading("Skills")
+		names := make([]string, len(r.Skills))
+		for i, s := range r.Skills {
+			names[i] = s.Name
+		}
+		w.paragraph(strings.Join(names, ", "), fontRegular, 10, 0)
+	}
+
+	return w.document(b.Name)
+}
+
+func (w *pdfWriter) newPage() {
+	w.page = &bytes.Buffer{}
+	w.pages = append(w.pages, w.page)
+	w.y = pageHeight - margin
+}
+
+func (w *pdfWriter) space(h float64) {
+	w.y -= h
+}
+
+func (w *pdfWriter) heading(s string) {
+	w.space(12)
+	w.text(s, fontBold, 13, 0)
+	w.page.WriteString(fmt.Sprintf("0.5 w %d %.2f m %d %.2f l S\n", margin, w.y+2, pageWidth-margin, w.y+2))
+	w.space(4)
+}
+
+// text writes a single line, starting a new page when the line does not fit on the current one
+func (w *pdfWriter) text(s, font string, size, indent float64) {
+	if s == "" {
+		return
+	}
+	leading := size * 1.3
+	if w.y-leading < margin {
+		w.newPage()
+	}
+	w.y -= leading
+	fmt.Fprintf(w.page, "BT /%s %g Tf %.2f %.2f Td (%s) Tj ET\n", font, size, margin+indent, w.y, escape(encode(s)))
+}
+
+// paragraph writes text wrapped to the width of the page, keeping its line breaks
+func (w *pdfWriter) paragraph(s, font string, size, indent float64) {
+	max := pageWidth - 2*margin - indent
+	for _, l := range strings.Split(s, "\n") {
+		line := ""
+		for _, word := range strings.Fields(l) {
+			next := strings.TrimSpace(line + " " + word)
+			if line != "" && textWidth(next, font, size) > max {
+				w.text(line, font, size, indent)
+				next = word
+			}
+			line = next
+		}
+		w.text(line, font, size, indent)
+	}
+}
+
+// document returns the PDF document of the pages, with offsets in its cross reference table
+func (w *pdfWriter) document(title string) []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // the page tree is written once the numbers of the pages are known
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (HubbedIn) >>", escape(encode(title))),
+	}
+
+	var kids []string
+	for _, p := range w.pages {
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		zw.Write(p.Bytes())
+		zw.Close()
+
+		content := len(objects) + 2
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, fontRegular, fontBold, content),
+			fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", z.Len(), z.String()),
+		)
+		kids = append(kids, strconv.Itoa(content-1)+" 0 R")
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, o := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes()
+}
+
+// textWidth returns the width of text in points
+func textWidth(s, font string, size float64) float64 {
+	widths := &helveticaWidths
+	if font == fontBold {
+		widths = &helveticaBoldWidths
+	}
+	total := 0
+	for _, c := range []byte(encode(s)) {
+		if c >= 32 && c < 127 {
+			total += widths[c-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// encode converts text to WinAnsiEncoding, replacing characters it does not support
+func encode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\t':
+			b.WriteByte(' ')
+		case r < 32:
+		case r < 127 || r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		case winAnsi[r] != 0:
+			b.WriteByte(winAnsi[r])
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// escape escapes the delimiters of a PDF literal string
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
+
+// formatPeriod formats the start and end dates of a job such as Jan 2019 - Present
+func formatPeriod(start, end string) string {
+	if start == "" {
+		return ""
+	}
+	return formatMonth(start) + " - " + formatMonth(end)
+}
+
+func formatMonth(d string) string {
+	if d == "" {
+		return "Present"
+	}
+	t, err := time.Parse(dateLayout, d)
+	if err != nil {
+		return d
+	}
+	return t.Format("Jan 2006")
+}
+
+func formatSalary(s *Salary) string {
+	return strings.TrimSpace(s.Currency + " " + strconv.FormatUint(uint64(s.Amount), 10))
+}
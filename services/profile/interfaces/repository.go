@@ -2,7 +2,9 @@ package interfaces
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/profile/models"
+	"time"
 
 	"github.com/go-pg/pg/v10"
 )
@@ -32,7 +34,7 @@ type Repository interface {
 	CreateCandidate(ctx context.Context, tx *pg.Tx, c *models.Candidate) (*models.Candidate, error)
 
 	// GetAllCandidates returns all candidates
-	GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, error)
+	GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, *pagination.Info, error)
 
 	// GetCandidateByID finds and returns a candidate by ID
 	GetCandidateByID(ctx context.Context, id uint64) (*models.User, error)
@@ -133,4 +135,141 @@ type Repository interface {
 
 	// DeleteJobHistory deletes a JobHistory by ID
 	DeleteJobHistory(ctx context.Context, cid, jhid uint64) error
+
+	/* --------------- Matching --------------- */
+
+	// GetAllJobPosts returns a page of open JobPosts from the joblisting service
+	GetAllJobPosts(ctx context.Context, p pagination.Params) ([]*models.JobPost, *pagination.Info, error)
+
+	// GetMatchCandidates returns a page of the candidates that have any of the skills sids
+	GetMatchCandidates(ctx context.Context, sids []uint64, p pagination.Params) ([]*models.User, *pagination.Info, error)
+
+	// GetJobPostByID returns a JobPost by ID from the joblisting service
+	GetJobPostByID(ctx context.Context, id uint64) (*models.JobPost, error)
+
+	// GetAssessmentScores returns the scores of completed assessments of the Candidates of Users by User ID
+	GetAssessmentScores(ctx context.Context, uids []uint64) ([]*models.AssessmentScore, error)
+
+	/* --------------- Shortlist --------------- */
+
+	// CreateShortlist creates a new Shortlist
+	CreateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error)
+
+	// GetAllShortlists returns all Shortlists that match the filters
+	GetAllShortlists(ctx context.Context, f models.ShortlistFilters) ([]*models.Shortlist, error)
+
+	// GetShortlistByID returns a Shortlist by ID
+	GetShortlistByID(ctx context.Context, id uint64) (*models.Shortlist, error)
+
+	// UpdateShortlist updates a Shortlist
+	UpdateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error)
+
+	// DeleteShortlist deletes a Shortlist by ID
+	DeleteShortlist(ctx context.Context, id uint64) error
+
+	// CreateShortlistCandidate adds a Candidate to a Shortlist, or updates the notes of a Candidate already in it
+	CreateShortlistCandidate(ctx context.Context, m *models.ShortlistCandidate) (*models.ShortlistCandidate, error)
+
+	// DeleteShortlistCandidate removes a Candidate from a Shortlist
+	DeleteShortlistCandidate(ctx context.Context, sid, cid uint64) error
+
+	// CreateShortlistShare shares a Shortlist with a User
+	CreateShortlistShare(ctx context.Context, m *models.ShortlistShare) (*models.ShortlistShare, error)
+
+	// DeleteShortlistShare stops sharing a Shortlist with a User
+	DeleteShortlistShare(ctx context.Context, sid, uid uint64) error
+
+	/* --------------- Contact Consent --------------- */
+
+	// CreateContactConsent records the consent of a Candidate to show their personal details to a Company
+	CreateContactConsent(ctx context.Context, m *models.ContactConsent) (*models.ContactConsent, error)
+
+	// GetAllContactConsents returns all ContactConsents that match the filters
+	GetAllContactConsents(ctx context.Context, f models.ContactConsentFilters) ([]*models.ContactConsent, error)
+
+	// DeleteContactConsent withdraws the consent of a Candidate to show their personal details to a Company
+	DeleteContactConsent(ctx context.Context, cid, coid uint64) error
+
+	/* --------------- Personal Data --------------- */
+
+	// GetPersonalData returns the data about a User that is kept by the profile service
+	GetPersonalData(ctx context.Context, id uint64) (*models.PersonalData, error)
+
+	// ExportCandidateData returns the data about a Candidate that is kept by the other services, as JSON by service.
+	// The other services keep the data by the ID of the User uid or by the Candidate ID cid
+	ExportCandidateData(ctx context.Context, uid, cid uint64) (map[string][]byte, error)
+
+	// GetUserWithDeleted returns a User by ID without relations, including a User that was soft deleted
+	GetUserWithDeleted(ctx context.Context, id uint64) (*models.User, error)
+
+	// EraseCandidateData erases the data about a Candidate that is kept by the other services,
+	// and returns the number of records erased by service.
+	// The other services keep the data by the ID of the User uid or by the Candidate ID cid
+	EraseCandidateData(ctx context.Context, uid, cid uint64) (map[string]uint64, error)
+
+	// EraseUser hard deletes a User and their Candidate profile, and records the ErasureReceipt
+	// and the deletion of the User from third parties in the same transaction
+	EraseUser(ctx context.Context, u *models.User, receipt *models.ErasureReceipt) (*models.ErasureReceipt, error)
+
+	// GetErasureReceipt returns the ErasureReceipt of an erased User
+	GetErasureReceipt(ctx context.Context, uid uint64) (*models.ErasureReceipt, error)
+
+	// GetApplicantIDs returns the IDs of the Candidates that applied to a Company in the joblisting service
+	GetApplicantIDs(ctx context.Context, coid uint64, cids []uint64) ([]uint64, error)
+}
+
+// LocationRepository declares the repository for locations
+type LocationRepository interface {
+	/* --------------- Region --------------- */
+
+	// GetAllRegions returns all Regions
+	GetAllRegions(ctx context.Context) ([]*models.Region, error)
+
+	/* --------------- Country --------------- */
+
+	// GetAllCountries returns all Countries
+	GetAllCountries(ctx context.Context, f models.CountryFilters) ([]*models.Country, error)
+
+	// SeedCountries creates or updates Countries and their Regions by ISO code
+	SeedCountries(ctx context.Context, m []*models.Country) error
+
+	/* --------------- State --------------- */
+
+	// CreateState creates a new State
+	CreateState(ctx context.Context, m *models.State) (*models.State, error)
+
+	// GetAllStates returns all States
+	GetAllStates(ctx context.Context, f models.StateFilters) ([]*models.State, error)
+
+	/* --------------- City --------------- */
+
+	// CreateCity creates a new City
+	CreateCity(ctx context.Context, m *models.City) (*models.City, error)
+
+	// GetAllCities returns all Cities
+	GetAllCities(ctx context.Context, f models.CityFilters) ([]*models.City, error)
+
+	/* --------------- Address --------------- */
+
+	// CreateAddress creates a new Address
+	CreateAddress(ctx context.Context, m *models.Address) (*models.Address, error)
+
+	// GetAddress returns an Address by ID
+	GetAddress(ctx context.Context, id uint64) (*models.Address, error)
+
+	// UpdateAddress updates an Address
+	UpdateAddress(ctx context.Context, m *models.Address) (*models.Address, error)
+
+	// DeleteAddress deletes an Address by ID
+	DeleteAddress(ctx context.Context, id uint64) error
+}
+
+// OutboxRepository declares the repository for outbox messages
+type OutboxRepository interface {
+	// ClaimOutboxMessages returns up to limit Pending OutboxMessages that are due,
+	// and delays them by lease so that they are not claimed again while being delivered
+	ClaimOutboxMessages(ctx context.Context, limit int, lease time.Duration) ([]*models.OutboxMessage, error)
+
+	// UpdateOutboxMessage records the outcome of delivering an OutboxMessage
+	UpdateOutboxMessage(ctx context.Context, m *models.OutboxMessage) error
 }
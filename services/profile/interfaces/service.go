@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/profile/models"
 )
 
@@ -27,17 +28,28 @@ type Service interface {
 	CreateCandidate(ctx context.Context, c *models.Candidate) (*models.Candidate, error)
 
 	// GetAllCandidates returns all candidates
-	GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, error)
+	GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, *pagination.Info, error)
 
 	// GetCandidateByID finds and returns a candidate by ID
 	GetCandidateByID(ctx context.Context, id uint64) (*models.User, error)
 
+	// LocalGetSCMLogins returns the logins of the git host accounts linked to the identity provider account of a user,
+	// keyed by the host of the git host
+	// This method is only for local server to server communication
+	LocalGetSCMLogins(ctx context.Context, uid uint64) (map[string]string, error)
+
 	// UpdateCandidate updates a candidate
 	UpdateCandidate(ctx context.Context, c *models.Candidate) (*models.Candidate, error)
 
 	// DeleteCandidate deletes a candidate by ID
 	DeleteCandidate(ctx context.Context, id uint64) error
 
+	// ImportResume parses a PDF or DOCX resume into a draft candidate profile that is not persisted
+	ImportResume(ctx context.Context, cid uint64, doc []byte) (*models.Candidate, error)
+
+	// ExportCandidateProfile renders a candidate profile as a JSON Resume or a PDF document
+	ExportCandidateProfile(ctx context.Context, id uint64, o models.ExportOptions) (*models.ProfileExport, error)
+
 	/* --------------- Skill --------------- */
 
 	// CreateSkill creates a new Skill
@@ -128,4 +140,113 @@ type Service interface {
 
 	// DeleteJobHistory deletes a JobHistory by ID
 	DeleteJobHistory(ctx context.Context, cid, jhid uint64) error
+
+	/* --------------- Matching --------------- */
+
+	// GetMatchingJobPosts ranks JobPosts by how well they match a Candidate
+	GetMatchingJobPosts(ctx context.Context, cid uint64, f models.MatchFilters) ([]*models.Match, error)
+
+	// GetMatchingCandidates ranks Candidates by how well they match a JobPost
+	GetMatchingCandidates(ctx context.Context, jpid uint64, f models.MatchFilters) ([]*models.Match, error)
+
+	/* --------------- Shortlist --------------- */
+
+	// CreateShortlist creates a new Shortlist
+	CreateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error)
+
+	// GetAllShortlists returns all Shortlists that match the filters
+	GetAllShortlists(ctx context.Context, f models.ShortlistFilters) ([]*models.Shortlist, error)
+
+	// GetShortlistByID returns a Shortlist by ID with the profiles of its Candidates
+	GetShortlistByID(ctx context.Context, id uint64) (*models.Shortlist, error)
+
+	// UpdateShortlist updates a Shortlist
+	UpdateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error)
+
+	// DeleteShortlist deletes a Shortlist by ID
+	DeleteShortlist(ctx context.Context, id uint64) error
+
+	// AddShortlistCandidate adds a Candidate with notes to a Shortlist
+	AddShortlistCandidate(ctx context.Context, m *models.ShortlistCandidate) (*models.ShortlistCandidate, error)
+
+	// RemoveShortlistCandidate removes a Candidate from a Shortlist
+	RemoveShortlistCandidate(ctx context.Context, sid, cid uint64) error
+
+	// ShareShortlist shares a Shortlist with a company user
+	ShareShortlist(ctx context.Context, sid, uid uint64) (*models.ShortlistShare, error)
+
+	// UnshareShortlist stops sharing a Shortlist with a company user
+	UnshareShortlist(ctx context.Context, sid, uid uint64) error
+
+	/* --------------- Contact Consent --------------- */
+
+	// GrantContactConsent lets the users of a Company see the personal details of a Candidate
+	GrantContactConsent(ctx context.Context, m *models.ContactConsent) (*models.ContactConsent, error)
+
+	// GetAllContactConsents returns all ContactConsents that match the filters
+	GetAllContactConsents(ctx context.Context, f models.ContactConsentFilters) ([]*models.ContactConsent, error)
+
+	// RevokeContactConsent stops letting the users of a Company see the personal details of a Candidate
+	RevokeContactConsent(ctx context.Context, cid, coid uint64) error
+
+	/* --------------- Personal Data --------------- */
+
+	// ExportMyData returns a zip archive of the data about a User kept by all services
+	ExportMyData(ctx context.Context, id uint64) (*models.ProfileExport, error)
+
+	// EraseUser hard deletes the data about a User kept by all services, queues the deletion
+	// of the User from third parties, and returns the ErasureReceipt
+	EraseUser(ctx context.Context, id uint64) (*models.ErasureReceipt, error)
+
+	// GetErasureReceipt returns the ErasureReceipt of an erased User
+	GetErasureReceipt(ctx context.Context, uid uint64) (*models.ErasureReceipt, error)
+}
+
+// LocationService describes the Location Service
+type LocationService interface {
+	/* --------------- Region --------------- */
+
+	// GetRegions returns all Regions
+	GetRegions(ctx context.Context) ([]*models.Region, error)
+
+	/* --------------- Country --------------- */
+
+	// GetCountries returns all Countries
+	GetCountries(ctx context.Context, f models.CountryFilters) ([]*models.Country, error)
+
+	/* --------------- State --------------- */
+
+	// CreateState creates a new State
+	CreateState(ctx context.Context, m *models.State) (*models.State, error)
+
+	// GetStates returns all States
+	GetStates(ctx context.Context, f models.StateFilters) ([]*models.State, error)
+
+	/* --------------- City --------------- */
+
+	// CreateCity creates a new City
+	CreateCity(ctx context.Context, m *models.City) (*models.City, error)
+
+	// GetCities returns all Cities
+	GetCities(ctx context.Context, f models.CityFilters) ([]*models.City, error)
+
+	/* --------------- Address --------------- */
+
+	// CreateAddress creates a new Address
+	CreateAddress(ctx context.Context, m *models.Address) (*models.Address, error)
+
+	// GetAddress returns an Address by ID
+	GetAddress(ctx context.Context, id uint64) (*models.Address, error)
+
+	// UpdateAddress updates an Address
+	UpdateAddress(ctx context.Context, m *models.Address) (*models.Address, error)
+
+	// DeleteAddress deletes an Address by ID
+	DeleteAddress(ctx context.Context, id uint64) error
+}
+
+// OutboxDispatcher delivers the side effects recorded in the outbox
+type OutboxDispatcher interface {
+	// Dispatch delivers the OutboxMessages that are due, retrying failures with backoff
+	Dispatch(ctx context.Context) error
 }
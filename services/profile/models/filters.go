@@ -1,8 +1,11 @@
 package models
 
+import "in-backend/pagination"
+
 // CandidateFilters define filters for Candidate model
 type CandidateFilters struct {
 	ID              []uint64
+	CandidateID     []uint64
 	FirstName       string
 	LastName        string
 	Email           string
@@ -15,6 +18,8 @@ type CandidateFilters struct {
 	EducationLevel  []string
 	MaxNoticePeriod uint32
 	Roles           []string
+	Query           string
+	Page            pagination.Params
 }
 
 // SkillFilters define filters for Skill model
@@ -63,3 +68,36 @@ type JobHistoryFilters struct {
 	City         []string
 	Title        []string
 }
+
+// ShortlistFilters define filters for Shortlist model.
+// UserID returns the Shortlists of a User and the Shortlists shared with them
+type ShortlistFilters struct {
+	UserID uint64
+}
+
+// ContactConsentFilters define filters for ContactConsent model
+type ContactConsentFilters struct {
+	CandidateID []uint64
+	CompanyID   []uint64
+}
+
+// CountryFilters define filters for Country model
+type CountryFilters struct {
+	RegionID uint64
+}
+
+// StateFilters define filters for State model
+type StateFilters struct {
+	CountryID uint64
+}
+
+// CityFilters define filters for City model
+type CityFilters struct {
+	StateID uint64
+}
+
+// MatchFilters define filters for Match model
+type MatchFilters struct {
+	Limit    uint64
+	MinScore float64
+}
@@ -2,6 +2,11 @@ package models
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/go-pg/pg/v10/orm"
@@ -35,6 +40,7 @@ type User struct {
 	DeletedAt     *time.Time  `json:"deleted_at,omitempty" pg:",soft_delete"`
 	Candidate     *Candidate  `json:"candidate" pg:"rel:has-one"`
 	JobCompany    *JobCompany `json:"job_company" pg:"-"`
+	Rank          float32     `json:"rank,omitempty" pg:"-"`
 }
 
 func (m *User) BeforeInsert(ctx context.Context) (context.Context, error) {
@@ -50,9 +56,43 @@ func (m *User) BeforeUpdate(ctx context.Context) (context.Context, error) {
 	return ctx, nil
 }
 
+// MaskPersonalDetails masks the email and contact number of a User, and removes the birthday and
+// expected salary of their Candidate profile, so that Candidates can be searched and shared without
+// revealing how to reach them. Masked contact details keep enough to be recognized by their owner
+func (m *User) MaskPersonalDetails() {
+	if m == nil {
+		return
+	}
+	m.Email = maskEmail(m.Email)
+	m.ContactNumber = maskContactNumber(m.ContactNumber)
+	if m.Candidate != nil {
+		m.Candidate.Birthday = nil
+		m.Candidate.ExpectedSalary = 0
+		m.Candidate.ExpectedSalaryCurrency = ""
+	}
+}
+
+// maskEmail keeps the first character of the local part and the domain of an email
+func maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 1 {
+		return strings.Repeat("*", len(email))
+	}
+	return email[:1] + strings.Repeat("*", at-1) + email[at:]
+}
+
+// maskContactNumber keeps the last 4 characters of a contact number
+func maskContactNumber(number string) string {
+	const visible = 4
+	if len(number) <= visible {
+		return strings.Repeat("*", len(number))
+	}
+	return strings.Repeat("*", len(number)-visible) + number[len(number)-visible:]
+}
+
 // Candidate declares the model for Candidate
 type Candidate struct {
-	tableName              struct{}           `pg:"candidates,alias:c"`
+	tableName              struct{}           `pg:"candidates,alias:c,discard_unknown_columns"`
 	ID                     uint64             `json:"id"`
 	Nationality            string             `json:"nationality,omitempty"`
 	ResidenceCity          string             `json:"residence_city,omitempty"`
@@ -89,7 +129,7 @@ func (m *Candidate) BeforeUpdate(ctx context.Context) (context.Context, error) {
 
 // Skill declares the model for Skill
 type Skill struct {
-	tableName struct{} `pg:"skills,alias:s"`
+	tableName struct{} `pg:"skills,alias:s,discard_unknown_columns"`
 
 	ID         uint64       `json:"id"`
 	Name       string       `json:"string" pg:",notnull"`
@@ -209,7 +249,7 @@ type CompanyDepartment struct {
 
 // JobHistory declares the model for JobHistory
 type JobHistory struct {
-	tableName struct{} `pg:"job_histories,alias:jh"`
+	tableName struct{} `pg:"job_histories,alias:jh,discard_unknown_columns"`
 
 	ID             uint64      `json:"id"`
 	CandidateID    uint64      `json:"-" pg:",notnull"`
@@ -251,3 +291,367 @@ type JobCompany struct {
 	LogoURL string `json:"logo_url"`
 	Size    uint64 `json:"size"`
 }
+
+// JobPost replicates the fields of JobPost in Joblisting service that are used for matching
+type JobPost struct {
+	ID              uint64   `json:"id"`
+	CompanyID       uint64   `json:"company_id"`
+	Title           string   `json:"title"`
+	YearsExperience uint64   `json:"years_experience"`
+	Function        string   `json:"function"`
+	Location        string   `json:"location"`
+	Remote          bool     `json:"remote"`
+	SalaryCurrency  string   `json:"salary_currency"`
+	MinSalary       uint64   `json:"min_salary"`
+	MaxSalary       uint64   `json:"max_salary"`
+	SkillID         []uint64 `json:"skill_id"`
+}
+
+// AssessmentScore replicates the score of a completed attempt in Assessment service.
+// Attempts are kept by the ID of the User who made them
+type AssessmentScore struct {
+	UserID       uint64 `json:"user_id"`
+	AssessmentID uint64 `json:"assessment_id"`
+	Score        int64  `json:"score"`
+	MaxScore     int64  `json:"max_score"`
+}
+
+const (
+	// MatchFactorSalary is the factor that compares the expected salary of a Candidate with the salary range of a JobPost
+	MatchFactorSalary = "salary"
+	// MatchNeutralScore is given to a factor that cannot be compared because either side did not specify it
+	MatchNeutralScore float64 = 0.5
+)
+
+// MatchFactor declares the score of a single factor considered when matching a Candidate to a JobPost
+type MatchFactor struct {
+	Factor string  `json:"factor"`
+	Score  float64 `json:"score"`
+	Weight float64 `json:"weight"`
+	Reason string  `json:"reason"`
+}
+
+// Match declares how well a Candidate matches a JobPost, with the breakdown by factor
+type Match struct {
+	UserID      uint64         `json:"-"`
+	CandidateID uint64         `json:"candidate_id"`
+	JobPostID   uint64         `json:"job_post_id"`
+	Score       float64        `json:"score"`
+	Factors     []*MatchFactor `json:"factors"`
+}
+
+// MaskPersonalDetails scores the salary factor of a Match as if the expected salary of the Candidate was not
+// specified, so that the caller cannot tell how it compares with the salary range of the JobPost
+func (m *Match) MaskPersonalDetails() {
+	if m == nil {
+		return
+	}
+	var total float64 = 0
+	for _, f := range m.Factors {
+		if f.Factor == MatchFactorSalary {
+			f.Score = MatchNeutralScore
+			f.Reason = "Salary not shown"
+		}
+		total += f.Score * f.Weight
+	}
+	m.Score = total
+}
+
+// Formats of an exported Candidate profile
+const (
+	ExportFormatJSON = "json"
+	ExportFormatPDF  = "pdf"
+)
+
+// ExportOptions declares the format of an exported Candidate profile and the fields that are masked,
+// so that profiles can be shared with clients without contact details or salaries
+type ExportOptions struct {
+	Format            string
+	HideEmail         bool
+	HideContactNumber bool
+	HideSalary        bool
+}
+
+// ProfileExport declares a Candidate profile rendered in an export format
+type ProfileExport struct {
+	ContentType string
+	Data        []byte
+}
+
+// Region declares the model for Region
+type Region struct {
+	tableName struct{} `pg:"regions,alias:rg"`
+
+	ID   uint64 `json:"id"`
+	Name string `json:"name" pg:",notnull,unique"`
+}
+
+// Country declares the model for Country
+type Country struct {
+	tableName struct{} `pg:"countries,alias:ct"`
+
+	ID          uint64  `json:"id"`
+	RegionID    uint64  `json:"region_id" pg:",notnull"`
+	Name        string  `json:"name" pg:",notnull"`
+	ISOCode     string  `json:"iso_code" pg:"iso_code,notnull,unique"`
+	CallingCode string  `json:"calling_code,omitempty"`
+	Currency    string  `json:"currency,omitempty"`
+	Region      *Region `json:"region,omitempty" pg:"rel:has-one"`
+}
+
+// State declares the model for State
+type State struct {
+	tableName struct{} `pg:"states,alias:st"`
+
+	ID        uint64   `json:"id"`
+	CountryID uint64   `json:"country_id" pg:",notnull"`
+	Name      string   `json:"name" pg:",notnull"`
+	Code      string   `json:"code,omitempty"`
+	Country   *Country `json:"country,omitempty" pg:"rel:has-one"`
+}
+
+// City declares the model for City
+type City struct {
+	tableName struct{} `pg:"cities,alias:ci"`
+
+	ID      uint64 `json:"id"`
+	StateID uint64 `json:"state_id" pg:",notnull"`
+	Name    string `json:"name" pg:",notnull"`
+	State   *State `json:"state,omitempty" pg:"rel:has-one"`
+}
+
+// Address declares the model for Address
+type Address struct {
+	tableName struct{} `pg:"addresses,alias:ad"`
+
+	ID            uint64     `json:"id"`
+	CandidateID   uint64     `json:"candidate_id" pg:",notnull"`
+	StreetAddress string     `json:"street_address,omitempty"`
+	CityID        uint64     `json:"city_id" pg:",notnull"`
+	Postcode      string     `json:"postcode,omitempty"`
+	City          *City      `json:"city,omitempty" pg:"rel:has-one"`
+	CreatedAt     *time.Time `json:"created_at,omitempty" pg:"default:now()"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty" pg:"default:now()"`
+}
+
+func (m *Address) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+func (m *Address) BeforeUpdate(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+// Shortlist declares the model for a named list of Candidates kept by a recruiter,
+// which can be shared with company users
+type Shortlist struct {
+	tableName struct{} `pg:"shortlists,alias:sl"`
+
+	ID         uint64                `json:"id"`
+	UserID     uint64                `json:"user_id" pg:",notnull"`
+	Name       string                `json:"name" pg:",notnull"`
+	Candidates []*ShortlistCandidate `json:"candidates,omitempty" pg:"rel:has-many"`
+	Shares     []*ShortlistShare     `json:"shares,omitempty" pg:"rel:has-many"`
+	CreatedAt  *time.Time            `json:"created_at,omitempty" pg:"default:now()"`
+	UpdatedAt  *time.Time            `json:"updated_at,omitempty" pg:"default:now()"`
+}
+
+func (m *Shortlist) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+func (m *Shortlist) BeforeUpdate(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+// IsSharedWith returns whether the Shortlist is shared with the User with uid
+func (m *Shortlist) IsSharedWith(uid uint64) bool {
+	for _, s := range m.Shares {
+		if s.UserID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// ShortlistCandidate declares the model for a Candidate in a Shortlist, with the notes of the recruiter.
+// User is the profile of the Candidate, which is only loaded with a single Shortlist
+type ShortlistCandidate struct {
+	tableName struct{} `pg:"shortlists_candidates,alias:sc"`
+
+	ID          uint64     `json:"id"`
+	ShortlistID uint64     `json:"shortlist_id" pg:",notnull"`
+	CandidateID uint64     `json:"candidate_id" pg:",notnull"`
+	Notes       string     `json:"notes,omitempty"`
+	User        *User      `json:"user,omitempty" pg:"-"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" pg:"default:now()"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" pg:"default:now()"`
+}
+
+func (m *ShortlistCandidate) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+func (m *ShortlistCandidate) BeforeUpdate(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+// ShortlistShare declares the model for a Shortlist shared with a company user
+type ShortlistShare struct {
+	tableName struct{} `pg:"shortlists_shares,alias:ss"`
+
+	ID          uint64     `json:"id"`
+	ShortlistID uint64     `json:"shortlist_id" pg:",notnull"`
+	UserID      uint64     `json:"user_id" pg:",notnull"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" pg:"default:now()"`
+}
+
+func (m *ShortlistShare) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	return ctx, nil
+}
+
+// ContactConsent declares the model for the consent of a Candidate to show their personal details
+// to the users of a Company that they have not applied to
+type ContactConsent struct {
+	tableName struct{} `pg:"contact_consents,alias:cc"`
+
+	ID          uint64     `json:"id"`
+	CandidateID uint64     `json:"candidate_id" pg:",notnull"`
+	CompanyID   uint64     `json:"company_id" pg:",notnull"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" pg:"default:now()"`
+}
+
+func (m *ContactConsent) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	return ctx, nil
+}
+
+// ErasureReceipt declares the model for the record that the data about a User was erased.
+// It keeps no personal data, only a hash of the email of the User to answer whether an email was erased
+type ErasureReceipt struct {
+	tableName struct{} `pg:"erasure_receipts,alias:er"`
+
+	ID              uint64            `json:"id"`
+	UserID          uint64            `json:"user_id" pg:",notnull"`
+	EmailHash       string            `json:"email_hash" pg:",notnull"`
+	RequestedBy     uint64            `json:"requested_by"`
+	Records         map[string]uint64 `json:"records" pg:"type:jsonb,notnull"`
+	ExternalSystems []string          `json:"external_systems" pg:",array"`
+	CreatedAt       *time.Time        `json:"created_at,omitempty" pg:"default:now()"`
+}
+
+func (m *ErasureReceipt) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	return ctx, nil
+}
+
+// HashEmail returns the hex encoded HMAC-SHA256 of an email with a secret key, ignoring case and surrounding spaces.
+// The key keeps the hash from being reversed by hashing guessed or leaked emails
+func HashEmail(key []byte, email string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// PersonalData declares the data about a User that is kept by the profile service,
+// which is exported to the User on request
+type PersonalData struct {
+	User            *User             `json:"user"`
+	Addresses       []*Address        `json:"addresses"`
+	ContactConsents []*ContactConsent `json:"contact_consents"`
+	Shortlists      []*Shortlist      `json:"shortlists"`
+}
+
+// OutboxMessage statuses
+const (
+	OutboxPending   string = "Pending"
+	OutboxDelivered string = "Delivered"
+	OutboxDead      string = "Dead"
+)
+
+// OutboxMessage kinds, one for each side effect delivered to a third party
+const (
+	OutboxIdentityUpdateUser string = "identity.update_user"
+	OutboxIdentitySetRoles   string = "identity.set_roles"
+	OutboxCRMProspect        string = "crm.prospect"
+	OutboxCRMCadence         string = "crm.cadence"
+	OutboxIdentityDeleteUser string = "identity.delete_user"
+	OutboxCRMDeleteProspect  string = "crm.delete_prospect"
+)
+
+// OutboxMessage declares the model for a side effect that is recorded in the same
+// transaction as the change that caused it, and delivered later by the outbox dispatcher
+type OutboxMessage struct {
+	tableName struct{} `pg:"outbox,alias:ob"`
+
+	ID            uint64          `json:"id"`
+	Kind          string          `json:"kind" pg:",notnull"`
+	Payload       json.RawMessage `json:"payload" pg:"type:jsonb,notnull"`
+	Status        string          `json:"status" pg:",notnull"`
+	Attempts      int             `json:"attempts" pg:",use_zero"`
+	LastError     string          `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time      `json:"next_attempt_at,omitempty" pg:"default:now()"`
+	CreatedAt     *time.Time      `json:"created_at,omitempty" pg:"default:now()"`
+	UpdatedAt     *time.Time      `json:"updated_at,omitempty" pg:"default:now()"`
+}
+
+func (m *OutboxMessage) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	m.UpdatedAt = &now
+	if m.NextAttemptAt == nil {
+		m.NextAttemptAt = &now
+	}
+	return ctx, nil
+}
+
+func (m *OutboxMessage) BeforeUpdate(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+// NewOutboxMessage returns a Pending OutboxMessage of kind with payload encoded as JSON
+func NewOutboxMessage(kind string, payload interface{}) (*OutboxMessage, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxMessage{
+		Kind:    kind,
+		Payload: b,
+		Status:  OutboxPending,
+	}, nil
+}
+
+// CadencePayload declares the payload of an OutboxCRMCadence OutboxMessage
+type CadencePayload struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// ErasurePayload declares the payload of an OutboxIdentityDeleteUser or OutboxCRMDeleteProspect OutboxMessage.
+// The payload is removed once the OutboxMessage is delivered, so that it is not kept after the erasure
+type ErasurePayload struct {
+	AuthID string `json:"auth_id,omitempty"`
+	Email  string `json:"email,omitempty"`
+}
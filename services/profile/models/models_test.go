@@ -0,0 +1,59 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskPersonalDetails(t *testing.T) {
+	birthday := time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)
+	u := &User{
+		FirstName:     "Jane",
+		Email:         "jane@example.com",
+		ContactNumber: "+6591234567",
+		Candidate:     &Candidate{Birthday: &birthday, ExpectedSalaryCurrency: "SGD", ExpectedSalary: 8000, Summary: "Go"},
+	}
+	u.MaskPersonalDetails()
+	require.Equal(t, &User{
+		FirstName:     "Jane",
+		Email:         "j***@example.com",
+		ContactNumber: "*******4567",
+		Candidate:     &Candidate{Summary: "Go"},
+	}, u)
+
+	var tests = []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"no domain", "jane", "****"},
+		{"empty", "", ""},
+		{"short number", "123", "***"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &User{Email: tt.input, ContactNumber: tt.input}
+			u.MaskPersonalDetails()
+			require.Equal(t, tt.want, u.Email)
+		})
+	}
+
+	var nilUser *User
+	nilUser.MaskPersonalDetails()
+}
+
+func TestHashEmail(t *testing.T) {
+	key := []byte("secret")
+	require.Equal(t, HashEmail(key, "jane@example.com"), HashEmail(key, " Jane@Example.com "))
+	require.NotEqual(t, HashEmail(key, "jane@example.com"), HashEmail(key, "john@example.com"))
+	require.Len(t, HashEmail(key, "jane@example.com"), 64)
+
+	// the hash depends on the key, so it cannot be found by hashing the email alone
+	sum := sha256.Sum256([]byte("jane@example.com"))
+	require.NotEqual(t, hex.EncodeToString(sum[:]), HashEmail(key, "jane@example.com"))
+	require.NotEqual(t, HashEmail(key, "jane@example.com"), HashEmail([]byte("other"), "jane@example.com"))
+}
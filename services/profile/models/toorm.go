@@ -232,3 +232,55 @@ func JoblistingCompanyToORM(m *pb.JoblistingCompany) *JobCompany {
 		Size:    m.Size,
 	}
 }
+
+// StateToORM maps the proto State model to the ORM model
+func StateToORM(m *pb.State) *State {
+	if m == nil {
+		return nil
+	}
+	return &State{
+		ID:        m.Id,
+		CountryID: m.CountryId,
+		Name:      m.Name,
+		Code:      m.Code,
+	}
+}
+
+// CityToORM maps the proto City model to the ORM model
+func CityToORM(m *pb.City) *City {
+	if m == nil {
+		return nil
+	}
+	return &City{
+		ID:      m.Id,
+		StateID: m.StateId,
+		Name:    m.Name,
+	}
+}
+
+// AddressToORM maps the proto Address model to the ORM model
+func AddressToORM(m *pb.Address) *Address {
+	if m == nil {
+		return nil
+	}
+	return &Address{
+		ID:            m.Id,
+		CandidateID:   m.CandidateId,
+		StreetAddress: m.StreetAddress,
+		CityID:        m.CityId,
+		Postcode:      m.Postcode,
+	}
+}
+
+// ShortlistToORM maps the proto Shortlist model to the ORM model.
+// Candidates and shares are changed by their own requests so they are not mapped
+func ShortlistToORM(m *pb.Shortlist) *Shortlist {
+	if m == nil {
+		return nil
+	}
+	return &Shortlist{
+		ID:     m.Id,
+		UserID: m.UserId,
+		Name:   m.Name,
+	}
+}
@@ -32,6 +32,7 @@ func (m *User) ToProto() *pb.User {
 		DeletedAt:     deletedAt,
 		Candidate:     m.Candidate.ToProto(),
 		JobCompany:    m.JobCompany.ToProto(),
+		Rank:          m.Rank,
 	}
 }
 
@@ -232,3 +233,191 @@ func (m *JobCompany) ToProto() *pb.JoblistingCompany {
 		Size:    m.Size,
 	}
 }
+
+// ToProto maps the Match model to the proto model
+func (m *Match) ToProto() *pb.Match {
+	if m == nil {
+		return nil
+	}
+
+	var factors []*pb.MatchFactor
+	for _, f := range m.Factors {
+		factors = append(factors, &pb.MatchFactor{
+			Factor: f.Factor,
+			Score:  f.Score,
+			Weight: f.Weight,
+			Reason: f.Reason,
+		})
+	}
+
+	return &pb.Match{
+		CandidateId: m.CandidateID,
+		JobPostId:   m.JobPostID,
+		Score:       m.Score,
+		Factors:     factors,
+	}
+}
+
+// ToProto maps the ORM Region model to the proto model
+func (m *Region) ToProto() *pb.Region {
+	if m == nil {
+		return nil
+	}
+	return &pb.Region{
+		Id:   m.ID,
+		Name: m.Name,
+	}
+}
+
+// ToProto maps the ORM Country model to the proto model
+func (m *Country) ToProto() *pb.Country {
+	if m == nil {
+		return nil
+	}
+	return &pb.Country{
+		Id:          m.ID,
+		RegionId:    m.RegionID,
+		Name:        m.Name,
+		IsoCode:     m.ISOCode,
+		CallingCode: m.CallingCode,
+		Currency:    m.Currency,
+	}
+}
+
+// ToProto maps the ORM State model to the proto model
+func (m *State) ToProto() *pb.State {
+	if m == nil {
+		return nil
+	}
+	return &pb.State{
+		Id:        m.ID,
+		CountryId: m.CountryID,
+		Name:      m.Name,
+		Code:      m.Code,
+	}
+}
+
+// ToProto maps the ORM City model to the proto model
+func (m *City) ToProto() *pb.City {
+	if m == nil {
+		return nil
+	}
+	return &pb.City{
+		Id:      m.ID,
+		StateId: m.StateID,
+		Name:    m.Name,
+	}
+}
+
+// ToProto maps the ORM Address model to the proto model
+func (m *Address) ToProto() *pb.Address {
+	if m == nil {
+		return nil
+	}
+
+	a := &pb.Address{
+		Id:            m.ID,
+		CandidateId:   m.CandidateID,
+		StreetAddress: m.StreetAddress,
+		CityId:        m.CityID,
+		Postcode:      m.Postcode,
+		City:          m.City.ToProto(),
+	}
+	if m.City != nil && m.City.State != nil {
+		a.State = m.City.State.ToProto()
+		a.Country = m.City.State.Country.ToProto()
+	}
+	return a
+}
+
+// ToProto maps the ORM Shortlist model to the proto model
+func (m *Shortlist) ToProto() *pb.Shortlist {
+	if m == nil {
+		return nil
+	}
+
+	var candidates []*pb.ShortlistCandidate
+	for _, c := range m.Candidates {
+		candidates = append(candidates, c.ToProto())
+	}
+
+	var shares []*pb.ShortlistShare
+	for _, s := range m.Shares {
+		shares = append(shares, s.ToProto())
+	}
+
+	createdAt := helpers.TimeToProto(m.CreatedAt)
+	updatedAt := helpers.TimeToProto(m.UpdatedAt)
+
+	return &pb.Shortlist{
+		Id:         m.ID,
+		UserId:     m.UserID,
+		Name:       m.Name,
+		Candidates: candidates,
+		Shares:     shares,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+// ToProto maps the ORM ShortlistCandidate model to the proto model
+func (m *ShortlistCandidate) ToProto() *pb.ShortlistCandidate {
+	if m == nil {
+		return nil
+	}
+
+	createdAt := helpers.TimeToProto(m.CreatedAt)
+	updatedAt := helpers.TimeToProto(m.UpdatedAt)
+
+	return &pb.ShortlistCandidate{
+		Id:          m.ID,
+		ShortlistId: m.ShortlistID,
+		CandidateId: m.CandidateID,
+		Notes:       m.Notes,
+		User:        m.User.ToProto(),
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// ToProto maps the ORM ShortlistShare model to the proto model
+func (m *ShortlistShare) ToProto() *pb.ShortlistShare {
+	if m == nil {
+		return nil
+	}
+	return &pb.ShortlistShare{
+		Id:          m.ID,
+		ShortlistId: m.ShortlistID,
+		UserId:      m.UserID,
+		CreatedAt:   helpers.TimeToProto(m.CreatedAt),
+	}
+}
+
+// ToProto maps the ORM ContactConsent model to the proto model
+func (m *ContactConsent) ToProto() *pb.ContactConsent {
+	if m == nil {
+		return nil
+	}
+	return &pb.ContactConsent{
+		Id:          m.ID,
+		CandidateId: m.CandidateID,
+		CompanyId:   m.CompanyID,
+		CreatedAt:   helpers.TimeToProto(m.CreatedAt),
+	}
+}
+
+// ToProto maps the ORM ErasureReceipt model to the proto model
+func (m *ErasureReceipt) ToProto() *pb.ErasureReceipt {
+	if m == nil {
+		return nil
+	}
+	return &pb.ErasureReceipt{
+		Id:              m.ID,
+		UserId:          m.UserID,
+		EmailHash:       m.EmailHash,
+		RequestedBy:     m.RequestedBy,
+		Records:         m.Records,
+		ExternalSystems: m.ExternalSystems,
+		CreatedAt:       helpers.TimeToProto(m.CreatedAt),
+	}
+}
@@ -10,6 +10,7 @@ import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -52,6 +53,7 @@ type User struct {
 	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	Candidate     *Candidate             `protobuf:"bytes,15,opt,name=candidate,proto3" json:"candidate,omitempty"`
 	JobCompany    *JoblistingCompany     `protobuf:"bytes,16,opt,name=job_company,json=jobCompany,proto3" json:"job_company,omitempty"`
+	Rank          float32                `protobuf:"fixed32,17,opt,name=rank,proto3" json:"rank,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -198,6 +200,13 @@ func (x *User) GetJobCompany() *JoblistingCompany {
 	return nil
 }
 
+func (x *User) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -687,6 +696,13 @@ type GetAllCandidatesRequest struct {
 	MaxSalary       uint32   `protobuf:"varint,10,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
 	EducationLevel  []string `protobuf:"bytes,11,rep,name=education_level,json=educationLevel,proto3" json:"education_level,omitempty"`
 	MaxNoticePeriod uint32   `protobuf:"varint,12,opt,name=max_notice_period,json=maxNoticePeriod,proto3" json:"max_notice_period,omitempty"`
+	PageSize        uint64   `protobuf:"varint,13,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string   `protobuf:"bytes,14,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy          string   `protobuf:"bytes,15,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc        bool     `protobuf:"varint,16,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	WithTotal       bool     `protobuf:"varint,17,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
+	Query           string   `protobuf:"bytes,18,opt,name=query,proto3" json:"query,omitempty"`
+	CandidateId     []uint64 `protobuf:"varint,19,rep,packed,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
 }
 
 func (x *GetAllCandidatesRequest) Reset() {
@@ -805,12 +821,63 @@ func (x *GetAllCandidatesRequest) GetMaxNoticePeriod() uint32 {
 	return 0
 }
 
+func (x *GetAllCandidatesRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllCandidatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllCandidatesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetAllCandidatesRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *GetAllCandidatesRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *GetAllCandidatesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetAllCandidatesRequest) GetCandidateId() []uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return nil
+}
+
 type GetAllCandidatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Candidates []*User `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Candidates    []*User `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    uint64  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
 func (x *GetAllCandidatesResponse) Reset() {
@@ -852,6 +919,20 @@ func (x *GetAllCandidatesResponse) GetCandidates() []*User {
 	return nil
 }
 
+func (x *GetAllCandidatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAllCandidatesResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type GetCandidateByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -899,17 +980,16 @@ func (x *GetCandidateByIDRequest) GetId() uint64 {
 	return 0
 }
 
-type UpdateCandidateRequest struct {
+type GetSCMLoginsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Candidate *Candidate `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *UpdateCandidateRequest) Reset() {
-	*x = UpdateCandidateRequest{}
+func (x *GetSCMLoginsRequest) Reset() {
+	*x = GetSCMLoginsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,13 +997,13 @@ func (x *UpdateCandidateRequest) Reset() {
 	}
 }
 
-func (x *UpdateCandidateRequest) String() string {
+func (x *GetSCMLoginsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCandidateRequest) ProtoMessage() {}
+func (*GetSCMLoginsRequest) ProtoMessage() {}
 
-func (x *UpdateCandidateRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSCMLoginsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -935,35 +1015,29 @@ func (x *UpdateCandidateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCandidateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCandidateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSCMLoginsRequest.ProtoReflect.Descriptor instead.
+func (*GetSCMLoginsRequest) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateCandidateRequest) GetId() uint64 {
+func (x *GetSCMLoginsRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateCandidateRequest) GetCandidate() *Candidate {
-	if x != nil {
-		return x.Candidate
-	}
-	return nil
-}
-
-type DeleteCandidateRequest struct {
+// SCMLogins maps the hosts of the git host accounts linked to a user to their logins
+type SCMLogins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Logins map[string]string `protobuf:"bytes,1,rep,name=logins,proto3" json:"logins,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DeleteCandidateRequest) Reset() {
-	*x = DeleteCandidateRequest{}
+func (x *SCMLogins) Reset() {
+	*x = SCMLogins{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -971,13 +1045,13 @@ func (x *DeleteCandidateRequest) Reset() {
 	}
 }
 
-func (x *DeleteCandidateRequest) String() string {
+func (x *SCMLogins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCandidateRequest) ProtoMessage() {}
+func (*SCMLogins) ProtoMessage() {}
 
-func (x *DeleteCandidateRequest) ProtoReflect() protoreflect.Message {
+func (x *SCMLogins) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,26 +1063,29 @@ func (x *DeleteCandidateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCandidateRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCandidateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SCMLogins.ProtoReflect.Descriptor instead.
+func (*SCMLogins) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DeleteCandidateRequest) GetId() uint64 {
+func (x *SCMLogins) GetLogins() map[string]string {
 	if x != nil {
-		return x.Id
+		return x.Logins
 	}
-	return 0
+	return nil
 }
 
-type DeleteCandidateResponse struct {
+type UpdateCandidateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Candidate *Candidate `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
 }
 
-func (x *DeleteCandidateResponse) Reset() {
-	*x = DeleteCandidateResponse{}
+func (x *UpdateCandidateRequest) Reset() {
+	*x = UpdateCandidateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1016,13 +1093,13 @@ func (x *DeleteCandidateResponse) Reset() {
 	}
 }
 
-func (x *DeleteCandidateResponse) String() string {
+func (x *UpdateCandidateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCandidateResponse) ProtoMessage() {}
+func (*UpdateCandidateRequest) ProtoMessage() {}
 
-func (x *DeleteCandidateResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateCandidateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1034,22 +1111,35 @@ func (x *DeleteCandidateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCandidateResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCandidateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCandidateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCandidateRequest) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{13}
 }
 
-type Skill struct {
+func (x *UpdateCandidateRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCandidateRequest) GetCandidate() *Candidate {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+type DeleteCandidateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Skill) Reset() {
-	*x = Skill{}
+func (x *DeleteCandidateRequest) Reset() {
+	*x = DeleteCandidateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1057,13 +1147,13 @@ func (x *Skill) Reset() {
 	}
 }
 
-func (x *Skill) String() string {
+func (x *DeleteCandidateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Skill) ProtoMessage() {}
+func (*DeleteCandidateRequest) ProtoMessage() {}
 
-func (x *Skill) ProtoReflect() protoreflect.Message {
+func (x *DeleteCandidateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1075,35 +1165,26 @@ func (x *Skill) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Skill.ProtoReflect.Descriptor instead.
-func (*Skill) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCandidateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCandidateRequest) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Skill) GetId() uint64 {
+func (x *DeleteCandidateRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Skill) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type CreateSkillRequest struct {
+type DeleteCandidateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Skill *Skill `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
 }
 
-func (x *CreateSkillRequest) Reset() {
-	*x = CreateSkillRequest{}
+func (x *DeleteCandidateResponse) Reset() {
+	*x = DeleteCandidateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1111,13 +1192,13 @@ func (x *CreateSkillRequest) Reset() {
 	}
 }
 
-func (x *CreateSkillRequest) String() string {
+func (x *DeleteCandidateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSkillRequest) ProtoMessage() {}
+func (*DeleteCandidateResponse) ProtoMessage() {}
 
-func (x *CreateSkillRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteCandidateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1129,28 +1210,24 @@ func (x *CreateSkillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSkillRequest.ProtoReflect.Descriptor instead.
-func (*CreateSkillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCandidateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCandidateResponse) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateSkillRequest) GetSkill() *Skill {
-	if x != nil {
-		return x.Skill
-	}
-	return nil
-}
-
-type GetSkillRequest struct {
+type ImportResumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// PDF or DOCX document, base64 encoded in JSON
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *GetSkillRequest) Reset() {
-	*x = GetSkillRequest{}
+func (x *ImportResumeRequest) Reset() {
+	*x = ImportResumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1158,13 +1235,13 @@ func (x *GetSkillRequest) Reset() {
 	}
 }
 
-func (x *GetSkillRequest) String() string {
+func (x *ImportResumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSkillRequest) ProtoMessage() {}
+func (*ImportResumeRequest) ProtoMessage() {}
 
-func (x *GetSkillRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportResumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1176,29 +1253,47 @@ func (x *GetSkillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSkillRequest.ProtoReflect.Descriptor instead.
-func (*GetSkillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportResumeRequest.ProtoReflect.Descriptor instead.
+func (*ImportResumeRequest) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetSkillRequest) GetId() uint64 {
+func (x *ImportResumeRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetAllSkillsRequest struct {
+func (x *ImportResumeRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportResumeRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ExportCandidateProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   []uint64 `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`
-	Name []string `protobuf:"bytes,2,rep,name=name,proto3" json:"name,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// json for the JSON Resume schema, which is the default, or pdf
+	Format            string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	HideEmail         bool   `protobuf:"varint,3,opt,name=hide_email,json=hideEmail,proto3" json:"hide_email,omitempty"`
+	HideContactNumber bool   `protobuf:"varint,4,opt,name=hide_contact_number,json=hideContactNumber,proto3" json:"hide_contact_number,omitempty"`
+	HideSalary        bool   `protobuf:"varint,5,opt,name=hide_salary,json=hideSalary,proto3" json:"hide_salary,omitempty"`
 }
 
-func (x *GetAllSkillsRequest) Reset() {
-	*x = GetAllSkillsRequest{}
+func (x *ExportCandidateProfileRequest) Reset() {
+	*x = ExportCandidateProfileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1206,13 +1301,13 @@ func (x *GetAllSkillsRequest) Reset() {
 	}
 }
 
-func (x *GetAllSkillsRequest) String() string {
+func (x *ExportCandidateProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllSkillsRequest) ProtoMessage() {}
+func (*ExportCandidateProfileRequest) ProtoMessage() {}
 
-func (x *GetAllSkillsRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportCandidateProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1224,35 +1319,57 @@ func (x *GetAllSkillsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllSkillsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllSkillsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportCandidateProfileRequest.ProtoReflect.Descriptor instead.
+func (*ExportCandidateProfileRequest) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetAllSkillsRequest) GetId() []uint64 {
+func (x *ExportCandidateProfileRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *GetAllSkillsRequest) GetName() []string {
+func (x *ExportCandidateProfileRequest) GetFormat() string {
 	if x != nil {
-		return x.Name
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-type GetAllSkillsResponse struct {
+func (x *ExportCandidateProfileRequest) GetHideEmail() bool {
+	if x != nil {
+		return x.HideEmail
+	}
+	return false
+}
+
+func (x *ExportCandidateProfileRequest) GetHideContactNumber() bool {
+	if x != nil {
+		return x.HideContactNumber
+	}
+	return false
+}
+
+func (x *ExportCandidateProfileRequest) GetHideSalary() bool {
+	if x != nil {
+		return x.HideSalary
+	}
+	return false
+}
+
+type Skill struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Skills []*Skill `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetAllSkillsResponse) Reset() {
-	*x = GetAllSkillsResponse{}
+func (x *Skill) Reset() {
+	*x = Skill{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_profile_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1260,13 +1377,13 @@ func (x *GetAllSkillsResponse) Reset() {
 	}
 }
 
-func (x *GetAllSkillsResponse) String() string {
+func (x *Skill) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllSkillsResponse) ProtoMessage() {}
+func (*Skill) ProtoMessage() {}
 
-func (x *GetAllSkillsResponse) ProtoReflect() protoreflect.Message {
+func (x *Skill) ProtoReflect() protoreflect.Message {
 	mi := &file_profile_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1278,34 +1395,237 @@ func (x *GetAllSkillsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllSkillsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllSkillsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Skill.ProtoReflect.Descriptor instead.
+func (*Skill) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetAllSkillsResponse) GetSkills() []*Skill {
+func (x *Skill) GetId() uint64 {
 	if x != nil {
-		return x.Skills
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type UserSkill struct {
+func (x *Skill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateSkillRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CandidateId uint64                 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
-	SkillId     uint64                 `protobuf:"varint,3,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Skill *Skill `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+}
+
+func (x *CreateSkillRequest) Reset() {
+	*x = CreateSkillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSkillRequest) ProtoMessage() {}
+
+func (x *CreateSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSkillRequest.ProtoReflect.Descriptor instead.
+func (*CreateSkillRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateSkillRequest) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type GetSkillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSkillRequest) Reset() {
+	*x = GetSkillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkillRequest) ProtoMessage() {}
+
+func (x *GetSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkillRequest.ProtoReflect.Descriptor instead.
+func (*GetSkillRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSkillRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAllSkillsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   []uint64 `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`
+	Name []string `protobuf:"bytes,2,rep,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetAllSkillsRequest) Reset() {
+	*x = GetAllSkillsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllSkillsRequest) ProtoMessage() {}
+
+func (x *GetAllSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllSkillsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAllSkillsRequest) GetId() []uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GetAllSkillsRequest) GetName() []string {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+type GetAllSkillsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skills []*Skill `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+}
+
+func (x *GetAllSkillsResponse) Reset() {
+	*x = GetAllSkillsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllSkillsResponse) ProtoMessage() {}
+
+func (x *GetAllSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllSkillsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAllSkillsResponse) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type UserSkill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CandidateId uint64                 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	SkillId     uint64                 `protobuf:"varint,3,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
 func (x *UserSkill) Reset() {
 	*x = UserSkill{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[19]
+		mi := &file_profile_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1318,7 +1638,7 @@ func (x *UserSkill) String() string {
 func (*UserSkill) ProtoMessage() {}
 
 func (x *UserSkill) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[19]
+	mi := &file_profile_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +1651,7 @@ func (x *UserSkill) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSkill.ProtoReflect.Descriptor instead.
 func (*UserSkill) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{19}
+	return file_profile_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UserSkill) GetId() uint64 {
@@ -1380,7 +1700,7 @@ type CreateUserSkillRequest struct {
 func (x *CreateUserSkillRequest) Reset() {
 	*x = CreateUserSkillRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[20]
+		mi := &file_profile_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1393,7 +1713,7 @@ func (x *CreateUserSkillRequest) String() string {
 func (*CreateUserSkillRequest) ProtoMessage() {}
 
 func (x *CreateUserSkillRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[20]
+	mi := &file_profile_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,7 +1726,7 @@ func (x *CreateUserSkillRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserSkillRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserSkillRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{20}
+	return file_profile_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateUserSkillRequest) GetUserSkill() *UserSkill {
@@ -1428,7 +1748,7 @@ type DeleteUserSkillRequest struct {
 func (x *DeleteUserSkillRequest) Reset() {
 	*x = DeleteUserSkillRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[21]
+		mi := &file_profile_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1441,7 +1761,7 @@ func (x *DeleteUserSkillRequest) String() string {
 func (*DeleteUserSkillRequest) ProtoMessage() {}
 
 func (x *DeleteUserSkillRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[21]
+	mi := &file_profile_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,7 +1774,7 @@ func (x *DeleteUserSkillRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserSkillRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserSkillRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{21}
+	return file_profile_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DeleteUserSkillRequest) GetCandidateId() uint64 {
@@ -1480,7 +1800,7 @@ type DeleteUserSkillResponse struct {
 func (x *DeleteUserSkillResponse) Reset() {
 	*x = DeleteUserSkillResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[22]
+		mi := &file_profile_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1493,7 +1813,7 @@ func (x *DeleteUserSkillResponse) String() string {
 func (*DeleteUserSkillResponse) ProtoMessage() {}
 
 func (x *DeleteUserSkillResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[22]
+	mi := &file_profile_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1506,7 +1826,7 @@ func (x *DeleteUserSkillResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserSkillResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserSkillResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{22}
+	return file_profile_proto_rawDescGZIP(), []int{26}
 }
 
 type Institution struct {
@@ -1522,7 +1842,7 @@ type Institution struct {
 func (x *Institution) Reset() {
 	*x = Institution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[23]
+		mi := &file_profile_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1535,7 +1855,7 @@ func (x *Institution) String() string {
 func (*Institution) ProtoMessage() {}
 
 func (x *Institution) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[23]
+	mi := &file_profile_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +1868,7 @@ func (x *Institution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Institution.ProtoReflect.Descriptor instead.
 func (*Institution) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{23}
+	return file_profile_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Institution) GetId() uint64 {
@@ -1583,7 +1903,7 @@ type CreateInstitutionRequest struct {
 func (x *CreateInstitutionRequest) Reset() {
 	*x = CreateInstitutionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[24]
+		mi := &file_profile_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1596,7 +1916,7 @@ func (x *CreateInstitutionRequest) String() string {
 func (*CreateInstitutionRequest) ProtoMessage() {}
 
 func (x *CreateInstitutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[24]
+	mi := &file_profile_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,7 +1929,7 @@ func (x *CreateInstitutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInstitutionRequest.ProtoReflect.Descriptor instead.
 func (*CreateInstitutionRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{24}
+	return file_profile_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateInstitutionRequest) GetInstitution() *Institution {
@@ -1630,7 +1950,7 @@ type GetInstitutionRequest struct {
 func (x *GetInstitutionRequest) Reset() {
 	*x = GetInstitutionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[25]
+		mi := &file_profile_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1643,7 +1963,7 @@ func (x *GetInstitutionRequest) String() string {
 func (*GetInstitutionRequest) ProtoMessage() {}
 
 func (x *GetInstitutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[25]
+	mi := &file_profile_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,7 +1976,7 @@ func (x *GetInstitutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstitutionRequest.ProtoReflect.Descriptor instead.
 func (*GetInstitutionRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{25}
+	return file_profile_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetInstitutionRequest) GetId() uint64 {
@@ -1678,7 +1998,7 @@ type GetAllInstitutionsRequest struct {
 func (x *GetAllInstitutionsRequest) Reset() {
 	*x = GetAllInstitutionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[26]
+		mi := &file_profile_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1691,7 +2011,7 @@ func (x *GetAllInstitutionsRequest) String() string {
 func (*GetAllInstitutionsRequest) ProtoMessage() {}
 
 func (x *GetAllInstitutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[26]
+	mi := &file_profile_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,7 +2024,7 @@ func (x *GetAllInstitutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllInstitutionsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllInstitutionsRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{26}
+	return file_profile_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetAllInstitutionsRequest) GetName() []string {
@@ -1732,7 +2052,7 @@ type GetAllInstitutionsResponse struct {
 func (x *GetAllInstitutionsResponse) Reset() {
 	*x = GetAllInstitutionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[27]
+		mi := &file_profile_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1745,7 +2065,7 @@ func (x *GetAllInstitutionsResponse) String() string {
 func (*GetAllInstitutionsResponse) ProtoMessage() {}
 
 func (x *GetAllInstitutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[27]
+	mi := &file_profile_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,7 +2078,7 @@ func (x *GetAllInstitutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllInstitutionsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllInstitutionsResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{27}
+	return file_profile_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetAllInstitutionsResponse) GetInstitutions() []*Institution {
@@ -1781,7 +2101,7 @@ type Course struct {
 func (x *Course) Reset() {
 	*x = Course{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[28]
+		mi := &file_profile_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1794,7 +2114,7 @@ func (x *Course) String() string {
 func (*Course) ProtoMessage() {}
 
 func (x *Course) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[28]
+	mi := &file_profile_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1807,7 +2127,7 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Course.ProtoReflect.Descriptor instead.
 func (*Course) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{28}
+	return file_profile_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Course) GetId() uint64 {
@@ -1842,7 +2162,7 @@ type CreateCourseRequest struct {
 func (x *CreateCourseRequest) Reset() {
 	*x = CreateCourseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[29]
+		mi := &file_profile_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1855,7 +2175,7 @@ func (x *CreateCourseRequest) String() string {
 func (*CreateCourseRequest) ProtoMessage() {}
 
 func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[29]
+	mi := &file_profile_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +2188,7 @@ func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
 func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{29}
+	return file_profile_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CreateCourseRequest) GetCourse() *Course {
@@ -1889,7 +2209,7 @@ type GetCourseRequest struct {
 func (x *GetCourseRequest) Reset() {
 	*x = GetCourseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[30]
+		mi := &file_profile_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1902,7 +2222,7 @@ func (x *GetCourseRequest) String() string {
 func (*GetCourseRequest) ProtoMessage() {}
 
 func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[30]
+	mi := &file_profile_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1915,7 +2235,7 @@ func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
 func (*GetCourseRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{30}
+	return file_profile_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetCourseRequest) GetId() uint64 {
@@ -1937,7 +2257,7 @@ type GetAllCoursesRequest struct {
 func (x *GetAllCoursesRequest) Reset() {
 	*x = GetAllCoursesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[31]
+		mi := &file_profile_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1950,7 +2270,7 @@ func (x *GetAllCoursesRequest) String() string {
 func (*GetAllCoursesRequest) ProtoMessage() {}
 
 func (x *GetAllCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[31]
+	mi := &file_profile_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,7 +2283,7 @@ func (x *GetAllCoursesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllCoursesRequest.ProtoReflect.Descriptor instead.
 func (*GetAllCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{31}
+	return file_profile_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetAllCoursesRequest) GetName() []string {
@@ -1991,7 +2311,7 @@ type GetAllCoursesResponse struct {
 func (x *GetAllCoursesResponse) Reset() {
 	*x = GetAllCoursesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[32]
+		mi := &file_profile_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2004,7 +2324,7 @@ func (x *GetAllCoursesResponse) String() string {
 func (*GetAllCoursesResponse) ProtoMessage() {}
 
 func (x *GetAllCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[32]
+	mi := &file_profile_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2017,7 +2337,7 @@ func (x *GetAllCoursesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllCoursesResponse.ProtoReflect.Descriptor instead.
 func (*GetAllCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{32}
+	return file_profile_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetAllCoursesResponse) GetCourses() []*Course {
@@ -2039,7 +2359,7 @@ type CourseInstitution struct {
 func (x *CourseInstitution) Reset() {
 	*x = CourseInstitution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[33]
+		mi := &file_profile_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2052,7 +2372,7 @@ func (x *CourseInstitution) String() string {
 func (*CourseInstitution) ProtoMessage() {}
 
 func (x *CourseInstitution) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[33]
+	mi := &file_profile_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +2385,7 @@ func (x *CourseInstitution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CourseInstitution.ProtoReflect.Descriptor instead.
 func (*CourseInstitution) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{33}
+	return file_profile_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CourseInstitution) GetId() uint64 {
@@ -2103,7 +2423,7 @@ type AcademicHistory struct {
 func (x *AcademicHistory) Reset() {
 	*x = AcademicHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[34]
+		mi := &file_profile_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2116,7 +2436,7 @@ func (x *AcademicHistory) String() string {
 func (*AcademicHistory) ProtoMessage() {}
 
 func (x *AcademicHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[34]
+	mi := &file_profile_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,7 +2449,7 @@ func (x *AcademicHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcademicHistory.ProtoReflect.Descriptor instead.
 func (*AcademicHistory) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{34}
+	return file_profile_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AcademicHistory) GetId() uint64 {
@@ -2220,7 +2540,7 @@ type CreateAcademicHistoryRequest struct {
 func (x *CreateAcademicHistoryRequest) Reset() {
 	*x = CreateAcademicHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[35]
+		mi := &file_profile_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2233,7 +2553,7 @@ func (x *CreateAcademicHistoryRequest) String() string {
 func (*CreateAcademicHistoryRequest) ProtoMessage() {}
 
 func (x *CreateAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[35]
+	mi := &file_profile_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2246,7 +2566,7 @@ func (x *CreateAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAcademicHistoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateAcademicHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{35}
+	return file_profile_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateAcademicHistoryRequest) GetAcademicHistory() *AcademicHistory {
@@ -2267,7 +2587,7 @@ type GetAcademicHistoryRequest struct {
 func (x *GetAcademicHistoryRequest) Reset() {
 	*x = GetAcademicHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[36]
+		mi := &file_profile_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2280,7 +2600,7 @@ func (x *GetAcademicHistoryRequest) String() string {
 func (*GetAcademicHistoryRequest) ProtoMessage() {}
 
 func (x *GetAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[36]
+	mi := &file_profile_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2293,7 +2613,7 @@ func (x *GetAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcademicHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetAcademicHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{36}
+	return file_profile_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetAcademicHistoryRequest) GetId() uint64 {
@@ -2315,7 +2635,7 @@ type UpdateAcademicHistoryRequest struct {
 func (x *UpdateAcademicHistoryRequest) Reset() {
 	*x = UpdateAcademicHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[37]
+		mi := &file_profile_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2328,7 +2648,7 @@ func (x *UpdateAcademicHistoryRequest) String() string {
 func (*UpdateAcademicHistoryRequest) ProtoMessage() {}
 
 func (x *UpdateAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[37]
+	mi := &file_profile_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2341,7 +2661,7 @@ func (x *UpdateAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAcademicHistoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAcademicHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{37}
+	return file_profile_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *UpdateAcademicHistoryRequest) GetId() uint64 {
@@ -2370,7 +2690,7 @@ type DeleteAcademicHistoryRequest struct {
 func (x *DeleteAcademicHistoryRequest) Reset() {
 	*x = DeleteAcademicHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[38]
+		mi := &file_profile_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2383,7 +2703,7 @@ func (x *DeleteAcademicHistoryRequest) String() string {
 func (*DeleteAcademicHistoryRequest) ProtoMessage() {}
 
 func (x *DeleteAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[38]
+	mi := &file_profile_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2396,7 +2716,7 @@ func (x *DeleteAcademicHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAcademicHistoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAcademicHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{38}
+	return file_profile_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *DeleteAcademicHistoryRequest) GetId() uint64 {
@@ -2422,7 +2742,7 @@ type DeleteAcademicHistoryResponse struct {
 func (x *DeleteAcademicHistoryResponse) Reset() {
 	*x = DeleteAcademicHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[39]
+		mi := &file_profile_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2435,7 +2755,7 @@ func (x *DeleteAcademicHistoryResponse) String() string {
 func (*DeleteAcademicHistoryResponse) ProtoMessage() {}
 
 func (x *DeleteAcademicHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[39]
+	mi := &file_profile_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,7 +2768,7 @@ func (x *DeleteAcademicHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAcademicHistoryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAcademicHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{39}
+	return file_profile_proto_rawDescGZIP(), []int{43}
 }
 
 type Company struct {
@@ -2463,7 +2783,7 @@ type Company struct {
 func (x *Company) Reset() {
 	*x = Company{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[40]
+		mi := &file_profile_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2476,7 +2796,7 @@ func (x *Company) String() string {
 func (*Company) ProtoMessage() {}
 
 func (x *Company) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[40]
+	mi := &file_profile_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,7 +2809,7 @@ func (x *Company) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Company.ProtoReflect.Descriptor instead.
 func (*Company) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{40}
+	return file_profile_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *Company) GetId() uint64 {
@@ -2517,7 +2837,7 @@ type CreateCompanyRequest struct {
 func (x *CreateCompanyRequest) Reset() {
 	*x = CreateCompanyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[41]
+		mi := &file_profile_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2530,7 +2850,7 @@ func (x *CreateCompanyRequest) String() string {
 func (*CreateCompanyRequest) ProtoMessage() {}
 
 func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[41]
+	mi := &file_profile_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,7 +2863,7 @@ func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
 func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{41}
+	return file_profile_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CreateCompanyRequest) GetCompany() *Company {
@@ -2564,7 +2884,7 @@ type GetCompanyRequest struct {
 func (x *GetCompanyRequest) Reset() {
 	*x = GetCompanyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[42]
+		mi := &file_profile_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2577,7 +2897,7 @@ func (x *GetCompanyRequest) String() string {
 func (*GetCompanyRequest) ProtoMessage() {}
 
 func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[42]
+	mi := &file_profile_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +2910,7 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
 func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{42}
+	return file_profile_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetCompanyRequest) GetId() uint64 {
@@ -2611,7 +2931,7 @@ type GetAllCompaniesRequest struct {
 func (x *GetAllCompaniesRequest) Reset() {
 	*x = GetAllCompaniesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[43]
+		mi := &file_profile_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2624,7 +2944,7 @@ func (x *GetAllCompaniesRequest) String() string {
 func (*GetAllCompaniesRequest) ProtoMessage() {}
 
 func (x *GetAllCompaniesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[43]
+	mi := &file_profile_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +2957,7 @@ func (x *GetAllCompaniesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllCompaniesRequest.ProtoReflect.Descriptor instead.
 func (*GetAllCompaniesRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{43}
+	return file_profile_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetAllCompaniesRequest) GetName() []string {
@@ -2658,7 +2978,7 @@ type GetAllCompaniesResponse struct {
 func (x *GetAllCompaniesResponse) Reset() {
 	*x = GetAllCompaniesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[44]
+		mi := &file_profile_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2671,7 +2991,7 @@ func (x *GetAllCompaniesResponse) String() string {
 func (*GetAllCompaniesResponse) ProtoMessage() {}
 
 func (x *GetAllCompaniesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[44]
+	mi := &file_profile_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +3004,7 @@ func (x *GetAllCompaniesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllCompaniesResponse.ProtoReflect.Descriptor instead.
 func (*GetAllCompaniesResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{44}
+	return file_profile_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetAllCompaniesResponse) GetCompanies() []*Company {
@@ -2706,7 +3026,7 @@ type Department struct {
 func (x *Department) Reset() {
 	*x = Department{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[45]
+		mi := &file_profile_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2719,7 +3039,7 @@ func (x *Department) String() string {
 func (*Department) ProtoMessage() {}
 
 func (x *Department) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[45]
+	mi := &file_profile_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2732,7 +3052,7 @@ func (x *Department) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Department.ProtoReflect.Descriptor instead.
 func (*Department) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{45}
+	return file_profile_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Department) GetId() uint64 {
@@ -2760,7 +3080,7 @@ type CreateDepartmentRequest struct {
 func (x *CreateDepartmentRequest) Reset() {
 	*x = CreateDepartmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[46]
+		mi := &file_profile_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2773,7 +3093,7 @@ func (x *CreateDepartmentRequest) String() string {
 func (*CreateDepartmentRequest) ProtoMessage() {}
 
 func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[46]
+	mi := &file_profile_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2786,7 +3106,7 @@ func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDepartmentRequest.ProtoReflect.Descriptor instead.
 func (*CreateDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{46}
+	return file_profile_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CreateDepartmentRequest) GetDepartment() *Department {
@@ -2807,7 +3127,7 @@ type GetDepartmentRequest struct {
 func (x *GetDepartmentRequest) Reset() {
 	*x = GetDepartmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[47]
+		mi := &file_profile_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2820,7 +3140,7 @@ func (x *GetDepartmentRequest) String() string {
 func (*GetDepartmentRequest) ProtoMessage() {}
 
 func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[47]
+	mi := &file_profile_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2833,7 +3153,7 @@ func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
 func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{47}
+	return file_profile_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetDepartmentRequest) GetId() uint64 {
@@ -2854,7 +3174,7 @@ type GetAllDepartmentsRequest struct {
 func (x *GetAllDepartmentsRequest) Reset() {
 	*x = GetAllDepartmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[48]
+		mi := &file_profile_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2867,7 +3187,7 @@ func (x *GetAllDepartmentsRequest) String() string {
 func (*GetAllDepartmentsRequest) ProtoMessage() {}
 
 func (x *GetAllDepartmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[48]
+	mi := &file_profile_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2880,7 +3200,7 @@ func (x *GetAllDepartmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllDepartmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllDepartmentsRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{48}
+	return file_profile_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetAllDepartmentsRequest) GetName() []string {
@@ -2901,7 +3221,7 @@ type GetAllDepartmentsResponse struct {
 func (x *GetAllDepartmentsResponse) Reset() {
 	*x = GetAllDepartmentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[49]
+		mi := &file_profile_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2914,7 +3234,7 @@ func (x *GetAllDepartmentsResponse) String() string {
 func (*GetAllDepartmentsResponse) ProtoMessage() {}
 
 func (x *GetAllDepartmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[49]
+	mi := &file_profile_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2927,7 +3247,7 @@ func (x *GetAllDepartmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllDepartmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllDepartmentsResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{49}
+	return file_profile_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetAllDepartmentsResponse) GetDepartments() []*Department {
@@ -2950,7 +3270,7 @@ type CompanyDepartment struct {
 func (x *CompanyDepartment) Reset() {
 	*x = CompanyDepartment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[50]
+		mi := &file_profile_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2963,7 +3283,7 @@ func (x *CompanyDepartment) String() string {
 func (*CompanyDepartment) ProtoMessage() {}
 
 func (x *CompanyDepartment) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[50]
+	mi := &file_profile_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2976,7 +3296,7 @@ func (x *CompanyDepartment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompanyDepartment.ProtoReflect.Descriptor instead.
 func (*CompanyDepartment) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{50}
+	return file_profile_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *CompanyDepartment) GetId() uint64 {
@@ -3027,7 +3347,7 @@ type JobHistory struct {
 func (x *JobHistory) Reset() {
 	*x = JobHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[51]
+		mi := &file_profile_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3040,7 +3360,7 @@ func (x *JobHistory) String() string {
 func (*JobHistory) ProtoMessage() {}
 
 func (x *JobHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[51]
+	mi := &file_profile_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3053,7 +3373,7 @@ func (x *JobHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobHistory.ProtoReflect.Descriptor instead.
 func (*JobHistory) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{51}
+	return file_profile_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *JobHistory) GetId() uint64 {
@@ -3186,7 +3506,7 @@ type CreateJobHistoryRequest struct {
 func (x *CreateJobHistoryRequest) Reset() {
 	*x = CreateJobHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[52]
+		mi := &file_profile_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3199,7 +3519,7 @@ func (x *CreateJobHistoryRequest) String() string {
 func (*CreateJobHistoryRequest) ProtoMessage() {}
 
 func (x *CreateJobHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[52]
+	mi := &file_profile_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3212,7 +3532,7 @@ func (x *CreateJobHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateJobHistoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateJobHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{52}
+	return file_profile_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *CreateJobHistoryRequest) GetJobHistory() *JobHistory {
@@ -3233,7 +3553,7 @@ type GetJobHistoryRequest struct {
 func (x *GetJobHistoryRequest) Reset() {
 	*x = GetJobHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[53]
+		mi := &file_profile_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3246,7 +3566,7 @@ func (x *GetJobHistoryRequest) String() string {
 func (*GetJobHistoryRequest) ProtoMessage() {}
 
 func (x *GetJobHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[53]
+	mi := &file_profile_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3259,7 +3579,7 @@ func (x *GetJobHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetJobHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{53}
+	return file_profile_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetJobHistoryRequest) GetId() uint64 {
@@ -3281,7 +3601,7 @@ type UpdateJobHistoryRequest struct {
 func (x *UpdateJobHistoryRequest) Reset() {
 	*x = UpdateJobHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[54]
+		mi := &file_profile_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3294,7 +3614,7 @@ func (x *UpdateJobHistoryRequest) String() string {
 func (*UpdateJobHistoryRequest) ProtoMessage() {}
 
 func (x *UpdateJobHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[54]
+	mi := &file_profile_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3307,7 +3627,7 @@ func (x *UpdateJobHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateJobHistoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateJobHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{54}
+	return file_profile_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *UpdateJobHistoryRequest) GetId() uint64 {
@@ -3336,7 +3656,7 @@ type DeleteJobHistoryRequest struct {
 func (x *DeleteJobHistoryRequest) Reset() {
 	*x = DeleteJobHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[55]
+		mi := &file_profile_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3349,7 +3669,7 @@ func (x *DeleteJobHistoryRequest) String() string {
 func (*DeleteJobHistoryRequest) ProtoMessage() {}
 
 func (x *DeleteJobHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[55]
+	mi := &file_profile_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,7 +3682,7 @@ func (x *DeleteJobHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobHistoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{55}
+	return file_profile_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *DeleteJobHistoryRequest) GetId() uint64 {
@@ -3388,7 +3708,7 @@ type DeleteJobHistoryResponse struct {
 func (x *DeleteJobHistoryResponse) Reset() {
 	*x = DeleteJobHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[56]
+		mi := &file_profile_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3401,7 +3721,7 @@ func (x *DeleteJobHistoryResponse) String() string {
 func (*DeleteJobHistoryResponse) ProtoMessage() {}
 
 func (x *DeleteJobHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[56]
+	mi := &file_profile_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,7 +3734,7 @@ func (x *DeleteJobHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobHistoryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteJobHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{56}
+	return file_profile_proto_rawDescGZIP(), []int{60}
 }
 
 type JoblistingCompany struct {
@@ -3431,7 +3751,7 @@ type JoblistingCompany struct {
 func (x *JoblistingCompany) Reset() {
 	*x = JoblistingCompany{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_profile_proto_msgTypes[57]
+		mi := &file_profile_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3444,7 +3764,7 @@ func (x *JoblistingCompany) String() string {
 func (*JoblistingCompany) ProtoMessage() {}
 
 func (x *JoblistingCompany) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[57]
+	mi := &file_profile_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3457,7 +3777,7 @@ func (x *JoblistingCompany) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoblistingCompany.ProtoReflect.Descriptor instead.
 func (*JoblistingCompany) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{57}
+	return file_profile_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *JoblistingCompany) GetId() uint64 {
@@ -3488,826 +3808,3379 @@ func (x *JoblistingCompany) GetSize() uint64 {
 	return 0
 }
 
-var File_profile_proto protoreflect.FileDescriptor
+type MatchFactor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_profile_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xcf, 0x04, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
-	0x74, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x5f,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x6a, 0x6f, 0x62, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x2b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b,
-	0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x22, 0x31, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x06, 0x0a, 0x09,
-	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72,
-	0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x69,
-	0x74, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
-	0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x61,
-	0x6c, 0x61, 0x72, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
-	0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f,
-	0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x63, 0x6d,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6d, 0x55,
-	0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
-	0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x64,
-	0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64,
-	0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x23,
-	0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
-	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x06,
-	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12,
-	0x31, 0x0a, 0x09, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
-	0x63, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x96,
-	0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a,
-	0x0e, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
-	0x43, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61,
-	0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c,
-	0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72,
-	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61,
-	0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x64, 0x75,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
-	0x61, 0x78, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x74, 0x69, 0x63,
-	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x44, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x29, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22,
-	0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x05, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x35, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6b, 0x69, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b, 0x69, 0x6c,
-	0x6c, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
-	0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x73, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x46, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c,
-	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x22, 0x56, 0x0a, 0x16, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6c,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x6c,
-	0x6c, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b,
-	0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x18, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69,
-	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
-	0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73,
-	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x51,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c,
-	0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x42, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x3d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0xcb, 0x03, 0x0a, 0x0f, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x79, 0x65, 0x61,
-	0x72, 0x5f, 0x6f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x79, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
-	0x63, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x6e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x3e, 0x0a, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x5f, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
-	0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x0f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x22, 0x51, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x22, 0x30, 0x0a,
-	0x0a, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x49, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x44, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa4, 0x05, 0x0a, 0x0a, 0x4a,
-	0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
-	0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x4a, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0b,
-	0x6a, 0x6f, 0x62, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x26, 0x0a,
-	0x14, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x2f, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x22, 0x4c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22,
-	0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x11, 0x4a,
-	0x6f, 0x62, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x55, 0x72, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x32, 0xe0, 0x19, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x70, 0x62,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x09, 0x2f,
-	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x47,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12, 0x16, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22,
-	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x4b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x1a, 0x0e,
-	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5f, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22,
-	0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x3a,
-	0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1b,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x56, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x1b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x64, 0x0a, 0x0f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x1a,
-	0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x67, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x4b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x3a, 0x05,
-	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6c,
-	0x6c, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b, 0x69, 0x6c,
-	0x6c, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53,
-	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x73, 0x12, 0x60, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x22,
-	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x3a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6b,
-	0x69, 0x6c, 0x6c, 0x12, 0x65, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x11, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x69,
-	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
-	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74,
-	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31,
-	0x2f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73, 0x74,
-	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12,
-	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x50, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x0b,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x3a, 0x06, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x59, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x7f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22,
-	0x15, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64,
-	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x1a,
-	0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x10, 0x61, 0x63, 0x61,
-	0x64, 0x65, 0x6d, 0x69, 0x63, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x83, 0x01,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
-	0x69, 0x63, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x3a, 0x01, 0x2a, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x18, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
-	0x65, 0x73, 0x3a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x4c, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x22, 0x1a, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x10,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x23, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x57, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22,
-	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x3a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x58,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
-	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a,
-	0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a,
-	0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x24, 0x1a, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x68, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x6f, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x15, 0x2f, 0x76,
-	0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Factor string  `protobuf:"bytes,1,opt,name=factor,proto3" json:"factor,omitempty"`
+	Score  float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Weight float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Reason string  `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-var (
-	file_profile_proto_rawDescOnce sync.Once
-	file_profile_proto_rawDescData = file_profile_proto_rawDesc
-)
+func (x *MatchFactor) Reset() {
+	*x = MatchFactor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_profile_proto_rawDescGZIP() []byte {
-	file_profile_proto_rawDescOnce.Do(func() {
-		file_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_profile_proto_rawDescData)
-	})
-	return file_profile_proto_rawDescData
+func (x *MatchFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
-var file_profile_proto_goTypes = []interface{}{
-	(*User)(nil),                          // 0: pb.User
-	(*CreateUserRequest)(nil),             // 1: pb.CreateUserRequest
-	(*GetUserByIDRequest)(nil),            // 2: pb.GetUserByIDRequest
-	(*UpdateUserRequest)(nil),             // 3: pb.UpdateUserRequest
-	(*DeleteUserRequest)(nil),             // 4: pb.DeleteUserRequest
-	(*DeleteUserResponse)(nil),            // 5: pb.DeleteUserResponse
-	(*Candidate)(nil),                     // 6: pb.Candidate
-	(*CreateCandidateRequest)(nil),        // 7: pb.CreateCandidateRequest
-	(*GetAllCandidatesRequest)(nil),       // 8: pb.GetAllCandidatesRequest
-	(*GetAllCandidatesResponse)(nil),      // 9: pb.GetAllCandidatesResponse
-	(*GetCandidateByIDRequest)(nil),       // 10: pb.GetCandidateByIDRequest
-	(*UpdateCandidateRequest)(nil),        // 11: pb.UpdateCandidateRequest
-	(*DeleteCandidateRequest)(nil),        // 12: pb.DeleteCandidateRequest
-	(*DeleteCandidateResponse)(nil),       // 13: pb.DeleteCandidateResponse
-	(*Skill)(nil),                         // 14: pb.Skill
-	(*CreateSkillRequest)(nil),            // 15: pb.CreateSkillRequest
-	(*GetSkillRequest)(nil),               // 16: pb.GetSkillRequest
-	(*GetAllSkillsRequest)(nil),           // 17: pb.GetAllSkillsRequest
-	(*GetAllSkillsResponse)(nil),          // 18: pb.GetAllSkillsResponse
-	(*UserSkill)(nil),                     // 19: pb.UserSkill
-	(*CreateUserSkillRequest)(nil),        // 20: pb.CreateUserSkillRequest
-	(*DeleteUserSkillRequest)(nil),        // 21: pb.DeleteUserSkillRequest
-	(*DeleteUserSkillResponse)(nil),       // 22: pb.DeleteUserSkillResponse
-	(*Institution)(nil),                   // 23: pb.Institution
-	(*CreateInstitutionRequest)(nil),      // 24: pb.CreateInstitutionRequest
-	(*GetInstitutionRequest)(nil),         // 25: pb.GetInstitutionRequest
-	(*GetAllInstitutionsRequest)(nil),     // 26: pb.GetAllInstitutionsRequest
-	(*GetAllInstitutionsResponse)(nil),    // 27: pb.GetAllInstitutionsResponse
-	(*Course)(nil),                        // 28: pb.Course
-	(*CreateCourseRequest)(nil),           // 29: pb.CreateCourseRequest
-	(*GetCourseRequest)(nil),              // 30: pb.GetCourseRequest
-	(*GetAllCoursesRequest)(nil),          // 31: pb.GetAllCoursesRequest
-	(*GetAllCoursesResponse)(nil),         // 32: pb.GetAllCoursesResponse
-	(*CourseInstitution)(nil),             // 33: pb.CourseInstitution
-	(*AcademicHistory)(nil),               // 34: pb.AcademicHistory
-	(*CreateAcademicHistoryRequest)(nil),  // 35: pb.CreateAcademicHistoryRequest
-	(*GetAcademicHistoryRequest)(nil),     // 36: pb.GetAcademicHistoryRequest
-	(*UpdateAcademicHistoryRequest)(nil),  // 37: pb.UpdateAcademicHistoryRequest
-	(*DeleteAcademicHistoryRequest)(nil),  // 38: pb.DeleteAcademicHistoryRequest
-	(*DeleteAcademicHistoryResponse)(nil), // 39: pb.DeleteAcademicHistoryResponse
-	(*Company)(nil),                       // 40: pb.Company
-	(*CreateCompanyRequest)(nil),          // 41: pb.CreateCompanyRequest
-	(*GetCompanyRequest)(nil),             // 42: pb.GetCompanyRequest
-	(*GetAllCompaniesRequest)(nil),        // 43: pb.GetAllCompaniesRequest
-	(*GetAllCompaniesResponse)(nil),       // 44: pb.GetAllCompaniesResponse
-	(*Department)(nil),                    // 45: pb.Department
-	(*CreateDepartmentRequest)(nil),       // 46: pb.CreateDepartmentRequest
-	(*GetDepartmentRequest)(nil),          // 47: pb.GetDepartmentRequest
-	(*GetAllDepartmentsRequest)(nil),      // 48: pb.GetAllDepartmentsRequest
-	(*GetAllDepartmentsResponse)(nil),     // 49: pb.GetAllDepartmentsResponse
-	(*CompanyDepartment)(nil),             // 50: pb.CompanyDepartment
-	(*JobHistory)(nil),                    // 51: pb.JobHistory
-	(*CreateJobHistoryRequest)(nil),       // 52: pb.CreateJobHistoryRequest
-	(*GetJobHistoryRequest)(nil),          // 53: pb.GetJobHistoryRequest
-	(*UpdateJobHistoryRequest)(nil),       // 54: pb.UpdateJobHistoryRequest
-	(*DeleteJobHistoryRequest)(nil),       // 55: pb.DeleteJobHistoryRequest
-	(*DeleteJobHistoryResponse)(nil),      // 56: pb.DeleteJobHistoryResponse
-	(*JoblistingCompany)(nil),             // 57: pb.JoblistingCompany
-	(*timestamppb.Timestamp)(nil),         // 58: google.protobuf.Timestamp
+func (*MatchFactor) ProtoMessage() {}
+
+func (x *MatchFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_profile_proto_depIdxs = []int32{
-	58, // 0: pb.User.created_at:type_name -> google.protobuf.Timestamp
-	58, // 1: pb.User.updated_at:type_name -> google.protobuf.Timestamp
-	58, // 2: pb.User.deleted_at:type_name -> google.protobuf.Timestamp
-	6,  // 3: pb.User.candidate:type_name -> pb.Candidate
-	57, // 4: pb.User.job_company:type_name -> pb.JoblistingCompany
-	0,  // 5: pb.CreateUserRequest.user:type_name -> pb.User
-	0,  // 6: pb.UpdateUserRequest.user:type_name -> pb.User
-	58, // 7: pb.Candidate.birthday:type_name -> google.protobuf.Timestamp
-	14, // 8: pb.Candidate.skills:type_name -> pb.Skill
-	34, // 9: pb.Candidate.academics:type_name -> pb.AcademicHistory
-	51, // 10: pb.Candidate.jobs:type_name -> pb.JobHistory
-	58, // 11: pb.Candidate.created_at:type_name -> google.protobuf.Timestamp
-	58, // 12: pb.Candidate.updated_at:type_name -> google.protobuf.Timestamp
-	58, // 13: pb.Candidate.deleted_at:type_name -> google.protobuf.Timestamp
-	6,  // 14: pb.CreateCandidateRequest.candidate:type_name -> pb.Candidate
-	0,  // 15: pb.GetAllCandidatesResponse.candidates:type_name -> pb.User
-	6,  // 16: pb.UpdateCandidateRequest.candidate:type_name -> pb.Candidate
-	14, // 17: pb.CreateSkillRequest.skill:type_name -> pb.Skill
-	14, // 18: pb.GetAllSkillsResponse.skills:type_name -> pb.Skill
-	58, // 19: pb.UserSkill.created_at:type_name -> google.protobuf.Timestamp
-	58, // 20: pb.UserSkill.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 21: pb.CreateUserSkillRequest.user_skill:type_name -> pb.UserSkill
-	23, // 22: pb.CreateInstitutionRequest.institution:type_name -> pb.Institution
-	23, // 23: pb.GetAllInstitutionsResponse.institutions:type_name -> pb.Institution
-	28, // 24: pb.CreateCourseRequest.course:type_name -> pb.Course
-	28, // 25: pb.GetAllCoursesResponse.courses:type_name -> pb.Course
-	23, // 26: pb.AcademicHistory.institution:type_name -> pb.Institution
-	28, // 27: pb.AcademicHistory.course:type_name -> pb.Course
-	58, // 28: pb.AcademicHistory.created_at:type_name -> google.protobuf.Timestamp
-	58, // 29: pb.AcademicHistory.updated_at:type_name -> google.protobuf.Timestamp
-	58, // 30: pb.AcademicHistory.deleted_at:type_name -> google.protobuf.Timestamp
-	34, // 31: pb.CreateAcademicHistoryRequest.academic_history:type_name -> pb.AcademicHistory
-	34, // 32: pb.UpdateAcademicHistoryRequest.academic_history:type_name -> pb.AcademicHistory
-	40, // 33: pb.CreateCompanyRequest.company:type_name -> pb.Company
-	40, // 34: pb.GetAllCompaniesResponse.companies:type_name -> pb.Company
-	45, // 35: pb.CreateDepartmentRequest.department:type_name -> pb.Department
-	45, // 36: pb.GetAllDepartmentsResponse.departments:type_name -> pb.Department
-	40, // 37: pb.JobHistory.company:type_name -> pb.Company
-	45, // 38: pb.JobHistory.department:type_name -> pb.Department
-	58, // 39: pb.JobHistory.start_date:type_name -> google.protobuf.Timestamp
-	58, // 40: pb.JobHistory.end_date:type_name -> google.protobuf.Timestamp
-	58, // 41: pb.JobHistory.created_at:type_name -> google.protobuf.Timestamp
-	58, // 42: pb.JobHistory.updated_at:type_name -> google.protobuf.Timestamp
-	58, // 43: pb.JobHistory.deleted_at:type_name -> google.protobuf.Timestamp
-	51, // 44: pb.CreateJobHistoryRequest.job_history:type_name -> pb.JobHistory
-	51, // 45: pb.UpdateJobHistoryRequest.job_history:type_name -> pb.JobHistory
-	1,  // 46: pb.ProfileService.CreateUser:input_type -> pb.CreateUserRequest
-	2,  // 47: pb.ProfileService.GetUserByID:input_type -> pb.GetUserByIDRequest
-	3,  // 48: pb.ProfileService.UpdateUser:input_type -> pb.UpdateUserRequest
-	4,  // 49: pb.ProfileService.DeleteUser:input_type -> pb.DeleteUserRequest
-	7,  // 50: pb.ProfileService.CreateCandidate:input_type -> pb.CreateCandidateRequest
-	8,  // 51: pb.ProfileService.GetAllCandidates:input_type -> pb.GetAllCandidatesRequest
-	10, // 52: pb.ProfileService.GetCandidateByID:input_type -> pb.GetCandidateByIDRequest
-	11, // 53: pb.ProfileService.UpdateCandidate:input_type -> pb.UpdateCandidateRequest
-	12, // 54: pb.ProfileService.DeleteCandidate:input_type -> pb.DeleteCandidateRequest
-	15, // 55: pb.ProfileService.CreateSkill:input_type -> pb.CreateSkillRequest
-	16, // 56: pb.ProfileService.GetSkill:input_type -> pb.GetSkillRequest
-	17, // 57: pb.ProfileService.GetAllSkills:input_type -> pb.GetAllSkillsRequest
-	20, // 58: pb.ProfileService.CreateUserSkill:input_type -> pb.CreateUserSkillRequest
-	21, // 59: pb.ProfileService.DeleteUserSkill:input_type -> pb.DeleteUserSkillRequest
-	24, // 60: pb.ProfileService.CreateInstitution:input_type -> pb.CreateInstitutionRequest
-	25, // 61: pb.ProfileService.GetInstitution:input_type -> pb.GetInstitutionRequest
-	26, // 62: pb.ProfileService.GetAllInstitutions:input_type -> pb.GetAllInstitutionsRequest
-	29, // 63: pb.ProfileService.CreateCourse:input_type -> pb.CreateCourseRequest
-	30, // 64: pb.ProfileService.GetCourse:input_type -> pb.GetCourseRequest
-	31, // 65: pb.ProfileService.GetAllCourses:input_type -> pb.GetAllCoursesRequest
-	35, // 66: pb.ProfileService.CreateAcademicHistory:input_type -> pb.CreateAcademicHistoryRequest
-	36, // 67: pb.ProfileService.GetAcademicHistory:input_type -> pb.GetAcademicHistoryRequest
-	37, // 68: pb.ProfileService.UpdateAcademicHistory:input_type -> pb.UpdateAcademicHistoryRequest
-	38, // 69: pb.ProfileService.DeleteAcademicHistory:input_type -> pb.DeleteAcademicHistoryRequest
-	41, // 70: pb.ProfileService.CreateCompany:input_type -> pb.CreateCompanyRequest
-	42, // 71: pb.ProfileService.GetCompany:input_type -> pb.GetCompanyRequest
-	43, // 72: pb.ProfileService.GetAllCompanies:input_type -> pb.GetAllCompaniesRequest
-	46, // 73: pb.ProfileService.CreateDepartment:input_type -> pb.CreateDepartmentRequest
-	47, // 74: pb.ProfileService.GetDepartment:input_type -> pb.GetDepartmentRequest
-	48, // 75: pb.ProfileService.GetAllDepartments:input_type -> pb.GetAllDepartmentsRequest
-	52, // 76: pb.ProfileService.CreateJobHistory:input_type -> pb.CreateJobHistoryRequest
-	53, // 77: pb.ProfileService.GetJobHistory:input_type -> pb.GetJobHistoryRequest
-	54, // 78: pb.ProfileService.UpdateJobHistory:input_type -> pb.UpdateJobHistoryRequest
-	55, // 79: pb.ProfileService.DeleteJobHistory:input_type -> pb.DeleteJobHistoryRequest
-	0,  // 80: pb.ProfileService.CreateUser:output_type -> pb.User
-	0,  // 81: pb.ProfileService.GetUserByID:output_type -> pb.User
-	0,  // 82: pb.ProfileService.UpdateUser:output_type -> pb.User
-	5,  // 83: pb.ProfileService.DeleteUser:output_type -> pb.DeleteUserResponse
-	6,  // 84: pb.ProfileService.CreateCandidate:output_type -> pb.Candidate
-	9,  // 85: pb.ProfileService.GetAllCandidates:output_type -> pb.GetAllCandidatesResponse
-	0,  // 86: pb.ProfileService.GetCandidateByID:output_type -> pb.User
-	6,  // 87: pb.ProfileService.UpdateCandidate:output_type -> pb.Candidate
-	13, // 88: pb.ProfileService.DeleteCandidate:output_type -> pb.DeleteCandidateResponse
-	14, // 89: pb.ProfileService.CreateSkill:output_type -> pb.Skill
-	14, // 90: pb.ProfileService.GetSkill:output_type -> pb.Skill
-	18, // 91: pb.ProfileService.GetAllSkills:output_type -> pb.GetAllSkillsResponse
-	19, // 92: pb.ProfileService.CreateUserSkill:output_type -> pb.UserSkill
-	22, // 93: pb.ProfileService.DeleteUserSkill:output_type -> pb.DeleteUserSkillResponse
-	23, // 94: pb.ProfileService.CreateInstitution:output_type -> pb.Institution
-	23, // 95: pb.ProfileService.GetInstitution:output_type -> pb.Institution
-	27, // 96: pb.ProfileService.GetAllInstitutions:output_type -> pb.GetAllInstitutionsResponse
-	28, // 97: pb.ProfileService.CreateCourse:output_type -> pb.Course
-	28, // 98: pb.ProfileService.GetCourse:output_type -> pb.Course
-	32, // 99: pb.ProfileService.GetAllCourses:output_type -> pb.GetAllCoursesResponse
-	34, // 100: pb.ProfileService.CreateAcademicHistory:output_type -> pb.AcademicHistory
-	34, // 101: pb.ProfileService.GetAcademicHistory:output_type -> pb.AcademicHistory
-	34, // 102: pb.ProfileService.UpdateAcademicHistory:output_type -> pb.AcademicHistory
-	39, // 103: pb.ProfileService.DeleteAcademicHistory:output_type -> pb.DeleteAcademicHistoryResponse
-	40, // 104: pb.ProfileService.CreateCompany:output_type -> pb.Company
-	40, // 105: pb.ProfileService.GetCompany:output_type -> pb.Company
-	44, // 106: pb.ProfileService.GetAllCompanies:output_type -> pb.GetAllCompaniesResponse
-	45, // 107: pb.ProfileService.CreateDepartment:output_type -> pb.Department
-	45, // 108: pb.ProfileService.GetDepartment:output_type -> pb.Department
-	49, // 109: pb.ProfileService.GetAllDepartments:output_type -> pb.GetAllDepartmentsResponse
-	51, // 110: pb.ProfileService.CreateJobHistory:output_type -> pb.JobHistory
-	51, // 111: pb.ProfileService.GetJobHistory:output_type -> pb.JobHistory
-	51, // 112: pb.ProfileService.UpdateJobHistory:output_type -> pb.JobHistory
-	56, // 113: pb.ProfileService.DeleteJobHistory:output_type -> pb.DeleteJobHistoryResponse
-	80, // [80:114] is the sub-list for method output_type
-	46, // [46:80] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+
+// Deprecated: Use MatchFactor.ProtoReflect.Descriptor instead.
+func (*MatchFactor) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{62}
 }
 
-func init() { file_profile_proto_init() }
-func file_profile_proto_init() {
-	if File_profile_proto != nil {
-		return
+func (x *MatchFactor) GetFactor() string {
+	if x != nil {
+		return x.Factor
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
-			case 0:
-				return &v.state
-			case 1:
+	return ""
+}
+
+func (x *MatchFactor) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MatchFactor) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *MatchFactor) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId uint64         `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	JobPostId   uint64         `protobuf:"varint,2,opt,name=job_post_id,json=jobPostId,proto3" json:"job_post_id,omitempty"`
+	Score       float64        `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Factors     []*MatchFactor `protobuf:"bytes,4,rep,name=factors,proto3" json:"factors,omitempty"`
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Match) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *Match) GetJobPostId() uint64 {
+	if x != nil {
+		return x.JobPostId
+	}
+	return 0
+}
+
+func (x *Match) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Match) GetFactors() []*MatchFactor {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+type GetMatchingJobPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId uint64  `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Limit       uint64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	MinScore    float64 `protobuf:"fixed64,3,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+}
+
+func (x *GetMatchingJobPostsRequest) Reset() {
+	*x = GetMatchingJobPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchingJobPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchingJobPostsRequest) ProtoMessage() {}
+
+func (x *GetMatchingJobPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchingJobPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchingJobPostsRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetMatchingJobPostsRequest) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *GetMatchingJobPostsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMatchingJobPostsRequest) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+type GetMatchingCandidatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobPostId uint64  `protobuf:"varint,1,opt,name=job_post_id,json=jobPostId,proto3" json:"job_post_id,omitempty"`
+	Limit     uint64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	MinScore  float64 `protobuf:"fixed64,3,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+}
+
+func (x *GetMatchingCandidatesRequest) Reset() {
+	*x = GetMatchingCandidatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchingCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchingCandidatesRequest) ProtoMessage() {}
+
+func (x *GetMatchingCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchingCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchingCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetMatchingCandidatesRequest) GetJobPostId() uint64 {
+	if x != nil {
+		return x.JobPostId
+	}
+	return 0
+}
+
+func (x *GetMatchingCandidatesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMatchingCandidatesRequest) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+type GetMatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *GetMatchesResponse) Reset() {
+	*x = GetMatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchesResponse) ProtoMessage() {}
+
+func (x *GetMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type Shortlist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the recruiter that keeps the shortlist
+	UserId     uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Candidates []*ShortlistCandidate  `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Shares     []*ShortlistShare      `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Shortlist) Reset() {
+	*x = Shortlist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Shortlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortlist) ProtoMessage() {}
+
+func (x *Shortlist) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortlist.ProtoReflect.Descriptor instead.
+func (*Shortlist) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Shortlist) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Shortlist) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Shortlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Shortlist) GetCandidates() []*ShortlistCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Shortlist) GetShares() []*ShortlistShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *Shortlist) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Shortlist) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ShortlistCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortlistId uint64 `protobuf:"varint,2,opt,name=shortlist_id,json=shortlistId,proto3" json:"shortlist_id,omitempty"`
+	CandidateId uint64 `protobuf:"varint,3,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Notes       string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Profile of the candidate without contact details, only set when getting a single shortlist
+	User      *User                  `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ShortlistCandidate) Reset() {
+	*x = ShortlistCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortlistCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortlistCandidate) ProtoMessage() {}
+
+func (x *ShortlistCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortlistCandidate.ProtoReflect.Descriptor instead.
+func (*ShortlistCandidate) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ShortlistCandidate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortlistCandidate) GetShortlistId() uint64 {
+	if x != nil {
+		return x.ShortlistId
+	}
+	return 0
+}
+
+func (x *ShortlistCandidate) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *ShortlistCandidate) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ShortlistCandidate) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ShortlistCandidate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShortlistCandidate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ShortlistShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortlistId uint64 `protobuf:"varint,2,opt,name=shortlist_id,json=shortlistId,proto3" json:"shortlist_id,omitempty"`
+	// ID of the company user that the shortlist is shared with
+	UserId    uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ShortlistShare) Reset() {
+	*x = ShortlistShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortlistShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortlistShare) ProtoMessage() {}
+
+func (x *ShortlistShare) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortlistShare.ProtoReflect.Descriptor instead.
+func (*ShortlistShare) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ShortlistShare) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortlistShare) GetShortlistId() uint64 {
+	if x != nil {
+		return x.ShortlistId
+	}
+	return 0
+}
+
+func (x *ShortlistShare) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShortlistShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateShortlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shortlist *Shortlist `protobuf:"bytes,1,opt,name=shortlist,proto3" json:"shortlist,omitempty"`
+}
+
+func (x *CreateShortlistRequest) Reset() {
+	*x = CreateShortlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShortlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShortlistRequest) ProtoMessage() {}
+
+func (x *CreateShortlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShortlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateShortlistRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateShortlistRequest) GetShortlist() *Shortlist {
+	if x != nil {
+		return x.Shortlist
+	}
+	return nil
+}
+
+type GetAllShortlistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Shortlists of the user and shortlists shared with the user
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetAllShortlistsRequest) Reset() {
+	*x = GetAllShortlistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllShortlistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllShortlistsRequest) ProtoMessage() {}
+
+func (x *GetAllShortlistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllShortlistsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllShortlistsRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetAllShortlistsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetAllShortlistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shortlists []*Shortlist `protobuf:"bytes,1,rep,name=shortlists,proto3" json:"shortlists,omitempty"`
+}
+
+func (x *GetAllShortlistsResponse) Reset() {
+	*x = GetAllShortlistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllShortlistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllShortlistsResponse) ProtoMessage() {}
+
+func (x *GetAllShortlistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllShortlistsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllShortlistsResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetAllShortlistsResponse) GetShortlists() []*Shortlist {
+	if x != nil {
+		return x.Shortlists
+	}
+	return nil
+}
+
+type GetShortlistByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetShortlistByIDRequest) Reset() {
+	*x = GetShortlistByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShortlistByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortlistByIDRequest) ProtoMessage() {}
+
+func (x *GetShortlistByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortlistByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetShortlistByIDRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetShortlistByIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateShortlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Shortlist *Shortlist `protobuf:"bytes,2,opt,name=shortlist,proto3" json:"shortlist,omitempty"`
+}
+
+func (x *UpdateShortlistRequest) Reset() {
+	*x = UpdateShortlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShortlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShortlistRequest) ProtoMessage() {}
+
+func (x *UpdateShortlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShortlistRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShortlistRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateShortlistRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateShortlistRequest) GetShortlist() *Shortlist {
+	if x != nil {
+		return x.Shortlist
+	}
+	return nil
+}
+
+type DeleteShortlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteShortlistRequest) Reset() {
+	*x = DeleteShortlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteShortlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShortlistRequest) ProtoMessage() {}
+
+func (x *DeleteShortlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShortlistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShortlistRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteShortlistRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteShortlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteShortlistResponse) Reset() {
+	*x = DeleteShortlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteShortlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShortlistResponse) ProtoMessage() {}
+
+func (x *DeleteShortlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShortlistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShortlistResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{76}
+}
+
+type AddShortlistCandidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortlistId uint64 `protobuf:"varint,1,opt,name=shortlist_id,json=shortlistId,proto3" json:"shortlist_id,omitempty"`
+	CandidateId uint64 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Notes       string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *AddShortlistCandidateRequest) Reset() {
+	*x = AddShortlistCandidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddShortlistCandidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShortlistCandidateRequest) ProtoMessage() {}
+
+func (x *AddShortlistCandidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShortlistCandidateRequest.ProtoReflect.Descriptor instead.
+func (*AddShortlistCandidateRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AddShortlistCandidateRequest) GetShortlistId() uint64 {
+	if x != nil {
+		return x.ShortlistId
+	}
+	return 0
+}
+
+func (x *AddShortlistCandidateRequest) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *AddShortlistCandidateRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type RemoveShortlistCandidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortlistId uint64 `protobuf:"varint,1,opt,name=shortlist_id,json=shortlistId,proto3" json:"shortlist_id,omitempty"`
+	CandidateId uint64 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+}
+
+func (x *RemoveShortlistCandidateRequest) Reset() {
+	*x = RemoveShortlistCandidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveShortlistCandidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveShortlistCandidateRequest) ProtoMessage() {}
+
+func (x *RemoveShortlistCandidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveShortlistCandidateRequest.ProtoReflect.Descriptor instead.
+func (*RemoveShortlistCandidateRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RemoveShortlistCandidateRequest) GetShortlistId() uint64 {
+	if x != nil {
+		return x.ShortlistId
+	}
+	return 0
+}
+
+func (x *RemoveShortlistCandidateRequest) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+type RemoveShortlistCandidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveShortlistCandidateResponse) Reset() {
+	*x = RemoveShortlistCandidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveShortlistCandidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveShortlistCandidateResponse) ProtoMessage() {}
+
+func (x *RemoveShortlistCandidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveShortlistCandidateResponse.ProtoReflect.Descriptor instead.
+func (*RemoveShortlistCandidateResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{79}
+}
+
+type ShareShortlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortlistId uint64 `protobuf:"varint,1,opt,name=shortlist_id,json=shortlistId,proto3" json:"shortlist_id,omitempty"`
+	UserId      uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ShareShortlistRequest) Reset() {
+	*x = ShareShortlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareShortlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareShortlistRequest) ProtoMessage() {}
+
+func (x *ShareShortlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareShortlistRequest.ProtoReflect.Descriptor instead.
+func (*ShareShortlistRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ShareShortlistRequest) GetShortlistId() uint64 {
+	if x != nil {
+		return x.ShortlistId
+	}
+	return 0
+}
+
+func (x *ShareShortlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnshareShortlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortlistId uint64 `protobuf:"varint,1,opt,name=shortlist_id,json=shortlistId,proto3" json:"shortlist_id,omitempty"`
+	UserId      uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UnshareShortlistRequest) Reset() {
+	*x = UnshareShortlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnshareShortlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareShortlistRequest) ProtoMessage() {}
+
+func (x *UnshareShortlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareShortlistRequest.ProtoReflect.Descriptor instead.
+func (*UnshareShortlistRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UnshareShortlistRequest) GetShortlistId() uint64 {
+	if x != nil {
+		return x.ShortlistId
+	}
+	return 0
+}
+
+func (x *UnshareShortlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnshareShortlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnshareShortlistResponse) Reset() {
+	*x = UnshareShortlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnshareShortlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareShortlistResponse) ProtoMessage() {}
+
+func (x *UnshareShortlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareShortlistResponse.ProtoReflect.Descriptor instead.
+func (*UnshareShortlistResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{82}
+}
+
+type ContactConsent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CandidateId uint64 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	// ID of the company in the joblisting service that can see the personal details of the candidate
+	CompanyId uint64                 `protobuf:"varint,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ContactConsent) Reset() {
+	*x = ContactConsent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactConsent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactConsent) ProtoMessage() {}
+
+func (x *ContactConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactConsent.ProtoReflect.Descriptor instead.
+func (*ContactConsent) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ContactConsent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContactConsent) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *ContactConsent) GetCompanyId() uint64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *ContactConsent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GrantContactConsentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId uint64 `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	CompanyId   uint64 `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *GrantContactConsentRequest) Reset() {
+	*x = GrantContactConsentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantContactConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantContactConsentRequest) ProtoMessage() {}
+
+func (x *GrantContactConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantContactConsentRequest.ProtoReflect.Descriptor instead.
+func (*GrantContactConsentRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GrantContactConsentRequest) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *GrantContactConsentRequest) GetCompanyId() uint64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+type GetAllContactConsentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId uint64 `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+}
+
+func (x *GetAllContactConsentsRequest) Reset() {
+	*x = GetAllContactConsentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllContactConsentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllContactConsentsRequest) ProtoMessage() {}
+
+func (x *GetAllContactConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllContactConsentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllContactConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetAllContactConsentsRequest) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+type GetAllContactConsentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consents []*ContactConsent `protobuf:"bytes,1,rep,name=consents,proto3" json:"consents,omitempty"`
+}
+
+func (x *GetAllContactConsentsResponse) Reset() {
+	*x = GetAllContactConsentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllContactConsentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllContactConsentsResponse) ProtoMessage() {}
+
+func (x *GetAllContactConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllContactConsentsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllContactConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetAllContactConsentsResponse) GetConsents() []*ContactConsent {
+	if x != nil {
+		return x.Consents
+	}
+	return nil
+}
+
+type RevokeContactConsentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId uint64 `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	CompanyId   uint64 `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *RevokeContactConsentRequest) Reset() {
+	*x = RevokeContactConsentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeContactConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeContactConsentRequest) ProtoMessage() {}
+
+func (x *RevokeContactConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeContactConsentRequest.ProtoReflect.Descriptor instead.
+func (*RevokeContactConsentRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RevokeContactConsentRequest) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *RevokeContactConsentRequest) GetCompanyId() uint64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+type RevokeContactConsentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeContactConsentResponse) Reset() {
+	*x = RevokeContactConsentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeContactConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeContactConsentResponse) ProtoMessage() {}
+
+func (x *RevokeContactConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeContactConsentResponse.ProtoReflect.Descriptor instead.
+func (*RevokeContactConsentResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{88}
+}
+
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ExportMyDataRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type EraseUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EraseUserRequest) Reset() {
+	*x = EraseUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserRequest) ProtoMessage() {}
+
+func (x *EraseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserRequest.ProtoReflect.Descriptor instead.
+func (*EraseUserRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *EraseUserRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetErasureReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetErasureReceiptRequest) Reset() {
+	*x = GetErasureReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetErasureReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErasureReceiptRequest) ProtoMessage() {}
+
+func (x *GetErasureReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErasureReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetErasureReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetErasureReceiptRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// ErasureReceipt records that the data about a user was erased, without keeping any of it
+type ErasureReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Hex encoded SHA-256 of the email of the user, to answer whether an email was erased
+	EmailHash string `protobuf:"bytes,3,opt,name=email_hash,json=emailHash,proto3" json:"email_hash,omitempty"`
+	// ID of the user that requested the erasure
+	RequestedBy uint64 `protobuf:"varint,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// Number of records erased, by service and table
+	Records map[string]uint64 `protobuf:"bytes,5,rep,name=records,proto3" json:"records,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Third party systems that the user is queued to be deleted from
+	ExternalSystems []string               `protobuf:"bytes,6,rep,name=external_systems,json=externalSystems,proto3" json:"external_systems,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ErasureReceipt) Reset() {
+	*x = ErasureReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_profile_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErasureReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureReceipt) ProtoMessage() {}
+
+func (x *ErasureReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureReceipt.ProtoReflect.Descriptor instead.
+func (*ErasureReceipt) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ErasureReceipt) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ErasureReceipt) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ErasureReceipt) GetEmailHash() string {
+	if x != nil {
+		return x.EmailHash
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetRequestedBy() uint64 {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *ErasureReceipt) GetRecords() map[string]uint64 {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ErasureReceipt) GetExternalSystems() []string {
+	if x != nil {
+		return x.ExternalSystems
+	}
+	return nil
+}
+
+func (x *ErasureReceipt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_profile_proto protoreflect.FileDescriptor
+
+var file_profile_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68,
+	0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x04,
+	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6a, 0x6f, 0x62,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x22, 0x31, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x06, 0x0a, 0x09, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74,
+	0x79, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x61,
+	0x6c, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x61, 0x6c,
+	0x61, 0x72, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x61,
+	0x6c, 0x61, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x49, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x63, 0x6d, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6d, 0x55, 0x72,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x55,
+	0x72, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x64, 0x75,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x73,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x31,
+	0x0a, 0x09, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x73, 0x12, 0x22, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0xe0, 0x04,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x43,
+	0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61,
+	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x64, 0x75, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x73, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69,
+	0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x22, 0x8d, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x53, 0x43, 0x4d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x09, 0x53,
+	0x43, 0x4d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x43,
+	0x4d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x28, 0x0a,
+	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0xb7, 0x01, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x64,
+	0x65, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68,
+	0x69, 0x64, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x69, 0x64, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x65,
+	0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68,
+	0x69, 0x64, 0x65, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x22, 0x2b, 0x0a, 0x05, 0x53, 0x6b, 0x69,
+	0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05,
+	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x22, 0x21, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x39, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x06,
+	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x46, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c,
+	0x22, 0x56, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b,
+	0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x4d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x51, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x42, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x3d, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x11, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0xcb, 0x03, 0x0a,
+	0x0f, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x6f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x79, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x10, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x51, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x65, 0x73, 0x22, 0x30, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0xa4, 0x05, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x61, 0x6c,
+	0x61, 0x72, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x61, 0x6c,
+	0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2f, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x17, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
+	0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x62, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x4c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x66, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67,
+	0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67,
+	0x6f, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x6b, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6a, 0x6f, 0x62, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x72, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x71, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6a, 0x6f,
+	0x62, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x39, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x36, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x12, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x22, 0x0a,
+	0x20, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x53, 0x0a, 0x15, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1a, 0x0a,
+	0x18, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x1a, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5f, 0x0a,
+	0x1b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x22, 0x1e,
+	0x0a, 0x1c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x45, 0x72, 0x61, 0x73, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd8,
+	0x02, 0x0a, 0x0e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xf6, 0x2b, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x79, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x4b, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x1c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x1a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a,
+	0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x5f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x3b, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x65, 0x74, 0x53, 0x43, 0x4d, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x43, 0x4d,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x43, 0x4d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x67, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5d, 0x0a, 0x0c, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x16, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6b, 0x69, 0x6c, 0x6c,
+	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6b, 0x69, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b,
+	0x69, 0x6c, 0x6c, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0a, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x3a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x43,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6b, 0x69,
+	0x6c, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53,
+	0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
+	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x3a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x65, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x2a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22,
+	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x3a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x59, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x12, 0x7f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a,
+	0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69,
+	0x63, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x84, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x1a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x10, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1a, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x56, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x0d, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x3a, 0x07, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x12, 0x4c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x0f,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3a,
+	0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x66, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x62, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x6b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x1a, 0x15, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x62, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x3a, 0x0b, 0x6a, 0x6f, 0x62, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x6f,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12,
+	0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x62, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x7d, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x73, 0x3a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x64, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x09, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0x95, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e,
+	0x41, 0x64, 0x64, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x1a, 0x37, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa6, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x2a, 0x37, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x77, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x1a, 0x2e, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x55,
+	0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x30, 0x2a, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x13, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x3b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x1a, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x2a, 0x33,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x5a, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f,
+	0x64, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x55, 0x0a, 0x09, 0x45, 0x72, 0x61, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65,
+	0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x6a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x72, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x72, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_profile_proto_rawDescOnce sync.Once
+	file_profile_proto_rawDescData = file_profile_proto_rawDesc
+)
+
+func file_profile_proto_rawDescGZIP() []byte {
+	file_profile_proto_rawDescOnce.Do(func() {
+		file_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_profile_proto_rawDescData)
+	})
+	return file_profile_proto_rawDescData
+}
+
+var file_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_profile_proto_goTypes = []interface{}{
+	(*User)(nil),                             // 0: pb.User
+	(*CreateUserRequest)(nil),                // 1: pb.CreateUserRequest
+	(*GetUserByIDRequest)(nil),               // 2: pb.GetUserByIDRequest
+	(*UpdateUserRequest)(nil),                // 3: pb.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                // 4: pb.DeleteUserRequest
+	(*DeleteUserResponse)(nil),               // 5: pb.DeleteUserResponse
+	(*Candidate)(nil),                        // 6: pb.Candidate
+	(*CreateCandidateRequest)(nil),           // 7: pb.CreateCandidateRequest
+	(*GetAllCandidatesRequest)(nil),          // 8: pb.GetAllCandidatesRequest
+	(*GetAllCandidatesResponse)(nil),         // 9: pb.GetAllCandidatesResponse
+	(*GetCandidateByIDRequest)(nil),          // 10: pb.GetCandidateByIDRequest
+	(*GetSCMLoginsRequest)(nil),              // 11: pb.GetSCMLoginsRequest
+	(*SCMLogins)(nil),                        // 12: pb.SCMLogins
+	(*UpdateCandidateRequest)(nil),           // 13: pb.UpdateCandidateRequest
+	(*DeleteCandidateRequest)(nil),           // 14: pb.DeleteCandidateRequest
+	(*DeleteCandidateResponse)(nil),          // 15: pb.DeleteCandidateResponse
+	(*ImportResumeRequest)(nil),              // 16: pb.ImportResumeRequest
+	(*ExportCandidateProfileRequest)(nil),    // 17: pb.ExportCandidateProfileRequest
+	(*Skill)(nil),                            // 18: pb.Skill
+	(*CreateSkillRequest)(nil),               // 19: pb.CreateSkillRequest
+	(*GetSkillRequest)(nil),                  // 20: pb.GetSkillRequest
+	(*GetAllSkillsRequest)(nil),              // 21: pb.GetAllSkillsRequest
+	(*GetAllSkillsResponse)(nil),             // 22: pb.GetAllSkillsResponse
+	(*UserSkill)(nil),                        // 23: pb.UserSkill
+	(*CreateUserSkillRequest)(nil),           // 24: pb.CreateUserSkillRequest
+	(*DeleteUserSkillRequest)(nil),           // 25: pb.DeleteUserSkillRequest
+	(*DeleteUserSkillResponse)(nil),          // 26: pb.DeleteUserSkillResponse
+	(*Institution)(nil),                      // 27: pb.Institution
+	(*CreateInstitutionRequest)(nil),         // 28: pb.CreateInstitutionRequest
+	(*GetInstitutionRequest)(nil),            // 29: pb.GetInstitutionRequest
+	(*GetAllInstitutionsRequest)(nil),        // 30: pb.GetAllInstitutionsRequest
+	(*GetAllInstitutionsResponse)(nil),       // 31: pb.GetAllInstitutionsResponse
+	(*Course)(nil),                           // 32: pb.Course
+	(*CreateCourseRequest)(nil),              // 33: pb.CreateCourseRequest
+	(*GetCourseRequest)(nil),                 // 34: pb.GetCourseRequest
+	(*GetAllCoursesRequest)(nil),             // 35: pb.GetAllCoursesRequest
+	(*GetAllCoursesResponse)(nil),            // 36: pb.GetAllCoursesResponse
+	(*CourseInstitution)(nil),                // 37: pb.CourseInstitution
+	(*AcademicHistory)(nil),                  // 38: pb.AcademicHistory
+	(*CreateAcademicHistoryRequest)(nil),     // 39: pb.CreateAcademicHistoryRequest
+	(*GetAcademicHistoryRequest)(nil),        // 40: pb.GetAcademicHistoryRequest
+	(*UpdateAcademicHistoryRequest)(nil),     // 41: pb.UpdateAcademicHistoryRequest
+	(*DeleteAcademicHistoryRequest)(nil),     // 42: pb.DeleteAcademicHistoryRequest
+	(*DeleteAcademicHistoryResponse)(nil),    // 43: pb.DeleteAcademicHistoryResponse
+	(*Company)(nil),                          // 44: pb.Company
+	(*CreateCompanyRequest)(nil),             // 45: pb.CreateCompanyRequest
+	(*GetCompanyRequest)(nil),                // 46: pb.GetCompanyRequest
+	(*GetAllCompaniesRequest)(nil),           // 47: pb.GetAllCompaniesRequest
+	(*GetAllCompaniesResponse)(nil),          // 48: pb.GetAllCompaniesResponse
+	(*Department)(nil),                       // 49: pb.Department
+	(*CreateDepartmentRequest)(nil),          // 50: pb.CreateDepartmentRequest
+	(*GetDepartmentRequest)(nil),             // 51: pb.GetDepartmentRequest
+	(*GetAllDepartmentsRequest)(nil),         // 52: pb.GetAllDepartmentsRequest
+	(*GetAllDepartmentsResponse)(nil),        // 53: pb.GetAllDepartmentsResponse
+	(*CompanyDepartment)(nil),                // 54: pb.CompanyDepartment
+	(*JobHistory)(nil),                       // 55: pb.JobHistory
+	(*CreateJobHistoryRequest)(nil),          // 56: pb.CreateJobHistoryRequest
+	(*GetJobHistoryRequest)(nil),             // 57: pb.GetJobHistoryRequest
+	(*UpdateJobHistoryRequest)(nil),          // 58: pb.UpdateJobHistoryRequest
+	(*DeleteJobHistoryRequest)(nil),          // 59: pb.DeleteJobHistoryRequest
+	(*DeleteJobHistoryResponse)(nil),         // 60: pb.DeleteJobHistoryResponse
+	(*JoblistingCompany)(nil),                // 61: pb.JoblistingCompany
+	(*MatchFactor)(nil),                      // 62: pb.MatchFactor
+	(*Match)(nil),                            // 63: pb.Match
+	(*GetMatchingJobPostsRequest)(nil),       // 64: pb.GetMatchingJobPostsRequest
+	(*GetMatchingCandidatesRequest)(nil),     // 65: pb.GetMatchingCandidatesRequest
+	(*GetMatchesResponse)(nil),               // 66: pb.GetMatchesResponse
+	(*Shortlist)(nil),                        // 67: pb.Shortlist
+	(*ShortlistCandidate)(nil),               // 68: pb.ShortlistCandidate
+	(*ShortlistShare)(nil),                   // 69: pb.ShortlistShare
+	(*CreateShortlistRequest)(nil),           // 70: pb.CreateShortlistRequest
+	(*GetAllShortlistsRequest)(nil),          // 71: pb.GetAllShortlistsRequest
+	(*GetAllShortlistsResponse)(nil),         // 72: pb.GetAllShortlistsResponse
+	(*GetShortlistByIDRequest)(nil),          // 73: pb.GetShortlistByIDRequest
+	(*UpdateShortlistRequest)(nil),           // 74: pb.UpdateShortlistRequest
+	(*DeleteShortlistRequest)(nil),           // 75: pb.DeleteShortlistRequest
+	(*DeleteShortlistResponse)(nil),          // 76: pb.DeleteShortlistResponse
+	(*AddShortlistCandidateRequest)(nil),     // 77: pb.AddShortlistCandidateRequest
+	(*RemoveShortlistCandidateRequest)(nil),  // 78: pb.RemoveShortlistCandidateRequest
+	(*RemoveShortlistCandidateResponse)(nil), // 79: pb.RemoveShortlistCandidateResponse
+	(*ShareShortlistRequest)(nil),            // 80: pb.ShareShortlistRequest
+	(*UnshareShortlistRequest)(nil),          // 81: pb.UnshareShortlistRequest
+	(*UnshareShortlistResponse)(nil),         // 82: pb.UnshareShortlistResponse
+	(*ContactConsent)(nil),                   // 83: pb.ContactConsent
+	(*GrantContactConsentRequest)(nil),       // 84: pb.GrantContactConsentRequest
+	(*GetAllContactConsentsRequest)(nil),     // 85: pb.GetAllContactConsentsRequest
+	(*GetAllContactConsentsResponse)(nil),    // 86: pb.GetAllContactConsentsResponse
+	(*RevokeContactConsentRequest)(nil),      // 87: pb.RevokeContactConsentRequest
+	(*RevokeContactConsentResponse)(nil),     // 88: pb.RevokeContactConsentResponse
+	(*ExportMyDataRequest)(nil),              // 89: pb.ExportMyDataRequest
+	(*EraseUserRequest)(nil),                 // 90: pb.EraseUserRequest
+	(*GetErasureReceiptRequest)(nil),         // 91: pb.GetErasureReceiptRequest
+	(*ErasureReceipt)(nil),                   // 92: pb.ErasureReceipt
+	nil,                                      // 93: pb.SCMLogins.LoginsEntry
+	nil,                                      // 94: pb.ErasureReceipt.RecordsEntry
+	(*timestamppb.Timestamp)(nil),            // 95: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),                // 96: google.api.HttpBody
+}
+var file_profile_proto_depIdxs = []int32{
+	95,  // 0: pb.User.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 1: pb.User.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 2: pb.User.deleted_at:type_name -> google.protobuf.Timestamp
+	6,   // 3: pb.User.candidate:type_name -> pb.Candidate
+	61,  // 4: pb.User.job_company:type_name -> pb.JoblistingCompany
+	0,   // 5: pb.CreateUserRequest.user:type_name -> pb.User
+	0,   // 6: pb.UpdateUserRequest.user:type_name -> pb.User
+	95,  // 7: pb.Candidate.birthday:type_name -> google.protobuf.Timestamp
+	18,  // 8: pb.Candidate.skills:type_name -> pb.Skill
+	38,  // 9: pb.Candidate.academics:type_name -> pb.AcademicHistory
+	55,  // 10: pb.Candidate.jobs:type_name -> pb.JobHistory
+	95,  // 11: pb.Candidate.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 12: pb.Candidate.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 13: pb.Candidate.deleted_at:type_name -> google.protobuf.Timestamp
+	6,   // 14: pb.CreateCandidateRequest.candidate:type_name -> pb.Candidate
+	0,   // 15: pb.GetAllCandidatesResponse.candidates:type_name -> pb.User
+	93,  // 16: pb.SCMLogins.logins:type_name -> pb.SCMLogins.LoginsEntry
+	6,   // 17: pb.UpdateCandidateRequest.candidate:type_name -> pb.Candidate
+	18,  // 18: pb.CreateSkillRequest.skill:type_name -> pb.Skill
+	18,  // 19: pb.GetAllSkillsResponse.skills:type_name -> pb.Skill
+	95,  // 20: pb.UserSkill.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 21: pb.UserSkill.updated_at:type_name -> google.protobuf.Timestamp
+	23,  // 22: pb.CreateUserSkillRequest.user_skill:type_name -> pb.UserSkill
+	27,  // 23: pb.CreateInstitutionRequest.institution:type_name -> pb.Institution
+	27,  // 24: pb.GetAllInstitutionsResponse.institutions:type_name -> pb.Institution
+	32,  // 25: pb.CreateCourseRequest.course:type_name -> pb.Course
+	32,  // 26: pb.GetAllCoursesResponse.courses:type_name -> pb.Course
+	27,  // 27: pb.AcademicHistory.institution:type_name -> pb.Institution
+	32,  // 28: pb.AcademicHistory.course:type_name -> pb.Course
+	95,  // 29: pb.AcademicHistory.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 30: pb.AcademicHistory.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 31: pb.AcademicHistory.deleted_at:type_name -> google.protobuf.Timestamp
+	38,  // 32: pb.CreateAcademicHistoryRequest.academic_history:type_name -> pb.AcademicHistory
+	38,  // 33: pb.UpdateAcademicHistoryRequest.academic_history:type_name -> pb.AcademicHistory
+	44,  // 34: pb.CreateCompanyRequest.company:type_name -> pb.Company
+	44,  // 35: pb.GetAllCompaniesResponse.companies:type_name -> pb.Company
+	49,  // 36: pb.CreateDepartmentRequest.department:type_name -> pb.Department
+	49,  // 37: pb.GetAllDepartmentsResponse.departments:type_name -> pb.Department
+	44,  // 38: pb.JobHistory.company:type_name -> pb.Company
+	49,  // 39: pb.JobHistory.department:type_name -> pb.Department
+	95,  // 40: pb.JobHistory.start_date:type_name -> google.protobuf.Timestamp
+	95,  // 41: pb.JobHistory.end_date:type_name -> google.protobuf.Timestamp
+	95,  // 42: pb.JobHistory.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 43: pb.JobHistory.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 44: pb.JobHistory.deleted_at:type_name -> google.protobuf.Timestamp
+	55,  // 45: pb.CreateJobHistoryRequest.job_history:type_name -> pb.JobHistory
+	55,  // 46: pb.UpdateJobHistoryRequest.job_history:type_name -> pb.JobHistory
+	62,  // 47: pb.Match.factors:type_name -> pb.MatchFactor
+	63,  // 48: pb.GetMatchesResponse.matches:type_name -> pb.Match
+	68,  // 49: pb.Shortlist.candidates:type_name -> pb.ShortlistCandidate
+	69,  // 50: pb.Shortlist.shares:type_name -> pb.ShortlistShare
+	95,  // 51: pb.Shortlist.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 52: pb.Shortlist.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 53: pb.ShortlistCandidate.user:type_name -> pb.User
+	95,  // 54: pb.ShortlistCandidate.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 55: pb.ShortlistCandidate.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 56: pb.ShortlistShare.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 57: pb.CreateShortlistRequest.shortlist:type_name -> pb.Shortlist
+	67,  // 58: pb.GetAllShortlistsResponse.shortlists:type_name -> pb.Shortlist
+	67,  // 59: pb.UpdateShortlistRequest.shortlist:type_name -> pb.Shortlist
+	95,  // 60: pb.ContactConsent.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 61: pb.GetAllContactConsentsResponse.consents:type_name -> pb.ContactConsent
+	94,  // 62: pb.ErasureReceipt.records:type_name -> pb.ErasureReceipt.RecordsEntry
+	95,  // 63: pb.ErasureReceipt.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 64: pb.ProfileService.CreateUser:input_type -> pb.CreateUserRequest
+	2,   // 65: pb.ProfileService.GetUserByID:input_type -> pb.GetUserByIDRequest
+	3,   // 66: pb.ProfileService.UpdateUser:input_type -> pb.UpdateUserRequest
+	4,   // 67: pb.ProfileService.DeleteUser:input_type -> pb.DeleteUserRequest
+	7,   // 68: pb.ProfileService.CreateCandidate:input_type -> pb.CreateCandidateRequest
+	8,   // 69: pb.ProfileService.GetAllCandidates:input_type -> pb.GetAllCandidatesRequest
+	10,  // 70: pb.ProfileService.GetCandidateByID:input_type -> pb.GetCandidateByIDRequest
+	11,  // 71: pb.ProfileService.LocalGetSCMLogins:input_type -> pb.GetSCMLoginsRequest
+	13,  // 72: pb.ProfileService.UpdateCandidate:input_type -> pb.UpdateCandidateRequest
+	14,  // 73: pb.ProfileService.DeleteCandidate:input_type -> pb.DeleteCandidateRequest
+	16,  // 74: pb.ProfileService.ImportResume:input_type -> pb.ImportResumeRequest
+	17,  // 75: pb.ProfileService.ExportCandidateProfile:input_type -> pb.ExportCandidateProfileRequest
+	19,  // 76: pb.ProfileService.CreateSkill:input_type -> pb.CreateSkillRequest
+	20,  // 77: pb.ProfileService.GetSkill:input_type -> pb.GetSkillRequest
+	21,  // 78: pb.ProfileService.GetAllSkills:input_type -> pb.GetAllSkillsRequest
+	24,  // 79: pb.ProfileService.CreateUserSkill:input_type -> pb.CreateUserSkillRequest
+	25,  // 80: pb.ProfileService.DeleteUserSkill:input_type -> pb.DeleteUserSkillRequest
+	28,  // 81: pb.ProfileService.CreateInstitution:input_type -> pb.CreateInstitutionRequest
+	29,  // 82: pb.ProfileService.GetInstitution:input_type -> pb.GetInstitutionRequest
+	30,  // 83: pb.ProfileService.GetAllInstitutions:input_type -> pb.GetAllInstitutionsRequest
+	33,  // 84: pb.ProfileService.CreateCourse:input_type -> pb.CreateCourseRequest
+	34,  // 85: pb.ProfileService.GetCourse:input_type -> pb.GetCourseRequest
+	35,  // 86: pb.ProfileService.GetAllCourses:input_type -> pb.GetAllCoursesRequest
+	39,  // 87: pb.ProfileService.CreateAcademicHistory:input_type -> pb.CreateAcademicHistoryRequest
+	40,  // 88: pb.ProfileService.GetAcademicHistory:input_type -> pb.GetAcademicHistoryRequest
+	41,  // 89: pb.ProfileService.UpdateAcademicHistory:input_type -> pb.UpdateAcademicHistoryRequest
+	42,  // 90: pb.ProfileService.DeleteAcademicHistory:input_type -> pb.DeleteAcademicHistoryRequest
+	45,  // 91: pb.ProfileService.CreateCompany:input_type -> pb.CreateCompanyRequest
+	46,  // 92: pb.ProfileService.GetCompany:input_type -> pb.GetCompanyRequest
+	47,  // 93: pb.ProfileService.GetAllCompanies:input_type -> pb.GetAllCompaniesRequest
+	50,  // 94: pb.ProfileService.CreateDepartment:input_type -> pb.CreateDepartmentRequest
+	51,  // 95: pb.ProfileService.GetDepartment:input_type -> pb.GetDepartmentRequest
+	52,  // 96: pb.ProfileService.GetAllDepartments:input_type -> pb.GetAllDepartmentsRequest
+	56,  // 97: pb.ProfileService.CreateJobHistory:input_type -> pb.CreateJobHistoryRequest
+	57,  // 98: pb.ProfileService.GetJobHistory:input_type -> pb.GetJobHistoryRequest
+	58,  // 99: pb.ProfileService.UpdateJobHistory:input_type -> pb.UpdateJobHistoryRequest
+	59,  // 100: pb.ProfileService.DeleteJobHistory:input_type -> pb.DeleteJobHistoryRequest
+	64,  // 101: pb.ProfileService.GetMatchingJobPosts:input_type -> pb.GetMatchingJobPostsRequest
+	65,  // 102: pb.ProfileService.GetMatchingCandidates:input_type -> pb.GetMatchingCandidatesRequest
+	70,  // 103: pb.ProfileService.CreateShortlist:input_type -> pb.CreateShortlistRequest
+	71,  // 104: pb.ProfileService.GetAllShortlists:input_type -> pb.GetAllShortlistsRequest
+	73,  // 105: pb.ProfileService.GetShortlistByID:input_type -> pb.GetShortlistByIDRequest
+	74,  // 106: pb.ProfileService.UpdateShortlist:input_type -> pb.UpdateShortlistRequest
+	75,  // 107: pb.ProfileService.DeleteShortlist:input_type -> pb.DeleteShortlistRequest
+	77,  // 108: pb.ProfileService.AddShortlistCandidate:input_type -> pb.AddShortlistCandidateRequest
+	78,  // 109: pb.ProfileService.RemoveShortlistCandidate:input_type -> pb.RemoveShortlistCandidateRequest
+	80,  // 110: pb.ProfileService.ShareShortlist:input_type -> pb.ShareShortlistRequest
+	81,  // 111: pb.ProfileService.UnshareShortlist:input_type -> pb.UnshareShortlistRequest
+	84,  // 112: pb.ProfileService.GrantContactConsent:input_type -> pb.GrantContactConsentRequest
+	85,  // 113: pb.ProfileService.GetAllContactConsents:input_type -> pb.GetAllContactConsentsRequest
+	87,  // 114: pb.ProfileService.RevokeContactConsent:input_type -> pb.RevokeContactConsentRequest
+	89,  // 115: pb.ProfileService.ExportMyData:input_type -> pb.ExportMyDataRequest
+	90,  // 116: pb.ProfileService.EraseUser:input_type -> pb.EraseUserRequest
+	91,  // 117: pb.ProfileService.GetErasureReceipt:input_type -> pb.GetErasureReceiptRequest
+	0,   // 118: pb.ProfileService.CreateUser:output_type -> pb.User
+	0,   // 119: pb.ProfileService.GetUserByID:output_type -> pb.User
+	0,   // 120: pb.ProfileService.UpdateUser:output_type -> pb.User
+	5,   // 121: pb.ProfileService.DeleteUser:output_type -> pb.DeleteUserResponse
+	6,   // 122: pb.ProfileService.CreateCandidate:output_type -> pb.Candidate
+	9,   // 123: pb.ProfileService.GetAllCandidates:output_type -> pb.GetAllCandidatesResponse
+	0,   // 124: pb.ProfileService.GetCandidateByID:output_type -> pb.User
+	12,  // 125: pb.ProfileService.LocalGetSCMLogins:output_type -> pb.SCMLogins
+	6,   // 126: pb.ProfileService.UpdateCandidate:output_type -> pb.Candidate
+	15,  // 127: pb.ProfileService.DeleteCandidate:output_type -> pb.DeleteCandidateResponse
+	6,   // 128: pb.ProfileService.ImportResume:output_type -> pb.Candidate
+	96,  // 129: pb.ProfileService.ExportCandidateProfile:output_type -> google.api.HttpBody
+	18,  // 130: pb.ProfileService.CreateSkill:output_type -> pb.Skill
+	18,  // 131: pb.ProfileService.GetSkill:output_type -> pb.Skill
+	22,  // 132: pb.ProfileService.GetAllSkills:output_type -> pb.GetAllSkillsResponse
+	23,  // 133: pb.ProfileService.CreateUserSkill:output_type -> pb.UserSkill
+	26,  // 134: pb.ProfileService.DeleteUserSkill:output_type -> pb.DeleteUserSkillResponse
+	27,  // 135: pb.ProfileService.CreateInstitution:output_type -> pb.Institution
+	27,  // 136: pb.ProfileService.GetInstitution:output_type -> pb.Institution
+	31,  // 137: pb.ProfileService.GetAllInstitutions:output_type -> pb.GetAllInstitutionsResponse
+	32,  // 138: pb.ProfileService.CreateCourse:output_type -> pb.Course
+	32,  // 139: pb.ProfileService.GetCourse:output_type -> pb.Course
+	36,  // 140: pb.ProfileService.GetAllCourses:output_type -> pb.GetAllCoursesResponse
+	38,  // 141: pb.ProfileService.CreateAcademicHistory:output_type -> pb.AcademicHistory
+	38,  // 142: pb.ProfileService.GetAcademicHistory:output_type -> pb.AcademicHistory
+	38,  // 143: pb.ProfileService.UpdateAcademicHistory:output_type -> pb.AcademicHistory
+	43,  // 144: pb.ProfileService.DeleteAcademicHistory:output_type -> pb.DeleteAcademicHistoryResponse
+	44,  // 145: pb.ProfileService.CreateCompany:output_type -> pb.Company
+	44,  // 146: pb.ProfileService.GetCompany:output_type -> pb.Company
+	48,  // 147: pb.ProfileService.GetAllCompanies:output_type -> pb.GetAllCompaniesResponse
+	49,  // 148: pb.ProfileService.CreateDepartment:output_type -> pb.Department
+	49,  // 149: pb.ProfileService.GetDepartment:output_type -> pb.Department
+	53,  // 150: pb.ProfileService.GetAllDepartments:output_type -> pb.GetAllDepartmentsResponse
+	55,  // 151: pb.ProfileService.CreateJobHistory:output_type -> pb.JobHistory
+	55,  // 152: pb.ProfileService.GetJobHistory:output_type -> pb.JobHistory
+	55,  // 153: pb.ProfileService.UpdateJobHistory:output_type -> pb.JobHistory
+	60,  // 154: pb.ProfileService.DeleteJobHistory:output_type -> pb.DeleteJobHistoryResponse
+	66,  // 155: pb.ProfileService.GetMatchingJobPosts:output_type -> pb.GetMatchesResponse
+	66,  // 156: pb.ProfileService.GetMatchingCandidates:output_type -> pb.GetMatchesResponse
+	67,  // 157: pb.ProfileService.CreateShortlist:output_type -> pb.Shortlist
+	72,  // 158: pb.ProfileService.GetAllShortlists:output_type -> pb.GetAllShortlistsResponse
+	67,  // 159: pb.ProfileService.GetShortlistByID:output_type -> pb.Shortlist
+	67,  // 160: pb.ProfileService.UpdateShortlist:output_type -> pb.Shortlist
+	76,  // 161: pb.ProfileService.DeleteShortlist:output_type -> pb.DeleteShortlistResponse
+	68,  // 162: pb.ProfileService.AddShortlistCandidate:output_type -> pb.ShortlistCandidate
+	79,  // 163: pb.ProfileService.RemoveShortlistCandidate:output_type -> pb.RemoveShortlistCandidateResponse
+	69,  // 164: pb.ProfileService.ShareShortlist:output_type -> pb.ShortlistShare
+	82,  // 165: pb.ProfileService.UnshareShortlist:output_type -> pb.UnshareShortlistResponse
+	83,  // 166: pb.ProfileService.GrantContactConsent:output_type -> pb.ContactConsent
+	86,  // 167: pb.ProfileService.GetAllContactConsents:output_type -> pb.GetAllContactConsentsResponse
+	88,  // 168: pb.ProfileService.RevokeContactConsent:output_type -> pb.RevokeContactConsentResponse
+	96,  // 169: pb.ProfileService.ExportMyData:output_type -> google.api.HttpBody
+	92,  // 170: pb.ProfileService.EraseUser:output_type -> pb.ErasureReceipt
+	92,  // 171: pb.ProfileService.GetErasureReceipt:output_type -> pb.ErasureReceipt
+	118, // [118:172] is the sub-list for method output_type
+	64,  // [64:118] is the sub-list for method input_type
+	64,  // [64:64] is the sub-list for extension type_name
+	64,  // [64:64] is the sub-list for extension extendee
+	0,   // [0:64] is the sub-list for field type_name
+}
+
+func init() { file_profile_proto_init() }
+func file_profile_proto_init() {
+	if File_profile_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCandidatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCandidatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandidateByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSCMLoginsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCMLogins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCandidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCandidateProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Skill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSkillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSkillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllSkillsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllSkillsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSkill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserSkillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserSkillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -4315,8 +7188,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+		file_profile_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserSkillResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4327,8 +7200,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserByIDRequest); i {
+		file_profile_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Institution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4339,8 +7212,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserRequest); i {
+		file_profile_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInstitutionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4351,8 +7224,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserRequest); i {
+		file_profile_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstitutionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4363,8 +7236,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserResponse); i {
+		file_profile_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllInstitutionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4375,8 +7248,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Candidate); i {
+		file_profile_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllInstitutionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4387,8 +7260,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCandidateRequest); i {
+		file_profile_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4399,8 +7272,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCandidatesRequest); i {
+		file_profile_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCourseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4411,8 +7284,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCandidatesResponse); i {
+		file_profile_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCourseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4423,8 +7296,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCandidateByIDRequest); i {
+		file_profile_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCoursesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4435,8 +7308,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCandidateRequest); i {
+		file_profile_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCoursesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4447,8 +7320,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCandidateRequest); i {
+		file_profile_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseInstitution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4459,8 +7332,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCandidateResponse); i {
+		file_profile_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcademicHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4471,8 +7344,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Skill); i {
+		file_profile_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAcademicHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4483,8 +7356,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSkillRequest); i {
+		file_profile_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAcademicHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4495,8 +7368,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSkillRequest); i {
+		file_profile_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAcademicHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4507,8 +7380,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllSkillsRequest); i {
+		file_profile_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAcademicHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4519,8 +7392,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllSkillsResponse); i {
+		file_profile_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAcademicHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4531,8 +7404,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSkill); i {
+		file_profile_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Company); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4543,8 +7416,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserSkillRequest); i {
+		file_profile_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompanyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4555,8 +7428,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserSkillRequest); i {
+		file_profile_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompanyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4567,8 +7440,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserSkillResponse); i {
+		file_profile_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCompaniesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4579,8 +7452,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Institution); i {
+		file_profile_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCompaniesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4591,8 +7464,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInstitutionRequest); i {
+		file_profile_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Department); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4603,8 +7476,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstitutionRequest); i {
+		file_profile_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDepartmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4615,8 +7488,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllInstitutionsRequest); i {
+		file_profile_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepartmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4627,8 +7500,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllInstitutionsResponse); i {
+		file_profile_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllDepartmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4639,8 +7512,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+		file_profile_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllDepartmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4651,8 +7524,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCourseRequest); i {
+		file_profile_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompanyDepartment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4663,8 +7536,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCourseRequest); i {
+		file_profile_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4675,8 +7548,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCoursesRequest); i {
+		file_profile_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4687,8 +7560,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCoursesResponse); i {
+		file_profile_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4699,8 +7572,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseInstitution); i {
+		file_profile_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4711,8 +7584,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcademicHistory); i {
+		file_profile_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4723,8 +7596,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAcademicHistoryRequest); i {
+		file_profile_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4735,8 +7608,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAcademicHistoryRequest); i {
+		file_profile_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoblistingCompany); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4747,8 +7620,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAcademicHistoryRequest); i {
+		file_profile_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchFactor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4759,8 +7632,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAcademicHistoryRequest); i {
+		file_profile_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Match); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4771,8 +7644,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAcademicHistoryResponse); i {
+		file_profile_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMatchingJobPostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4783,8 +7656,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Company); i {
+		file_profile_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMatchingCandidatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4795,8 +7668,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCompanyRequest); i {
+		file_profile_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMatchesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4807,8 +7680,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompanyRequest); i {
+		file_profile_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shortlist); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4819,8 +7692,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCompaniesRequest); i {
+		file_profile_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortlistCandidate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4831,8 +7704,128 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCompaniesResponse); i {
+		file_profile_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortlistShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShortlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllShortlistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllShortlistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShortlistByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateShortlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShortlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShortlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddShortlistCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveShortlistCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_profile_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveShortlistCandidateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4843,8 +7836,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Department); i {
+		file_profile_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareShortlistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4855,8 +7848,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDepartmentRequest); i {
+		file_profile_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareShortlistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4867,8 +7860,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDepartmentRequest); i {
+		file_profile_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareShortlistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4879,8 +7872,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllDepartmentsRequest); i {
+		file_profile_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactConsent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4891,8 +7884,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllDepartmentsResponse); i {
+		file_profile_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantContactConsentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4903,8 +7896,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompanyDepartment); i {
+		file_profile_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllContactConsentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4915,8 +7908,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobHistory); i {
+		file_profile_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllContactConsentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4927,8 +7920,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJobHistoryRequest); i {
+		file_profile_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeContactConsentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4939,8 +7932,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobHistoryRequest); i {
+		file_profile_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeContactConsentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4951,8 +7944,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobHistoryRequest); i {
+		file_profile_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMyDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4963,8 +7956,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobHistoryRequest); i {
+		file_profile_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4975,8 +7968,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobHistoryResponse); i {
+		file_profile_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetErasureReceiptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4987,8 +7980,8 @@ func file_profile_proto_init() {
 				return nil
 			}
 		}
-		file_profile_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoblistingCompany); i {
+		file_profile_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErasureReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5000,1145 +7993,1785 @@ func file_profile_proto_init() {
 			}
 		}
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_profile_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   58,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_profile_proto_goTypes,
-		DependencyIndexes: file_profile_proto_depIdxs,
-		MessageInfos:      file_profile_proto_msgTypes,
-	}.Build()
-	File_profile_proto = out.File
-	file_profile_proto_rawDesc = nil
-	file_profile_proto_goTypes = nil
-	file_profile_proto_depIdxs = nil
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_profile_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   95,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_profile_proto_goTypes,
+		DependencyIndexes: file_profile_proto_depIdxs,
+		MessageInfos:      file_profile_proto_msgTypes,
+	}.Build()
+	File_profile_proto = out.File
+	file_profile_proto_rawDesc = nil
+	file_profile_proto_goTypes = nil
+	file_profile_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ProfileServiceClient is the client API for ProfileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProfileServiceClient interface {
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	GetUserByID(ctx context.Context, in *GetUserByIDRequest, opts ...grpc.CallOption) (*User, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	CreateCandidate(ctx context.Context, in *CreateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error)
+	GetAllCandidates(ctx context.Context, in *GetAllCandidatesRequest, opts ...grpc.CallOption) (*GetAllCandidatesResponse, error)
+	GetCandidateByID(ctx context.Context, in *GetCandidateByIDRequest, opts ...grpc.CallOption) (*User, error)
+	LocalGetSCMLogins(ctx context.Context, in *GetSCMLoginsRequest, opts ...grpc.CallOption) (*SCMLogins, error)
+	UpdateCandidate(ctx context.Context, in *UpdateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error)
+	DeleteCandidate(ctx context.Context, in *DeleteCandidateRequest, opts ...grpc.CallOption) (*DeleteCandidateResponse, error)
+	ImportResume(ctx context.Context, in *ImportResumeRequest, opts ...grpc.CallOption) (*Candidate, error)
+	ExportCandidateProfile(ctx context.Context, in *ExportCandidateProfileRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*Skill, error)
+	GetSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*Skill, error)
+	GetAllSkills(ctx context.Context, in *GetAllSkillsRequest, opts ...grpc.CallOption) (*GetAllSkillsResponse, error)
+	CreateUserSkill(ctx context.Context, in *CreateUserSkillRequest, opts ...grpc.CallOption) (*UserSkill, error)
+	DeleteUserSkill(ctx context.Context, in *DeleteUserSkillRequest, opts ...grpc.CallOption) (*DeleteUserSkillResponse, error)
+	CreateInstitution(ctx context.Context, in *CreateInstitutionRequest, opts ...grpc.CallOption) (*Institution, error)
+	GetInstitution(ctx context.Context, in *GetInstitutionRequest, opts ...grpc.CallOption) (*Institution, error)
+	GetAllInstitutions(ctx context.Context, in *GetAllInstitutionsRequest, opts ...grpc.CallOption) (*GetAllInstitutionsResponse, error)
+	CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	GetAllCourses(ctx context.Context, in *GetAllCoursesRequest, opts ...grpc.CallOption) (*GetAllCoursesResponse, error)
+	CreateAcademicHistory(ctx context.Context, in *CreateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error)
+	GetAcademicHistory(ctx context.Context, in *GetAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error)
+	UpdateAcademicHistory(ctx context.Context, in *UpdateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error)
+	DeleteAcademicHistory(ctx context.Context, in *DeleteAcademicHistoryRequest, opts ...grpc.CallOption) (*DeleteAcademicHistoryResponse, error)
+	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	GetAllCompanies(ctx context.Context, in *GetAllCompaniesRequest, opts ...grpc.CallOption) (*GetAllCompaniesResponse, error)
+	CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	GetAllDepartments(ctx context.Context, in *GetAllDepartmentsRequest, opts ...grpc.CallOption) (*GetAllDepartmentsResponse, error)
+	CreateJobHistory(ctx context.Context, in *CreateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error)
+	GetJobHistory(ctx context.Context, in *GetJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error)
+	UpdateJobHistory(ctx context.Context, in *UpdateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error)
+	DeleteJobHistory(ctx context.Context, in *DeleteJobHistoryRequest, opts ...grpc.CallOption) (*DeleteJobHistoryResponse, error)
+	GetMatchingJobPosts(ctx context.Context, in *GetMatchingJobPostsRequest, opts ...grpc.CallOption) (*GetMatchesResponse, error)
+	GetMatchingCandidates(ctx context.Context, in *GetMatchingCandidatesRequest, opts ...grpc.CallOption) (*GetMatchesResponse, error)
+	CreateShortlist(ctx context.Context, in *CreateShortlistRequest, opts ...grpc.CallOption) (*Shortlist, error)
+	GetAllShortlists(ctx context.Context, in *GetAllShortlistsRequest, opts ...grpc.CallOption) (*GetAllShortlistsResponse, error)
+	GetShortlistByID(ctx context.Context, in *GetShortlistByIDRequest, opts ...grpc.CallOption) (*Shortlist, error)
+	UpdateShortlist(ctx context.Context, in *UpdateShortlistRequest, opts ...grpc.CallOption) (*Shortlist, error)
+	DeleteShortlist(ctx context.Context, in *DeleteShortlistRequest, opts ...grpc.CallOption) (*DeleteShortlistResponse, error)
+	AddShortlistCandidate(ctx context.Context, in *AddShortlistCandidateRequest, opts ...grpc.CallOption) (*ShortlistCandidate, error)
+	RemoveShortlistCandidate(ctx context.Context, in *RemoveShortlistCandidateRequest, opts ...grpc.CallOption) (*RemoveShortlistCandidateResponse, error)
+	ShareShortlist(ctx context.Context, in *ShareShortlistRequest, opts ...grpc.CallOption) (*ShortlistShare, error)
+	UnshareShortlist(ctx context.Context, in *UnshareShortlistRequest, opts ...grpc.CallOption) (*UnshareShortlistResponse, error)
+	GrantContactConsent(ctx context.Context, in *GrantContactConsentRequest, opts ...grpc.CallOption) (*ContactConsent, error)
+	GetAllContactConsents(ctx context.Context, in *GetAllContactConsentsRequest, opts ...grpc.CallOption) (*GetAllContactConsentsResponse, error)
+	RevokeContactConsent(ctx context.Context, in *RevokeContactConsentRequest, opts ...grpc.CallOption) (*RevokeContactConsentResponse, error)
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureReceipt, error)
+	GetErasureReceipt(ctx context.Context, in *GetErasureReceiptRequest, opts ...grpc.CallOption) (*ErasureReceipt, error)
+}
+
+type profileServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfileServiceClient(cc grpc.ClientConnInterface) ProfileServiceClient {
+	return &profileServiceClient{cc}
+}
+
+func (c *profileServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetUserByID(ctx context.Context, in *GetUserByIDRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetUserByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateCandidate(ctx context.Context, in *CreateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error) {
+	out := new(Candidate)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetAllCandidates(ctx context.Context, in *GetAllCandidatesRequest, opts ...grpc.CallOption) (*GetAllCandidatesResponse, error) {
+	out := new(GetAllCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetCandidateByID(ctx context.Context, in *GetCandidateByIDRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetCandidateByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) LocalGetSCMLogins(ctx context.Context, in *GetSCMLoginsRequest, opts ...grpc.CallOption) (*SCMLogins, error) {
+	out := new(SCMLogins)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/LocalGetSCMLogins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateCandidate(ctx context.Context, in *UpdateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error) {
+	out := new(Candidate)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteCandidate(ctx context.Context, in *DeleteCandidateRequest, opts ...grpc.CallOption) (*DeleteCandidateResponse, error) {
+	out := new(DeleteCandidateResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ImportResume(ctx context.Context, in *ImportResumeRequest, opts ...grpc.CallOption) (*Candidate, error) {
+	out := new(Candidate)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/ImportResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ExportCandidateProfile(ctx context.Context, in *ExportCandidateProfileRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/ExportCandidateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
+	out := new(Skill)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateSkill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
+	out := new(Skill)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetSkill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetAllSkills(ctx context.Context, in *GetAllSkillsRequest, opts ...grpc.CallOption) (*GetAllSkillsResponse, error) {
+	out := new(GetAllSkillsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllSkills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateUserSkill(ctx context.Context, in *CreateUserSkillRequest, opts ...grpc.CallOption) (*UserSkill, error) {
+	out := new(UserSkill)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateUserSkill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteUserSkill(ctx context.Context, in *DeleteUserSkillRequest, opts ...grpc.CallOption) (*DeleteUserSkillResponse, error) {
+	out := new(DeleteUserSkillResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteUserSkill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateInstitution(ctx context.Context, in *CreateInstitutionRequest, opts ...grpc.CallOption) (*Institution, error) {
+	out := new(Institution)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateInstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetInstitution(ctx context.Context, in *GetInstitutionRequest, opts ...grpc.CallOption) (*Institution, error) {
+	out := new(Institution)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetInstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (c *profileServiceClient) GetAllInstitutions(ctx context.Context, in *GetAllInstitutionsRequest, opts ...grpc.CallOption) (*GetAllInstitutionsResponse, error) {
+	out := new(GetAllInstitutionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllInstitutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (c *profileServiceClient) CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// ProfileServiceClient is the client API for ProfileService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProfileServiceClient interface {
-	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
-	GetUserByID(ctx context.Context, in *GetUserByIDRequest, opts ...grpc.CallOption) (*User, error)
-	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
-	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
-	CreateCandidate(ctx context.Context, in *CreateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error)
-	GetAllCandidates(ctx context.Context, in *GetAllCandidatesRequest, opts ...grpc.CallOption) (*GetAllCandidatesResponse, error)
-	GetCandidateByID(ctx context.Context, in *GetCandidateByIDRequest, opts ...grpc.CallOption) (*User, error)
-	UpdateCandidate(ctx context.Context, in *UpdateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error)
-	DeleteCandidate(ctx context.Context, in *DeleteCandidateRequest, opts ...grpc.CallOption) (*DeleteCandidateResponse, error)
-	CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*Skill, error)
-	GetSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*Skill, error)
-	GetAllSkills(ctx context.Context, in *GetAllSkillsRequest, opts ...grpc.CallOption) (*GetAllSkillsResponse, error)
-	CreateUserSkill(ctx context.Context, in *CreateUserSkillRequest, opts ...grpc.CallOption) (*UserSkill, error)
-	DeleteUserSkill(ctx context.Context, in *DeleteUserSkillRequest, opts ...grpc.CallOption) (*DeleteUserSkillResponse, error)
-	CreateInstitution(ctx context.Context, in *CreateInstitutionRequest, opts ...grpc.CallOption) (*Institution, error)
-	GetInstitution(ctx context.Context, in *GetInstitutionRequest, opts ...grpc.CallOption) (*Institution, error)
-	GetAllInstitutions(ctx context.Context, in *GetAllInstitutionsRequest, opts ...grpc.CallOption) (*GetAllInstitutionsResponse, error)
-	CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error)
-	GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Course, error)
-	GetAllCourses(ctx context.Context, in *GetAllCoursesRequest, opts ...grpc.CallOption) (*GetAllCoursesResponse, error)
-	CreateAcademicHistory(ctx context.Context, in *CreateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error)
-	GetAcademicHistory(ctx context.Context, in *GetAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error)
-	UpdateAcademicHistory(ctx context.Context, in *UpdateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error)
-	DeleteAcademicHistory(ctx context.Context, in *DeleteAcademicHistoryRequest, opts ...grpc.CallOption) (*DeleteAcademicHistoryResponse, error)
-	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error)
-	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error)
-	GetAllCompanies(ctx context.Context, in *GetAllCompaniesRequest, opts ...grpc.CallOption) (*GetAllCompaniesResponse, error)
-	CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
-	GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
-	GetAllDepartments(ctx context.Context, in *GetAllDepartmentsRequest, opts ...grpc.CallOption) (*GetAllDepartmentsResponse, error)
-	CreateJobHistory(ctx context.Context, in *CreateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error)
-	GetJobHistory(ctx context.Context, in *GetJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error)
-	UpdateJobHistory(ctx context.Context, in *UpdateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error)
-	DeleteJobHistory(ctx context.Context, in *DeleteJobHistoryRequest, opts ...grpc.CallOption) (*DeleteJobHistoryResponse, error)
+func (c *profileServiceClient) GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type profileServiceClient struct {
-	cc grpc.ClientConnInterface
+func (c *profileServiceClient) GetAllCourses(ctx context.Context, in *GetAllCoursesRequest, opts ...grpc.CallOption) (*GetAllCoursesResponse, error) {
+	out := new(GetAllCoursesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllCourses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewProfileServiceClient(cc grpc.ClientConnInterface) ProfileServiceClient {
-	return &profileServiceClient{cc}
+func (c *profileServiceClient) CreateAcademicHistory(ctx context.Context, in *CreateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error) {
+	out := new(AcademicHistory)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateAcademicHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *profileServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateUser", in, out, opts...)
+func (c *profileServiceClient) GetAcademicHistory(ctx context.Context, in *GetAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error) {
+	out := new(AcademicHistory)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAcademicHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) GetUserByID(ctx context.Context, in *GetUserByIDRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetUserByID", in, out, opts...)
+func (c *profileServiceClient) UpdateAcademicHistory(ctx context.Context, in *UpdateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error) {
+	out := new(AcademicHistory)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateAcademicHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteAcademicHistory(ctx context.Context, in *DeleteAcademicHistoryRequest, opts ...grpc.CallOption) (*DeleteAcademicHistoryResponse, error) {
+	out := new(DeleteAcademicHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteAcademicHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	out := new(Company)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	out := new(Company)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetAllCompanies(ctx context.Context, in *GetAllCompaniesRequest, opts ...grpc.CallOption) (*GetAllCompaniesResponse, error) {
+	out := new(GetAllCompaniesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllCompanies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	out := new(Department)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateDepartment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	out := new(Department)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetDepartment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetAllDepartments(ctx context.Context, in *GetAllDepartmentsRequest, opts ...grpc.CallOption) (*GetAllDepartmentsResponse, error) {
+	out := new(GetAllDepartmentsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllDepartments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateJobHistory(ctx context.Context, in *CreateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error) {
+	out := new(JobHistory)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateJobHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetJobHistory(ctx context.Context, in *GetJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error) {
+	out := new(JobHistory)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetJobHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateJobHistory(ctx context.Context, in *UpdateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error) {
+	out := new(JobHistory)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateJobHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteJobHistory(ctx context.Context, in *DeleteJobHistoryRequest, opts ...grpc.CallOption) (*DeleteJobHistoryResponse, error) {
+	out := new(DeleteJobHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteJobHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetMatchingJobPosts(ctx context.Context, in *GetMatchingJobPostsRequest, opts ...grpc.CallOption) (*GetMatchesResponse, error) {
+	out := new(GetMatchesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetMatchingJobPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetMatchingCandidates(ctx context.Context, in *GetMatchingCandidatesRequest, opts ...grpc.CallOption) (*GetMatchesResponse, error) {
+	out := new(GetMatchesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetMatchingCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateShortlist(ctx context.Context, in *CreateShortlistRequest, opts ...grpc.CallOption) (*Shortlist, error) {
+	out := new(Shortlist)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateShortlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetAllShortlists(ctx context.Context, in *GetAllShortlistsRequest, opts ...grpc.CallOption) (*GetAllShortlistsResponse, error) {
+	out := new(GetAllShortlistsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllShortlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetShortlistByID(ctx context.Context, in *GetShortlistByIDRequest, opts ...grpc.CallOption) (*Shortlist, error) {
+	out := new(Shortlist)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetShortlistByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateShortlist(ctx context.Context, in *UpdateShortlistRequest, opts ...grpc.CallOption) (*Shortlist, error) {
+	out := new(Shortlist)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateShortlist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateUser", in, out, opts...)
+func (c *profileServiceClient) DeleteShortlist(ctx context.Context, in *DeleteShortlistRequest, opts ...grpc.CallOption) (*DeleteShortlistResponse, error) {
+	out := new(DeleteShortlistResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteShortlist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
-	out := new(DeleteUserResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteUser", in, out, opts...)
+func (c *profileServiceClient) AddShortlistCandidate(ctx context.Context, in *AddShortlistCandidateRequest, opts ...grpc.CallOption) (*ShortlistCandidate, error) {
+	out := new(ShortlistCandidate)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/AddShortlistCandidate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) CreateCandidate(ctx context.Context, in *CreateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error) {
-	out := new(Candidate)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateCandidate", in, out, opts...)
+func (c *profileServiceClient) RemoveShortlistCandidate(ctx context.Context, in *RemoveShortlistCandidateRequest, opts ...grpc.CallOption) (*RemoveShortlistCandidateResponse, error) {
+	out := new(RemoveShortlistCandidateResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/RemoveShortlistCandidate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) GetAllCandidates(ctx context.Context, in *GetAllCandidatesRequest, opts ...grpc.CallOption) (*GetAllCandidatesResponse, error) {
-	out := new(GetAllCandidatesResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllCandidates", in, out, opts...)
+func (c *profileServiceClient) ShareShortlist(ctx context.Context, in *ShareShortlistRequest, opts ...grpc.CallOption) (*ShortlistShare, error) {
+	out := new(ShortlistShare)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/ShareShortlist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) GetCandidateByID(ctx context.Context, in *GetCandidateByIDRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetCandidateByID", in, out, opts...)
+func (c *profileServiceClient) UnshareShortlist(ctx context.Context, in *UnshareShortlistRequest, opts ...grpc.CallOption) (*UnshareShortlistResponse, error) {
+	out := new(UnshareShortlistResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/UnshareShortlist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) UpdateCandidate(ctx context.Context, in *UpdateCandidateRequest, opts ...grpc.CallOption) (*Candidate, error) {
-	out := new(Candidate)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateCandidate", in, out, opts...)
+func (c *profileServiceClient) GrantContactConsent(ctx context.Context, in *GrantContactConsentRequest, opts ...grpc.CallOption) (*ContactConsent, error) {
+	out := new(ContactConsent)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GrantContactConsent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) DeleteCandidate(ctx context.Context, in *DeleteCandidateRequest, opts ...grpc.CallOption) (*DeleteCandidateResponse, error) {
-	out := new(DeleteCandidateResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteCandidate", in, out, opts...)
+func (c *profileServiceClient) GetAllContactConsents(ctx context.Context, in *GetAllContactConsentsRequest, opts ...grpc.CallOption) (*GetAllContactConsentsResponse, error) {
+	out := new(GetAllContactConsentsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllContactConsents", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
-	out := new(Skill)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateSkill", in, out, opts...)
+func (c *profileServiceClient) RevokeContactConsent(ctx context.Context, in *RevokeContactConsentRequest, opts ...grpc.CallOption) (*RevokeContactConsentResponse, error) {
+	out := new(RevokeContactConsentResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/RevokeContactConsent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) GetSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
-	out := new(Skill)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetSkill", in, out, opts...)
+func (c *profileServiceClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/ExportMyData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) GetAllSkills(ctx context.Context, in *GetAllSkillsRequest, opts ...grpc.CallOption) (*GetAllSkillsResponse, error) {
-	out := new(GetAllSkillsResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllSkills", in, out, opts...)
+func (c *profileServiceClient) EraseUser(ctx context.Context, in *EraseUserRequest, opts ...grpc.CallOption) (*ErasureReceipt, error) {
+	out := new(ErasureReceipt)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/EraseUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) CreateUserSkill(ctx context.Context, in *CreateUserSkillRequest, opts ...grpc.CallOption) (*UserSkill, error) {
-	out := new(UserSkill)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateUserSkill", in, out, opts...)
+func (c *profileServiceClient) GetErasureReceipt(ctx context.Context, in *GetErasureReceiptRequest, opts ...grpc.CallOption) (*ErasureReceipt, error) {
+	out := new(ErasureReceipt)
+	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetErasureReceipt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *profileServiceClient) DeleteUserSkill(ctx context.Context, in *DeleteUserSkillRequest, opts ...grpc.CallOption) (*DeleteUserSkillResponse, error) {
-	out := new(DeleteUserSkillResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteUserSkill", in, out, opts...)
-	if err != nil {
+// ProfileServiceServer is the server API for ProfileService service.
+type ProfileServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	GetUserByID(context.Context, *GetUserByIDRequest) (*User, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	CreateCandidate(context.Context, *CreateCandidateRequest) (*Candidate, error)
+	GetAllCandidates(context.Context, *GetAllCandidatesRequest) (*GetAllCandidatesResponse, error)
+	GetCandidateByID(context.Context, *GetCandidateByIDRequest) (*User, error)
+	LocalGetSCMLogins(context.Context, *GetSCMLoginsRequest) (*SCMLogins, error)
+	UpdateCandidate(context.Context, *UpdateCandidateRequest) (*Candidate, error)
+	DeleteCandidate(context.Context, *DeleteCandidateRequest) (*DeleteCandidateResponse, error)
+	ImportResume(context.Context, *ImportResumeRequest) (*Candidate, error)
+	ExportCandidateProfile(context.Context, *ExportCandidateProfileRequest) (*httpbody.HttpBody, error)
+	CreateSkill(context.Context, *CreateSkillRequest) (*Skill, error)
+	GetSkill(context.Context, *GetSkillRequest) (*Skill, error)
+	GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error)
+	CreateUserSkill(context.Context, *CreateUserSkillRequest) (*UserSkill, error)
+	DeleteUserSkill(context.Context, *DeleteUserSkillRequest) (*DeleteUserSkillResponse, error)
+	CreateInstitution(context.Context, *CreateInstitutionRequest) (*Institution, error)
+	GetInstitution(context.Context, *GetInstitutionRequest) (*Institution, error)
+	GetAllInstitutions(context.Context, *GetAllInstitutionsRequest) (*GetAllInstitutionsResponse, error)
+	CreateCourse(context.Context, *CreateCourseRequest) (*Course, error)
+	GetCourse(context.Context, *GetCourseRequest) (*Course, error)
+	GetAllCourses(context.Context, *GetAllCoursesRequest) (*GetAllCoursesResponse, error)
+	CreateAcademicHistory(context.Context, *CreateAcademicHistoryRequest) (*AcademicHistory, error)
+	GetAcademicHistory(context.Context, *GetAcademicHistoryRequest) (*AcademicHistory, error)
+	UpdateAcademicHistory(context.Context, *UpdateAcademicHistoryRequest) (*AcademicHistory, error)
+	DeleteAcademicHistory(context.Context, *DeleteAcademicHistoryRequest) (*DeleteAcademicHistoryResponse, error)
+	CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error)
+	GetCompany(context.Context, *GetCompanyRequest) (*Company, error)
+	GetAllCompanies(context.Context, *GetAllCompaniesRequest) (*GetAllCompaniesResponse, error)
+	CreateDepartment(context.Context, *CreateDepartmentRequest) (*Department, error)
+	GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error)
+	GetAllDepartments(context.Context, *GetAllDepartmentsRequest) (*GetAllDepartmentsResponse, error)
+	CreateJobHistory(context.Context, *CreateJobHistoryRequest) (*JobHistory, error)
+	GetJobHistory(context.Context, *GetJobHistoryRequest) (*JobHistory, error)
+	UpdateJobHistory(context.Context, *UpdateJobHistoryRequest) (*JobHistory, error)
+	DeleteJobHistory(context.Context, *DeleteJobHistoryRequest) (*DeleteJobHistoryResponse, error)
+	GetMatchingJobPosts(context.Context, *GetMatchingJobPostsRequest) (*GetMatchesResponse, error)
+	GetMatchingCandidates(context.Context, *GetMatchingCandidatesRequest) (*GetMatchesResponse, error)
+	CreateShortlist(context.Context, *CreateShortlistRequest) (*Shortlist, error)
+	GetAllShortlists(context.Context, *GetAllShortlistsRequest) (*GetAllShortlistsResponse, error)
+	GetShortlistByID(context.Context, *GetShortlistByIDRequest) (*Shortlist, error)
+	UpdateShortlist(context.Context, *UpdateShortlistRequest) (*Shortlist, error)
+	DeleteShortlist(context.Context, *DeleteShortlistRequest) (*DeleteShortlistResponse, error)
+	AddShortlistCandidate(context.Context, *AddShortlistCandidateRequest) (*ShortlistCandidate, error)
+	RemoveShortlistCandidate(context.Context, *RemoveShortlistCandidateRequest) (*RemoveShortlistCandidateResponse, error)
+	ShareShortlist(context.Context, *ShareShortlistRequest) (*ShortlistShare, error)
+	UnshareShortlist(context.Context, *UnshareShortlistRequest) (*UnshareShortlistResponse, error)
+	GrantContactConsent(context.Context, *GrantContactConsentRequest) (*ContactConsent, error)
+	GetAllContactConsents(context.Context, *GetAllContactConsentsRequest) (*GetAllContactConsentsResponse, error)
+	RevokeContactConsent(context.Context, *RevokeContactConsentRequest) (*RevokeContactConsentResponse, error)
+	ExportMyData(context.Context, *ExportMyDataRequest) (*httpbody.HttpBody, error)
+	EraseUser(context.Context, *EraseUserRequest) (*ErasureReceipt, error)
+	GetErasureReceipt(context.Context, *GetErasureReceiptRequest) (*ErasureReceipt, error)
+}
+
+// UnimplementedProfileServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProfileServiceServer struct {
+}
+
+func (*UnimplementedProfileServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetUserByID(context.Context, *GetUserByIDRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
+}
+func (*UnimplementedProfileServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (*UnimplementedProfileServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateCandidate(context.Context, *CreateCandidateRequest) (*Candidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCandidate not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllCandidates(context.Context, *GetAllCandidatesRequest) (*GetAllCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCandidates not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetCandidateByID(context.Context, *GetCandidateByIDRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidateByID not implemented")
+}
+func (*UnimplementedProfileServiceServer) LocalGetSCMLogins(context.Context, *GetSCMLoginsRequest) (*SCMLogins, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalGetSCMLogins not implemented")
+}
+func (*UnimplementedProfileServiceServer) UpdateCandidate(context.Context, *UpdateCandidateRequest) (*Candidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCandidate not implemented")
+}
+func (*UnimplementedProfileServiceServer) DeleteCandidate(context.Context, *DeleteCandidateRequest) (*DeleteCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCandidate not implemented")
+}
+func (*UnimplementedProfileServiceServer) ImportResume(context.Context, *ImportResumeRequest) (*Candidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportResume not implemented")
+}
+func (*UnimplementedProfileServiceServer) ExportCandidateProfile(context.Context, *ExportCandidateProfileRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCandidateProfile not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateSkill(context.Context, *CreateSkillRequest) (*Skill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSkill not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetSkill(context.Context, *GetSkillRequest) (*Skill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkill not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSkills not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateUserSkill(context.Context, *CreateUserSkillRequest) (*UserSkill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserSkill not implemented")
+}
+func (*UnimplementedProfileServiceServer) DeleteUserSkill(context.Context, *DeleteUserSkillRequest) (*DeleteUserSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSkill not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateInstitution(context.Context, *CreateInstitutionRequest) (*Institution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstitution not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetInstitution(context.Context, *GetInstitutionRequest) (*Institution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstitution not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllInstitutions(context.Context, *GetAllInstitutionsRequest) (*GetAllInstitutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllInstitutions not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetCourse(context.Context, *GetCourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllCourses(context.Context, *GetAllCoursesRequest) (*GetAllCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCourses not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateAcademicHistory(context.Context, *CreateAcademicHistoryRequest) (*AcademicHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAcademicHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAcademicHistory(context.Context, *GetAcademicHistoryRequest) (*AcademicHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAcademicHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) UpdateAcademicHistory(context.Context, *UpdateAcademicHistoryRequest) (*AcademicHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAcademicHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) DeleteAcademicHistory(context.Context, *DeleteAcademicHistoryRequest) (*DeleteAcademicHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAcademicHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetCompany(context.Context, *GetCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompany not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllCompanies(context.Context, *GetAllCompaniesRequest) (*GetAllCompaniesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCompanies not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateDepartment(context.Context, *CreateDepartmentRequest) (*Department, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartment not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllDepartments(context.Context, *GetAllDepartmentsRequest) (*GetAllDepartmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDepartments not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateJobHistory(context.Context, *CreateJobHistoryRequest) (*JobHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetJobHistory(context.Context, *GetJobHistoryRequest) (*JobHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) UpdateJobHistory(context.Context, *UpdateJobHistoryRequest) (*JobHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) DeleteJobHistory(context.Context, *DeleteJobHistoryRequest) (*DeleteJobHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobHistory not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetMatchingJobPosts(context.Context, *GetMatchingJobPostsRequest) (*GetMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchingJobPosts not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetMatchingCandidates(context.Context, *GetMatchingCandidatesRequest) (*GetMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchingCandidates not implemented")
+}
+func (*UnimplementedProfileServiceServer) CreateShortlist(context.Context, *CreateShortlistRequest) (*Shortlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShortlist not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllShortlists(context.Context, *GetAllShortlistsRequest) (*GetAllShortlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllShortlists not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetShortlistByID(context.Context, *GetShortlistByIDRequest) (*Shortlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortlistByID not implemented")
+}
+func (*UnimplementedProfileServiceServer) UpdateShortlist(context.Context, *UpdateShortlistRequest) (*Shortlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShortlist not implemented")
+}
+func (*UnimplementedProfileServiceServer) DeleteShortlist(context.Context, *DeleteShortlistRequest) (*DeleteShortlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortlist not implemented")
+}
+func (*UnimplementedProfileServiceServer) AddShortlistCandidate(context.Context, *AddShortlistCandidateRequest) (*ShortlistCandidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddShortlistCandidate not implemented")
+}
+func (*UnimplementedProfileServiceServer) RemoveShortlistCandidate(context.Context, *RemoveShortlistCandidateRequest) (*RemoveShortlistCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveShortlistCandidate not implemented")
+}
+func (*UnimplementedProfileServiceServer) ShareShortlist(context.Context, *ShareShortlistRequest) (*ShortlistShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareShortlist not implemented")
+}
+func (*UnimplementedProfileServiceServer) UnshareShortlist(context.Context, *UnshareShortlistRequest) (*UnshareShortlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareShortlist not implemented")
+}
+func (*UnimplementedProfileServiceServer) GrantContactConsent(context.Context, *GrantContactConsentRequest) (*ContactConsent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantContactConsent not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetAllContactConsents(context.Context, *GetAllContactConsentsRequest) (*GetAllContactConsentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllContactConsents not implemented")
+}
+func (*UnimplementedProfileServiceServer) RevokeContactConsent(context.Context, *RevokeContactConsentRequest) (*RevokeContactConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeContactConsent not implemented")
+}
+func (*UnimplementedProfileServiceServer) ExportMyData(context.Context, *ExportMyDataRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (*UnimplementedProfileServiceServer) EraseUser(context.Context, *EraseUserRequest) (*ErasureReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUser not implemented")
+}
+func (*UnimplementedProfileServiceServer) GetErasureReceipt(context.Context, *GetErasureReceiptRequest) (*ErasureReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetErasureReceipt not implemented")
+}
+
+func RegisterProfileServiceServer(s *grpc.Server, srv ProfileServiceServer) {
+	s.RegisterService(&_ProfileService_serviceDesc, srv)
+}
+
+func _ProfileService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) CreateInstitution(ctx context.Context, in *CreateInstitutionRequest, opts ...grpc.CallOption) (*Institution, error) {
-	out := new(Institution)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateInstitution", in, out, opts...)
-	if err != nil {
+func _ProfileService_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetUserByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/GetUserByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetUserByID(ctx, req.(*GetUserByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetInstitution(ctx context.Context, in *GetInstitutionRequest, opts ...grpc.CallOption) (*Institution, error) {
-	out := new(Institution)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetInstitution", in, out, opts...)
-	if err != nil {
+func _ProfileService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *profileServiceClient) GetAllInstitutions(ctx context.Context, in *GetAllInstitutionsRequest, opts ...grpc.CallOption) (*GetAllInstitutionsResponse, error) {
-	out := new(GetAllInstitutionsResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllInstitutions", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteUser(ctx, in)
 	}
-	return out, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error) {
-	out := new(Course)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateCourse", in, out, opts...)
-	if err != nil {
+func _ProfileService_CreateCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCandidateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/CreateCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateCandidate(ctx, req.(*CreateCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Course, error) {
-	out := new(Course)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetCourse", in, out, opts...)
-	if err != nil {
+func _ProfileService_GetAllCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCandidatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetAllCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/GetAllCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetAllCandidates(ctx, req.(*GetAllCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetAllCourses(ctx context.Context, in *GetAllCoursesRequest, opts ...grpc.CallOption) (*GetAllCoursesResponse, error) {
-	out := new(GetAllCoursesResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllCourses", in, out, opts...)
-	if err != nil {
+func _ProfileService_GetCandidateByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateByIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetCandidateByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/GetCandidateByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetCandidateByID(ctx, req.(*GetCandidateByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) CreateAcademicHistory(ctx context.Context, in *CreateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error) {
-	out := new(AcademicHistory)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateAcademicHistory", in, out, opts...)
-	if err != nil {
+func _ProfileService_LocalGetSCMLogins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSCMLoginsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).LocalGetSCMLogins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/LocalGetSCMLogins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).LocalGetSCMLogins(ctx, req.(*GetSCMLoginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetAcademicHistory(ctx context.Context, in *GetAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error) {
-	out := new(AcademicHistory)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAcademicHistory", in, out, opts...)
-	if err != nil {
+func _ProfileService_UpdateCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCandidateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/UpdateCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateCandidate(ctx, req.(*UpdateCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) UpdateAcademicHistory(ctx context.Context, in *UpdateAcademicHistoryRequest, opts ...grpc.CallOption) (*AcademicHistory, error) {
-	out := new(AcademicHistory)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateAcademicHistory", in, out, opts...)
-	if err != nil {
+func _ProfileService_DeleteCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCandidateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/DeleteCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteCandidate(ctx, req.(*DeleteCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) DeleteAcademicHistory(ctx context.Context, in *DeleteAcademicHistoryRequest, opts ...grpc.CallOption) (*DeleteAcademicHistoryResponse, error) {
-	out := new(DeleteAcademicHistoryResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteAcademicHistory", in, out, opts...)
-	if err != nil {
+func _ProfileService_ImportResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResumeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ImportResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/ImportResume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ImportResume(ctx, req.(*ImportResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
-	out := new(Company)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateCompany", in, out, opts...)
-	if err != nil {
+func _ProfileService_ExportCandidateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCandidateProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ExportCandidateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/ExportCandidateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ExportCandidateProfile(ctx, req.(*ExportCandidateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
-	out := new(Company)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetCompany", in, out, opts...)
-	if err != nil {
+func _ProfileService_CreateSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSkillRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/CreateSkill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateSkill(ctx, req.(*CreateSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetAllCompanies(ctx context.Context, in *GetAllCompaniesRequest, opts ...grpc.CallOption) (*GetAllCompaniesResponse, error) {
-	out := new(GetAllCompaniesResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllCompanies", in, out, opts...)
-	if err != nil {
+func _ProfileService_GetSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/GetSkill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetSkill(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
-	out := new(Department)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateDepartment", in, out, opts...)
-	if err != nil {
+func _ProfileService_GetAllSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllSkillsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetAllSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/GetAllSkills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetAllSkills(ctx, req.(*GetAllSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
-	out := new(Department)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetDepartment", in, out, opts...)
-	if err != nil {
+func _ProfileService_CreateUserSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserSkillRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateUserSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/CreateUserSkill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateUserSkill(ctx, req.(*CreateUserSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) GetAllDepartments(ctx context.Context, in *GetAllDepartmentsRequest, opts ...grpc.CallOption) (*GetAllDepartmentsResponse, error) {
-	out := new(GetAllDepartmentsResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetAllDepartments", in, out, opts...)
-	if err != nil {
+func _ProfileService_DeleteUserSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSkillRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteUserSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/DeleteUserSkill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteUserSkill(ctx, req.(*DeleteUserSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) CreateJobHistory(ctx context.Context, in *CreateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error) {
-	out := new(JobHistory)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/CreateJobHistory", in, out, opts...)
-	if err != nil {
+func _ProfileService_CreateInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstitutionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *profileServiceClient) GetJobHistory(ctx context.Context, in *GetJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error) {
-	out := new(JobHistory)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/GetJobHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateInstitution(ctx, in)
 	}
-	return out, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/CreateInstitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateInstitution(ctx, req.(*CreateInstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) UpdateJobHistory(ctx context.Context, in *UpdateJobHistoryRequest, opts ...grpc.CallOption) (*JobHistory, error) {
-	out := new(JobHistory)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/UpdateJobHistory", in, out, opts...)
-	if err != nil {
+func _ProfileService_GetInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstitutionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetInstitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/GetInstitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetInstitution(ctx, req.(*GetInstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profileServiceClient) DeleteJobHistory(ctx context.Context, in *DeleteJobHistoryRequest, opts ...grpc.CallOption) (*DeleteJobHistoryResponse, error) {
-	out := new(DeleteJobHistoryResponse)
-	err := c.cc.Invoke(ctx, "/pb.ProfileService/DeleteJobHistory", in, out, opts...)
-	if err != nil {
+func _ProfileService_GetAllInstitutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllInstitutionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ProfileServiceServer is the server API for ProfileService service.
-type ProfileServiceServer interface {
-	CreateUser(context.Context, *CreateUserRequest) (*User, error)
-	GetUserByID(context.Context, *GetUserByIDRequest) (*User, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
-	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	CreateCandidate(context.Context, *CreateCandidateRequest) (*Candidate, error)
-	GetAllCandidates(context.Context, *GetAllCandidatesRequest) (*GetAllCandidatesResponse, error)
-	GetCandidateByID(context.Context, *GetCandidateByIDRequest) (*User, error)
-	UpdateCandidate(context.Context, *UpdateCandidateRequest) (*Candidate, error)
-	DeleteCandidate(context.Context, *DeleteCandidateRequest) (*DeleteCandidateResponse, error)
-	CreateSkill(context.Context, *CreateSkillRequest) (*Skill, error)
-	GetSkill(context.Context, *GetSkillRequest) (*Skill, error)
-	GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error)
-	CreateUserSkill(context.Context, *CreateUserSkillRequest) (*UserSkill, error)
-	DeleteUserSkill(context.Context, *DeleteUserSkillRequest) (*DeleteUserSkillResponse, error)
-	CreateInstitution(context.Context, *CreateInstitutionRequest) (*Institution, error)
-	GetInstitution(context.Context, *GetInstitutionRequest) (*Institution, error)
-	GetAllInstitutions(context.Context, *GetAllInstitutionsRequest) (*GetAllInstitutionsResponse, error)
-	CreateCourse(context.Context, *CreateCourseRequest) (*Course, error)
-	GetCourse(context.Context, *GetCourseRequest) (*Course, error)
-	GetAllCourses(context.Context, *GetAllCoursesRequest) (*GetAllCoursesResponse, error)
-	CreateAcademicHistory(context.Context, *CreateAcademicHistoryRequest) (*AcademicHistory, error)
-	GetAcademicHistory(context.Context, *GetAcademicHistoryRequest) (*AcademicHistory, error)
-	UpdateAcademicHistory(context.Context, *UpdateAcademicHistoryRequest) (*AcademicHistory, error)
-	DeleteAcademicHistory(context.Context, *DeleteAcademicHistoryRequest) (*DeleteAcademicHistoryResponse, error)
-	CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error)
-	GetCompany(context.Context, *GetCompanyRequest) (*Company, error)
-	GetAllCompanies(context.Context, *GetAllCompaniesRequest) (*GetAllCompaniesResponse, error)
-	CreateDepartment(context.Context, *CreateDepartmentRequest) (*Department, error)
-	GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error)
-	GetAllDepartments(context.Context, *GetAllDepartmentsRequest) (*GetAllDepartmentsResponse, error)
-	CreateJobHistory(context.Context, *CreateJobHistoryRequest) (*JobHistory, error)
-	GetJobHistory(context.Context, *GetJobHistoryRequest) (*JobHistory, error)
-	UpdateJobHistory(context.Context, *UpdateJobHistoryRequest) (*JobHistory, error)
-	DeleteJobHistory(context.Context, *DeleteJobHistoryRequest) (*DeleteJobHistoryResponse, error)
-}
-
-// UnimplementedProfileServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedProfileServiceServer struct {
-}
-
-func (*UnimplementedProfileServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetUserByID(context.Context, *GetUserByIDRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
-}
-func (*UnimplementedProfileServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
-}
-func (*UnimplementedProfileServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateCandidate(context.Context, *CreateCandidateRequest) (*Candidate, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCandidate not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetAllCandidates(context.Context, *GetAllCandidatesRequest) (*GetAllCandidatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCandidates not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetCandidateByID(context.Context, *GetCandidateByIDRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCandidateByID not implemented")
-}
-func (*UnimplementedProfileServiceServer) UpdateCandidate(context.Context, *UpdateCandidateRequest) (*Candidate, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCandidate not implemented")
-}
-func (*UnimplementedProfileServiceServer) DeleteCandidate(context.Context, *DeleteCandidateRequest) (*DeleteCandidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCandidate not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateSkill(context.Context, *CreateSkillRequest) (*Skill, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSkill not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetSkill(context.Context, *GetSkillRequest) (*Skill, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSkill not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllSkills not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateUserSkill(context.Context, *CreateUserSkillRequest) (*UserSkill, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUserSkill not implemented")
-}
-func (*UnimplementedProfileServiceServer) DeleteUserSkill(context.Context, *DeleteUserSkillRequest) (*DeleteUserSkillResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSkill not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateInstitution(context.Context, *CreateInstitutionRequest) (*Institution, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateInstitution not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetInstitution(context.Context, *GetInstitutionRequest) (*Institution, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInstitution not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetAllInstitutions(context.Context, *GetAllInstitutionsRequest) (*GetAllInstitutionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllInstitutions not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*Course, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetCourse(context.Context, *GetCourseRequest) (*Course, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetAllCourses(context.Context, *GetAllCoursesRequest) (*GetAllCoursesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCourses not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateAcademicHistory(context.Context, *CreateAcademicHistoryRequest) (*AcademicHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAcademicHistory not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetAcademicHistory(context.Context, *GetAcademicHistoryRequest) (*AcademicHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAcademicHistory not implemented")
-}
-func (*UnimplementedProfileServiceServer) UpdateAcademicHistory(context.Context, *UpdateAcademicHistoryRequest) (*AcademicHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAcademicHistory not implemented")
-}
-func (*UnimplementedProfileServiceServer) DeleteAcademicHistory(context.Context, *DeleteAcademicHistoryRequest) (*DeleteAcademicHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAcademicHistory not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetCompany(context.Context, *GetCompanyRequest) (*Company, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompany not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetAllCompanies(context.Context, *GetAllCompaniesRequest) (*GetAllCompaniesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCompanies not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateDepartment(context.Context, *CreateDepartmentRequest) (*Department, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetDepartment(context.Context, *GetDepartmentRequest) (*Department, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepartment not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetAllDepartments(context.Context, *GetAllDepartmentsRequest) (*GetAllDepartmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllDepartments not implemented")
-}
-func (*UnimplementedProfileServiceServer) CreateJobHistory(context.Context, *CreateJobHistoryRequest) (*JobHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateJobHistory not implemented")
-}
-func (*UnimplementedProfileServiceServer) GetJobHistory(context.Context, *GetJobHistoryRequest) (*JobHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobHistory not implemented")
-}
-func (*UnimplementedProfileServiceServer) UpdateJobHistory(context.Context, *UpdateJobHistoryRequest) (*JobHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobHistory not implemented")
-}
-func (*UnimplementedProfileServiceServer) DeleteJobHistory(context.Context, *DeleteJobHistoryRequest) (*DeleteJobHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobHistory not implemented")
-}
-
-func RegisterProfileServiceServer(s *grpc.Server, srv ProfileServiceServer) {
-	s.RegisterService(&_ProfileService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetAllInstitutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProfileService/GetAllInstitutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetAllInstitutions(ctx, req.(*GetAllInstitutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _ProfileService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateUser(ctx, in)
+		return srv.(ProfileServiceServer).CreateCourse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateUser",
+		FullMethod: "/pb.ProfileService/CreateCourse",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(ProfileServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByIDRequest)
+func _ProfileService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetUserByID(ctx, in)
+		return srv.(ProfileServiceServer).GetCourse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetUserByID",
+		FullMethod: "/pb.ProfileService/GetCourse",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetUserByID(ctx, req.(*GetUserByIDRequest))
+		return srv.(ProfileServiceServer).GetCourse(ctx, req.(*GetCourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _ProfileService_GetAllCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCoursesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).UpdateUser(ctx, in)
+		return srv.(ProfileServiceServer).GetAllCourses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/UpdateUser",
+		FullMethod: "/pb.ProfileService/GetAllCourses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(ProfileServiceServer).GetAllCourses(ctx, req.(*GetAllCoursesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _ProfileService_CreateAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAcademicHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).DeleteUser(ctx, in)
+		return srv.(ProfileServiceServer).CreateAcademicHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/DeleteUser",
+		FullMethod: "/pb.ProfileService/CreateAcademicHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(ProfileServiceServer).CreateAcademicHistory(ctx, req.(*CreateAcademicHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCandidateRequest)
+func _ProfileService_GetAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAcademicHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateCandidate(ctx, in)
+		return srv.(ProfileServiceServer).GetAcademicHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateCandidate",
+		FullMethod: "/pb.ProfileService/GetAcademicHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateCandidate(ctx, req.(*CreateCandidateRequest))
+		return srv.(ProfileServiceServer).GetAcademicHistory(ctx, req.(*GetAcademicHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetAllCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCandidatesRequest)
+func _ProfileService_UpdateAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAcademicHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetAllCandidates(ctx, in)
+		return srv.(ProfileServiceServer).UpdateAcademicHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetAllCandidates",
+		FullMethod: "/pb.ProfileService/UpdateAcademicHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetAllCandidates(ctx, req.(*GetAllCandidatesRequest))
+		return srv.(ProfileServiceServer).UpdateAcademicHistory(ctx, req.(*UpdateAcademicHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetCandidateByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCandidateByIDRequest)
+func _ProfileService_DeleteAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAcademicHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetCandidateByID(ctx, in)
+		return srv.(ProfileServiceServer).DeleteAcademicHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetCandidateByID",
+		FullMethod: "/pb.ProfileService/DeleteAcademicHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetCandidateByID(ctx, req.(*GetCandidateByIDRequest))
+		return srv.(ProfileServiceServer).DeleteAcademicHistory(ctx, req.(*DeleteAcademicHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_UpdateCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCandidateRequest)
+func _ProfileService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).UpdateCandidate(ctx, in)
+		return srv.(ProfileServiceServer).CreateCompany(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/UpdateCandidate",
+		FullMethod: "/pb.ProfileService/CreateCompany",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).UpdateCandidate(ctx, req.(*UpdateCandidateRequest))
+		return srv.(ProfileServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_DeleteCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCandidateRequest)
+func _ProfileService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).DeleteCandidate(ctx, in)
+		return srv.(ProfileServiceServer).GetCompany(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/DeleteCandidate",
+		FullMethod: "/pb.ProfileService/GetCompany",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).DeleteCandidate(ctx, req.(*DeleteCandidateRequest))
+		return srv.(ProfileServiceServer).GetCompany(ctx, req.(*GetCompanyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSkillRequest)
+func _ProfileService_GetAllCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCompaniesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateSkill(ctx, in)
+		return srv.(ProfileServiceServer).GetAllCompanies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateSkill",
+		FullMethod: "/pb.ProfileService/GetAllCompanies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateSkill(ctx, req.(*CreateSkillRequest))
+		return srv.(ProfileServiceServer).GetAllCompanies(ctx, req.(*GetAllCompaniesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSkillRequest)
+func _ProfileService_CreateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDepartmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetSkill(ctx, in)
+		return srv.(ProfileServiceServer).CreateDepartment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetSkill",
+		FullMethod: "/pb.ProfileService/CreateDepartment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetSkill(ctx, req.(*GetSkillRequest))
+		return srv.(ProfileServiceServer).CreateDepartment(ctx, req.(*CreateDepartmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetAllSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllSkillsRequest)
+func _ProfileService_GetDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetAllSkills(ctx, in)
+		return srv.(ProfileServiceServer).GetDepartment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetAllSkills",
+		FullMethod: "/pb.ProfileService/GetDepartment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetAllSkills(ctx, req.(*GetAllSkillsRequest))
+		return srv.(ProfileServiceServer).GetDepartment(ctx, req.(*GetDepartmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateUserSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserSkillRequest)
+func _ProfileService_GetAllDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllDepartmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateUserSkill(ctx, in)
+		return srv.(ProfileServiceServer).GetAllDepartments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateUserSkill",
+		FullMethod: "/pb.ProfileService/GetAllDepartments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateUserSkill(ctx, req.(*CreateUserSkillRequest))
+		return srv.(ProfileServiceServer).GetAllDepartments(ctx, req.(*GetAllDepartmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_DeleteUserSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserSkillRequest)
+func _ProfileService_CreateJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).DeleteUserSkill(ctx, in)
+		return srv.(ProfileServiceServer).CreateJobHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/DeleteUserSkill",
+		FullMethod: "/pb.ProfileService/CreateJobHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).DeleteUserSkill(ctx, req.(*DeleteUserSkillRequest))
+		return srv.(ProfileServiceServer).CreateJobHistory(ctx, req.(*CreateJobHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateInstitutionRequest)
+func _ProfileService_GetJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateInstitution(ctx, in)
+		return srv.(ProfileServiceServer).GetJobHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateInstitution",
+		FullMethod: "/pb.ProfileService/GetJobHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateInstitution(ctx, req.(*CreateInstitutionRequest))
+		return srv.(ProfileServiceServer).GetJobHistory(ctx, req.(*GetJobHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInstitutionRequest)
+func _ProfileService_UpdateJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetInstitution(ctx, in)
+		return srv.(ProfileServiceServer).UpdateJobHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetInstitution",
+		FullMethod: "/pb.ProfileService/UpdateJobHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetInstitution(ctx, req.(*GetInstitutionRequest))
+		return srv.(ProfileServiceServer).UpdateJobHistory(ctx, req.(*UpdateJobHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetAllInstitutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllInstitutionsRequest)
+func _ProfileService_DeleteJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetAllInstitutions(ctx, in)
+		return srv.(ProfileServiceServer).DeleteJobHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetAllInstitutions",
+		FullMethod: "/pb.ProfileService/DeleteJobHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetAllInstitutions(ctx, req.(*GetAllInstitutionsRequest))
+		return srv.(ProfileServiceServer).DeleteJobHistory(ctx, req.(*DeleteJobHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCourseRequest)
+func _ProfileService_GetMatchingJobPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchingJobPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateCourse(ctx, in)
+		return srv.(ProfileServiceServer).GetMatchingJobPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateCourse",
+		FullMethod: "/pb.ProfileService/GetMatchingJobPosts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+		return srv.(ProfileServiceServer).GetMatchingJobPosts(ctx, req.(*GetMatchingJobPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseRequest)
+func _ProfileService_GetMatchingCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchingCandidatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetCourse(ctx, in)
+		return srv.(ProfileServiceServer).GetMatchingCandidates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetCourse",
+		FullMethod: "/pb.ProfileService/GetMatchingCandidates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetCourse(ctx, req.(*GetCourseRequest))
+		return srv.(ProfileServiceServer).GetMatchingCandidates(ctx, req.(*GetMatchingCandidatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetAllCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCoursesRequest)
+func _ProfileService_CreateShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetAllCourses(ctx, in)
+		return srv.(ProfileServiceServer).CreateShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetAllCourses",
+		FullMethod: "/pb.ProfileService/CreateShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetAllCourses(ctx, req.(*GetAllCoursesRequest))
+		return srv.(ProfileServiceServer).CreateShortlist(ctx, req.(*CreateShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAcademicHistoryRequest)
+func _ProfileService_GetAllShortlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllShortlistsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateAcademicHistory(ctx, in)
+		return srv.(ProfileServiceServer).GetAllShortlists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateAcademicHistory",
+		FullMethod: "/pb.ProfileService/GetAllShortlists",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateAcademicHistory(ctx, req.(*CreateAcademicHistoryRequest))
+		return srv.(ProfileServiceServer).GetAllShortlists(ctx, req.(*GetAllShortlistsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAcademicHistoryRequest)
+func _ProfileService_GetShortlistByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortlistByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetAcademicHistory(ctx, in)
+		return srv.(ProfileServiceServer).GetShortlistByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetAcademicHistory",
+		FullMethod: "/pb.ProfileService/GetShortlistByID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetAcademicHistory(ctx, req.(*GetAcademicHistoryRequest))
+		return srv.(ProfileServiceServer).GetShortlistByID(ctx, req.(*GetShortlistByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_UpdateAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAcademicHistoryRequest)
+func _ProfileService_UpdateShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).UpdateAcademicHistory(ctx, in)
+		return srv.(ProfileServiceServer).UpdateShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/UpdateAcademicHistory",
+		FullMethod: "/pb.ProfileService/UpdateShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).UpdateAcademicHistory(ctx, req.(*UpdateAcademicHistoryRequest))
+		return srv.(ProfileServiceServer).UpdateShortlist(ctx, req.(*UpdateShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_DeleteAcademicHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAcademicHistoryRequest)
+func _ProfileService_DeleteShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).DeleteAcademicHistory(ctx, in)
+		return srv.(ProfileServiceServer).DeleteShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/DeleteAcademicHistory",
+		FullMethod: "/pb.ProfileService/DeleteShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).DeleteAcademicHistory(ctx, req.(*DeleteAcademicHistoryRequest))
+		return srv.(ProfileServiceServer).DeleteShortlist(ctx, req.(*DeleteShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCompanyRequest)
+func _ProfileService_AddShortlistCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddShortlistCandidateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateCompany(ctx, in)
+		return srv.(ProfileServiceServer).AddShortlistCandidate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateCompany",
+		FullMethod: "/pb.ProfileService/AddShortlistCandidate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+		return srv.(ProfileServiceServer).AddShortlistCandidate(ctx, req.(*AddShortlistCandidateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompanyRequest)
+func _ProfileService_RemoveShortlistCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveShortlistCandidateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetCompany(ctx, in)
+		return srv.(ProfileServiceServer).RemoveShortlistCandidate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetCompany",
+		FullMethod: "/pb.ProfileService/RemoveShortlistCandidate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetCompany(ctx, req.(*GetCompanyRequest))
+		return srv.(ProfileServiceServer).RemoveShortlistCandidate(ctx, req.(*RemoveShortlistCandidateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetAllCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCompaniesRequest)
+func _ProfileService_ShareShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetAllCompanies(ctx, in)
+		return srv.(ProfileServiceServer).ShareShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetAllCompanies",
+		FullMethod: "/pb.ProfileService/ShareShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetAllCompanies(ctx, req.(*GetAllCompaniesRequest))
+		return srv.(ProfileServiceServer).ShareShortlist(ctx, req.(*ShareShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDepartmentRequest)
+func _ProfileService_UnshareShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateDepartment(ctx, in)
+		return srv.(ProfileServiceServer).UnshareShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateDepartment",
+		FullMethod: "/pb.ProfileService/UnshareShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateDepartment(ctx, req.(*CreateDepartmentRequest))
+		return srv.(ProfileServiceServer).UnshareShortlist(ctx, req.(*UnshareShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDepartmentRequest)
+func _ProfileService_GrantContactConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantContactConsentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetDepartment(ctx, in)
+		return srv.(ProfileServiceServer).GrantContactConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetDepartment",
+		FullMethod: "/pb.ProfileService/GrantContactConsent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetDepartment(ctx, req.(*GetDepartmentRequest))
+		return srv.(ProfileServiceServer).GrantContactConsent(ctx, req.(*GrantContactConsentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetAllDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllDepartmentsRequest)
+func _ProfileService_GetAllContactConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllContactConsentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetAllDepartments(ctx, in)
+		return srv.(ProfileServiceServer).GetAllContactConsents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetAllDepartments",
+		FullMethod: "/pb.ProfileService/GetAllContactConsents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetAllDepartments(ctx, req.(*GetAllDepartmentsRequest))
+		return srv.(ProfileServiceServer).GetAllContactConsents(ctx, req.(*GetAllContactConsentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_CreateJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateJobHistoryRequest)
+func _ProfileService_RevokeContactConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeContactConsentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).CreateJobHistory(ctx, in)
+		return srv.(ProfileServiceServer).RevokeContactConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/CreateJobHistory",
+		FullMethod: "/pb.ProfileService/RevokeContactConsent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).CreateJobHistory(ctx, req.(*CreateJobHistoryRequest))
+		return srv.(ProfileServiceServer).RevokeContactConsent(ctx, req.(*RevokeContactConsentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_GetJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobHistoryRequest)
+func _ProfileService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).GetJobHistory(ctx, in)
+		return srv.(ProfileServiceServer).ExportMyData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/GetJobHistory",
+		FullMethod: "/pb.ProfileService/ExportMyData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).GetJobHistory(ctx, req.(*GetJobHistoryRequest))
+		return srv.(ProfileServiceServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_UpdateJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateJobHistoryRequest)
+func _ProfileService_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).UpdateJobHistory(ctx, in)
+		return srv.(ProfileServiceServer).EraseUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/UpdateJobHistory",
+		FullMethod: "/pb.ProfileService/EraseUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).UpdateJobHistory(ctx, req.(*UpdateJobHistoryRequest))
+		return srv.(ProfileServiceServer).EraseUser(ctx, req.(*EraseUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfileService_DeleteJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteJobHistoryRequest)
+func _ProfileService_GetErasureReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetErasureReceiptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServiceServer).DeleteJobHistory(ctx, in)
+		return srv.(ProfileServiceServer).GetErasureReceipt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ProfileService/DeleteJobHistory",
+		FullMethod: "/pb.ProfileService/GetErasureReceipt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).DeleteJobHistory(ctx, req.(*DeleteJobHistoryRequest))
+		return srv.(ProfileServiceServer).GetErasureReceipt(ctx, req.(*GetErasureReceiptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6175,6 +9808,10 @@ var _ProfileService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCandidateByID",
 			Handler:    _ProfileService_GetCandidateByID_Handler,
 		},
+		{
+			MethodName: "LocalGetSCMLogins",
+			Handler:    _ProfileService_LocalGetSCMLogins_Handler,
+		},
 		{
 			MethodName: "UpdateCandidate",
 			Handler:    _ProfileService_UpdateCandidate_Handler,
@@ -6183,6 +9820,14 @@ var _ProfileService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCandidate",
 			Handler:    _ProfileService_DeleteCandidate_Handler,
 		},
+		{
+			MethodName: "ImportResume",
+			Handler:    _ProfileService_ImportResume_Handler,
+		},
+		{
+			MethodName: "ExportCandidateProfile",
+			Handler:    _ProfileService_ExportCandidateProfile_Handler,
+		},
 		{
 			MethodName: "CreateSkill",
 			Handler:    _ProfileService_CreateSkill_Handler,
@@ -6283,6 +9928,74 @@ var _ProfileService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJobHistory",
 			Handler:    _ProfileService_DeleteJobHistory_Handler,
 		},
+		{
+			MethodName: "GetMatchingJobPosts",
+			Handler:    _ProfileService_GetMatchingJobPosts_Handler,
+		},
+		{
+			MethodName: "GetMatchingCandidates",
+			Handler:    _ProfileService_GetMatchingCandidates_Handler,
+		},
+		{
+			MethodName: "CreateShortlist",
+			Handler:    _ProfileService_CreateShortlist_Handler,
+		},
+		{
+			MethodName: "GetAllShortlists",
+			Handler:    _ProfileService_GetAllShortlists_Handler,
+		},
+		{
+			MethodName: "GetShortlistByID",
+			Handler:    _ProfileService_GetShortlistByID_Handler,
+		},
+		{
+			MethodName: "UpdateShortlist",
+			Handler:    _ProfileService_UpdateShortlist_Handler,
+		},
+		{
+			MethodName: "DeleteShortlist",
+			Handler:    _ProfileService_DeleteShortlist_Handler,
+		},
+		{
+			MethodName: "AddShortlistCandidate",
+			Handler:    _ProfileService_AddShortlistCandidate_Handler,
+		},
+		{
+			MethodName: "RemoveShortlistCandidate",
+			Handler:    _ProfileService_RemoveShortlistCandidate_Handler,
+		},
+		{
+			MethodName: "ShareShortlist",
+			Handler:    _ProfileService_ShareShortlist_Handler,
+		},
+		{
+			MethodName: "UnshareShortlist",
+			Handler:    _ProfileService_UnshareShortlist_Handler,
+		},
+		{
+			MethodName: "GrantContactConsent",
+			Handler:    _ProfileService_GrantContactConsent_Handler,
+		},
+		{
+			MethodName: "GetAllContactConsents",
+			Handler:    _ProfileService_GetAllContactConsents_Handler,
+		},
+		{
+			MethodName: "RevokeContactConsent",
+			Handler:    _ProfileService_RevokeContactConsent_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _ProfileService_ExportMyData_Handler,
+		},
+		{
+			MethodName: "EraseUser",
+			Handler:    _ProfileService_EraseUser_Handler,
+		},
+		{
+			MethodName: "GetErasureReceipt",
+			Handler:    _ProfileService_GetErasureReceipt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profile.proto",
@@ -3,60 +3,116 @@ package providers
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"in-backend/services/profile/configs"
 	"in-backend/services/profile/interfaces"
 	"in-backend/services/profile/models"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
-// Auth0Provider describes the methods to interact with the Auth0 identity provider
-type Auth0Provider interface {
-	GetToken() (map[string]interface{}, error)
-	UpdateUser(token string, u *models.User) error
-	SetUserRole(token, authID string, roles []string) error
-}
-
-type auth0Provider struct {
-	config configs.Config
-	client interfaces.HTTPClient
-}
+// tokenExpiryMargin is how long before it expires a cached management token is refreshed
+const tokenExpiryMargin = time.Minute
 
 var (
-	auth0URL        string = "https://hubbed-in.au.auth0.com"
-	candidateRoleID string = "rol_zlZ3Ha3n1E7WIbNI"
-	companyRoleID   string = "rol_I8Ol4fIrKZrFph2p"
-	adminRoleID     string = "rol_NjsiJ7p3Z6IEhlRm"
+	errAuth0URLRequired = errors.New("Auth0 URL is not configured")
+	errAuth0NoToken     = errors.New("Auth0 token response has no access token")
+	errAuth0NotFound    = errors.New("Auth0 resource not found")
 )
 
-// NewAuth0 creates and returns a new Auth0Provider
-func NewAuth0(cfg configs.Config, client interfaces.HTTPClient) Auth0Provider {
+type auth0Provider struct {
+	url     string
+	config  configs.Auth0
+	client  interfaces.HTTPClient
+	roleIDs map[string]string
+	now     func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewAuth0 creates and returns a new IdentityProvider backed by the Auth0 management API
+func NewAuth0(cfg configs.Config, client interfaces.HTTPClient) (IdentityProvider, error) {
+	if cfg.Auth0.URL == "" {
+		return nil, errAuth0URLRequired
+	}
 	return &auth0Provider{
-		config: cfg,
+		url:    strings.TrimSuffix(cfg.Auth0.URL, "/"),
+		config: cfg.Auth0,
 		client: client,
-	}
+		roleIDs: map[string]string{
+			"Candidate": cfg.Auth0.CandidateRoleID,
+			"Company":   cfg.Auth0.CompanyRoleID,
+			"Admin":     cfg.Auth0.AdminRoleID,
+			"Recruiter": cfg.Auth0.RecruiterRoleID,
+		},
+		now: time.Now,
+	}, nil
 }
 
-func (p *auth0Provider) GetToken() (map[string]interface{}, error) {
-	url := auth0URL + "/oauth/token"
+// getToken returns a management API token, which is cached until shortly before it expires
+func (p *auth0Provider) getToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && p.now().Before(p.expiry) {
+		return p.token, nil
+	}
+
 	reqBody, err := json.Marshal(map[string]string{
-		"client_id":     p.config.Auth0.MgmtClientID,
-		"client_secret": p.config.Auth0.MgmtClientSecret,
-		"audience":      auth0URL + "/api/v2/",
+		"client_id":     p.config.MgmtClientID,
+		"client_secret": p.config.MgmtClientSecret,
+		"audience":      p.url + "/api/v2/",
 		"grant_type":    "client_credentials",
 	})
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(reqBody))
+	req, err := http.NewRequest("POST", p.url+"/oauth/token", bytes.NewBuffer(reqBody))
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	req.Header.Add("content-type", "application/json")
 
+	body, err := p.do(req)
+	if err != nil {
+		return "", err
+	}
+
+	var res struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return "", err
+	}
+	if res.AccessToken == "" {
+		return "", errAuth0NoToken
+	}
+
+	p.token = res.AccessToken
+	p.expiry = p.now().Add(time.Duration(res.ExpiresIn)*time.Second - tokenExpiryMargin)
+	return p.token, nil
+}
+
+// clearToken drops the cached token so that the next request gets a new one
+func (p *auth0Provider) clearToken() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.token = ""
+}
+
+// do sends req and returns the response body, or an error if the response is not successful
+func (p *auth0Provider) do(req *http.Request) ([]byte, error) {
 	res, err := p.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -67,95 +123,136 @@ func (p *auth0Provider) GetToken() (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	jsonBody := make(map[string]interface{})
-	err = json.Unmarshal(body, &jsonBody)
-	if err != nil {
-		return nil, err
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s %s", errAuth0NotFound, req.Method, req.URL.Path)
 	}
-
-	return jsonBody, nil
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		if res.StatusCode == http.StatusUnauthorized {
+			p.clearToken()
+		}
+		return nil, fmt.Errorf("Auth0 request %s %s failed with status %d", req.Method, req.URL.Path, res.StatusCode)
+	}
+	return body, nil
 }
 
-func (p *auth0Provider) UpdateUser(token string, u *models.User) error {
-	url := auth0URL + "/api/v2/users/" + url.QueryEscape(u.AuthID)
-
-	data := make(map[string]string)
-	data["id"] = strconv.FormatUint(u.ID, 10)
-	if u.CandidateID > 0 {
-		data["candidateId"] = strconv.FormatUint(u.CandidateID, 10)
-	}
-	if u.JobCompanyID > 0 {
-		data["companyId"] = strconv.FormatUint(u.JobCompanyID, 10)
+// send sends an authorized management API request with a JSON body, or no body if data is nil,
+// and returns the response body
+func (p *auth0Provider) send(method, path string, data interface{}) ([]byte, error) {
+	token, err := p.getToken()
+	if err != nil {
+		return nil, err
 	}
 
-	reqBody, err := json.Marshal(map[string](map[string]string){
-		"app_metadata": data,
-	})
-	if err != nil {
-		return err
+	var body io.Reader = http.NoBody
+	if data != nil {
+		reqBody, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewBuffer(reqBody)
 	}
 
-	req, err := http.NewRequest("PATCH", url, bytes.NewBuffer(reqBody))
+	req, err := http.NewRequest(method, p.url+path, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	req.Header.Add("authorization", "Bearer "+token)
 	req.Header.Add("content-type", "application/json")
+	req.Header.Add("cache-control", "no-cache")
 
-	res, err := p.client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
+	return p.do(req)
+}
 
-	_, err = ioutil.ReadAll(res.Body)
-	if err != nil {
-		return err
+// UpdateUser stores the IDs of a User in the app metadata of their Auth0 account
+func (p *auth0Provider) UpdateUser(u *models.User) error {
+	if u.AuthID == "" {
+		return errAuthIDRequired
 	}
-
-	return nil
+	_, err := p.send("PATCH", "/api/v2/users/"+url.PathEscape(u.AuthID), map[string](map[string]string){
+		"app_metadata": appMetadata(u),
+	})
+	return err
 }
 
-func (p *auth0Provider) SetUserRole(token, authID string, roles []string) error {
+// SetUserRoles assigns the Auth0 roles configured for roles to the Auth0 account with authID
+func (p *auth0Provider) SetUserRoles(authID string, roles []string) error {
+	if authID == "" {
+		return errAuthIDRequired
+	}
+
 	var val []string
 	for _, role := range roles {
-		switch role {
-		case "Candidate":
-			val = append(val, candidateRoleID)
-		case "Company":
-			val = append(val, companyRoleID)
-		case "Admin":
-			val = append(val, adminRoleID)
+		id := p.roleIDs[role]
+		if id == "" {
+			return fmt.Errorf("No Auth0 role is configured for role %s", role)
 		}
+		val = append(val, id)
+	}
+	if len(val) == 0 {
+		return nil
 	}
 
-	url := auth0URL + "/api/v2/users/" + url.QueryEscape(authID) + "/roles"
-	reqBody, err := json.Marshal(map[string]([]string){
+	_, err := p.send("POST", "/api/v2/users/"+url.PathEscape(authID)+"/roles", map[string]([]string){
 		"roles": val,
 	})
-	if err != nil {
-		return err
-	}
+	return err
+}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(reqBody))
-	if err != nil {
-		return err
+// DeleteUser deletes the Auth0 account with authID
+func (p *auth0Provider) DeleteUser(authID string) error {
+	if authID == "" {
+		return errAuthIDRequired
 	}
-	req.Header.Add("authorization", "Bearer "+token)
-	req.Header.Add("content-type", "application/json")
-	req.Header.Add("cache-control", "no-cache")
+	_, err := p.send("DELETE", "/api/v2/users/"+url.PathEscape(authID), nil)
+	if errors.Is(err, errAuth0NotFound) {
+		return nil
+	}
+	return err
+}
 
-	res, err := p.client.Do(req)
+// auth0Identity declares an account of a connection that is linked to an Auth0 user.
+// The profile of the primary identity is stored on the user, and profileData is only set on linked identities
+type auth0Identity struct {
+	Provider    string `json:"provider"`
+	ProfileData struct {
+		Nickname string `json:"nickname"`
+	} `json:"profileData"`
+}
+
+// GetSCMLogins returns the logins of the GitHub and GitLab accounts that are linked to the Auth0 account with authID,
+// keyed by the host of the git host.
+// Auth0 sets the nickname of a social identity to the login that the provider verified with OAuth
+func (p *auth0Provider) GetSCMLogins(authID string) (map[string]string, error) {
+	if authID == "" {
+		return nil, errAuthIDRequired
+	}
+	body, err := p.send("GET", "/api/v2/users/"+url.PathEscape(authID)+"?fields=nickname,identities&include_fields=true", nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer res.Body.Close()
 
-	_, err = ioutil.ReadAll(res.Body)
+	var u struct {
+		Nickname   string          `json:"nickname"`
+		Identities []auth0Identity `json:"identities"`
+	}
+	err = json.Unmarshal(body, &u)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	logins := make(map[string]string)
+	for i, id := range u.Identities {
+		host, ok := scmHosts[id.Provider]
+		if !ok {
+			continue
+		}
+		login := id.ProfileData.Nickname
+		if i == 0 {
+			login = u.Nickname
+		}
+		if login != "" {
+			logins[host] = login
+		}
+	}
+	return logins, nil
 }
@@ -0,0 +1,180 @@
+package providers
+
+import (
+	"encoding/json"
+	"in-backend/services/profile/configs"
+	"in-backend/services/profile/models"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAuth0 is a test server for the Auth0 token and management API endpoints
+type fakeAuth0 struct {
+	tokens   int
+	status   int
+	response string
+	requests []*http.Request
+	bodies   []map[string]interface{}
+}
+
+func (f *fakeAuth0) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/oauth/token" {
+		f.tokens++
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token", "expires_in": 3600})
+		return
+	}
+	b, _ := ioutil.ReadAll(r.Body)
+	body := make(map[string]interface{})
+	json.Unmarshal(b, &body)
+	f.requests = append(f.requests, r)
+	f.bodies = append(f.bodies, body)
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+	}
+	w.Write([]byte(f.response))
+}
+
+func newTestAuth0(t *testing.T) (*auth0Provider, *fakeAuth0, *time.Time) {
+	f := &fakeAuth0{}
+	s := httptest.NewServer(f)
+	t.Cleanup(s.Close)
+
+	cfg := configs.Config{Auth0: configs.Auth0{URL: s.URL + "/", CandidateRoleID: "rol_candidate", CompanyRoleID: "rol_company"}}
+	p, err := NewAuth0(cfg, s.Client())
+	require.NoError(t, err)
+
+	now := time.Now()
+	a := p.(*auth0Provider)
+	a.now = func() time.Time { return now }
+	return a, f, &now
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	p, err := NewIdentityProvider(configs.Config{Auth0: configs.Auth0{URL: "https://tenant.auth0.com"}}, http.DefaultClient)
+	require.NoError(t, err)
+	require.IsType(t, &auth0Provider{}, p)
+
+	_, err = NewIdentityProvider(configs.Config{}, http.DefaultClient)
+	require.Equal(t, errAuth0URLRequired, err)
+
+	p, err = NewIdentityProvider(configs.Config{IdentityProvider: IdentityProviderFake}, http.DefaultClient)
+	require.NoError(t, err)
+	require.IsType(t, &FakeIdentityProvider{}, p)
+
+	_, err = NewIdentityProvider(configs.Config{IdentityProvider: "okta"}, http.DefaultClient)
+	require.Error(t, err)
+}
+
+func TestAuth0TokenCache(t *testing.T) {
+	p, f, now := newTestAuth0(t)
+	u := &models.User{ID: 1, AuthID: "auth0|1", CandidateID: 2}
+
+	require.NoError(t, p.UpdateUser(u))
+	require.NoError(t, p.UpdateUser(u))
+	require.Equal(t, 1, f.tokens)
+	require.Equal(t, "Bearer token", f.requests[0].Header.Get("authorization"))
+	require.Equal(t, map[string]interface{}{"id": "1", "candidateId": "2"}, f.bodies[0]["app_metadata"])
+
+	// tokens are refreshed shortly before they expire
+	*now = now.Add(time.Hour - tokenExpiryMargin)
+	require.NoError(t, p.UpdateUser(u))
+	require.Equal(t, 2, f.tokens)
+
+	// rejected tokens are dropped and refreshed on the next request
+	f.status = http.StatusUnauthorized
+	require.Error(t, p.UpdateUser(u))
+	f.status = 0
+	require.NoError(t, p.UpdateUser(u))
+	require.Equal(t, 3, f.tokens)
+}
+
+func TestAuth0SetUserRoles(t *testing.T) {
+	var tests = []struct {
+		name    string
+		authID  string
+		roles   []string
+		want    []interface{}
+		wantErr bool
+	}{
+		{"candidate", "auth0|1", []string{"Candidate"}, []interface{}{"rol_candidate"}, false},
+		{"candidate and company", "auth0|1", []string{"Candidate", "Company"}, []interface{}{"rol_candidate", "rol_company"}, false},
+		{"unconfigured role", "auth0|1", []string{"Admin"}, nil, true},
+		{"no auth id", "", []string{"Candidate"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, f, _ := newTestAuth0(t)
+			err := p.SetUserRoles(tt.authID, tt.roles)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Empty(t, f.requests)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "/api/v2/users/auth0%7C1/roles", f.requests[0].URL.EscapedPath())
+			require.Equal(t, tt.want, f.bodies[0]["roles"])
+		})
+	}
+}
+
+func TestAuth0DeleteUser(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"deleted", http.StatusNoContent, false},
+		{"already deleted", http.StatusNotFound, false},
+		{"failed", http.StatusInternalServerError, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, f, _ := newTestAuth0(t)
+			f.status = tt.status
+			err := p.DeleteUser("auth0|1")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "DELETE", f.requests[0].Method)
+			require.Equal(t, "/api/v2/users/auth0%7C1", f.requests[0].URL.EscapedPath())
+		})
+	}
+
+	p, _, _ := newTestAuth0(t)
+	require.Error(t, p.DeleteUser(""))
+}
+
+func TestAuth0GetSCMLogins(t *testing.T) {
+	p, f, _ := newTestAuth0(t)
+	f.response = `{
+		"nickname": "octocat",
+		"identities": [
+			{"provider": "github", "user_id": 583231, "connection": "github", "isSocial": true},
+			{"provider": "gitlab", "user_id": "1", "connection": "gitlab", "isSocial": true, "profileData": {"nickname": "hubot"}},
+			{"provider": "auth0", "user_id": "2", "connection": "Username-Password-Authentication", "profileData": {"nickname": "mallory"}}
+		]
+	}`
+
+	got, err := p.GetSCMLogins("github|583231")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"github.com": "octocat", "gitlab.com": "hubot"}, got)
+	require.Equal(t, "/api/v2/users/github%7C583231", f.requests[0].URL.EscapedPath())
+
+	// the nickname of a primary database account is not a git host login
+	f.response = `{"nickname": "octocat", "identities": [{"provider": "auth0", "user_id": "1"}]}`
+	got, err = p.GetSCMLogins("auth0|1")
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	_, err = p.GetSCMLogins("")
+	require.Equal(t, errAuthIDRequired, err)
+}
@@ -0,0 +1,61 @@
+package providers
+
+import (
+	"fmt"
+	"in-backend/services/profile/configs"
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+)
+
+// CRMProvider describes the methods to sync Users to the CRM platform used for onboarding
+type CRMProvider interface {
+	// UpdateOrCreateProspect creates or updates the prospect of a User
+	UpdateOrCreateProspect(u *models.User) error
+	// StartCadence starts the signup cadence of role for the prospect with email
+	StartCadence(email, role string) error
+	// DeleteProspect deletes the prospect with email, and succeeds if there is no such prospect
+	DeleteProspect(email string) error
+}
+
+// CRM providers that can be selected with the crm_provider config
+const (
+	CRMProviderNone    string = "none"
+	CRMProviderKlenty  string = "klenty"
+	CRMProviderWebhook string = "webhook"
+)
+
+// NewCRMProvider creates and returns the CRMProvider selected in cfg.
+// No CRM is used unless one is selected, so that environments do not sync Users by accident
+func NewCRMProvider(cfg configs.Config, client interfaces.HTTPClient) (CRMProvider, error) {
+	switch cfg.CRMProvider {
+	case "", CRMProviderNone:
+		return NewNoopCRM(), nil
+	case CRMProviderKlenty:
+		return NewKlenty(cfg, client)
+	case CRMProviderWebhook:
+		return NewWebhookCRM(cfg, client)
+	}
+	return nil, fmt.Errorf("Unknown CRM provider %s", cfg.CRMProvider)
+}
+
+type noopCRMProvider struct{}
+
+// NewNoopCRM creates and returns a new CRMProvider that does nothing
+func NewNoopCRM() CRMProvider {
+	return noopCRMProvider{}
+}
+
+// UpdateOrCreateProspect does nothing
+func (noopCRMProvider) UpdateOrCreateProspect(u *models.User) error {
+	return nil
+}
+
+// StartCadence does nothing
+func (noopCRMProvider) StartCadence(email, role string) error {
+	return nil
+}
+
+// DeleteProspect does nothing
+func (noopCRMProvider) DeleteProspect(email string) error {
+	return nil
+}
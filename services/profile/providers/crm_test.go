@@ -0,0 +1,90 @@
+package providers
+
+import (
+	"encoding/json"
+	"in-backend/services/profile/configs"
+	"in-backend/services/profile/models"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCRMProvider(t *testing.T) {
+	var tests = []struct {
+		name    string
+		cfg     configs.Config
+		want    CRMProvider
+		wantErr bool
+	}{
+		{"default", configs.Config{}, noopCRMProvider{}, false},
+		{"none", configs.Config{CRMProvider: CRMProviderNone}, noopCRMProvider{}, false},
+		{"klenty", configs.Config{CRMProvider: CRMProviderKlenty, Klenty: configs.Klenty{User: "crm@hubbedin.com", APIKey: "key"}}, &klentyProvider{}, false},
+		{"klenty not configured", configs.Config{CRMProvider: CRMProviderKlenty}, nil, true},
+		{"webhook", configs.Config{CRMProvider: CRMProviderWebhook, CRMWebhook: configs.CRMWebhook{URL: "https://crm.test", Secret: "secret"}}, &webhookCRMProvider{}, false},
+		{"webhook not configured", configs.Config{CRMProvider: CRMProviderWebhook}, nil, true},
+		{"unknown", configs.Config{CRMProvider: "hubspot"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCRMProvider(tt.cfg, http.DefaultClient)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tt.want, got)
+		})
+	}
+
+	p, err := NewKlenty(configs.Config{Klenty: configs.Klenty{User: "crm@hubbedin.com", APIKey: "key"}}, http.DefaultClient)
+	require.NoError(t, err)
+	require.Equal(t, "https://app.klenty.com/apis/v1/user/crm@hubbedin.com", p.(*klentyProvider).url)
+}
+
+func TestWebhookCRM(t *testing.T) {
+	var (
+		headers http.Header
+		body    []byte
+		status  int
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+		if status != 0 {
+			w.WriteHeader(status)
+		}
+	}))
+	defer s.Close()
+
+	cfg := configs.Config{CRMWebhook: configs.CRMWebhook{URL: s.URL, Secret: "secret"}}
+	c, err := NewWebhookCRM(cfg, s.Client())
+	require.NoError(t, err)
+	p := c.(*webhookCRMProvider)
+	p.now = func() time.Time { return time.Unix(1600000000, 0) }
+
+	err = p.UpdateOrCreateProspect(&models.User{Email: "first@last.com", FirstName: "first", LastName: "last", Roles: []string{"Candidate"}})
+	require.NoError(t, err)
+	require.Equal(t, "1600000000", headers.Get(WebhookTimestampHeader))
+	require.Equal(t, SignWebhook("secret", "1600000000", body), headers.Get(WebhookSignatureHeader))
+	require.NotEqual(t, SignWebhook("other", "1600000000", body), headers.Get(WebhookSignatureHeader))
+
+	var got struct {
+		Event string          `json:"event"`
+		Data  WebhookProspect `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(body, &got))
+	require.Equal(t, WebhookProspectUpdated, got.Event)
+	require.Equal(t, WebhookProspect{Email: "first@last.com", FirstName: "first", LastName: "last", Roles: []string{"Candidate"}}, got.Data)
+
+	require.NoError(t, p.StartCadence("first@last.com", "Candidate"))
+	require.JSONEq(t, `{"event":"cadence.started","data":{"email":"first@last.com","role":"Candidate"}}`, string(body))
+
+	// rejected events are returned as errors so that they are retried
+	status = http.StatusInternalServerError
+	require.Error(t, p.StartCadence("first@last.com", "Candidate"))
+}
@@ -0,0 +1,139 @@
+package providers
+
+import (
+	"in-backend/services/profile/models"
+	"sync"
+)
+
+// FakeIdentity declares an account of the FakeIdentityProvider
+type FakeIdentity struct {
+	AppMetadata map[string]string
+	Roles       []string
+	// SCMLogins maps the hosts of linked git host accounts to their logins
+	SCMLogins map[string]string
+}
+
+// FakeIdentityProvider is an in-memory IdentityProvider for tests and local development
+type FakeIdentityProvider struct {
+	mu         sync.RWMutex
+	identities map[string]*FakeIdentity
+}
+
+// NewFakeIdentityProvider creates and returns a new FakeIdentityProvider with no accounts
+func NewFakeIdentityProvider() *FakeIdentityProvider {
+	return &FakeIdentityProvider{
+		identities: make(map[string]*FakeIdentity),
+	}
+}
+
+// identity returns the account with authID, creating it if it does not exist
+func (p *FakeIdentityProvider) identity(authID string) *FakeIdentity {
+	i, ok := p.identities[authID]
+	if !ok {
+		i = &FakeIdentity{AppMetadata: make(map[string]string), SCMLogins: make(map[string]string)}
+		p.identities[authID] = i
+	}
+	return i
+}
+
+// UpdateUser stores the IDs of a User in the app metadata of their account
+func (p *FakeIdentityProvider) UpdateUser(u *models.User) error {
+	if u.AuthID == "" {
+		return errAuthIDRequired
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	i := p.identity(u.AuthID)
+	for k, v := range appMetadata(u) {
+		i.AppMetadata[k] = v
+	}
+	return nil
+}
+
+// SetUserRoles adds roles to the account with authID
+func (p *FakeIdentityProvider) SetUserRoles(authID string, roles []string) error {
+	if authID == "" {
+		return errAuthIDRequired
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	i := p.identity(authID)
+	for _, role := range roles {
+		if !contains(i.Roles, role) {
+			i.Roles = append(i.Roles, role)
+		}
+	}
+	return nil
+}
+
+// DeleteUser deletes the account with authID
+func (p *FakeIdentityProvider) DeleteUser(authID string) error {
+	if authID == "" {
+		return errAuthIDRequired
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.identities, authID)
+	return nil
+}
+
+// LinkSCMAccount links the account of login on the git host to the account with authID
+func (p *FakeIdentityProvider) LinkSCMAccount(authID, host, login string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.identity(authID).SCMLogins[host] = login
+}
+
+// GetSCMLogins returns the logins of the git host accounts linked to the account with authID
+func (p *FakeIdentityProvider) GetSCMLogins(authID string) (map[string]string, error) {
+	if authID == "" {
+		return nil, errAuthIDRequired
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	logins := make(map[string]string)
+	if i, ok := p.identities[authID]; ok {
+		for k, v := range i.SCMLogins {
+			logins[k] = v
+		}
+	}
+	return logins, nil
+}
+
+// Identity returns a copy of the account with authID, and whether it exists
+func (p *FakeIdentityProvider) Identity(authID string) (FakeIdentity, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	i, ok := p.identities[authID]
+	if !ok {
+		return FakeIdentity{}, false
+	}
+	c := FakeIdentity{
+		AppMetadata: make(map[string]string, len(i.AppMetadata)),
+		Roles:       append([]string(nil), i.Roles...),
+		SCMLogins:   make(map[string]string, len(i.SCMLogins)),
+	}
+	for k, v := range i.AppMetadata {
+		c.AppMetadata[k] = v
+	}
+	for k, v := range i.SCMLogins {
+		c.SCMLogins[k] = v
+	}
+	return c, true
+}
+
+// contains returns whether s contains v
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
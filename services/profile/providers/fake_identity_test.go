@@ -0,0 +1,38 @@
+package providers
+
+import (
+	"in-backend/services/profile/models"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeIdentityProvider(t *testing.T) {
+	p := NewFakeIdentityProvider()
+	_, ok := p.Identity("auth0|1")
+	require.False(t, ok)
+
+	require.NoError(t, p.UpdateUser(&models.User{ID: 1, AuthID: "auth0|1", JobCompanyID: 3}))
+	require.NoError(t, p.SetUserRoles("auth0|1", []string{"Company"}))
+	require.NoError(t, p.SetUserRoles("auth0|1", []string{"Company", "Admin"}))
+	require.Equal(t, errAuthIDRequired, p.UpdateUser(&models.User{ID: 2}))
+	require.Equal(t, errAuthIDRequired, p.SetUserRoles("", []string{"Company"}))
+
+	got, ok := p.Identity("auth0|1")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"id": "1", "companyId": "3"}, got.AppMetadata)
+	require.Equal(t, []string{"Company", "Admin"}, got.Roles)
+
+	// identities are copied so that callers cannot change them
+	got.Roles[0] = "Candidate"
+	got, _ = p.Identity("auth0|1")
+	require.Equal(t, "Company", got.Roles[0])
+
+	logins, err := p.GetSCMLogins("auth0|1")
+	require.NoError(t, err)
+	require.Empty(t, logins)
+	p.LinkSCMAccount("auth0|1", "github.com", "octocat")
+	logins, err = p.GetSCMLogins("auth0|1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"github.com": "octocat"}, logins)
+}
@@ -0,0 +1,63 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"in-backend/services/profile/configs"
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+	"strconv"
+)
+
+// IdentityProvider describes the methods to sync Users to the identity provider they sign in with
+type IdentityProvider interface {
+	// UpdateUser stores the IDs of a User in the metadata of their identity provider account
+	UpdateUser(u *models.User) error
+	// SetUserRoles assigns roles to the identity provider account with authID
+	SetUserRoles(authID string, roles []string) error
+	// DeleteUser deletes the identity provider account with authID, and succeeds if there is no such account
+	DeleteUser(authID string) error
+	// GetSCMLogins returns the logins of the git host accounts that are linked to the identity provider account with authID,
+	// keyed by the host of the git host, such as github.com
+	GetSCMLogins(authID string) (map[string]string, error)
+}
+
+// Identity providers that can be selected with the identity_provider config
+const (
+	IdentityProviderAuth0 string = "auth0"
+	IdentityProviderFake  string = "fake"
+)
+
+// scmHosts maps the identity providers of social connections to the git hosts they sign in with
+var scmHosts = map[string]string{
+	"github": "github.com",
+	"gitlab": "gitlab.com",
+}
+
+var (
+	errAuthIDRequired = errors.New("User must have an auth ID")
+)
+
+// NewIdentityProvider creates and returns the IdentityProvider selected in cfg, which defaults to Auth0
+func NewIdentityProvider(cfg configs.Config, client interfaces.HTTPClient) (IdentityProvider, error) {
+	switch cfg.IdentityProvider {
+	case "", IdentityProviderAuth0:
+		return NewAuth0(cfg, client)
+	case IdentityProviderFake:
+		return NewFakeIdentityProvider(), nil
+	}
+	return nil, fmt.Errorf("Unknown identity provider %s", cfg.IdentityProvider)
+}
+
+// appMetadata returns the IDs of a User that are stored in their identity provider account
+func appMetadata(u *models.User) map[string]string {
+	data := make(map[string]string)
+	data["id"] = strconv.FormatUint(u.ID, 10)
+	if u.CandidateID > 0 {
+		data["candidateId"] = strconv.FormatUint(u.CandidateID, 10)
+	}
+	if u.JobCompanyID > 0 {
+		data["companyId"] = strconv.FormatUint(u.JobCompanyID, 10)
+	}
+	return data
+}
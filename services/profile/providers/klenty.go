@@ -10,24 +10,19 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 )
 
-// KlentyProvider describes the methods to interact with the Klenty CRM platform
-type KlentyProvider interface {
-	GetProspect(c *models.User) (map[string]string, error)
-	UpdateOrCreateProspect(c *models.User) error
-	CreateProspect(c *models.User) error
-	UpdateProspect(c *models.User) error
-	StartCadence(email, role string) error
-}
-
 type klentyProvider struct {
+	url    string
 	config configs.Config
 	client interfaces.HTTPClient
 }
 
 var (
-	klentyURL string = "https://app.klenty.com/apis/v1/user/agnes@hubbedin.com"
+	klentyURL string = "https://app.klenty.com/apis/v1/user/"
+
+	errKlentyNotConfigured = errors.New("Klenty user and API key are not configured")
 )
 
 type Prospect struct {
@@ -55,16 +50,20 @@ type Prospect struct {
 	AssignTo      string `json:"assignTo"`
 }
 
-// NewKlenty creates and returns a new KlentyProvider
-func NewKlenty(cfg configs.Config, client interfaces.HTTPClient) KlentyProvider {
+// NewKlenty creates and returns a new CRMProvider backed by the Klenty CRM platform
+func NewKlenty(cfg configs.Config, client interfaces.HTTPClient) (CRMProvider, error) {
+	if cfg.Klenty.User == "" || cfg.Klenty.APIKey == "" {
+		return nil, errKlentyNotConfigured
+	}
 	return &klentyProvider{
+		url:    klentyURL + url.PathEscape(cfg.Klenty.User),
 		config: cfg,
 		client: client,
-	}
+	}, nil
 }
 
 func (p *klentyProvider) GetProspect(u *models.User) (map[string]string, error) {
-	url := klentyURL + "/prospects"
+	url := p.url + "/prospects"
 	req, err := p.newRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -108,7 +107,7 @@ func (p *klentyProvider) UpdateOrCreateProspect(u *models.User) error {
 }
 
 func (p *klentyProvider) CreateProspect(u *models.User) error {
-	url := klentyURL + "/prospects"
+	url := p.url + "/prospects"
 
 	reqBody, err := json.Marshal(map[string]interface{}{
 		"Email":     u.Email,
@@ -138,7 +137,7 @@ func (p *klentyProvider) CreateProspect(u *models.User) error {
 }
 
 func (p *klentyProvider) UpdateProspect(u *models.User) error {
-	url := klentyURL + "/prospects/" + u.Email
+	url := p.url + "/prospects/" + u.Email
 	reqBody, err := json.Marshal(map[string]string{
 		"FirstName": u.FirstName,
 		"LastName":  u.LastName,
@@ -166,6 +165,24 @@ func (p *klentyProvider) UpdateProspect(u *models.User) error {
 	return nil
 }
 
+func (p *klentyProvider) DeleteProspect(email string) error {
+	url := p.url + "/prospects/" + url.PathEscape(email)
+	req, err := p.newRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return getKlentyRequestError(res.Body)
+}
+
 func (p *klentyProvider) StartCadence(email, role string) error {
 	var cadence string
 	if role == "Candidate" {
@@ -176,7 +193,7 @@ func (p *klentyProvider) StartCadence(email, role string) error {
 	}
 
 	if len(cadence) > 0 {
-		url := klentyURL + "/startCadence"
+		url := p.url + "/startCadence"
 		reqBody, err := json.Marshal(map[string]string{
 			"Email":       email,
 			"cadenceName": cadence,
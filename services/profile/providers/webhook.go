@@ -0,0 +1,132 @@
+package providers
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"in-backend/services/profile/configs"
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers of webhook requests.
+// The signature is the hex encoded HMAC-SHA256 of the timestamp, a period and the body,
+// keyed with the webhook secret, so that receivers can verify requests and reject replays
+const (
+	WebhookSignatureHeader string = "X-Hubbedin-Signature"
+	WebhookTimestampHeader string = "X-Hubbedin-Timestamp"
+)
+
+// Events sent to the CRM webhook
+const (
+	WebhookProspectUpdated string = "prospect.updated"
+	WebhookCadenceStarted  string = "cadence.started"
+	WebhookProspectDeleted string = "prospect.deleted"
+)
+
+var (
+	errWebhookNotConfigured = errors.New("CRM webhook URL and secret are not configured")
+)
+
+// WebhookEvent declares the body of a CRM webhook request
+type WebhookEvent struct {
+	Event string      `json:"event"`
+	Data  interface{} `json:"data"`
+}
+
+// WebhookProspect declares the data of a WebhookProspectUpdated event
+type WebhookProspect struct {
+	Email     string   `json:"email"`
+	FirstName string   `json:"first_name"`
+	LastName  string   `json:"last_name"`
+	Phone     string   `json:"phone,omitempty"`
+	Roles     []string `json:"roles"`
+}
+
+type webhookCRMProvider struct {
+	config configs.CRMWebhook
+	client interfaces.HTTPClient
+	now    func() time.Time
+}
+
+// NewWebhookCRM creates and returns a new CRMProvider that sends signed events to a webhook
+func NewWebhookCRM(cfg configs.Config, client interfaces.HTTPClient) (CRMProvider, error) {
+	if cfg.CRMWebhook.URL == "" || cfg.CRMWebhook.Secret == "" {
+		return nil, errWebhookNotConfigured
+	}
+	return &webhookCRMProvider{
+		config: cfg.CRMWebhook,
+		client: client,
+		now:    time.Now,
+	}, nil
+}
+
+// SignWebhook returns the signature of a webhook body sent at timestamp
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// UpdateOrCreateProspect sends a WebhookProspectUpdated event
+func (p *webhookCRMProvider) UpdateOrCreateProspect(u *models.User) error {
+	return p.send(WebhookProspectUpdated, &WebhookProspect{
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Phone:     u.ContactNumber,
+		Roles:     u.Roles,
+	})
+}
+
+// StartCadence sends a WebhookCadenceStarted event
+func (p *webhookCRMProvider) StartCadence(email, role string) error {
+	return p.send(WebhookCadenceStarted, map[string]string{
+		"email": email,
+		"role":  role,
+	})
+}
+
+// DeleteProspect sends a WebhookProspectDeleted event
+func (p *webhookCRMProvider) DeleteProspect(email string) error {
+	return p.send(WebhookProspectDeleted, map[string]string{
+		"email": email,
+	})
+}
+
+// send posts a signed event to the webhook, and returns an error if it is not accepted
+func (p *webhookCRMProvider) send(event string, data interface{}) error {
+	body, err := json.Marshal(&WebhookEvent{Event: event, Data: data})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", p.config.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	ts := strconv.FormatInt(p.now().Unix(), 10)
+	req.Header.Add("content-type", "application/json")
+	req.Header.Add(WebhookTimestampHeader, ts)
+	req.Header.Add(WebhookSignatureHeader, SignWebhook(p.config.Secret, ts, body))
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("CRM webhook %s failed with status %d", event, res.StatusCode)
+	}
+	return nil
+}
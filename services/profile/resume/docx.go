@@ -0,0 +1,84 @@
+package resume
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// docxBody is the part of a DOCX package that holds the text of the document
+const docxBody = "word/document.xml"
+
+// extractDOCX returns the text of the paragraphs of a DOCX document.
+// Tables are flattened so that each cell is a paragraph
+func extractDOCX(doc []byte) (string, error) {
+	r, err := zip.NewReader(bytes.NewReader(doc), int64(len(doc)))
+	if err != nil {
+		return "", ErrUnsupportedFormat
+	}
+
+	var body *zip.File
+	for _, f := range r.File {
+		if f.Name == docxBody {
+			body = f
+			break
+		}
+	}
+	if body == nil {
+		return "", ErrUnsupportedFormat
+	}
+	// a small document can inflate to a very large one
+	if body.UncompressedSize64 > 8*MaxSize {
+		return "", ErrTooLarge
+	}
+
+	rc, err := body.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(rc, 8*MaxSize))
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	d := xml.NewDecoder(bytes.NewReader(data))
+	inText := false
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", ErrUnsupportedFormat
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				sb.WriteString("\t")
+			case "br", "cr":
+				sb.WriteString("\n")
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				sb.WriteString("\n")
+			}
+		case xml.CharData:
+			if inText {
+				sb.Write(t)
+			}
+		}
+	}
+	return sb.String(), nil
+}
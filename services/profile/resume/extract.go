@@ -0,0 +1,49 @@
+package resume
+
+import (
+	"bytes"
+	"errors"
+)
+
+// MaxSize is the largest document that text is extracted from
+const MaxSize = 4 << 20
+
+var (
+	// ErrUnsupportedFormat is returned for documents that are not PDF or DOCX files
+	ErrUnsupportedFormat = errors.New("Resume must be a PDF or DOCX document")
+	// ErrTooLarge is returned for documents larger than MaxSize
+	ErrTooLarge = errors.New("Resume is too large")
+	// ErrNoText is returned for documents without extractable text, such as scanned PDFs
+	ErrNoText = errors.New("Resume has no text that can be extracted")
+)
+
+var (
+	pdfMagic = []byte("%PDF-")
+	zipMagic = []byte("PK\x03\x04")
+)
+
+// ExtractText returns the text of a PDF or DOCX document, with a line for each line or paragraph.
+// The format is detected from the content of the document rather than its name
+func ExtractText(doc []byte) (string, error) {
+	if len(doc) > MaxSize {
+		return "", ErrTooLarge
+	}
+
+	var text string
+	var err error
+	switch {
+	case bytes.HasPrefix(doc, pdfMagic):
+		text, err = extractPDF(doc)
+	case bytes.HasPrefix(doc, zipMagic):
+		text, err = extractDOCX(doc)
+	default:
+		return "", ErrUnsupportedFormat
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(bytes.TrimSpace([]byte(text))) == 0 {
+		return "", ErrNoText
+	}
+	return text, nil
+}
@@ -0,0 +1,148 @@
+package resume
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newPDF returns a PDF document of the objects, numbered from 1
+func newPDF(objects ...string) []byte {
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	for i, o := range objects {
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+	b.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+	return b.Bytes()
+}
+
+func stream(dict, data string) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", dict, len(data), data)
+}
+
+func flateStream(data string) string {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	w.Write([]byte(data))
+	w.Close()
+	return fmt.Sprintf("<< /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream", b.Len(), b.String())
+}
+
+func newDOCX(t *testing.T, body string) []byte {
+	var b bytes.Buffer
+	z := zip.NewWriter(&b)
+	w, err := z.Create("[Content_Types].xml")
+	require.NoError(t, err)
+	w.Write([]byte(`<?xml version="1.0"?><Types/>`))
+	w, err = z.Create(docxBody)
+	require.NoError(t, err)
+	w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+		body + `</w:body></w:document>`))
+	require.NoError(t, z.Close())
+	return b.Bytes()
+}
+
+func TestExtractPDF(t *testing.T) {
+	// the second page is first in the page tree, and uses a font with a ToUnicode CMap
+	cmap := stream("", strings.Join([]string{
+		"begincmap",
+		"1 begincodespacerange <0000> <FFFF> endcodespacerange",
+		"2 beginbfchar",
+		"<0001> <0047>",
+		"<0002> <006F>",
+		"endbfchar",
+		"1 beginbfrange",
+		"<0010> <0012> <0061>",
+		"endbfrange",
+		"endcmap",
+	}, "\n"))
+	doc := newPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [4 0 R 3 0 R] /Count 2 /Resources << /Font << /F1 7 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 5 0 R >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /Font << /F2 8 0 R >> >> /Contents [6 0 R] >>",
+		flateStream("BT /F1 12 Tf 72 720 Td (Experience) Tj 0 -14 Td [(Software)-300(Engineer)] TJ T* (Caf\\351 \\(Acme\\)) Tj ET"),
+		stream("", "BT /F2 12 Tf 1 0 0 1 72 720 Tm <00010002> Tj 1 0 0 1 72 700 Tm <001000110012> Tj ET"),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Type /Font /Subtype /Type0 /ToUnicode 9 0 R >>",
+		cmap,
+	)
+
+	got, err := ExtractText(doc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Go", "abc", "Experience", "Software Engineer", "Café (Acme)"}, nonEmpty(strings.Split(got, "\n")))
+}
+
+func TestExtractPDFObjectStream(t *testing.T) {
+	// the page tree is stored in a compressed object stream
+	objs := "1 0 2 50 3 100 "
+	first := len(objs)
+	body := fmt.Sprintf("%-50s%-50s%s",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Contents 5 0 R >>",
+	)
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	w.Write([]byte(objs + body))
+	w.Close()
+
+	doc := newPDF(
+		"<< /Type /Placeholder >>",
+		"<< /Type /Placeholder >>",
+		"<< /Type /Placeholder >>",
+		fmt.Sprintf("<< /Type /ObjStm /N 3 /First %d /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream", first, b.Len(), b.String()),
+		stream("", "BT (Hello) Tj ET"),
+	)
+	// objects in the body take precedence, so remove the placeholders
+	doc = bytes.Replace(doc, []byte("1 0 obj\n<< /Type /Placeholder >>\nendobj\n"), nil, 1)
+	doc = bytes.Replace(doc, []byte("2 0 obj\n<< /Type /Placeholder >>\nendobj\n"), nil, 1)
+	doc = bytes.Replace(doc, []byte("3 0 obj\n<< /Type /Placeholder >>\nendobj\n"), nil, 1)
+
+	got, err := ExtractText(doc)
+	require.NoError(t, err)
+	require.Equal(t, "Hello", strings.TrimSpace(got))
+}
+
+func TestExtractDOCX(t *testing.T) {
+	doc := newDOCX(t, `<w:p><w:r><w:t>Software Engineer</w:t><w:tab/><w:t xml:space="preserve">Jan 2019 - Present</w:t></w:r></w:p>`+
+		`<w:p><w:r><w:t>Acme </w:t></w:r><w:r><w:t>Pte Ltd</w:t><w:br/><w:t>Singapore</w:t></w:r></w:p>`)
+
+	got, err := ExtractText(doc)
+	require.NoError(t, err)
+	require.Equal(t, "Software Engineer\tJan 2019 - Present\nAcme Pte Ltd\nSingapore\n", got)
+}
+
+func TestExtractTextErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		doc     []byte
+		wantErr error
+	}{
+		{"plain text", []byte("Software Engineer"), ErrUnsupportedFormat},
+		{"zip without document", func() []byte {
+			var b bytes.Buffer
+			z := zip.NewWriter(&b)
+			z.Create("hello.txt")
+			z.Close()
+			return b.Bytes()
+		}(), ErrUnsupportedFormat},
+		{"too large", append([]byte("%PDF-"), make([]byte, MaxSize)...), ErrTooLarge},
+		{"no text", newPDF("<< /Type /Catalog /Pages 2 0 R >>", "<< /Type /Pages /Kids [3 0 R] >>", "<< /Type /Page >>"), ErrNoText},
+		{"empty docx", newDOCX(t, "<w:p/>"), ErrNoText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ExtractText(tt.doc)
+			require.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,495 @@
+package resume
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Draft is a candidate profile parsed from the text of a resume
+type Draft struct {
+	Summary     string
+	LinkedInURL string
+	SCMURL      string
+	Skills      []string
+	Jobs        []*Job
+	Academics   []*Academic
+}
+
+// Job is an entry of the work experience of a resume
+type Job struct {
+	Title       string
+	Company     string
+	StartDate   *time.Time
+	EndDate     *time.Time
+	Description string
+}
+
+// Academic is an entry of the education of a resume
+type Academic struct {
+	Institution  string
+	Course       string
+	Level        string
+	YearObtained uint32
+	Grade        string
+}
+
+// section is a part of a resume that is parsed differently
+type section int
+
+const (
+	sectionHeader section = iota
+	sectionSummary
+	sectionExperience
+	sectionEducation
+	sectionSkills
+	sectionOther
+)
+
+// maxHeaderLines is the number of lines before a date range that can hold the title and company of a job
+const maxHeaderLines = 2
+
+var headings = map[string]section{
+	"summary":                    sectionSummary,
+	"professional summary":       sectionSummary,
+	"profile":                    sectionSummary,
+	"professional profile":       sectionSummary,
+	"about":                      sectionSummary,
+	"about me":                   sectionSummary,
+	"objective":                  sectionSummary,
+	"career objective":           sectionSummary,
+	"experience":                 sectionExperience,
+	"work experience":            sectionExperience,
+	"professional experience":    sectionExperience,
+	"relevant experience":        sectionExperience,
+	"employment":                 sectionExperience,
+	"employment history":         sectionExperience,
+	"work history":               sectionExperience,
+	"career history":             sectionExperience,
+	"education":                  sectionEducation,
+	"education and training":     sectionEducation,
+	"academic background":        sectionEducation,
+	"academic qualifications":    sectionEducation,
+	"academics":                  sectionEducation,
+	"qualifications":             sectionEducation,
+	"skills":                     sectionSkills,
+	"technical skills":           sectionSkills,
+	"key skills":                 sectionSkills,
+	"core skills":                sectionSkills,
+	"skills and tools":           sectionSkills,
+	"competencies":               sectionSkills,
+	"core competencies":          sectionSkills,
+	"technologies":               sectionSkills,
+	"tools and technologies":     sectionSkills,
+	"projects":                   sectionOther,
+	"personal projects":          sectionOther,
+	"certifications":             sectionOther,
+	"awards":                     sectionOther,
+	"achievements":               sectionOther,
+	"publications":               sectionOther,
+	"languages":                  sectionOther,
+	"interests":                  sectionOther,
+	"hobbies":                    sectionOther,
+	"activities":                 sectionOther,
+	"volunteering":               sectionOther,
+	"volunteer experience":       sectionOther,
+	"references":                 sectionOther,
+	"contact":                    sectionOther,
+	"contact information":        sectionOther,
+	"extracurricular activities": sectionOther,
+}
+
+const (
+	month = `(?:jan(?:uary)?|feb(?:ruary)?|mar(?:ch)?|apr(?:il)?|may|june?|july?|aug(?:ust)?|sep(?:t(?:ember)?)?|oct(?:ober)?|nov(?:ember)?|dec(?:ember)?)`
+	date  = `(?:` + month + `\.?,?\s+(?:19|20)\d{2}|\d{1,2}/(?:19|20)\d{2}|(?:19|20)\d{2})`
+)
+
+var (
+	reDateRange = regexp.MustCompile(`(?i)\(?\b(` + date + `)\s*(?:-|–|—|to|until)\s*(` + date + `|present|current|now|today|ongoing|date)\b\)?`)
+	reMonthYear = regexp.MustCompile(`(?i)^(` + month + `)\.?,?\s+(\d{4})$`)
+	reNumYear   = regexp.MustCompile(`^(\d{1,2})/(\d{4})$`)
+	reYear      = regexp.MustCompile(`\b(?:19|20)\d{2}\b`)
+	reLinkedIn  = regexp.MustCompile(`(?i)\b(?:https?://)?(?:[a-z]{2,3}\.)?linkedin\.com/[^\s|,;()]+`)
+	reSCM       = regexp.MustCompile(`(?i)\b(?:https?://)?(?:www\.)?(?:github\.com|gitlab\.com|bitbucket\.org)/[^\s|,;()]+`)
+	reBullet    = regexp.MustCompile(`^(?:[-*•·▪◦●‣o]\s+|[-*•·▪◦●‣])`)
+	reAt        = regexp.MustCompile(`(?i)\s+(?:at|@)\s+`)
+	reSeparator = regexp.MustCompile(`\s*(?:\||\s[-–—]\s|,)\s*`)
+	reGrade     = regexp.MustCompile(`(?i)\b(?:c?gpa|grade|score)\s*:?\s*([0-9]+(?:\.[0-9]+)?(?:\s*/\s*[0-9]+(?:\.[0-9]+)?)?)`)
+	reHonours   = regexp.MustCompile(`(?i)\b(?:(?:first|second|third)\s+class(?:\s+(?:upper|lower))?(?:\s+(?:division|honou?rs))?|(?:summa|magna)?\s*cum\s+laude|with\s+(?:high\s+)?distinction|distinction|merit)\b`)
+	reSkillSep  = regexp.MustCompile(`\s*[,;|•·/]\s*`)
+
+	reTitle       = regexp.MustCompile(`(?i)\b(?:engineer|developer|programmer|architect|manager|analyst|intern|consultant|designer|scientist|director|officer|specialist|administrator|technician|lead|head|associate|executive|assistant|coordinator|president|founder|co-founder|cto|ceo|cfo|coo|vp|tester|researcher|lecturer|teacher|tutor|devops|sre|owner|partner|freelancer?)\b`)
+	reCompany     = regexp.MustCompile(`(?i)\b(?:pte|ltd|inc|llc|llp|corp|corporation|company|co|gmbh|plc|limited|group|holdings|technologies|technology|labs|solutions|systems|bank|agency|studios?|ventures|partners|consulting|software)\b\.?`)
+	reInstitution = regexp.MustCompile(`(?i)\b(?:university|universiti|universität|université|college|institute|school|polytechnic|academy|conservatory|seminary)\b`)
+
+	levels = []struct {
+		level string
+		re    *regexp.Regexp
+	}{
+		{"doctorate", regexp.MustCompile(`(?i)\b(?:ph\.?\s?d|doctor\s+of|doctorate|d\.?phil)\b`)},
+		{"master", regexp.MustCompile(`(?i)\b(?:master(?:'?s)?|m\.?sc|m\.?eng|mba|m\.?a|m\.?s|mphil|m\.?tech)\b`)},
+		{"bachelor", regexp.MustCompile(`(?i)\b(?:bachelor(?:'?s)?|b\.?sc|b\.?eng|b\.?comp|b\.?tech|b\.?a|b\.?s|honou?rs\s+degree|degree)\b`)},
+		{"diploma", regexp.MustCompile(`(?i)\b(?:diploma|associate\s+degree)\b`)},
+		{"certificate", regexp.MustCompile(`(?i)\b(?:certificate|a[\s-]levels?|o[\s-]levels?|gce|high\s+school)\b`)},
+	}
+)
+
+// Parse heuristically maps the text of a resume to a Draft.
+// Skills are taken from the skills section, and known skills are also found anywhere in the text
+func Parse(text string, knownSkills []string) *Draft {
+	d := &Draft{}
+	lines := splitLines(text)
+
+	if m := reLinkedIn.FindString(text); m != "" {
+		d.LinkedInURL = withScheme(m)
+	}
+	if m := reSCM.FindString(text); m != "" {
+		d.SCMURL = withScheme(m)
+	}
+
+	parts := map[section][]string{}
+	current := sectionHeader
+	for _, l := range lines {
+		if s, ok := heading(l); ok {
+			current = s
+			continue
+		}
+		parts[current] = append(parts[current], l)
+	}
+
+	d.Summary = parseSummary(parts[sectionSummary])
+	d.Jobs = parseJobs(parts[sectionExperience])
+	d.Academics = parseAcademics(parts[sectionEducation])
+	d.Skills = parseSkills(parts[sectionSkills], text, knownSkills)
+	return d
+}
+
+// splitLines returns the non empty lines of text with whitespace collapsed.
+// Tabs often separate the columns of a line, so they are kept as separators
+func splitLines(text string) []string {
+	var lines []string
+	for _, l := range strings.Split(strings.ReplaceAll(text, "\r", "\n"), "\n") {
+		cols := strings.Split(l, "\t")
+		for i := range cols {
+			cols[i] = strings.Join(strings.Fields(cols[i]), " ")
+		}
+		l = strings.Join(nonEmpty(cols), " | ")
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// heading returns the section of a line if it is a section heading
+func heading(l string) (section, bool) {
+	h := strings.ToLower(strings.TrimRight(strings.TrimSpace(l), ":"))
+	h = strings.ReplaceAll(h, "&", "and")
+	s, ok := headings[h]
+	return s, ok
+}
+
+func parseSummary(lines []string) string {
+	for i := range lines {
+		lines[i] = stripBullet(lines[i])
+	}
+	return strings.Join(lines, " ")
+}
+
+// parseJobs finds jobs by their date ranges, taking the title and company from the line of the date range
+// and the lines right before it, and the description from the lines after it
+func parseJobs(lines []string) []*Job {
+	var anchors []int
+	for i, l := range lines {
+		if reDateRange.MatchString(l) {
+			anchors = append(anchors, i)
+		}
+	}
+
+	// the first line of each job, including its header lines
+	starts := make([]int, len(anchors))
+	for a, i := range anchors {
+		min := 0
+		if a > 0 {
+			min = anchors[a-1] + 1
+		}
+		start := i
+		for start > min && i-start < maxHeaderLines && isHeaderLine(lines[start-1]) {
+			start--
+		}
+		starts[a] = start
+	}
+
+	var jobs []*Job
+	for a, i := range anchors {
+		end := len(lines)
+		if a+1 < len(anchors) {
+			end = starts[a+1]
+		}
+
+		m := reDateRange.FindStringSubmatchIndex(lines[i])
+		j := &Job{
+			StartDate: parseDate(lines[i][m[2]:m[3]]),
+			EndDate:   parseDate(lines[i][m[4]:m[5]]),
+		}
+
+		header := lines[starts[a]:i]
+		if rest := strings.Trim(lines[i][:m[0]]+" | "+lines[i][m[1]:], " |,-–—"); rest != "" {
+			header = append(append([]string{}, header...), rest)
+		}
+		next := i + 1
+		// the title is often on the line after the company and dates
+		if len(header) < 2 && next < end && isHeaderLine(lines[next]) && !reTitle.MatchString(strings.Join(header, " ")) {
+			header = append(header, lines[next])
+			next++
+		}
+		j.Title, j.Company = titleAndCompany(header)
+
+		var desc []string
+		for _, l := range lines[next:end] {
+			desc = append(desc, stripBullet(l))
+		}
+		j.Description = strings.Join(desc, "\n")
+		jobs = append(jobs, j)
+	}
+	return jobs
+}
+
+// isHeaderLine reports whether a line looks like a title or company rather than a description
+func isHeaderLine(l string) bool {
+	return !reBullet.MatchString(l) && len(l) <= 80 && !strings.HasSuffix(l, ".")
+}
+
+// titleAndCompany splits the header lines of a job into its title and company
+func titleAndCompany(header []string) (string, string) {
+	var title, company string
+	var rest []string
+	for _, h := range header {
+		if loc := reAt.FindStringIndex(h); loc != nil {
+			if title == "" {
+				title = strings.TrimSpace(h[:loc[0]])
+			}
+			if company == "" {
+				company = firstPart(h[loc[1]:])
+			}
+			continue
+		}
+		rest = append(rest, nonEmpty(reSeparator.Split(h, -1))...)
+	}
+
+	used := make([]bool, len(rest))
+	for i, p := range rest {
+		if title == "" && reTitle.MatchString(p) {
+			title, used[i] = p, true
+		}
+	}
+	for i, p := range rest {
+		if company == "" && !used[i] && reCompany.MatchString(p) {
+			company, used[i] = p, true
+		}
+	}
+	for i, p := range rest {
+		if used[i] || reYear.MatchString(p) {
+			continue
+		}
+		if title == "" {
+			title, used[i] = p, true
+		} else if company == "" {
+			company, used[i] = p, true
+		}
+	}
+	return title, company
+}
+
+// parseAcademics groups the lines of the education section into entries, starting a new entry
+// when an institution or course is found and the current entry already has one
+func parseAcademics(lines []string) []*Academic {
+	var academics []*Academic
+	var a *Academic
+	for _, l := range lines {
+		l = stripBullet(l)
+		for _, p := range nonEmpty(reSeparator.Split(l, -1)) {
+			text := strings.TrimSpace(reDateRange.ReplaceAllString(p, ""))
+			switch {
+			case reInstitution.MatchString(text):
+				if a == nil || a.Institution != "" {
+					a = &Academic{}
+					academics = append(academics, a)
+				}
+				a.Institution = trimYears(text)
+			case courseLevel(text) != "":
+				if a == nil || a.Course != "" {
+					a = &Academic{}
+					academics = append(academics, a)
+				}
+				a.Course = trimYears(reGrade.ReplaceAllString(text, ""))
+				a.Level = courseLevel(text)
+			}
+		}
+
+		if a == nil {
+			continue
+		}
+		if m := reGrade.FindStringSubmatch(l); m != nil && a.Grade == "" {
+			a.Grade = strings.ReplaceAll(m[1], " ", "")
+		} else if m := reHonours.FindString(l); m != "" && a.Grade == "" {
+			a.Grade = strings.TrimSpace(m)
+		}
+		// the year obtained is the end of a date range or the last year on the line
+		if m := reDateRange.FindStringSubmatch(l); m != nil {
+			if t := parseDate(m[2]); t != nil {
+				a.YearObtained = uint32(t.Year())
+			}
+		} else if ys := reYear.FindAllString(l, -1); len(ys) > 0 {
+			y, _ := strconv.Atoi(ys[len(ys)-1])
+			a.YearObtained = uint32(y)
+		}
+	}
+	return academics
+}
+
+// courseLevel returns the level of a course from its degree, or an empty string if it is not a course
+func courseLevel(s string) string {
+	for _, l := range levels {
+		if l.re.MatchString(s) {
+			return l.level
+		}
+	}
+	return ""
+}
+
+// parseSkills returns the skills listed in the skills section and the known skills mentioned in the text.
+// Known skills are returned with the name they are known by
+func parseSkills(lines []string, text string, knownSkills []string) []string {
+	known := map[string]string{}
+	for _, k := range knownSkills {
+		known[strings.ToLower(k)] = k
+	}
+
+	var skills []string
+	seen := map[string]bool{}
+	add := func(s string) {
+		s = strings.Trim(s, " .")
+		key := strings.ToLower(s)
+		if s == "" || seen[key] || len(s) > 40 || len(strings.Fields(s)) > 4 {
+			return
+		}
+		if k, ok := known[key]; ok {
+			s = k
+		}
+		seen[key] = true
+		skills = append(skills, s)
+	}
+
+	for _, l := range lines {
+		l = stripBullet(l)
+		// drop labels such as "Languages: Go, Python"
+		if i := strings.Index(l, ":"); i >= 0 && i <= 30 {
+			l = l[i+1:]
+		}
+		for _, s := range reSkillSep.Split(l, -1) {
+			add(s)
+		}
+	}
+
+	// short names such as Go and C are common words or letters, so they are only taken from the skills section
+	lower := strings.ToLower(text)
+	for _, k := range knownSkills {
+		if len(k) <= 2 || seen[strings.ToLower(k)] {
+			continue
+		}
+		if containsWord(lower, strings.ToLower(k)) {
+			add(k)
+		}
+	}
+	return skills
+}
+
+// containsWord reports whether s contains w with no letters or digits on either side
+func containsWord(s, w string) bool {
+	for i := 0; ; {
+		j := strings.Index(s[i:], w)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(w)
+		if (start == 0 || !isWordByte(s[start-1])) && (end == len(s) || !isWordByte(s[end])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '+' || c == '#' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// parseDate parses the dates of a date range, returning nil for ongoing dates such as present
+func parseDate(s string) *time.Time {
+	s = strings.TrimSpace(s)
+	var t time.Time
+	if m := reMonthYear.FindStringSubmatch(s); m != nil {
+		y, _ := strconv.Atoi(m[2])
+		t = time.Date(y, monthOf(m[1]), 1, 0, 0, 0, 0, time.UTC)
+	} else if m := reNumYear.FindStringSubmatch(s); m != nil {
+		mo, _ := strconv.Atoi(m[1])
+		y, _ := strconv.Atoi(m[2])
+		if mo < 1 || mo > 12 {
+			mo = 1
+		}
+		t = time.Date(y, time.Month(mo), 1, 0, 0, 0, 0, time.UTC)
+	} else if reYear.MatchString(s) && len(s) == 4 {
+		y, _ := strconv.Atoi(s)
+		t = time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+	} else {
+		return nil
+	}
+	return &t
+}
+
+// monthOf returns the month of a month name or abbreviation
+func monthOf(s string) time.Month {
+	s = strings.ToLower(s)
+	for m := time.January; m <= time.December; m++ {
+		if strings.HasPrefix(strings.ToLower(m.String()), s[:3]) {
+			return m
+		}
+	}
+	return time.January
+}
+
+func stripBullet(l string) string {
+	return strings.TrimSpace(reBullet.ReplaceAllString(l, ""))
+}
+
+// trimYears removes years and the punctuation around them from a part of a line
+func trimYears(s string) string {
+	return strings.Trim(reYear.ReplaceAllString(s, ""), " ()-–—,|")
+}
+
+// firstPart returns the first part of a line split on separators
+func firstPart(s string) string {
+	if parts := nonEmpty(reSeparator.Split(s, -1)); len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
+
+func withScheme(url string) string {
+	url = strings.TrimRight(url, ".")
+	if strings.HasPrefix(strings.ToLower(url), "http") {
+		return url
+	}
+	return "https://" + url
+}
+
+func nonEmpty(ss []string) []string {
+	var out []string
+	for _, s := range ss {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
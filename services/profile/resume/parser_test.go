@@ -0,0 +1,115 @@
+package resume
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newDate(y int, m time.Month) *time.Time {
+	t := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestParse(t *testing.T) {
+	text := `Jane Tan
+jane@example.com | linkedin.com/in/janetan | https://github.com/janetan
+
+Summary
+Backend engineer who likes
+distributed systems.
+
+Work Experience
+Senior Software Engineer | Acme Pte Ltd | Jan 2019 - Present
+- Built payment services in Go
+- Migrated to Kubernetes
+Globex Corporation, Singapore	03/2016 – 12/2018
+Software Developer
+• Maintained Java services.
+Data Analyst at Initech
+2014 to 2016
+
+Education
+National University of Singapore	2012 - 2016
+Bachelor of Computing in Computer Science, First Class Honours
+Singapore Polytechnic
+Diploma in Information Technology, GPA: 3.8 / 4.0	2011
+
+Skills
+Languages: Go, Java; Python
+PostgreSQL / Docker
+
+Interests
+Running`
+
+	got := Parse(text, []string{"Kubernetes", "Go", "Docker", "Terraform", "React"})
+
+	require.Equal(t, "https://linkedin.com/in/janetan", got.LinkedInURL)
+	require.Equal(t, "https://github.com/janetan", got.SCMURL)
+	require.Equal(t, "Backend engineer who likes distributed systems.", got.Summary)
+	require.Equal(t, []*Job{
+		{
+			Title:       "Senior Software Engineer",
+			Company:     "Acme Pte Ltd",
+			StartDate:   newDate(2019, time.January),
+			Description: "Built payment services in Go\nMigrated to Kubernetes",
+		},
+		{
+			Title:       "Software Developer",
+			Company:     "Globex Corporation",
+			StartDate:   newDate(2016, time.March),
+			EndDate:     newDate(2018, time.December),
+			Description: "Maintained Java services.",
+		},
+		{
+			Title:     "Data Analyst",
+			Company:   "Initech",
+			StartDate: newDate(2014, time.January),
+			EndDate:   newDate(2016, time.January),
+		},
+	}, got.Jobs)
+	require.Equal(t, []*Academic{
+		{
+			Institution:  "National University of Singapore",
+			Course:       "Bachelor of Computing in Computer Science",
+			Level:        "bachelor",
+			YearObtained: 2016,
+			Grade:        "First Class Honours",
+		},
+		{
+			Institution:  "Singapore Polytechnic",
+			Course:       "Diploma in Information Technology",
+			Level:        "diploma",
+			YearObtained: 2011,
+			Grade:        "3.8/4.0",
+		},
+	}, got.Academics)
+	// known skills are returned with the name they are known by, and short names are only taken from the skills section
+	require.Equal(t, []string{"Go", "Java", "Python", "PostgreSQL", "Docker", "Kubernetes"}, got.Skills)
+}
+
+func TestParseEmpty(t *testing.T) {
+	got := Parse("Jane Tan\nRunning, reading", nil)
+	require.Equal(t, &Draft{}, got)
+}
+
+func TestParseDate(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want *time.Time
+	}{
+		{"Sept 2019", newDate(2019, time.September)},
+		{"february, 2020", newDate(2020, time.February)},
+		{"7/2018", newDate(2018, time.July)},
+		{"13/2018", newDate(2018, time.January)},
+		{"2015", newDate(2015, time.January)},
+		{"Present", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			require.Equal(t, tt.want, parseDate(tt.in))
+		})
+	}
+}
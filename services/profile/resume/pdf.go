@@ -0,0 +1,715 @@
+package resume
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// pdfObject is an indirect object of a PDF document
+type pdfObject struct {
+	dict   string
+	stream []byte
+}
+
+// pdfDocument holds the objects of a PDF document by object number
+type pdfDocument struct {
+	objects map[int]*pdfObject
+}
+
+// cmap maps character codes of a font to text using its ToUnicode CMap
+type cmap struct {
+	codeLen int
+	codes   map[uint32]string
+}
+
+var (
+	reObject   = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	reRef      = regexp.MustCompile(`^(\d+)\s+\d+\s+R`)
+	reRefs     = regexp.MustCompile(`(\d+)\s+\d+\s+R`)
+	reFontRefs = regexp.MustCompile(`/([^\s/<>\[\]()]+)\s+(\d+)\s+\d+\s+R`)
+	reHex      = regexp.MustCompile(`<([0-9A-Fa-f\s]*)>`)
+)
+
+// extractPDF returns the text of the pages of a PDF document in order.
+// Only the text operators of content streams are interpreted, so layout is approximated
+// by starting a new line when the text moves vertically
+func extractPDF(doc []byte) (string, error) {
+	d := parsePDF(doc)
+	if len(d.objects) == 0 {
+		return "", ErrUnsupportedFormat
+	}
+
+	var sb strings.Builder
+	for _, page := range d.pages() {
+		fonts := d.fonts(page)
+		var content bytes.Buffer
+		contents := dictValue(page.dict, "Contents")
+		for _, m := range reRefs.FindAllStringSubmatch(contents, -1) {
+			if o := d.object(m[1]); o != nil {
+				content.Write(o.stream)
+				content.WriteString("\n")
+			}
+		}
+		sb.WriteString(showText(content.Bytes(), fonts))
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
+// parsePDF finds the objects of a PDF document, including those in object streams.
+// Cross reference tables are ignored so that damaged and incrementally updated documents can be read
+func parsePDF(doc []byte) *pdfDocument {
+	d := &pdfDocument{objects: map[int]*pdfObject{}}
+	locs := reObject.FindAllSubmatchIndex(doc, -1)
+	for i, loc := range locs {
+		end := len(doc)
+		if i+1 < len(locs) {
+			end = locs[i+1][0]
+		}
+		num, _ := strconv.Atoi(string(doc[loc[2]:loc[3]]))
+		d.objects[num] = parseObject(doc[loc[1]:end])
+	}
+
+	for _, o := range d.objects {
+		if nameValue(o.dict, "Type") != "ObjStm" {
+			continue
+		}
+		n, _ := strconv.Atoi(dictValue(o.dict, "N"))
+		first, _ := strconv.Atoi(dictValue(o.dict, "First"))
+		if first > len(o.stream) {
+			continue
+		}
+		header := strings.Fields(string(o.stream[:first]))
+		for i := 0; i+1 < len(header) && i/2 < n; i += 2 {
+			num, err1 := strconv.Atoi(header[i])
+			off, err2 := strconv.Atoi(header[i+1])
+			if err1 != nil || err2 != nil || first+off > len(o.stream) {
+				continue
+			}
+			end := len(o.stream)
+			if i+3 < len(header) {
+				if next, err := strconv.Atoi(header[i+3]); err == nil && first+next <= end && next >= off {
+					end = first + next
+				}
+			}
+			if _, ok := d.objects[num]; !ok {
+				d.objects[num] = &pdfObject{dict: string(o.stream[first+off : end])}
+			}
+		}
+	}
+	return d
+}
+
+// parseObject splits the body of an object into its dictionary and decoded stream
+func parseObject(body []byte) *pdfObject {
+	if i := bytes.Index(body, []byte("endobj")); i >= 0 {
+		body = body[:i]
+	}
+	i := bytes.Index(body, []byte("stream"))
+	if i < 0 {
+		return &pdfObject{dict: string(body)}
+	}
+
+	o := &pdfObject{dict: string(body[:i])}
+	data := body[i+len("stream"):]
+	if bytes.HasPrefix(data, []byte("\r\n")) {
+		data = data[2:]
+	} else if bytes.HasPrefix(data, []byte("\n")) {
+		data = data[1:]
+	}
+	if n, err := strconv.Atoi(dictValue(o.dict, "Length")); err == nil && n <= len(data) {
+		data = data[:n]
+	} else if j := bytes.LastIndex(data, []byte("endstream")); j >= 0 {
+		data = data[:j]
+	}
+
+	filter := dictValue(o.dict, "Filter")
+	switch {
+	case filter == "":
+		o.stream = data
+	case strings.Contains(filter, "FlateDecode") && !strings.Contains(filter, "DCT"):
+		o.stream = inflate(data)
+	}
+	return o
+}
+
+// inflate decodes a FlateDecode stream, returning what could be decoded of a damaged one
+func inflate(data []byte) []byte {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	defer r.Close()
+	out, _ := ioutil.ReadAll(io.LimitReader(r, 8*MaxSize))
+	return out
+}
+
+// object returns an object by its number, or nil if it does not exist
+func (d *pdfDocument) object(num string) *pdfObject {
+	n, err := strconv.Atoi(num)
+	if err != nil {
+		return nil
+	}
+	return d.objects[n]
+}
+
+// resolve returns the dictionary of a value, following it if it is a reference
+func (d *pdfDocument) resolve(v string) string {
+	if m := reRef.FindStringSubmatch(v); m != nil {
+		if o := d.object(m[1]); o != nil {
+			return o.dict
+		}
+		return ""
+	}
+	return v
+}
+
+// pages returns the page objects in the order of the page tree,
+// or in object order when the document has no usable page tree
+func (d *pdfDocument) pages() []*pdfObject {
+	var pages []*pdfObject
+	seen := map[string]bool{}
+	var walk func(ref string)
+	walk = func(ref string) {
+		if seen[ref] {
+			return
+		}
+		seen[ref] = true
+		o := d.object(ref)
+		if o == nil {
+			return
+		}
+		if nameValue(o.dict, "Type") == "Page" {
+			pages = append(pages, o)
+			return
+		}
+		for _, m := range reRefs.FindAllStringSubmatch(dictValue(o.dict, "Kids"), -1) {
+			walk(m[1])
+		}
+	}
+	for _, o := range d.objects {
+		if nameValue(o.dict, "Type") != "Catalog" {
+			continue
+		}
+		if m := reRef.FindStringSubmatch(dictValue(o.dict, "Pages")); m != nil {
+			walk(m[1])
+		}
+	}
+	if len(pages) > 0 {
+		return pages
+	}
+
+	nums := make([]int, 0, len(d.objects))
+	for n, o := range d.objects {
+		if nameValue(o.dict, "Type") == "Page" {
+			nums = append(nums, n)
+		}
+	}
+	sort.Ints(nums)
+	for _, n := range nums {
+		pages = append(pages, d.objects[n])
+	}
+	return pages
+}
+
+// fonts returns the ToUnicode CMaps of the fonts of a page by resource name
+func (d *pdfDocument) fonts(page *pdfObject) map[string]*cmap {
+	fonts := map[string]*cmap{}
+	// resources can be inherited from the nodes of the page tree
+	resources := d.resolve(dictValue(page.dict, "Resources"))
+	for node, i := page, 0; resources == "" && node != nil && i < 16; i++ {
+		node = nil
+		if m := reRef.FindStringSubmatch(dictValue(page.dict, "Parent")); m != nil {
+			node = d.object(m[1])
+		}
+		if node != nil {
+			resources = d.resolve(dictValue(node.dict, "Resources"))
+			page = node
+		}
+	}
+	for _, m := range reFontRefs.FindAllStringSubmatch(d.resolve(dictValue(resources, "Font")), -1) {
+		font := d.object(m[2])
+		if font == nil {
+			continue
+		}
+		if r := reRef.FindStringSubmatch(dictValue(font.dict, "ToUnicode")); r != nil {
+			if o := d.object(r[1]); o != nil {
+				fonts[m[1]] = parseCMap(o.stream)
+			}
+		}
+	}
+	return fonts
+}
+
+// parseCMap parses the bfchar and bfrange mappings of a ToUnicode CMap
+func parseCMap(data []byte) *cmap {
+	c := &cmap{codeLen: 1, codes: map[uint32]string{}}
+	s := string(data)
+	if sec := between(s, "begincodespacerange", "endcodespacerange"); len(sec) > 0 {
+		if m := reHex.FindStringSubmatch(sec[0]); m != nil {
+			c.codeLen = max(1, len(hexBytes(m[1])))
+		}
+	}
+
+	for _, sec := range between(s, "beginbfchar", "endbfchar") {
+		m := reHex.FindAllStringSubmatch(sec, -1)
+		for i := 0; i+1 < len(m); i += 2 {
+			c.codes[code(hexBytes(m[i][1]))] = utf16Text(hexBytes(m[i+1][1]))
+		}
+	}
+
+	for _, sec := range between(s, "beginbfrange", "endbfrange") {
+		for _, line := range strings.Split(sec, "\n") {
+			m := reHex.FindAllStringSubmatch(line, -1)
+			if len(m) < 3 {
+				continue
+			}
+			lo, hi := code(hexBytes(m[0][1])), code(hexBytes(m[1][1]))
+			if hi < lo || hi-lo > 0xffff {
+				continue
+			}
+			if strings.Contains(line, "[") {
+				for i, dst := range m[2:] {
+					if lo+uint32(i) > hi {
+						break
+					}
+					c.codes[lo+uint32(i)] = utf16Text(hexBytes(dst[1]))
+				}
+				continue
+			}
+			dst := hexBytes(m[2][1])
+			for cc := lo; cc <= hi; cc++ {
+				c.codes[cc] = utf16Text(dst)
+				incrementLast(dst)
+			}
+		}
+	}
+	return c
+}
+
+// decode returns the text of a string shown with the font of the CMap
+func (c *cmap) decode(b []byte) string {
+	if c == nil {
+		// without a CMap the simple fonts used by most resume generators are close to Latin-1
+		runes := make([]rune, len(b))
+		for i, ch := range b {
+			runes[i] = rune(ch)
+		}
+		return string(runes)
+	}
+	var sb strings.Builder
+	for i := 0; i+c.codeLen <= len(b); i += c.codeLen {
+		if t, ok := c.codes[code(b[i:i+c.codeLen])]; ok {
+			sb.WriteString(t)
+		}
+	}
+	return sb.String()
+}
+
+// showText interprets the text operators of a content stream
+func showText(content []byte, fonts map[string]*cmap) string {
+	var sb strings.Builder
+	var font *cmap
+	var operands []interface{}
+	lastY, hasY := 0.0, false
+
+	newline := func() {
+		s := sb.String()
+		if len(s) > 0 && !strings.HasSuffix(s, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	space := func() {
+		s := sb.String()
+		if len(s) > 0 && !strings.HasSuffix(s, " ") && !strings.HasSuffix(s, "\n") {
+			sb.WriteString(" ")
+		}
+	}
+	show := func(v interface{}) {
+		switch t := v.(type) {
+		case []byte:
+			sb.WriteString(font.decode(t))
+		case []interface{}:
+			for _, e := range t {
+				switch x := e.(type) {
+				case []byte:
+					sb.WriteString(font.decode(x))
+				case float64:
+					// large negative adjustments are used as word spaces by many generators
+					if x < -200 {
+						space()
+					}
+				}
+			}
+		}
+	}
+	number := func(i int) float64 {
+		if i < 0 || i >= len(operands) {
+			return 0
+		}
+		f, _ := operands[i].(float64)
+		return f
+	}
+	last := func() interface{} {
+		if len(operands) == 0 {
+			return nil
+		}
+		return operands[len(operands)-1]
+	}
+
+	l := &lexer{data: content}
+	for {
+		tok, ok := l.next()
+		if !ok {
+			break
+		}
+		op, isOp := tok.(operator)
+		if !isOp {
+			operands = append(operands, tok)
+			continue
+		}
+
+		switch op {
+		case "Tf":
+			if len(operands) > 0 {
+				if name, ok := operands[0].(name); ok {
+					font = fonts[string(name)]
+				}
+			}
+		case "Td", "TD":
+			if number(1) != 0 {
+				newline()
+			} else if number(0) != 0 {
+				space()
+			}
+		case "Tm":
+			y := number(5)
+			if hasY && y != lastY {
+				newline()
+			} else {
+				space()
+			}
+			lastY, hasY = y, true
+		case "T*":
+			newline()
+		case "Tj", "TJ":
+			show(last())
+		case "'", "\"":
+			newline()
+			show(last())
+		case "ET":
+			space()
+		}
+		operands = operands[:0]
+	}
+	return sb.String()
+}
+
+// name is a PDF name operand without its leading slash
+type name string
+
+// operator is a content stream operator
+type operator string
+
+// lexer tokenizes content streams into numbers, names, strings, arrays and operators
+type lexer struct {
+	data []byte
+	pos  int
+}
+
+// next returns the next token of the content stream, skipping dictionaries and inline images
+func (l *lexer) next() (interface{}, bool) {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		switch {
+		case isSpace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		case c == '(':
+			return l.literal(), true
+		case c == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+			l.pos += 2
+		case c == '>' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '>':
+			l.pos += 2
+		case c == '<':
+			end := bytes.IndexByte(l.data[l.pos:], '>')
+			if end < 0 {
+				l.pos = len(l.data)
+				return nil, false
+			}
+			b := hexBytes(string(l.data[l.pos+1 : l.pos+end]))
+			l.pos += end + 1
+			return b, true
+		case c == '[':
+			l.pos++
+			var arr []interface{}
+			for {
+				l.skipSpace()
+				if l.pos >= len(l.data) {
+					return arr, true
+				}
+				if l.data[l.pos] == ']' {
+					l.pos++
+					return arr, true
+				}
+				tok, ok := l.next()
+				if !ok {
+					return arr, true
+				}
+				arr = append(arr, tok)
+			}
+		case c == ']' || c == '{' || c == '}' || c == ')' || c == '>':
+			l.pos++
+		case c == '/':
+			l.pos++
+			return name(l.word()), true
+		default:
+			w := l.word()
+			if w == "" {
+				w = string(c)
+				l.pos++
+			}
+			if f, err := strconv.ParseFloat(w, 64); err == nil {
+				return f, true
+			}
+			if w == "ID" {
+				// skip the binary data of an inline image
+				end := bytes.Index(l.data[l.pos:], []byte("EI"))
+				if end < 0 {
+					l.pos = len(l.data)
+				} else {
+					l.pos += end + 2
+				}
+				continue
+			}
+			return operator(w), true
+		}
+	}
+	return nil, false
+}
+
+// word reads a run of regular characters
+func (l *lexer) word() string {
+	start := l.pos
+	for l.pos < len(l.data) && !isSpace(l.data[l.pos]) && !isDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	return string(l.data[start:l.pos])
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.data) && isSpace(l.data[l.pos]) {
+		l.pos++
+	}
+}
+
+// literal reads a literal string with balanced parentheses and escapes
+func (l *lexer) literal() []byte {
+	var out []byte
+	depth := 0
+	l.pos++
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+			out = append(out, c)
+		case ')':
+			if depth == 0 {
+				return out
+			}
+			depth--
+			out = append(out, c)
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r', '\n':
+				// line continuation
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					out = append(out, byte(v))
+				} else {
+					out = append(out, e)
+				}
+			}
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t' || c == '\f' || c == 0
+}
+
+func isDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+// dictValue returns the raw value of a key of a dictionary, such as a number, name, reference,
+// array or nested dictionary, or an empty string if the key does not exist
+func dictValue(dict, key string) string {
+	k := "/" + key
+	for i := 0; ; {
+		j := strings.Index(dict[i:], k)
+		if j < 0 {
+			return ""
+		}
+		i += j + len(k)
+		if i < len(dict) && !isSpace(dict[i]) && !isDelimiter(dict[i]) {
+			continue
+		}
+		v := strings.TrimLeft(dict[i:], " \r\n\t\f")
+		switch {
+		case strings.HasPrefix(v, "<<"):
+			return balanced(v, "<<", ">>")
+		case strings.HasPrefix(v, "["):
+			return balanced(v, "[", "]")
+		case strings.HasPrefix(v, "/"):
+			l := &lexer{data: []byte(v[1:])}
+			return "/" + l.word()
+		}
+		if m := reRef.FindString(v); m != "" {
+			return m
+		}
+		l := &lexer{data: []byte(v)}
+		return l.word()
+	}
+}
+
+// nameValue returns the value of a key of a dictionary that holds a name, without its slash
+func nameValue(dict, key string) string {
+	return strings.TrimPrefix(dictValue(dict, key), "/")
+}
+
+// balanced returns the prefix of s that is enclosed by balanced open and close delimiters
+func balanced(s, open, close string) string {
+	depth := 0
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], open):
+			depth++
+			i += len(open)
+		case strings.HasPrefix(s[i:], close):
+			depth--
+			i += len(close)
+			if depth == 0 {
+				return s[:i]
+			}
+		default:
+			i++
+		}
+	}
+	return s
+}
+
+// between returns the sections of s that are enclosed by begin and end keywords
+func between(s, begin, end string) []string {
+	var secs []string
+	for {
+		i := strings.Index(s, begin)
+		if i < 0 {
+			return secs
+		}
+		s = s[i+len(begin):]
+		j := strings.Index(s, end)
+		if j < 0 {
+			return append(secs, s)
+		}
+		secs = append(secs, s[:j])
+		s = s[j+len(end):]
+	}
+}
+
+// hexBytes decodes a hex string, ignoring whitespace and padding an odd final digit with zero
+func hexBytes(s string) []byte {
+	var digits []byte
+	for i := 0; i < len(s); i++ {
+		if v, ok := hexDigit(s[i]); ok {
+			digits = append(digits, v)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, 0)
+	}
+	out := make([]byte, len(digits)/2)
+	for i := range out {
+		out[i] = digits[2*i]<<4 | digits[2*i+1]
+	}
+	return out
+}
+
+func hexDigit(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// code returns the big endian value of a character code
+func code(b []byte) uint32 {
+	var c uint32
+	for _, x := range b {
+		c = c<<8 | uint32(x)
+	}
+	return c
+}
+
+// incrementLast increments the last byte of the destination of a bfrange in place
+func incrementLast(b []byte) {
+	if len(b) > 0 {
+		b[len(b)-1]++
+	}
+}
+
+// utf16Text decodes the UTF-16BE destination of a CMap mapping
+func utf16Text(b []byte) string {
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		u = append(u, uint16(b[i])<<8|uint16(b[i+1]))
+	}
+	return string(utf16.Decode(u))
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,12 @@
+create table if not exists erasure_receipts (
+    id bigserial not null primary key,
+    -- ID of the erased user, which is not a foreign key as the user no longer exists
+    user_id bigint not null unique,
+    email_hash text not null,
+    requested_by bigint,
+    records jsonb not null,
+    external_systems text[],
+    created_at timestamptz not null default now()
+);
+
+create index on erasure_receipts (email_hash);
@@ -0,0 +1,5 @@
+drop table if exists addresses;
+drop table if exists cities;
+drop table if exists states;
+drop table if exists countries;
+drop table if exists regions;
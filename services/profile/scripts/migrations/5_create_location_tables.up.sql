@@ -0,0 +1,47 @@
+create table if not exists regions (
+    id bigserial not null primary key,
+    name text not null unique
+);
+
+create table if not exists countries (
+    id bigserial not null primary key,
+    region_id bigint not null,
+    name text not null,
+    iso_code text not null unique,
+    calling_code text,
+    currency text,
+    constraint fk_regions foreign key(region_id) references regions(id) on delete cascade on update cascade
+);
+
+create index on countries (region_id);
+
+create table if not exists states (
+    id bigserial not null primary key,
+    country_id bigint not null,
+    name text not null,
+    code text,
+    constraint fk_countries foreign key(country_id) references countries(id) on delete cascade on update cascade,
+    unique (country_id, name)
+);
+
+create table if not exists cities (
+    id bigserial not null primary key,
+    state_id bigint not null,
+    name text not null,
+    constraint fk_states foreign key(state_id) references states(id) on delete cascade on update cascade,
+    unique (state_id, name)
+);
+
+create table if not exists addresses (
+    id bigserial not null primary key,
+    candidate_id bigint not null,
+    street_address text,
+    city_id bigint not null,
+    postcode text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    constraint fk_candidates foreign key(candidate_id) references candidates(id) on delete cascade on update cascade,
+    constraint fk_cities foreign key(city_id) references cities(id) on delete restrict on update cascade
+);
+
+create index on addresses (candidate_id);
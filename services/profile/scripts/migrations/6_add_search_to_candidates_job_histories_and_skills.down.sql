@@ -0,0 +1,8 @@
+alter table skills
+drop column search;
+
+alter table job_histories
+drop column search;
+
+alter table candidates
+drop column search;
@@ -0,0 +1,14 @@
+alter table candidates
+add search tsvector generated always as (to_tsvector('english', coalesce(summary, ''))) stored;
+
+create index on candidates using gin (search);
+
+alter table job_histories
+add search tsvector generated always as (to_tsvector('english', title)) stored;
+
+create index on job_histories using gin (search);
+
+alter table skills
+add search tsvector generated always as (to_tsvector('english', name)) stored;
+
+create index on skills using gin (search);
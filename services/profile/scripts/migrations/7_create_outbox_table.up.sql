@@ -0,0 +1,13 @@
+create table if not exists outbox (
+    id bigserial not null primary key,
+    kind text not null,
+    payload jsonb not null,
+    status text not null default 'Pending',
+    attempts int not null default 0,
+    last_error text,
+    next_attempt_at timestamptz not null default now(),
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+
+create index on outbox (status, next_attempt_at);
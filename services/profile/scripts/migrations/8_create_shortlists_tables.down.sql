@@ -0,0 +1,3 @@
+drop table if exists shortlists_shares;
+drop table if exists shortlists_candidates;
+drop table if exists shortlists;
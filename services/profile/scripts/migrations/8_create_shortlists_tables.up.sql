@@ -0,0 +1,34 @@
+create table if not exists shortlists (
+    id bigserial not null primary key,
+    user_id bigint not null,
+    name text not null,
+    created_at timestamptz,
+    updated_at timestamptz,
+    constraint fk_users foreign key(user_id) references users(id) on delete cascade on update cascade
+);
+
+create index on shortlists (user_id);
+
+create table if not exists shortlists_candidates (
+    id bigserial not null primary key,
+    shortlist_id bigint not null,
+    candidate_id bigint not null,
+    notes text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    constraint fk_shortlists foreign key(shortlist_id) references shortlists(id) on delete cascade on update cascade,
+    constraint fk_candidates foreign key(candidate_id) references candidates(id) on delete cascade on update cascade,
+    unique (shortlist_id, candidate_id)
+);
+
+create table if not exists shortlists_shares (
+    id bigserial not null primary key,
+    shortlist_id bigint not null,
+    user_id bigint not null,
+    created_at timestamptz,
+    constraint fk_shortlists foreign key(shortlist_id) references shortlists(id) on delete cascade on update cascade,
+    constraint fk_users foreign key(user_id) references users(id) on delete cascade on update cascade,
+    unique (shortlist_id, user_id)
+);
+
+create index on shortlists_shares (user_id);
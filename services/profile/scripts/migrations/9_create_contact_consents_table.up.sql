@@ -0,0 +1,11 @@
+create table if not exists contact_consents (
+    id bigserial not null primary key,
+    candidate_id bigint not null,
+    -- ID of the company in the joblisting service
+    company_id bigint not null,
+    created_at timestamptz,
+    constraint fk_candidates foreign key(candidate_id) references candidates(id) on delete cascade on update cascade,
+    unique (candidate_id, company_id)
+);
+
+create index on contact_consents (company_id);
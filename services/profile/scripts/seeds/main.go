@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"in-backend/services/profile/configs"
+	"in-backend/services/profile/database"
+)
+
+const usageText = `This program seeds reference data into the db. Supported commands are:
+  - countries [file] - creates or updates ISO countries and regions, defaults to countries.csv
+Usage:
+  go run *.go <command> [args]
+`
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+	}
+
+	cfg, err := configs.LoadConfig(configs.FileName)
+	if err != nil {
+		println(err.Error())
+		os.Exit(-1)
+	}
+
+	db := database.NewDatabase(database.GetPgConnectionOptions(cfg))
+	defer db.Close()
+
+	switch args[0] {
+	case "countries":
+		file := "countries.csv"
+		if len(args) > 1 {
+			file = args[1]
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			exitf(err.Error())
+		}
+		defer f.Close()
+
+		n, err := database.SeedCountries(context.Background(), database.NewLocationRepository(db), f)
+		if err != nil {
+			exitf(err.Error())
+		}
+		fmt.Printf("seeded %d countries\n", n)
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Print(usageText)
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func exitf(s string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, s+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"in-backend/services/profile/models"
+)
+
+var errContactConsentInvalid = errors.New("Contact consent must have a candidate and a company")
+
+/* --------------- Contact Consent --------------- */
+
+// GrantContactConsent lets the users of a Company see the personal details of a Candidate
+func (s *service) GrantContactConsent(ctx context.Context, consent *models.ContactConsent) (*models.ContactConsent, error) {
+	if consent == nil || consent.CandidateID == 0 || consent.CompanyID == 0 {
+		return nil, errContactConsentInvalid
+	}
+
+	m, err := s.repository.CreateContactConsent(ctx, consent)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetAllContactConsents returns all ContactConsents that match the filters
+func (s *service) GetAllContactConsents(ctx context.Context, f models.ContactConsentFilters) ([]*models.ContactConsent, error) {
+	m, err := s.repository.GetAllContactConsents(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// RevokeContactConsent stops letting the users of a Company see the personal details of a Candidate
+func (s *service) RevokeContactConsent(ctx context.Context, cid, coid uint64) error {
+	err := s.repository.DeleteContactConsent(ctx, cid, coid)
+	if err != nil {
+		return err
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"in-backend/rbac"
+	"in-backend/services/profile/models"
+)
+
+var (
+	errUserNotFound           = errors.New("User not found")
+	errErasureReceiptNotFound = errors.New("Erasure receipt not found")
+)
+
+/* --------------- Personal Data --------------- */
+
+// ExportMyData returns a zip archive with a JSON file of the data about a User kept by each service
+func (s *service) ExportMyData(ctx context.Context, id uint64) (*models.ProfileExport, error) {
+	d, err := s.repository.GetPersonalData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil || d.User == nil {
+		return nil, errUserNotFound
+	}
+
+	profile, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{"profile.json": profile}
+
+	if d.User.CandidateID > 0 {
+		remote, err := s.repository.ExportCandidateData(ctx, d.User.ID, d.User.CandidateID)
+		if err != nil {
+			return nil, err
+		}
+		for svc, data := range remote {
+			var b bytes.Buffer
+			if err := json.Indent(&b, data, "", "  "); err != nil {
+				return nil, err
+			}
+			files[svc+".json"] = b.Bytes()
+		}
+	}
+
+	data, err := zipFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ProfileExport{ContentType: "application/zip", Data: data}, nil
+}
+
+// zipFiles returns a zip archive of files by name, in the order of their names
+func zipFiles(files map[string][]byte) ([]byte, error) {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		f, err := w.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EraseUser erases the data about a User, including a User that was soft deleted.
+// The data kept by the other services is erased first, so that a failure leaves the User in place
+// and the erasure can be retried. The User is then hard deleted with their ErasureReceipt,
+// which records who requested the erasure and how many records were erased
+func (s *service) EraseUser(ctx context.Context, id uint64) (*models.ErasureReceipt, error) {
+	u, err := s.repository.GetUserWithDeleted(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, errUserNotFound
+	}
+
+	receipt := &models.ErasureReceipt{
+		UserID:    u.ID,
+		EmailHash: models.HashEmail(s.emailHashKey, u.Email),
+		Records:   make(map[string]uint64),
+	}
+	if sub := rbac.FromContext(ctx); sub != nil {
+		receipt.RequestedBy = sub.ID
+	}
+
+	if u.CandidateID > 0 {
+		erased, err := s.repository.EraseCandidateData(ctx, u.ID, u.CandidateID)
+		if err != nil {
+			return nil, err
+		}
+		for svc, n := range erased {
+			receipt.Records[svc] = n
+		}
+	}
+
+	m, err := s.repository.EraseUser(ctx, u, receipt)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetErasureReceipt returns the ErasureReceipt of an erased User
+func (s *service) GetErasureReceipt(ctx context.Context, uid uint64) (*models.ErasureReceipt, error) {
+	m, err := s.repository.GetErasureReceipt(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errErasureReceiptNotFound
+	}
+	return m, err
+}
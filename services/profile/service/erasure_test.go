@@ -0,0 +1,92 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"in-backend/rbac"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportMyData(t *testing.T) {
+	d := &models.PersonalData{User: &models.User{ID: 5, CandidateID: 50, Email: "jane@example.com"}}
+
+	repo := &mocks.Repository{}
+	repo.On("GetPersonalData", ctx, uint64(5)).Return(d, nil)
+	repo.On("GetPersonalData", ctx, uint64(6)).Return(nil, nil)
+	repo.On("ExportCandidateData", ctx, uint64(5), uint64(50)).Return(map[string][]byte{
+		"joblisting": []byte(`{"applications":[]}`),
+		"assessment": []byte(`{"assessment_attempts":[]}`),
+		"project":    []byte(`{"projects":[],"candidate_projects":[]}`),
+	}, nil)
+
+	s := New(repo, nil, nil, nil)
+	got, err := s.ExportMyData(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, "application/zip", got.ContentType)
+
+	r, err := zip.NewReader(bytes.NewReader(got.Data), int64(len(got.Data)))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"assessment.json", "joblisting.json", "profile.json", "project.json"}, names)
+
+	f, err := r.File[2].Open()
+	require.NoError(t, err)
+	profile, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	require.Contains(t, string(profile), "jane@example.com")
+
+	_, err = s.ExportMyData(ctx, 6)
+	require.Equal(t, errUserNotFound, err)
+}
+
+func TestEraseUser(t *testing.T) {
+	u := &models.User{ID: 5, AuthID: "auth0|5", CandidateID: 50, Email: "Jane@example.com"}
+	admin := rbac.NewContext(ctx, &rbac.Subject{ID: 1, Roles: []string{"Admin"}})
+
+	t.Run("erased", func(t *testing.T) {
+		repo := &mocks.Repository{}
+		repo.On("GetUserWithDeleted", admin, uint64(5)).Return(u, nil)
+		repo.On("EraseCandidateData", admin, uint64(5), uint64(50)).Return(map[string]uint64{"joblisting": 2, "assessment": 3, "project": 1}, nil)
+		repo.On("EraseUser", admin, u, mock.Anything).Return(func(_ context.Context, _ *models.User, r *models.ErasureReceipt) *models.ErasureReceipt {
+			return r
+		}, nil)
+
+		got, err := New(repo, nil, nil, []byte("secret")).EraseUser(admin, 5)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), got.UserID)
+		require.Equal(t, uint64(1), got.RequestedBy)
+		require.Equal(t, models.HashEmail([]byte("secret"), "jane@example.com"), got.EmailHash)
+		require.Equal(t, map[string]uint64{"joblisting": 2, "assessment": 3, "project": 1}, got.Records)
+	})
+
+	t.Run("other service failed", func(t *testing.T) {
+		repo := &mocks.Repository{}
+		repo.On("GetUserWithDeleted", admin, uint64(5)).Return(u, nil)
+		repo.On("EraseCandidateData", admin, uint64(5), uint64(50)).Return(nil, errors.New("unavailable"))
+
+		_, err := New(repo, nil, nil, nil).EraseUser(admin, 5)
+		require.Error(t, err)
+		// the user is kept so that the erasure can be retried
+		repo.AssertNotCalled(t, "EraseUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo := &mocks.Repository{}
+		repo.On("GetUserWithDeleted", admin, uint64(6)).Return(nil, nil)
+
+		_, err := New(repo, nil, nil, nil).EraseUser(admin, 6)
+		require.Equal(t, errUserNotFound, err)
+	})
+}
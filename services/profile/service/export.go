@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"in-backend/services/profile/export"
+	"in-backend/services/profile/models"
+)
+
+var errExportFormat = errors.New("Export format must be json or pdf")
+
+/* --------------- Export --------------- */
+
+// ExportCandidateProfile renders the profile of a Candidate as a JSON Resume or a PDF document,
+// masking the fields hidden by the options
+func (s *service) ExportCandidateProfile(ctx context.Context, id uint64, o models.ExportOptions) (*models.ProfileExport, error) {
+	if o.Format == "" {
+		o.Format = models.ExportFormatJSON
+	}
+	if o.Format != models.ExportFormatJSON && o.Format != models.ExportFormatPDF {
+		return nil, errExportFormat
+	}
+
+	u, err := s.repository.GetCandidateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || u.Candidate == nil {
+		return nil, errCandidateNotFound
+	}
+
+	r := export.JSONResume(u, o)
+	if o.Format == models.ExportFormatPDF {
+		return &models.ProfileExport{ContentType: "application/pdf", Data: export.PDF(r)}, nil
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &models.ProfileExport{ContentType: "application/json", Data: data}, nil
+}
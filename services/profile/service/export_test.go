@@ -0,0 +1,62 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"in-backend/services/profile/export"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCandidateProfile(t *testing.T) {
+	u := &models.User{
+		ID:            1,
+		FirstName:     "Jane",
+		LastName:      "Tan",
+		Email:         "jane@example.com",
+		ContactNumber: "+6591234567",
+		Candidate:     &models.Candidate{ID: 1, ExpectedSalaryCurrency: "SGD", ExpectedSalary: 8000},
+	}
+
+	var tests = []struct {
+		name            string
+		id              uint64
+		opts            models.ExportOptions
+		wantContentType string
+		wantErr         error
+	}{
+		{"json by default", 1, models.ExportOptions{}, "application/json", nil},
+		{"masked json", 1, models.ExportOptions{Format: "json", HideContactNumber: true, HideSalary: true}, "application/json", nil},
+		{"pdf", 1, models.ExportOptions{Format: "pdf"}, "application/pdf", nil},
+		{"unknown format", 1, models.ExportOptions{Format: "docx"}, "", errExportFormat},
+		{"candidate not found", 2, models.ExportOptions{}, "", errCandidateNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.Repository{}
+			repo.On("GetCandidateByID", ctx, uint64(1)).Return(u, nil)
+			repo.On("GetCandidateByID", ctx, uint64(2)).Return(nil, nil)
+
+			s := New(repo, nil, nil, nil)
+			got, err := s.ExportCandidateProfile(ctx, tt.id, tt.opts)
+			require.Equal(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				require.Nil(t, got)
+				return
+			}
+			require.Equal(t, tt.wantContentType, got.ContentType)
+			if got.ContentType != "application/json" {
+				require.Equal(t, "%PDF-", string(got.Data[:5]))
+				return
+			}
+
+			var r export.Resume
+			require.NoError(t, json.Unmarshal(got.Data, &r))
+			require.Equal(t, export.JSONResume(u, tt.opts), &r)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+)
+
+var (
+	errStateInvalid    = errors.New("State must have a name and country")
+	errCityInvalid     = errors.New("City must have a name and state")
+	errAddressInvalid  = errors.New("Address must have a city")
+	errAddressNotFound = errors.New("Address not found")
+)
+
+// locationService implements the profile LocationService interface
+type locationService struct {
+	repository interfaces.LocationRepository
+}
+
+// NewLocationService creates and returns a new LocationService that implements the profile LocationService interface
+func NewLocationService(r interfaces.LocationRepository) interfaces.LocationService {
+	return &locationService{
+		repository: r,
+	}
+}
+
+/* --------------- Region --------------- */
+
+// GetRegions returns all Regions
+func (s *locationService) GetRegions(ctx context.Context) ([]*models.Region, error) {
+	m, err := s.repository.GetAllRegions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+/* --------------- Country --------------- */
+
+// GetCountries returns all Countries
+func (s *locationService) GetCountries(ctx context.Context, f models.CountryFilters) ([]*models.Country, error) {
+	m, err := s.repository.GetAllCountries(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+/* --------------- State --------------- */
+
+// CreateState creates a new State
+func (s *locationService) CreateState(ctx context.Context, state *models.State) (*models.State, error) {
+	if state == nil || state.CountryID == 0 || strings.TrimSpace(state.Name) == "" {
+		return nil, errStateInvalid
+	}
+	state.Name = strings.TrimSpace(state.Name)
+
+	m, err := s.repository.CreateState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetStates returns all States
+func (s *locationService) GetStates(ctx context.Context, f models.StateFilters) ([]*models.State, error) {
+	m, err := s.repository.GetAllStates(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+/* --------------- City --------------- */
+
+// CreateCity creates a new City
+func (s *locationService) CreateCity(ctx context.Context, city *models.City) (*models.City, error) {
+	if city == nil || city.StateID == 0 || strings.TrimSpace(city.Name) == "" {
+		return nil, errCityInvalid
+	}
+	city.Name = strings.TrimSpace(city.Name)
+
+	m, err := s.repository.CreateCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetCities returns all Cities
+func (s *locationService) GetCities(ctx context.Context, f models.CityFilters) ([]*models.City, error) {
+	m, err := s.repository.GetAllCities(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+/* --------------- Address --------------- */
+
+// CreateAddress creates a new Address
+func (s *locationService) CreateAddress(ctx context.Context, address *models.Address) (*models.Address, error) {
+	if address == nil || address.CityID == 0 {
+		return nil, errAddressInvalid
+	}
+
+	m, err := s.repository.CreateAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetAddress returns an Address by ID
+func (s *locationService) GetAddress(ctx context.Context, id uint64) (*models.Address, error) {
+	m, err := s.repository.GetAddress(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// UpdateAddress updates an Address
+func (s *locationService) UpdateAddress(ctx context.Context, address *models.Address) (*models.Address, error) {
+	if address == nil || address.CityID == 0 {
+		return nil, errAddressInvalid
+	}
+
+	existing, err := s.repository.GetAddress(ctx, address.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, errAddressNotFound
+	}
+	// addresses cannot be moved to another candidate
+	address.CandidateID = existing.CandidateID
+
+	m, err := s.repository.UpdateAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// DeleteAddress deletes an Address by ID
+func (s *locationService) DeleteAddress(ctx context.Context, id uint64) error {
+	err := s.repository.DeleteAddress(ctx, id)
+	if err != nil {
+		return err
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLocationService(t *testing.T) {
+	lr := &mocks.LocationRepository{}
+	require.Equal(t, &locationService{repository: lr}, NewLocationService(lr))
+}
+
+func TestCreateState(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input *models.State
+		err   error
+	}{
+		{"valid", &models.State{CountryID: 1, Name: " Selangor "}, nil},
+		{"no country", &models.State{Name: "Selangor"}, errStateInvalid},
+		{"no name", &models.State{CountryID: 1, Name: "  "}, errStateInvalid},
+		{"nil", nil, errStateInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := &mocks.LocationRepository{}
+			s := NewLocationService(lr)
+			lr.On("CreateState", ctx, tt.input).Return(tt.input, nil)
+
+			got, err := s.CreateState(ctx, tt.input)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				lr.AssertNotCalled(t, "CreateState", mock.Anything, mock.Anything)
+				return
+			}
+			require.Equal(t, "Selangor", got.Name)
+		})
+	}
+}
+
+func TestCreateCity(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input *models.City
+		err   error
+	}{
+		{"valid", &models.City{StateID: 1, Name: "Petaling Jaya"}, nil},
+		{"no state", &models.City{Name: "Petaling Jaya"}, errCityInvalid},
+		{"no name", &models.City{StateID: 1}, errCityInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := &mocks.LocationRepository{}
+			s := NewLocationService(lr)
+			lr.On("CreateCity", ctx, tt.input).Return(tt.input, nil)
+
+			_, err := s.CreateCity(ctx, tt.input)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestUpdateAddress(t *testing.T) {
+	lr := &mocks.LocationRepository{}
+	s := NewLocationService(lr)
+
+	existing := &models.Address{ID: 1, CandidateID: 2, CityID: 3}
+	input := &models.Address{ID: 1, CandidateID: 9, CityID: 4, Postcode: "018956"}
+	lr.On("GetAddress", ctx, uint64(1)).Return(existing, nil)
+	lr.On("UpdateAddress", ctx, input).Return(input, nil)
+
+	got, err := s.UpdateAddress(ctx, input)
+	require.NoError(t, err)
+	// addresses cannot be moved to another candidate
+	require.Equal(t, uint64(2), got.CandidateID)
+	require.Equal(t, uint64(4), got.CityID)
+
+	lr.On("GetAddress", ctx, uint64(5)).Return(nil, nil)
+	_, err = s.UpdateAddress(ctx, &models.Address{ID: 5, CityID: 4})
+	require.Equal(t, errAddressNotFound, err)
+
+	_, err = s.UpdateAddress(ctx, &models.Address{ID: 1})
+	require.Equal(t, errAddressInvalid, err)
+}
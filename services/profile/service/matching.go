@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"in-backend/pagination"
+	"in-backend/services/profile/models"
+)
+
+var (
+	errCandidateNotFound = errors.New("Candidate not found")
+	errJobPostNotFound   = errors.New("Job post not found")
+)
+
+// Factors considered when matching a Candidate to a JobPost
+const (
+	factorSkills     = "skills"
+	factorSalary     = models.MatchFactorSalary
+	factorRole       = "role"
+	factorExperience = "experience"
+	factorLocation   = "location"
+	factorAssessment = "assessment"
+)
+
+// matchWeights are the weights of each factor in the overall match score, they sum up to 1
+var matchWeights = map[string]float64{
+	factorSkills:     0.3,
+	factorSalary:     0.15,
+	factorRole:       0.15,
+	factorExperience: 0.15,
+	factorLocation:   0.1,
+	factorAssessment: 0.15,
+}
+
+// neutralScore is given to a factor that cannot be compared because either side did not specify it
+const neutralScore = models.MatchNeutralScore
+
+/* --------------- Matching --------------- */
+
+// GetMatchingJobPosts ranks JobPosts by how well they match a Candidate.
+// JobPosts are matched a page at a time, keeping only the best matches
+func (s *service) GetMatchingJobPosts(ctx context.Context, cid uint64, f models.MatchFilters) ([]*models.Match, error) {
+	u, err := s.repository.GetCandidateByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || u.Candidate == nil {
+		return nil, errCandidateNotFound
+	}
+
+	scores, err := s.repository.GetAssessmentScores(ctx, []uint64{u.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var m []*models.Match
+	p := pagination.Params{PageSize: pagination.MaxPageSize}
+	for {
+		jps, page, err := s.repository.GetAllJobPosts(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, jp := range jps {
+			match := matchCandidate(u.Candidate, jp, scores, now)
+			match.UserID = u.ID
+			m = append(m, match)
+		}
+		m = rankMatches(m, f)
+		if page == nil || page.NextPageToken == "" {
+			return m, nil
+		}
+		p.PageToken = page.NextPageToken
+	}
+}
+
+// GetMatchingCandidates ranks Candidates by how well they match a JobPost.
+// Only Candidates with at least one of the skills required by the JobPost are considered,
+// and they are matched a page at a time, keeping only the best matches
+func (s *service) GetMatchingCandidates(ctx context.Context, jpid uint64, f models.MatchFilters) ([]*models.Match, error) {
+	jp, err := s.repository.GetJobPostByID(ctx, jpid)
+	if err != nil {
+		return nil, err
+	}
+	if jp == nil {
+		return nil, errJobPostNotFound
+	}
+
+	now := time.Now()
+	var m []*models.Match
+	p := pagination.Params{PageSize: pagination.MaxPageSize}
+	for {
+		users, page, err := s.repository.GetMatchCandidates(ctx, jp.SkillID, p)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := s.matchCandidates(ctx, users, jp, now)
+		if err != nil {
+			return nil, err
+		}
+		m = rankMatches(append(m, matches...), f)
+		if page == nil || page.NextPageToken == "" {
+			return m, nil
+		}
+		p.PageToken = page.NextPageToken
+	}
+}
+
+// matchCandidates scores how well the Candidates of users match jp
+func (s *service) matchCandidates(ctx context.Context, users []*models.User, jp *models.JobPost, now time.Time) ([]*models.Match, error) {
+	var uids []uint64
+	for _, u := range users {
+		if u.Candidate != nil {
+			uids = append(uids, u.ID)
+		}
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+
+	// attempts are kept by the ID of the User who made them
+	scores, err := s.repository.GetAssessmentScores(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+	scoresByUser := make(map[uint64][]*models.AssessmentScore)
+	for _, sc := range scores {
+		scoresByUser[sc.UserID] = append(scoresByUser[sc.UserID], sc)
+	}
+
+	var m []*models.Match
+	for _, u := range users {
+		if u.Candidate == nil {
+			continue
+		}
+		match := matchCandidate(u.Candidate, jp, scoresByUser[u.ID], now)
+		match.UserID = u.ID
+		m = append(m, match)
+	}
+	return m, nil
+}
+
+// rankMatches sorts matches from best to worst, dropping those below f.MinScore and those beyond f.Limit,
+// or beyond the default page size if f.Limit is not set
+func rankMatches(m []*models.Match, f models.MatchFilters) []*models.Match {
+	var ranked []*models.Match
+	for _, match := range m {
+		if match.Score >= f.MinScore {
+			ranked = append(ranked, match)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	limit := f.Limit
+	if limit == 0 {
+		limit = pagination.DefaultPageSize
+	}
+	if uint64(len(ranked)) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// matchCandidate scores how well c matches jp on every factor in matchWeights.
+// The overall score is the weighted sum of the factor scores, which are all between 0 and 1
+func matchCandidate(c *models.Candidate, jp *models.JobPost, scores []*models.AssessmentScore, now time.Time) *models.Match {
+	factors := []*models.MatchFactor{
+		matchSkills(c, jp),
+		matchSalary(c, jp),
+		matchRole(c, jp),
+		matchExperience(c, jp, now),
+		matchLocation(c, jp),
+		matchAssessments(scores),
+	}
+
+	var total float64 = 0
+	for _, f := range factors {
+		f.Weight = matchWeights[f.Factor]
+		total += f.Score * f.Weight
+	}
+
+	return &models.Match{
+		CandidateID: c.ID,
+		JobPostID:   jp.ID,
+		Score:       total,
+		Factors:     factors,
+	}
+}
+
+// matchSkills scores the proportion of skills required by jp that c has
+func matchSkills(c *models.Candidate, jp *models.JobPost) *models.MatchFactor {
+	if len(jp.SkillID) == 0 {
+		return &models.MatchFactor{Factor: factorSkills, Score: 1, Reason: "No skills required"}
+	}
+
+	has := make(map[uint64]bool)
+	for _, s := range c.Skills {
+		has[s.ID] = true
+	}
+	var matched int
+	for _, id := range jp.SkillID {
+		if has[id] {
+			matched++
+		}
+	}
+	return &models.MatchFactor{
+		Factor: factorSkills,
+		Score:  float64(matched) / float64(len(jp.SkillID)),
+		Reason: fmt.Sprintf("Has %d of %d required skills", matched, len(jp.SkillID)),
+	}
+}
+
+// matchSalary scores whether the expected salary of c is within the salary range of jp.
+// Only whether the expectation is below, within or above the range is reported,
+// so that the expected salary cannot be worked out from the score
+func matchSalary(c *models.Candidate, jp *models.JobPost) *models.MatchFactor {
+	if c.ExpectedSalary == 0 || (jp.MinSalary == 0 && jp.MaxSalary == 0) {
+		return &models.MatchFactor{Factor: factorSalary, Score: neutralScore, Reason: "Salary not specified"}
+	}
+	if c.ExpectedSalaryCurrency != "" && jp.SalaryCurrency != "" &&
+		!strings.EqualFold(c.ExpectedSalaryCurrency, jp.SalaryCurrency) {
+		return &models.MatchFactor{Factor: factorSalary, Score: neutralScore, Reason: "Salary currencies differ"}
+	}
+
+	expected := uint64(c.ExpectedSalary)
+	switch {
+	case jp.MaxSalary > 0 && expected > jp.MaxSalary:
+		return &models.MatchFactor{Factor: factorSalary, Score: 0, Reason: "Expected salary is above range"}
+	case expected < jp.MinSalary:
+		return &models.MatchFactor{Factor: factorSalary, Score: 1, Reason: "Expected salary is below range"}
+	default:
+		return &models.MatchFactor{Factor: factorSalary, Score: 1, Reason: "Expected salary is within range"}
+	}
+}
+
+// matchRole scores whether any of the preferred roles of c is the job function or title of jp
+func matchRole(c *models.Candidate, jp *models.JobPost) *models.MatchFactor {
+	if len(c.PreferredRoles) == 0 || (jp.Function == "" && jp.Title == "") {
+		return &models.MatchFactor{Factor: factorRole, Score: neutralScore, Reason: "Role not specified"}
+	}
+
+	title := strings.ToLower(jp.Title)
+	for _, r := range c.PreferredRoles {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		if strings.EqualFold(r, jp.Function) || strings.Contains(title, strings.ToLower(r)) {
+			return &models.MatchFactor{Factor: factorRole, Score: 1, Reason: fmt.Sprintf("Prefers %s roles", r)}
+		}
+	}
+	return &models.MatchFactor{Factor: factorRole, Score: 0, Reason: "Not a preferred role"}
+}
+
+// matchExperience scores the years of experience of c against the years required by jp
+func matchExperience(c *models.Candidate, jp *models.JobPost, now time.Time) *models.MatchFactor {
+	years := yearsOfExperience(c.Jobs, now)
+	if jp.YearsExperience == 0 {
+		return &models.MatchFactor{Factor: factorExperience, Score: 1, Reason: "No experience required"}
+	}
+
+	score := years / float64(jp.YearsExperience)
+	if score > 1 {
+		score = 1
+	}
+	return &models.MatchFactor{
+		Factor: factorExperience,
+		Score:  score,
+		Reason: fmt.Sprintf("Has %.1f of %d years required", years, jp.YearsExperience),
+	}
+}
+
+// yearsOfExperience returns the total years covered by jobs, counting overlapping jobs once.
+// Jobs without an end date are ongoing
+func yearsOfExperience(jobs []*models.JobHistory, now time.Time) float64 {
+	type interval struct{ start, end time.Time }
+
+	var intervals []interval
+	for _, j := range jobs {
+		if j == nil || j.StartDate == nil {
+			continue
+		}
+		end := now
+		if j.EndDate != nil {
+			end = *j.EndDate
+		}
+		if end.After(*j.StartDate) {
+			intervals = append(intervals, interval{*j.StartDate, end})
+		}
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+
+	var total time.Duration
+	var cur *interval
+	for i := range intervals {
+		in := intervals[i]
+		if cur != nil && !in.start.After(cur.end) {
+			if in.end.After(cur.end) {
+				cur.end = in.end
+			}
+			continue
+		}
+		if cur != nil {
+			total += cur.end.Sub(cur.start)
+		}
+		cur = &in
+	}
+	if cur != nil {
+		total += cur.end.Sub(cur.start)
+	}
+	return total.Hours() / 24 / 365.25
+}
+
+// matchLocation scores remote job posts fully, otherwise whether c resides in the location of jp
+func matchLocation(c *models.Candidate, jp *models.JobPost) *models.MatchFactor {
+	if jp.Remote {
+		return &models.MatchFactor{Factor: factorLocation, Score: 1, Reason: "Remote"}
+	}
+	if jp.Location == "" || c.ResidenceCity == "" {
+		return &models.MatchFactor{Factor: factorLocation, Score: neutralScore, Reason: "Location not specified"}
+	}
+	if strings.Contains(strings.ToLower(jp.Location), strings.ToLower(strings.TrimSpace(c.ResidenceCity))) {
+		return &models.MatchFactor{Factor: factorLocation, Score: 1, Reason: fmt.Sprintf("Resides in %s", c.ResidenceCity)}
+	}
+	return &models.MatchFactor{Factor: factorLocation, Score: 0, Reason: fmt.Sprintf("Resides outside %s", jp.Location)}
+}
+
+// matchAssessments scores the average of the best score on each assessment completed by the candidate
+func matchAssessments(scores []*models.AssessmentScore) *models.MatchFactor {
+	best := make(map[uint64]float64)
+	for _, s := range scores {
+		if s.MaxScore <= 0 {
+			continue
+		}
+		r := float64(s.Score) / float64(s.MaxScore)
+		if r < 0 {
+			r = 0
+		}
+		if b, ok := best[s.AssessmentID]; !ok || r > b {
+			best[s.AssessmentID] = r
+		}
+	}
+	if len(best) == 0 {
+		return &models.MatchFactor{Factor: factorAssessment, Score: 0, Reason: "No completed assessments"}
+	}
+
+	var total float64 = 0
+	for _, r := range best {
+		total += r
+	}
+	return &models.MatchFactor{
+		Factor: factorAssessment,
+		Score:  total / float64(len(best)),
+		Reason: fmt.Sprintf("Completed %d assessments", len(best)),
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"in-backend/pagination"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestYearsOfExperience(t *testing.T) {
+	at := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	date := func(y int) *time.Time {
+		d := time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	var tests = []struct {
+		name string
+		jobs []*models.JobHistory
+		want float64
+	}{
+		{"no jobs", nil, 0},
+		{"single", []*models.JobHistory{{StartDate: date(2015), EndDate: date(2017)}}, 2},
+		{"ongoing", []*models.JobHistory{{StartDate: date(2018)}}, 2},
+		{"overlapping", []*models.JobHistory{
+			{StartDate: date(2014), EndDate: date(2017)},
+			{StartDate: date(2016), EndDate: date(2018)},
+		}, 4},
+		{"disjoint", []*models.JobHistory{
+			{StartDate: date(2018), EndDate: date(2019)},
+			{StartDate: date(2014), EndDate: date(2015)},
+		}, 2},
+		{"no start date", []*models.JobHistory{{EndDate: date(2019)}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.InDelta(t, tt.want, yearsOfExperience(tt.jobs, at), 0.01)
+		})
+	}
+}
+
+func TestMatchCandidate(t *testing.T) {
+	start := now.AddDate(-3, 0, 0)
+	c := &models.Candidate{
+		ID:                     1,
+		ResidenceCity:          "Singapore",
+		ExpectedSalaryCurrency: "SGD",
+		ExpectedSalary:         6000,
+		PreferredRoles:         []string{"Engineering"},
+		Skills:                 []*models.Skill{{ID: 1}, {ID: 2}},
+		Jobs:                   []*models.JobHistory{{StartDate: &start}},
+	}
+	scores := []*models.AssessmentScore{
+		{UserID: 11, AssessmentID: 1, Score: 5, MaxScore: 10},
+		{UserID: 11, AssessmentID: 1, Score: 8, MaxScore: 10},
+	}
+
+	var tests = []struct {
+		name    string
+		jp      *models.JobPost
+		factors map[string]float64
+	}{
+		{
+			"perfect",
+			&models.JobPost{ID: 1, SkillID: []uint64{1, 2}, SalaryCurrency: "SGD", MinSalary: 5000, MaxSalary: 7000,
+				Function: "Engineering", YearsExperience: 3, Location: "Singapore"},
+			map[string]float64{factorSkills: 1, factorSalary: 1, factorRole: 1, factorExperience: 1, factorLocation: 1, factorAssessment: 0.8},
+		},
+		{
+			"partial",
+			&models.JobPost{ID: 2, SkillID: []uint64{1, 3, 4, 5}, SalaryCurrency: "SGD", MinSalary: 3000, MaxSalary: 4000,
+				Function: "Sales", YearsExperience: 6, Location: "Kuala Lumpur"},
+			map[string]float64{factorSkills: 0.25, factorSalary: 0, factorRole: 0, factorExperience: 0.5, factorLocation: 0, factorAssessment: 0.8},
+		},
+		{
+			"below salary range",
+			&models.JobPost{ID: 4, SkillID: []uint64{1, 2}, SalaryCurrency: "SGD", MinSalary: 7000, MaxSalary: 9000,
+				Function: "Engineering", YearsExperience: 3, Location: "Singapore"},
+			map[string]float64{factorSkills: 1, factorSalary: 1, factorRole: 1, factorExperience: 1, factorLocation: 1, factorAssessment: 0.8},
+		},
+		{
+			"remote and unspecified",
+			&models.JobPost{ID: 3, SalaryCurrency: "USD", MaxSalary: 5000, Remote: true},
+			map[string]float64{factorSkills: 1, factorSalary: neutralScore, factorRole: neutralScore, factorExperience: 1, factorLocation: 1, factorAssessment: 0.8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchCandidate(c, tt.jp, scores, now)
+			require.Equal(t, uint64(1), got.CandidateID)
+			require.Equal(t, tt.jp.ID, got.JobPostID)
+			require.Len(t, got.Factors, len(matchWeights))
+
+			var want float64 = 0
+			for _, f := range got.Factors {
+				require.InDelta(t, tt.factors[f.Factor], f.Score, 0.01, f.Factor)
+				require.Equal(t, matchWeights[f.Factor], f.Weight)
+				require.NotEmpty(t, f.Reason)
+				want += tt.factors[f.Factor] * matchWeights[f.Factor]
+			}
+			require.InDelta(t, want, got.Score, 0.01)
+		})
+	}
+}
+
+func TestRankMatches(t *testing.T) {
+	m := []*models.Match{
+		{JobPostID: 1, Score: 0.4},
+		{JobPostID: 2, Score: 0.9},
+		{JobPostID: 3, Score: 0.6},
+		{JobPostID: 4, Score: 0.2},
+	}
+
+	got := rankMatches(m, models.MatchFilters{})
+	require.Len(t, got, 4)
+	require.Equal(t, uint64(2), got[0].JobPostID)
+	require.Equal(t, uint64(4), got[3].JobPostID)
+
+	got = rankMatches(m, models.MatchFilters{Limit: 2, MinScore: 0.5})
+	require.Len(t, got, 2)
+	require.Equal(t, uint64(2), got[0].JobPostID)
+	require.Equal(t, uint64(3), got[1].JobPostID)
+
+	got = rankMatches(m, models.MatchFilters{MinScore: 0.5, Limit: 5})
+	require.Len(t, got, 2)
+
+	var many []*models.Match
+	for i := 0; i < 30; i++ {
+		many = append(many, &models.Match{JobPostID: uint64(i)})
+	}
+	require.Len(t, rankMatches(many, models.MatchFilters{}), int(pagination.DefaultPageSize))
+}
+
+func TestGetMatchingCandidates(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, nil, nil, nil)
+
+	jp := &models.JobPost{ID: 1, SkillID: []uint64{1}}
+	// attempts are kept by User ID, which differs from the Candidate ID
+	first := []*models.User{
+		{ID: 11, Candidate: &models.Candidate{ID: 1}},
+		{ID: 3},
+	}
+	second := []*models.User{
+		{ID: 12, Candidate: &models.Candidate{ID: 2, Skills: []*models.Skill{{ID: 1}}}},
+	}
+	p := pagination.Params{PageSize: pagination.MaxPageSize}
+	repo.On("GetJobPostByID", ctx, uint64(1)).Return(jp, nil)
+	repo.On("GetMatchCandidates", ctx, []uint64{1}, p).Return(first, &pagination.Info{NextPageToken: "next"}, nil)
+	p.PageToken = "next"
+	repo.On("GetMatchCandidates", ctx, []uint64{1}, p).Return(second, &pagination.Info{}, nil)
+	repo.On("GetAssessmentScores", ctx, []uint64{11}).Return([]*models.AssessmentScore{
+		{UserID: 11, AssessmentID: 1, Score: 10, MaxScore: 10},
+	}, nil)
+	repo.On("GetAssessmentScores", ctx, []uint64{12}).Return(nil, nil)
+
+	got, err := s.GetMatchingCandidates(ctx, 1, models.MatchFilters{})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, uint64(2), got[0].CandidateID)
+	require.Equal(t, uint64(12), got[0].UserID)
+	require.Equal(t, uint64(1), got[1].CandidateID)
+	require.Equal(t, factorAssessment, got[1].Factors[5].Factor)
+	require.Equal(t, float64(1), got[1].Factors[5].Score)
+
+	repo.On("GetJobPostByID", ctx, uint64(2)).Return(nil, nil)
+	_, err = s.GetMatchingCandidates(ctx, 2, models.MatchFilters{})
+	require.Equal(t, errJobPostNotFound, err)
+}
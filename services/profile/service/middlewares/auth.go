@@ -2,99 +2,35 @@ package middlewares
 
 import (
 	"context"
-	"errors"
 	"in-backend/helpers"
+	"in-backend/pagination"
+	"in-backend/rbac"
 	"in-backend/services/profile/interfaces"
 	"in-backend/services/profile/models"
-	"strconv"
-	"strings"
-
-	"github.com/dgrijalva/jwt-go"
-	"google.golang.org/grpc/metadata"
 )
 
 type authMiddleware struct {
 	next       interfaces.Service
 	repository interfaces.Repository
+	authorizer *rbac.Authorizer
 }
 
-var (
-	errAuth = errors.New("Forbidden")
+var errAuth = rbac.ErrForbidden
 
-	idKey          = "https://hubbedin/id"
-	candidateIDKey = "https://hubbedin/candidateId"
-	rolesKey       = "https://hubbedin/roles"
-)
+// privilegedRoles are the roles that users cannot give themselves
+var privilegedRoles = []string{"Admin", "Recruiter"}
 
-// NewAuthMiddleware creates and returns a new Auth Middleware that implements the profile Service interface
-func NewAuthMiddleware(svc interfaces.Service, r interfaces.Repository) interfaces.Service {
+// NewAuthMiddleware creates and returns a new Auth Middleware that implements the profile Service interface.
+// Callers are authorized by the policy of the service before their requests reach the service,
+// so this middleware only checks the roles that users are given and the personal details of candidates that callers see
+func NewAuthMiddleware(svc interfaces.Service, r interfaces.Repository, a *rbac.Authorizer) interfaces.Service {
 	return &authMiddleware{
 		next:       svc,
 		repository: r,
+		authorizer: a,
 	}
 }
 
-func getRoleAndID(ctx context.Context, ownerID *uint64, keyType string) (*string, *uint64, error) {
-	claims, err := getClaims(ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	var role string = ""
-	var id uint64 = 0
-
-	var key string
-	if keyType == "User" {
-		key = idKey
-	}
-	if keyType == "Candidate" {
-		key = candidateIDKey
-	}
-	// this should come first so that role gets overwritten if owner is also an admin
-	if claims[key] != nil {
-		id, err = strconv.ParseUint(claims[key].(string), 10, 64)
-		if err != nil {
-			return nil, nil, err
-		}
-		if ownerID != nil && id == *ownerID {
-			role = "Owner"
-		}
-	}
-
-	if claims[rolesKey] != nil {
-		for _, r := range claims[rolesKey].([]interface{}) {
-			roleCast := r.(string)
-			if roleCast == "Admin" {
-				role = "Admin"
-			}
-		}
-	}
-
-	return &role, &id, nil
-}
-
-func getClaims(ctx context.Context) (jwt.MapClaims, error) {
-	headers, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, errAuth
-	}
-
-	if len(headers["authorization"]) == 0 {
-		return nil, errAuth
-	}
-	tokenString := strings.Split(headers["authorization"][0], " ")[1]
-	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
-	if err != nil {
-		return nil, errAuth
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, errAuth
-	}
-	return claims, nil
-}
-
 /* --------------- User --------------- */
 
 // CreateUser creates a new User
@@ -112,15 +48,13 @@ func (mw authMiddleware) CreateUser(ctx context.Context, m *models.User) (*model
 		return nil, errAuth
 	}
 
-	// Users with newly assigned Admin role can only be created by admins
-	if helpers.IsStringInSlice("Admin", m.Roles) &&
-		(u.Roles == nil || !helpers.IsStringInSlice("Admin", u.Roles)) {
-		role, _, err := getRoleAndID(ctx, nil, "User")
-		if err != nil {
-			return nil, err
-		}
-		if *role != "Admin" {
-			return nil, errAuth
+	// Users with newly assigned privileged roles can only be created by callers allowed to assign roles
+	for _, role := range privilegedRoles {
+		if helpers.IsStringInSlice(role, m.Roles) &&
+			(u == nil || !helpers.IsStringInSlice(role, u.Roles)) {
+			if err := mw.authorizer.Authorize(ctx, "AssignUserRoles"); err != nil {
+				return nil, err
+			}
 		}
 	}
 	return mw.next.CreateUser(ctx, m)
@@ -128,13 +62,6 @@ func (mw authMiddleware) CreateUser(ctx context.Context, m *models.User) (*model
 
 // GetUserByID gets a User by ID
 func (mw authMiddleware) GetUserByID(ctx context.Context, id uint64) (*models.User, error) {
-	role, _, err := getRoleAndID(ctx, &id, "User")
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" {
-		return nil, errAuth
-	}
 	return mw.next.GetUserByID(ctx, id)
 }
 
@@ -154,26 +81,12 @@ func (mw authMiddleware) UpdateUser(ctx context.Context, m *models.User) (*model
 	}
 
 	// Only candidates can freely update their own details
-	// Companies must go through admin for updates
-	if helpers.IsStringInSlice("Company", m.Roles) || helpers.IsStringInSlice("Admin", m.Roles) {
-		role, _, err := getRoleAndID(ctx, nil, "User")
-		if err != nil {
+	// Companies and privileged users must go through callers allowed to assign roles for updates
+	if helpers.IsStringInSlice("Company", m.Roles) || helpers.IsStringInSlice("Admin", m.Roles) ||
+		helpers.IsStringInSlice("Recruiter", m.Roles) {
+		if err := mw.authorizer.Authorize(ctx, "AssignUserRoles"); err != nil {
 			return nil, err
 		}
-		if *role != "Admin" {
-			return nil, errAuth
-		}
-	} else {
-		if err != nil {
-			return nil, err
-		}
-		role, _, err := getRoleAndID(ctx, &u.ID, "User")
-		if err != nil {
-			return nil, err
-		}
-		if *role != "Admin" && *role != "Owner" {
-			return nil, errAuth
-		}
 	}
 
 	return mw.next.UpdateUser(ctx, m)
@@ -181,13 +94,6 @@ func (mw authMiddleware) UpdateUser(ctx context.Context, m *models.User) (*model
 
 // DeleteUser deletes a User by ID
 func (mw authMiddleware) DeleteUser(ctx context.Context, id uint64) error {
-	role, _, err := getRoleAndID(ctx, &id, "User")
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return errAuth
-	}
 	return mw.next.DeleteUser(ctx, id)
 }
 
@@ -199,57 +105,60 @@ func (mw authMiddleware) CreateCandidate(ctx context.Context, candidate *models.
 }
 
 // GetAllCandidates returns all Candidates
-func (mw authMiddleware) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, error) {
-	role, _, err := getRoleAndID(ctx, nil, "User")
+// Personal details are masked unless the caller may see them, so only admins can filter or sort by them
+func (mw authMiddleware) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, *pagination.Info, error) {
+	if filtersPersonalDetails(f) && mw.authorizer.Authorize(ctx, "ViewCandidatePersonalDetails") != nil {
+		return nil, nil, errAuth
+	}
+
+	m, page, err := mw.next.GetAllCandidates(ctx, f)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	if *role != "Admin" {
-		return nil, errAuth
+	if err := mw.projectPersonalDetails(ctx, m...); err != nil {
+		return nil, nil, err
 	}
-	return mw.next.GetAllCandidates(ctx, f)
+	return m, page, nil
 }
 
-// GetCandidateByID returns a Candidate by ID
+// GetCandidateByID returns a Candidate by ID, with personal details masked unless the caller may see them
 func (mw authMiddleware) GetCandidateByID(ctx context.Context, id uint64) (*models.User, error) {
-	role, _, err := getRoleAndID(ctx, &id, "User")
+	m, err := mw.next.GetCandidateByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
+	if err := mw.projectPersonalDetails(ctx, m); err != nil {
+		return nil, err
 	}
-	return mw.next.GetCandidateByID(ctx, id)
+	return m, nil
+}
+
+// LocalGetSCMLogins returns the logins of the git host accounts linked to a User
+// This method is only for local server to server communication
+func (mw authMiddleware) LocalGetSCMLogins(ctx context.Context, uid uint64) (map[string]string, error) {
+	return mw.next.LocalGetSCMLogins(ctx, uid)
 }
 
 // UpdateCandidate updates a Candidate
 func (mw authMiddleware) UpdateCandidate(ctx context.Context, m *models.Candidate) (*models.Candidate, error) {
-	c, err := mw.repository.GetCandidateByID(ctx, m.ID)
-	if err != nil {
-		return nil, err
-	}
-	role, _, err := getRoleAndID(ctx, &c.ID, "Candidate")
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.UpdateCandidate(ctx, m)
 }
 
 // DeleteCandidate deletes a Candidate by ID
 func (mw authMiddleware) DeleteCandidate(ctx context.Context, id uint64) error {
-	role, _, err := getRoleAndID(ctx, &id, "Candidate")
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return errAuth
-	}
 	return mw.next.DeleteCandidate(ctx, id)
 }
 
+// ImportResume parses a resume into a draft Candidate profile
+func (mw authMiddleware) ImportResume(ctx context.Context, cid uint64, doc []byte) (*models.Candidate, error) {
+	return mw.next.ImportResume(ctx, cid, doc)
+}
+
+// ExportCandidateProfile renders a Candidate profile as a JSON Resume or a PDF document
+func (mw authMiddleware) ExportCandidateProfile(ctx context.Context, id uint64, o models.ExportOptions) (*models.ProfileExport, error) {
+	return mw.next.ExportCandidateProfile(ctx, id, o)
+}
+
 /* --------------- Skill --------------- */
 
 // CreateSkill creates a new Skill
@@ -271,25 +180,11 @@ func (mw authMiddleware) GetAllSkills(ctx context.Context, f models.SkillFilters
 
 // CreateUserSkill creates a new UserSkill
 func (mw authMiddleware) CreateUserSkill(ctx context.Context, m *models.UserSkill) (*models.UserSkill, error) {
-	role, _, err := getRoleAndID(ctx, &m.CandidateID, "Candidate")
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.CreateUserSkill(ctx, m)
 }
 
 // DeleteUserSkill deletes a UserSkill by ID
 func (mw authMiddleware) DeleteUserSkill(ctx context.Context, cid, sid uint64) error {
-	role, _, err := getRoleAndID(ctx, &cid, "Candidate")
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return errAuth
-	}
 	return mw.next.DeleteUserSkill(ctx, cid, sid)
 }
 
@@ -331,57 +226,21 @@ func (mw authMiddleware) GetAllCourses(ctx context.Context, f models.CourseFilte
 
 // CreateAcademicHistory creates a new AcademicHistory
 func (mw authMiddleware) CreateAcademicHistory(ctx context.Context, m *models.AcademicHistory) (*models.AcademicHistory, error) {
-	role, _, err := getRoleAndID(ctx, &m.CandidateID, "Candidate")
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.CreateAcademicHistory(ctx, m)
 }
 
 // GetAcademicHistory returns a AcademicHistory by ID
 func (mw authMiddleware) GetAcademicHistory(ctx context.Context, id uint64) (*models.AcademicHistory, error) {
-	ah, err := mw.repository.GetAcademicHistory(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-	role, _, err := getRoleAndID(ctx, &ah.CandidateID, "Candidate")
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.GetAcademicHistory(ctx, id)
 }
 
 // UpdateAcademicHistory updates a AcademicHistory
 func (mw authMiddleware) UpdateAcademicHistory(ctx context.Context, m *models.AcademicHistory) (*models.AcademicHistory, error) {
-	ah, err := mw.repository.GetAcademicHistory(ctx, m.ID)
-	if err != nil {
-		return nil, err
-	}
-	role, _, err := getRoleAndID(ctx, &ah.CandidateID, "Candidate")
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.UpdateAcademicHistory(ctx, m)
 }
 
 // DeleteAcademicHistory deletes a AcademicHistory by ID
 func (mw authMiddleware) DeleteAcademicHistory(ctx context.Context, cid, ahid uint64) error {
-	role, _, err := getRoleAndID(ctx, &cid, "Candidate")
-	if err != nil {
-		return err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return errAuth
-	}
 	return mw.next.DeleteAcademicHistory(ctx, cid, ahid)
 }
 
@@ -423,56 +282,245 @@ func (mw authMiddleware) GetAllDepartments(ctx context.Context, f models.Departm
 
 // CreateJobHistory creates a new JobHistory
 func (mw authMiddleware) CreateJobHistory(ctx context.Context, m *models.JobHistory) (*models.JobHistory, error) {
-	role, _, err := getRoleAndID(ctx, &m.CandidateID, "Candidate")
-	if err != nil {
-		return nil, err
-	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
-	}
 	return mw.next.CreateJobHistory(ctx, m)
 }
 
 // GetJobHistory returns a JobHistory by ID
 func (mw authMiddleware) GetJobHistory(ctx context.Context, id uint64) (*models.JobHistory, error) {
-	jh, err := mw.repository.GetJobHistory(ctx, id)
+	return mw.next.GetJobHistory(ctx, id)
+}
+
+// UpdateJobHistory updates a JobHistory
+func (mw authMiddleware) UpdateJobHistory(ctx context.Context, m *models.JobHistory) (*models.JobHistory, error) {
+	return mw.next.UpdateJobHistory(ctx, m)
+}
+
+// DeleteJobHistory deletes a JobHistory by ID
+func (mw authMiddleware) DeleteJobHistory(ctx context.Context, cid, jhid uint64) error {
+	return mw.next.DeleteJobHistory(ctx, cid, jhid)
+}
+
+/* --------------- Matching --------------- */
+
+// GetMatchingJobPosts ranks JobPosts by how well they match a Candidate
+func (mw authMiddleware) GetMatchingJobPosts(ctx context.Context, cid uint64, f models.MatchFilters) ([]*models.Match, error) {
+	return mw.next.GetMatchingJobPosts(ctx, cid, f)
+}
+
+// GetMatchingCandidates ranks Candidates by how well they match a JobPost
+// How the expected salary of a Candidate matches is masked unless the caller may see their personal details
+func (mw authMiddleware) GetMatchingCandidates(ctx context.Context, jpid uint64, f models.MatchFilters) ([]*models.Match, error) {
+	m, err := mw.next.GetMatchingCandidates(ctx, jpid, f)
 	if err != nil {
 		return nil, err
 	}
-	role, _, err := getRoleAndID(ctx, &jh.CandidateID, "Candidate")
+
+	users := make([]*models.User, len(m))
+	matches := make(map[*models.User]*models.Match, len(m))
+	for i, match := range m {
+		users[i] = &models.User{ID: match.UserID, CandidateID: match.CandidateID}
+		matches[users[i]] = match
+	}
+	hidden, err := mw.hiddenPersonalDetails(ctx, users)
 	if err != nil {
 		return nil, err
 	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
+	for _, u := range hidden {
+		matches[u].MaskPersonalDetails()
 	}
-	return mw.next.GetJobHistory(ctx, id)
+	return m, nil
 }
 
-// UpdateJobHistory updates a JobHistory
-func (mw authMiddleware) UpdateJobHistory(ctx context.Context, m *models.JobHistory) (*models.JobHistory, error) {
-	jh, err := mw.repository.GetJobHistory(ctx, m.ID)
-	if err != nil {
+/* --------------- Shortlist --------------- */
+
+// CreateShortlist creates a new Shortlist
+// Only recruiters keep shortlists
+func (mw authMiddleware) CreateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	if err := mw.authorizer.Authorize(ctx, "ManageShortlists"); err != nil {
 		return nil, err
 	}
-	role, _, err := getRoleAndID(ctx, &jh.CandidateID, "Candidate")
+	return mw.next.CreateShortlist(ctx, m)
+}
+
+// GetAllShortlists returns all Shortlists that match the filters
+// Users only see their own shortlists and the shortlists shared with them
+func (mw authMiddleware) GetAllShortlists(ctx context.Context, f models.ShortlistFilters) ([]*models.Shortlist, error) {
+	if mw.authorizer.Authorize(ctx, "GetAllShortlistsUnscoped") != nil {
+		s := rbac.FromContext(ctx)
+		if s == nil || s.ID == 0 {
+			return nil, errAuth
+		}
+		f.UserID = s.ID
+	}
+	return mw.next.GetAllShortlists(ctx, f)
+}
+
+// GetShortlistByID returns a Shortlist by ID with the profiles of its Candidates,
+// with personal details masked unless the caller may see them
+func (mw authMiddleware) GetShortlistByID(ctx context.Context, id uint64) (*models.Shortlist, error) {
+	m, err := mw.next.GetShortlistByID(ctx, id)
+	if err != nil || m == nil {
+		return m, err
+	}
+
+	users := make([]*models.User, len(m.Candidates))
+	for i, c := range m.Candidates {
+		users[i] = c.User
+	}
+	if err := mw.projectPersonalDetails(ctx, users...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UpdateShortlist updates a Shortlist
+func (mw authMiddleware) UpdateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	return mw.next.UpdateShortlist(ctx, m)
+}
+
+// DeleteShortlist deletes a Shortlist by ID
+func (mw authMiddleware) DeleteShortlist(ctx context.Context, id uint64) error {
+	return mw.next.DeleteShortlist(ctx, id)
+}
+
+// AddShortlistCandidate adds a Candidate with notes to a Shortlist
+func (mw authMiddleware) AddShortlistCandidate(ctx context.Context, m *models.ShortlistCandidate) (*models.ShortlistCandidate, error) {
+	return mw.next.AddShortlistCandidate(ctx, m)
+}
+
+// RemoveShortlistCandidate removes a Candidate from a Shortlist
+func (mw authMiddleware) RemoveShortlistCandidate(ctx context.Context, sid, cid uint64) error {
+	return mw.next.RemoveShortlistCandidate(ctx, sid, cid)
+}
+
+// ShareShortlist shares a Shortlist with a company user
+func (mw authMiddleware) ShareShortlist(ctx context.Context, sid, uid uint64) (*models.ShortlistShare, error) {
+	return mw.next.ShareShortlist(ctx, sid, uid)
+}
+
+// UnshareShortlist stops sharing a Shortlist with a company user
+func (mw authMiddleware) UnshareShortlist(ctx context.Context, sid, uid uint64) error {
+	return mw.next.UnshareShortlist(ctx, sid, uid)
+}
+
+/* --------------- Contact Consent --------------- */
+
+// GrantContactConsent lets the users of a Company see the personal details of a Candidate
+func (mw authMiddleware) GrantContactConsent(ctx context.Context, m *models.ContactConsent) (*models.ContactConsent, error) {
+	return mw.next.GrantContactConsent(ctx, m)
+}
+
+// GetAllContactConsents returns all ContactConsents that match the filters
+func (mw authMiddleware) GetAllContactConsents(ctx context.Context, f models.ContactConsentFilters) ([]*models.ContactConsent, error) {
+	return mw.next.GetAllContactConsents(ctx, f)
+}
+
+// RevokeContactConsent stops letting the users of a Company see the personal details of a Candidate
+func (mw authMiddleware) RevokeContactConsent(ctx context.Context, cid, coid uint64) error {
+	return mw.next.RevokeContactConsent(ctx, cid, coid)
+}
+
+/* --------------- Personal Data --------------- */
+
+// ExportMyData returns a zip archive of the data about a User kept by all services
+func (mw authMiddleware) ExportMyData(ctx context.Context, id uint64) (*models.ProfileExport, error) {
+	return mw.next.ExportMyData(ctx, id)
+}
+
+// EraseUser hard deletes the data about a User kept by all services
+func (mw authMiddleware) EraseUser(ctx context.Context, id uint64) (*models.ErasureReceipt, error) {
+	return mw.next.EraseUser(ctx, id)
+}
+
+// GetErasureReceipt returns the ErasureReceipt of an erased User
+func (mw authMiddleware) GetErasureReceipt(ctx context.Context, uid uint64) (*models.ErasureReceipt, error) {
+	return mw.next.GetErasureReceipt(ctx, uid)
+}
+
+/* --------------- Personal Details --------------- */
+
+// filtersPersonalDetails tells whether f filters or sorts Candidates by their contact details or expected salaries,
+// which would reveal the personal details that are masked in the results
+func filtersPersonalDetails(f models.CandidateFilters) bool {
+	return f.Email != "" || f.ContactNumber != "" || f.MinSalary > 0 || f.MaxSalary > 0 ||
+		f.Page.SortBy == "expected_salary"
+}
+
+// projectPersonalDetails masks the personal details of the Users that the caller may not see
+func (mw authMiddleware) projectPersonalDetails(ctx context.Context, users ...*models.User) error {
+	hidden, err := mw.hiddenPersonalDetails(ctx, users)
+	if err != nil {
+		return err
+	}
+	for _, u := range hidden {
+		u.MaskPersonalDetails()
+	}
+	return nil
+}
+
+// hiddenPersonalDetails returns the Users whose personal details the caller may not see.
+// Admins and the Users themselves see everything, and company users see the details of the
+// Candidates that applied to their company or consented to show them to it
+func (mw authMiddleware) hiddenPersonalDetails(ctx context.Context, users []*models.User) ([]*models.User, error) {
+	var denied []*models.User
+	for _, u := range users {
+		if u != nil && mw.authorizer.Authorize(ctx, "ViewCandidatePersonalDetails", rbac.Owner{UserID: u.ID}) != nil {
+			denied = append(denied, u)
+		}
+	}
+	if len(denied) == 0 {
+		return nil, nil
+	}
+
+	shared, err := mw.sharedCandidates(ctx, denied)
 	if err != nil {
 		return nil, err
 	}
-	if *role != "Admin" && *role != "Owner" {
-		return nil, errAuth
+	var hidden []*models.User
+	for _, u := range denied {
+		if !shared[u.CandidateID] {
+			hidden = append(hidden, u)
+		}
 	}
-	return mw.next.UpdateJobHistory(ctx, m)
+	return hidden, nil
 }
 
-// DeleteJobHistory deletes a JobHistory by ID
-func (mw authMiddleware) DeleteJobHistory(ctx context.Context, cid, jhid uint64) error {
-	role, _, err := getRoleAndID(ctx, &cid, "Candidate")
+// sharedCandidates returns the IDs of the Candidates of the Users whose personal details are shared
+// with the company of the caller, because they applied to it or consented to show them to it
+func (mw authMiddleware) sharedCandidates(ctx context.Context, users []*models.User) (map[uint64]bool, error) {
+	shared := make(map[uint64]bool)
+	s := rbac.FromContext(ctx)
+	if s == nil || s.CompanyID == 0 || !s.HasRole("Company") {
+		return shared, nil
+	}
+
+	var cids []uint64
+	for _, u := range users {
+		if u.CandidateID != 0 {
+			cids = append(cids, u.CandidateID)
+		}
+	}
+	if len(cids) == 0 {
+		return shared, nil
+	}
+
+	applicants, err := mw.repository.GetApplicantIDs(ctx, s.CompanyID, cids)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if *role != "Admin" && *role != "Owner" {
-		return errAuth
+	for _, cid := range applicants {
+		shared[cid] = true
 	}
-	return mw.next.DeleteJobHistory(ctx, cid, jhid)
+
+	consents, err := mw.repository.GetAllContactConsents(ctx, models.ContactConsentFilters{
+		CandidateID: cids,
+		CompanyID:   []uint64{s.CompanyID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range consents {
+		shared[c.CandidateID] = true
+	}
+	return shared, nil
 }
@@ -0,0 +1,201 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"in-backend/pagination"
+	"in-backend/rbac"
+	"in-backend/services/profile/endpoints"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeVerifier returns the claims of a token by the token itself
+type fakeVerifier map[string]jwt.MapClaims
+
+func (v fakeVerifier) Verify(token string) (jwt.MapClaims, error) {
+	c, ok := v[token]
+	if !ok {
+		return nil, errors.New("Invalid token")
+	}
+	return c, nil
+}
+
+var verifier = fakeVerifier{
+	"admin":     {rbac.IDKey: "1", rbac.RolesKey: []interface{}{"Admin"}},
+	"recruiter": {rbac.IDKey: "2", rbac.RolesKey: []interface{}{"Recruiter"}},
+	"company":   {rbac.IDKey: "3", rbac.CompanyIDKey: "10", rbac.RolesKey: []interface{}{"Company"}},
+	"other":     {rbac.IDKey: "4", rbac.CompanyIDKey: "20", rbac.RolesKey: []interface{}{"Company"}},
+	"stranger":  {rbac.IDKey: "6", rbac.CompanyIDKey: "30", rbac.RolesKey: []interface{}{"Company"}},
+	"candidate": {rbac.IDKey: "5", rbac.CandidateIDKey: "50", rbac.RolesKey: []interface{}{"Candidate"}},
+}
+
+// callers are the tokens of the callers of each test case
+const (
+	admin     = "admin"
+	recruiter = "recruiter"
+	company   = "company"
+	other     = "other"
+	stranger  = "stranger"
+	candidate = "candidate"
+)
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// newUser returns User 5 with Candidate 50, who applied to company 10 and consented to show their details to company 20
+func newUser() *models.User {
+	birthday := time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)
+	return &models.User{
+		ID:            5,
+		CandidateID:   50,
+		Email:         "jane@example.com",
+		ContactNumber: "+6591234567",
+		Candidate: &models.Candidate{
+			ID:                     50,
+			Birthday:               &birthday,
+			ExpectedSalaryCurrency: "SGD",
+			ExpectedSalary:         8000,
+		},
+	}
+}
+
+// newAuthMiddleware returns the endpoints of the middleware, authorized by the policy of the service
+func newAuthMiddleware(t *testing.T) (endpoints.Endpoints, *mocks.Service) {
+	svc := &mocks.Service{}
+	r := &mocks.Repository{}
+	r.On("GetApplicantIDs", mock.Anything, uint64(10), []uint64{50}).Return([]uint64{50}, nil)
+	r.On("GetApplicantIDs", mock.Anything, uint64(10), []uint64{50, 70}).Return([]uint64{50}, nil)
+	r.On("GetApplicantIDs", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	r.On("GetAllContactConsents", mock.Anything, models.ContactConsentFilters{CandidateID: []uint64{50}, CompanyID: []uint64{20}}).
+		Return([]*models.ContactConsent{{CandidateID: 50, CompanyID: 20}}, nil)
+	r.On("GetAllContactConsents", mock.Anything, mock.Anything).Return(nil, nil)
+	r.On("GetJobPostByID", mock.Anything, mock.Anything).Return(&models.JobPost{ID: 1, CompanyID: 10}, nil)
+
+	policy, err := rbac.LoadPolicy(rbac.FileName)
+	require.NoError(t, err)
+	a := rbac.NewAuthorizer(policy, verifier, endpoints.MakeOwners(r))
+	eps := endpoints.MakeEndpoints(NewAuthMiddleware(svc, r, a))
+	require.NoError(t, a.Wrap(&eps))
+	return eps, svc
+}
+
+func TestGetCandidateByIDPersonalDetails(t *testing.T) {
+	masked := newUser()
+	masked.MaskPersonalDetails()
+
+	var tests = []struct {
+		name   string
+		caller string
+		want   *models.User
+	}{
+		{"admin", admin, newUser()},
+		{"owner", candidate, newUser()},
+		{"applied to company", company, newUser()},
+		{"consented to company", other, newUser()},
+		{"unrelated company", stranger, masked},
+		{"recruiter", recruiter, masked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetCandidateByID", mock.Anything, uint64(5)).Return(newUser(), nil)
+
+			res, err := eps.GetCandidateByID(withToken(tt.caller), endpoints.GetCandidateByIDRequest{ID: 5})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, res.(endpoints.GetCandidateByIDResponse).Candidate)
+		})
+	}
+}
+
+func TestGetAllCandidatesPersonalDetails(t *testing.T) {
+	masked := newUser()
+	masked.MaskPersonalDetails()
+
+	var tests = []struct {
+		name    string
+		caller  string
+		f       endpoints.GetAllCandidatesRequest
+		want    []*models.User
+		wantErr error
+	}{
+		{"admin", admin, endpoints.GetAllCandidatesRequest{Email: "jane@example.com"}, []*models.User{newUser()}, nil},
+		{"applied to company", company, endpoints.GetAllCandidatesRequest{}, []*models.User{newUser()}, nil},
+		{"recruiter", recruiter, endpoints.GetAllCandidatesRequest{}, []*models.User{masked}, nil},
+		{"recruiter by email", recruiter, endpoints.GetAllCandidatesRequest{Email: "jane@example.com"}, nil, rbac.ErrForbidden},
+		{"recruiter by salary", recruiter, endpoints.GetAllCandidatesRequest{MinSalary: 5000}, nil, rbac.ErrForbidden},
+		{"company by salary", company, endpoints.GetAllCandidatesRequest{MaxSalary: 9000}, nil, rbac.ErrForbidden},
+		{"recruiter sorted by salary", recruiter,
+			endpoints.GetAllCandidatesRequest{Page: pagination.Params{SortBy: "expected_salary"}}, nil, rbac.ErrForbidden},
+		{"admin sorted by salary", admin,
+			endpoints.GetAllCandidatesRequest{Page: pagination.Params{SortBy: "expected_salary"}}, []*models.User{newUser()}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetAllCandidates", mock.Anything, mock.Anything).Return([]*models.User{newUser()}, nil, nil)
+
+			res, err := eps.GetAllCandidates(withToken(tt.caller), tt.f)
+			require.NoError(t, err)
+			got := res.(endpoints.GetAllCandidatesResponse)
+			require.Equal(t, tt.wantErr, got.Err)
+			require.Equal(t, tt.want, got.Candidates)
+		})
+	}
+}
+
+// newMatch returns the Match of the Candidate cid of User uid to JobPost 1 of company 10
+func newMatch(uid, cid uint64) *models.Match {
+	return &models.Match{
+		UserID:      uid,
+		CandidateID: cid,
+		JobPostID:   1,
+		Score:       0.45,
+		Factors: []*models.MatchFactor{
+			{Factor: "skills", Score: 1, Weight: 0.3, Reason: "Has 1 of 1 required skills"},
+			{Factor: models.MatchFactorSalary, Score: 1, Weight: 0.15, Reason: "Expected salary is within range"},
+		},
+	}
+}
+
+func TestGetMatchingCandidatesPersonalDetails(t *testing.T) {
+	masked := newMatch(7, 70)
+	masked.MaskPersonalDetails()
+
+	var tests = []struct {
+		name   string
+		caller string
+		want   []*models.Match
+	}{
+		{"admin", admin, []*models.Match{newMatch(5, 50), newMatch(7, 70)}},
+		{"company", company, []*models.Match{newMatch(5, 50), masked}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eps, svc := newAuthMiddleware(t)
+			svc.On("GetMatchingCandidates", mock.Anything, uint64(1), mock.Anything).
+				Return([]*models.Match{newMatch(5, 50), newMatch(7, 70)}, nil)
+
+			res, err := eps.GetMatchingCandidates(withToken(tt.caller), endpoints.GetMatchingCandidatesRequest{JobPostID: 1})
+			require.NoError(t, err)
+			got := res.(endpoints.GetMatchesResponse)
+			require.NoError(t, got.Err)
+			require.Equal(t, tt.want, got.Matches)
+		})
+	}
+
+	require.InDelta(t, 0.375, masked.Score, 0.001)
+	require.Equal(t, models.MatchNeutralScore, masked.Factors[1].Score)
+}
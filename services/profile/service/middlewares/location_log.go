@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"context"
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+type locationLogMiddleware struct {
+	logger log.Logger
+	next   interfaces.LocationService
+}
+
+// NewLocationLogMiddleware creates and returns a new Log Middleware that implements the profile LocationService interface
+func NewLocationLogMiddleware(logger log.Logger, svc interfaces.LocationService) interfaces.LocationService {
+	return &locationLogMiddleware{
+		logger: logger,
+		next:   svc,
+	}
+}
+
+func (mw locationLogMiddleware) log(method string, begin time.Time, input, output interface{}, err *error) {
+	logMiddleware{logger: mw.logger}.log(method, begin, input, output, err)
+}
+
+/* --------------- Region --------------- */
+
+// GetRegions returns all Regions
+func (mw locationLogMiddleware) GetRegions(ctx context.Context) (output []*models.Region, err error) {
+	defer mw.log("GetRegions", time.Now(), nil, output, &err)
+	output, err = mw.next.GetRegions(ctx)
+	return
+}
+
+/* --------------- Country --------------- */
+
+// GetCountries returns all Countries
+func (mw locationLogMiddleware) GetCountries(ctx context.Context, input models.CountryFilters) (output []*models.Country, err error) {
+	defer mw.log("GetCountries", time.Now(), input, output, &err)
+	output, err = mw.next.GetCountries(ctx, input)
+	return
+}
+
+/* --------------- State --------------- */
+
+// CreateState creates a new State
+func (mw locationLogMiddleware) CreateState(ctx context.Context, input *models.State) (output *models.State, err error) {
+	defer mw.log("CreateState", time.Now(), input, output, &err)
+	output, err = mw.next.CreateState(ctx, input)
+	return
+}
+
+// GetStates returns all States
+func (mw locationLogMiddleware) GetStates(ctx context.Context, input models.StateFilters) (output []*models.State, err error) {
+	defer mw.log("GetStates", time.Now(), input, output, &err)
+	output, err = mw.next.GetStates(ctx, input)
+	return
+}
+
+/* --------------- City --------------- */
+
+// CreateCity creates a new City
+func (mw locationLogMiddleware) CreateCity(ctx context.Context, input *models.City) (output *models.City, err error) {
+	defer mw.log("CreateCity", time.Now(), input, output, &err)
+	output, err = mw.next.CreateCity(ctx, input)
+	return
+}
+
+// GetCities returns all Cities
+func (mw locationLogMiddleware) GetCities(ctx context.Context, input models.CityFilters) (output []*models.City, err error) {
+	defer mw.log("GetCities", time.Now(), input, output, &err)
+	output, err = mw.next.GetCities(ctx, input)
+	return
+}
+
+/* --------------- Address --------------- */
+
+// CreateAddress creates a new Address
+func (mw locationLogMiddleware) CreateAddress(ctx context.Context, input *models.Address) (output *models.Address, err error) {
+	defer mw.log("CreateAddress", time.Now(), input, output, &err)
+	output, err = mw.next.CreateAddress(ctx, input)
+	return
+}
+
+// GetAddress returns an Address by ID
+func (mw locationLogMiddleware) GetAddress(ctx context.Context, input uint64) (output *models.Address, err error) {
+	defer mw.log("GetAddress", time.Now(), input, output, &err)
+	output, err = mw.next.GetAddress(ctx, input)
+	return
+}
+
+// UpdateAddress updates an Address
+func (mw locationLogMiddleware) UpdateAddress(ctx context.Context, input *models.Address) (output *models.Address, err error) {
+	defer mw.log("UpdateAddress", time.Now(), input, output, &err)
+	output, err = mw.next.UpdateAddress(ctx, input)
+	return
+}
+
+// DeleteAddress deletes an Address by ID
+func (mw locationLogMiddleware) DeleteAddress(ctx context.Context, input uint64) (err error) {
+	defer mw.log("DeleteAddress", time.Now(), input, nil, &err)
+	err = mw.next.DeleteAddress(ctx, input)
+	return
+}
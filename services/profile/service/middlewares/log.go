@@ -3,6 +3,7 @@ package middlewares
 import (
 	"context"
 	"fmt"
+	"in-backend/pagination"
 	"in-backend/services/profile/interfaces"
 	"in-backend/services/profile/models"
 	"time"
@@ -80,9 +81,9 @@ func (mw logMiddleware) CreateCandidate(ctx context.Context, input *models.Candi
 }
 
 // GetAllCandidates returns all Candidates
-func (mw logMiddleware) GetAllCandidates(ctx context.Context, input models.CandidateFilters) (output []*models.User, err error) {
+func (mw logMiddleware) GetAllCandidates(ctx context.Context, input models.CandidateFilters) (output []*models.User, page *pagination.Info, err error) {
 	defer mw.log("GetAllCandidates", time.Now(), input, output, &err)
-	output, err = mw.next.GetAllCandidates(ctx, input)
+	output, page, err = mw.next.GetAllCandidates(ctx, input)
 	return
 }
 
@@ -93,6 +94,14 @@ func (mw logMiddleware) GetCandidateByID(ctx context.Context, input uint64) (out
 	return
 }
 
+// LocalGetSCMLogins returns the logins of the git host accounts linked to a User
+// This method is only for local server to server communication
+func (mw logMiddleware) LocalGetSCMLogins(ctx context.Context, input uint64) (output map[string]string, err error) {
+	defer mw.log("LocalGetSCMLogins", time.Now(), input, output, &err)
+	output, err = mw.next.LocalGetSCMLogins(ctx, input)
+	return
+}
+
 // UpdateCandidate updates a Candidate
 func (mw logMiddleware) UpdateCandidate(ctx context.Context, input *models.Candidate) (output *models.Candidate, err error) {
 	defer mw.log("UpdateCandidate", time.Now(), input, output, &err)
@@ -107,6 +116,28 @@ func (mw logMiddleware) DeleteCandidate(ctx context.Context, input uint64) (err
 	return
 }
 
+// ImportResume parses a resume into a draft Candidate profile
+func (mw logMiddleware) ImportResume(ctx context.Context, cid uint64, doc []byte) (output *models.Candidate, err error) {
+	// the resume itself is not logged as it is large and full of personal data
+	defer mw.log("ImportResume", time.Now(), []interface{}{cid, len(doc)}, output, &err)
+	output, err = mw.next.ImportResume(ctx, cid, doc)
+	return
+}
+
+// ExportCandidateProfile renders a Candidate profile as a JSON Resume or a PDF document
+func (mw logMiddleware) ExportCandidateProfile(ctx context.Context, id uint64, o models.ExportOptions) (output *models.ProfileExport, err error) {
+	// only the content type of the export is logged as the profile is full of personal data
+	defer func(begin time.Time) {
+		var contentType string
+		if output != nil {
+			contentType = output.ContentType
+		}
+		mw.log("ExportCandidateProfile", begin, []interface{}{id, o}, contentType, &err)
+	}(time.Now())
+	output, err = mw.next.ExportCandidateProfile(ctx, id, o)
+	return
+}
+
 /* --------------- Skill --------------- */
 
 // CreateSkill creates a new Skill
@@ -297,3 +328,137 @@ func (mw logMiddleware) DeleteJobHistory(ctx context.Context, cid, jhid uint64)
 	err = mw.next.DeleteJobHistory(ctx, cid, jhid)
 	return
 }
+
+/* --------------- Matching --------------- */
+
+// GetMatchingJobPosts ranks JobPosts by how well they match a Candidate
+func (mw logMiddleware) GetMatchingJobPosts(ctx context.Context, cid uint64, f models.MatchFilters) (output []*models.Match, err error) {
+	defer mw.log("GetMatchingJobPosts", time.Now(), []interface{}{cid, f}, output, &err)
+	output, err = mw.next.GetMatchingJobPosts(ctx, cid, f)
+	return
+}
+
+// GetMatchingCandidates ranks Candidates by how well they match a JobPost
+func (mw logMiddleware) GetMatchingCandidates(ctx context.Context, jpid uint64, f models.MatchFilters) (output []*models.Match, err error) {
+	defer mw.log("GetMatchingCandidates", time.Now(), []interface{}{jpid, f}, output, &err)
+	output, err = mw.next.GetMatchingCandidates(ctx, jpid, f)
+	return
+}
+
+/* --------------- Shortlist --------------- */
+
+// CreateShortlist creates a new Shortlist
+func (mw logMiddleware) CreateShortlist(ctx context.Context, input *models.Shortlist) (output *models.Shortlist, err error) {
+	defer mw.log("CreateShortlist", time.Now(), input, output, &err)
+	output, err = mw.next.CreateShortlist(ctx, input)
+	return
+}
+
+// GetAllShortlists returns all Shortlists that match the filters
+func (mw logMiddleware) GetAllShortlists(ctx context.Context, input models.ShortlistFilters) (output []*models.Shortlist, err error) {
+	defer mw.log("GetAllShortlists", time.Now(), input, output, &err)
+	output, err = mw.next.GetAllShortlists(ctx, input)
+	return
+}
+
+// GetShortlistByID returns a Shortlist by ID with the profiles of its Candidates
+func (mw logMiddleware) GetShortlistByID(ctx context.Context, input uint64) (output *models.Shortlist, err error) {
+	defer mw.log("GetShortlistByID", time.Now(), input, output, &err)
+	output, err = mw.next.GetShortlistByID(ctx, input)
+	return
+}
+
+// UpdateShortlist updates a Shortlist
+func (mw logMiddleware) UpdateShortlist(ctx context.Context, input *models.Shortlist) (output *models.Shortlist, err error) {
+	defer mw.log("UpdateShortlist", time.Now(), input, output, &err)
+	output, err = mw.next.UpdateShortlist(ctx, input)
+	return
+}
+
+// DeleteShortlist deletes a Shortlist by ID
+func (mw logMiddleware) DeleteShortlist(ctx context.Context, input uint64) (err error) {
+	defer mw.log("DeleteShortlist", time.Now(), input, nil, &err)
+	err = mw.next.DeleteShortlist(ctx, input)
+	return
+}
+
+// AddShortlistCandidate adds a Candidate with notes to a Shortlist
+func (mw logMiddleware) AddShortlistCandidate(ctx context.Context, input *models.ShortlistCandidate) (output *models.ShortlistCandidate, err error) {
+	defer mw.log("AddShortlistCandidate", time.Now(), input, output, &err)
+	output, err = mw.next.AddShortlistCandidate(ctx, input)
+	return
+}
+
+// RemoveShortlistCandidate removes a Candidate from a Shortlist
+func (mw logMiddleware) RemoveShortlistCandidate(ctx context.Context, sid, cid uint64) (err error) {
+	defer mw.log("RemoveShortlistCandidate", time.Now(), []uint64{sid, cid}, nil, &err)
+	err = mw.next.RemoveShortlistCandidate(ctx, sid, cid)
+	return
+}
+
+// ShareShortlist shares a Shortlist with a company user
+func (mw logMiddleware) ShareShortlist(ctx context.Context, sid, uid uint64) (output *models.ShortlistShare, err error) {
+	defer mw.log("ShareShortlist", time.Now(), []uint64{sid, uid}, output, &err)
+	output, err = mw.next.ShareShortlist(ctx, sid, uid)
+	return
+}
+
+// UnshareShortlist stops sharing a Shortlist with a company user
+func (mw logMiddleware) UnshareShortlist(ctx context.Context, sid, uid uint64) (err error) {
+	defer mw.log("UnshareShortlist", time.Now(), []uint64{sid, uid}, nil, &err)
+	err = mw.next.UnshareShortlist(ctx, sid, uid)
+	return
+}
+
+/* --------------- Contact Consent --------------- */
+
+// GrantContactConsent lets the users of a Company see the personal details of a Candidate
+func (mw logMiddleware) GrantContactConsent(ctx context.Context, input *models.ContactConsent) (output *models.ContactConsent, err error) {
+	defer mw.log("GrantContactConsent", time.Now(), input, output, &err)
+	output, err = mw.next.GrantContactConsent(ctx, input)
+	return
+}
+
+// GetAllContactConsents returns all ContactConsents that match the filters
+func (mw logMiddleware) GetAllContactConsents(ctx context.Context, input models.ContactConsentFilters) (output []*models.ContactConsent, err error) {
+	defer mw.log("GetAllContactConsents", time.Now(), input, output, &err)
+	output, err = mw.next.GetAllContactConsents(ctx, input)
+	return
+}
+
+// RevokeContactConsent stops letting the users of a Company see the personal details of a Candidate
+func (mw logMiddleware) RevokeContactConsent(ctx context.Context, cid, coid uint64) (err error) {
+	defer mw.log("RevokeContactConsent", time.Now(), []uint64{cid, coid}, nil, &err)
+	err = mw.next.RevokeContactConsent(ctx, cid, coid)
+	return
+}
+
+/* --------------- Personal Data --------------- */
+
+// ExportMyData returns a zip archive of the data about a User kept by all services
+func (mw logMiddleware) ExportMyData(ctx context.Context, input uint64) (output *models.ProfileExport, err error) {
+	// only the content type of the export is logged as the archive is full of personal data
+	defer func(begin time.Time) {
+		var contentType string
+		if output != nil {
+			contentType = output.ContentType
+		}
+		mw.log("ExportMyData", begin, input, contentType, &err)
+	}(time.Now())
+	output, err = mw.next.ExportMyData(ctx, input)
+	return
+}
+
+// EraseUser hard deletes the data about a User kept by all services
+func (mw logMiddleware) EraseUser(ctx context.Context, input uint64) (output *models.ErasureReceipt, err error) {
+	defer mw.log("EraseUser", time.Now(), input, &output, &err)
+	output, err = mw.next.EraseUser(ctx, input)
+	return
+}
+
+// GetErasureReceipt returns the ErasureReceipt of an erased User
+func (mw logMiddleware) GetErasureReceipt(ctx context.Context, input uint64) (output *models.ErasureReceipt, err error) {
+	defer mw.log("GetErasureReceipt", time.Now(), input, &output, &err)
+	output, err = mw.next.GetErasureReceipt(ctx, input)
+	return
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"in-backend/services/profile/interfaces"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/providers"
+)
+
+const (
+	// outboxBatchSize is the number of OutboxMessages claimed in each Dispatch
+	outboxBatchSize = 50
+	// outboxLease is how long a claimed OutboxMessage is hidden from other dispatchers
+	outboxLease = 5 * time.Minute
+	// outboxMaxAttempts is the number of failed deliveries after which an OutboxMessage is dead lettered
+	outboxMaxAttempts = 10
+	// outboxBaseBackoff and outboxMaxBackoff bound the delay before an OutboxMessage is retried
+	outboxBaseBackoff = 30 * time.Second
+	outboxMaxBackoff  = 6 * time.Hour
+)
+
+var (
+	errOutboxUnknownKind = errors.New("Unknown outbox message kind")
+)
+
+// outboxDispatcher implements the profile OutboxDispatcher interface
+type outboxDispatcher struct {
+	repository interfaces.OutboxRepository
+	identity   providers.IdentityProvider
+	crm        providers.CRMProvider
+	logger     log.Logger
+}
+
+// NewOutboxDispatcher creates and returns a new OutboxDispatcher that implements the profile OutboxDispatcher interface
+func NewOutboxDispatcher(r interfaces.OutboxRepository, i providers.IdentityProvider, c providers.CRMProvider, logger log.Logger) interfaces.OutboxDispatcher {
+	return &outboxDispatcher{
+		repository: r,
+		identity:   i,
+		crm:        c,
+		logger:     logger,
+	}
+}
+
+// outboxBackoff returns the delay before an OutboxMessage is retried after its nth failed attempt,
+// which doubles with every attempt up to outboxMaxBackoff
+func outboxBackoff(attempts int) time.Duration {
+	d := outboxBaseBackoff
+	for i := 1; i < attempts && d < outboxMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > outboxMaxBackoff {
+		d = outboxMaxBackoff
+	}
+	return d
+}
+
+// Dispatch delivers the OutboxMessages that are due.
+// Failed messages are retried with exponential backoff until outboxMaxAttempts,
+// after which they are marked Dead and are no longer retried
+func (d *outboxDispatcher) Dispatch(ctx context.Context) error {
+	msgs, err := d.repository.ClaimOutboxMessages(ctx, outboxBatchSize, outboxLease)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		err := d.deliver(m)
+		m.Attempts++
+		switch {
+		case err == nil:
+			m.Status = models.OutboxDelivered
+			m.LastError = ""
+			if m.Kind == models.OutboxIdentityDeleteUser || m.Kind == models.OutboxCRMDeleteProspect {
+				// the payload identifies an erased User, so it is not kept once it is delivered
+				m.Payload = json.RawMessage("{}")
+			}
+		case m.Attempts >= outboxMaxAttempts:
+			m.Status = models.OutboxDead
+			m.LastError = err.Error()
+			level.Error(d.logger).Log("msg", "outbox message dead lettered", "id", m.ID, "kind", m.Kind, "attempts", m.Attempts, "err", err)
+		default:
+			next := time.Now().Add(outboxBackoff(m.Attempts))
+			m.NextAttemptAt = &next
+			m.LastError = err.Error()
+			level.Warn(d.logger).Log("msg", "outbox message failed", "id", m.ID, "kind", m.Kind, "attempts", m.Attempts, "err", err)
+		}
+
+		if err := d.repository.UpdateOutboxMessage(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver performs the side effect recorded in an OutboxMessage
+func (d *outboxDispatcher) deliver(m *models.OutboxMessage) error {
+	switch m.Kind {
+	case models.OutboxIdentityUpdateUser:
+		u := &models.User{}
+		if err := json.Unmarshal(m.Payload, u); err != nil {
+			return err
+		}
+		return d.identity.UpdateUser(u)
+	case models.OutboxIdentitySetRoles:
+		u := &models.User{}
+		if err := json.Unmarshal(m.Payload, u); err != nil {
+			return err
+		}
+		return d.identity.SetUserRoles(u.AuthID, u.Roles)
+	case models.OutboxCRMProspect:
+		u := &models.User{}
+		if err := json.Unmarshal(m.Payload, u); err != nil {
+			return err
+		}
+		return d.crm.UpdateOrCreateProspect(u)
+	case models.OutboxCRMCadence:
+		p := &models.CadencePayload{}
+		if err := json.Unmarshal(m.Payload, p); err != nil {
+			return err
+		}
+		return d.crm.StartCadence(p.Email, p.Role)
+	case models.OutboxIdentityDeleteUser:
+		p := &models.ErasurePayload{}
+		if err := json.Unmarshal(m.Payload, p); err != nil {
+			return err
+		}
+		return d.identity.DeleteUser(p.AuthID)
+	case models.OutboxCRMDeleteProspect:
+		p := &models.ErasurePayload{}
+		if err := json.Unmarshal(m.Payload, p); err != nil {
+			return err
+		}
+		return d.crm.DeleteProspect(p.Email)
+	}
+	return errOutboxUnknownKind
+}
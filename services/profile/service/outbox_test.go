@@ -0,0 +1,131 @@
+package service
+
+import (
+	"errors"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOutboxDispatcher(t *testing.T) {
+	or := &mocks.OutboxRepository{}
+	a := &mocks.IdentityProvider{}
+	k := &mocks.CRMProvider{}
+	logger := log.NewNopLogger()
+	want := &outboxDispatcher{repository: or, identity: a, crm: k, logger: logger}
+	require.Equal(t, want, NewOutboxDispatcher(or, a, k, logger))
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	var tests = []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{9, 128 * time.Minute},
+		{20, outboxMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, outboxBackoff(tt.attempts))
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	user := &models.User{AuthID: "authId", Email: "first@last.com", Roles: []string{"Candidate"}}
+	failed := errors.New("unavailable")
+
+	var tests = []struct {
+		name     string
+		kind     string
+		payload  interface{}
+		attempts int
+		setup    func(a *mocks.IdentityProvider, k *mocks.CRMProvider)
+		status   string
+		retry    bool
+	}{
+		{"update auth0 user", models.OutboxIdentityUpdateUser, user, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			a.On("UpdateUser", user).Return(nil)
+		}, models.OutboxDelivered, false},
+		{"set auth0 roles", models.OutboxIdentitySetRoles, user, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			a.On("SetUserRoles", "authId", []string{"Candidate"}).Return(nil)
+		}, models.OutboxDelivered, false},
+		{"crm prospect", models.OutboxCRMProspect, user, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			k.On("UpdateOrCreateProspect", user).Return(nil)
+		}, models.OutboxDelivered, false},
+		{"crm cadence", models.OutboxCRMCadence, &models.CadencePayload{Email: "first@last.com", Role: "Candidate"}, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			k.On("StartCadence", "first@last.com", "Candidate").Return(nil)
+		}, models.OutboxDelivered, false},
+		{"delete auth0 user", models.OutboxIdentityDeleteUser, &models.ErasurePayload{AuthID: "authId"}, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			a.On("DeleteUser", "authId").Return(nil)
+		}, models.OutboxDelivered, false},
+		{"delete crm prospect", models.OutboxCRMDeleteProspect, &models.ErasurePayload{Email: "first@last.com"}, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			k.On("DeleteProspect", "first@last.com").Return(nil)
+		}, models.OutboxDelivered, false},
+		{"delete crm prospect failed", models.OutboxCRMDeleteProspect, &models.ErasurePayload{Email: "first@last.com"}, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			k.On("DeleteProspect", "first@last.com").Return(failed)
+		}, models.OutboxPending, true},
+		{"identity provider failed", models.OutboxIdentityUpdateUser, user, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			a.On("UpdateUser", user).Return(failed)
+		}, models.OutboxPending, true},
+		{"failed", models.OutboxCRMProspect, user, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			k.On("UpdateOrCreateProspect", user).Return(failed)
+		}, models.OutboxPending, true},
+		{"dead letter", models.OutboxCRMProspect, user, outboxMaxAttempts - 1, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {
+			k.On("UpdateOrCreateProspect", user).Return(failed)
+		}, models.OutboxDead, false},
+		{"unknown kind", "unknown", user, 0, func(a *mocks.IdentityProvider, k *mocks.CRMProvider) {}, models.OutboxPending, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			or := &mocks.OutboxRepository{}
+			a := &mocks.IdentityProvider{}
+			k := &mocks.CRMProvider{}
+			tt.setup(a, k)
+			d := NewOutboxDispatcher(or, a, k, log.NewNopLogger())
+
+			m, err := models.NewOutboxMessage(tt.kind, tt.payload)
+			require.NoError(t, err)
+			m.Attempts = tt.attempts
+			or.On("ClaimOutboxMessages", ctx, outboxBatchSize, outboxLease).Return([]*models.OutboxMessage{m}, nil)
+			or.On("UpdateOutboxMessage", ctx, m).Return(nil)
+
+			start := time.Now()
+			err = d.Dispatch(ctx)
+			require.NoError(t, err)
+			or.AssertCalled(t, "UpdateOutboxMessage", ctx, mock.Anything)
+			a.AssertExpectations(t)
+			k.AssertExpectations(t)
+
+			require.Equal(t, tt.status, m.Status)
+			require.Equal(t, tt.attempts+1, m.Attempts)
+			if tt.status == models.OutboxDelivered {
+				require.Empty(t, m.LastError)
+			} else {
+				require.NotEmpty(t, m.LastError)
+			}
+			if tt.kind == models.OutboxIdentityDeleteUser || tt.kind == models.OutboxCRMDeleteProspect {
+				// erased users are not kept in the outbox once they are deleted from third parties
+				require.Equal(t, tt.status == models.OutboxDelivered, string(m.Payload) == "{}")
+			}
+			if tt.retry {
+				require.True(t, m.NextAttemptAt.After(start.Add(outboxBackoff(m.Attempts)-time.Second)))
+			}
+		})
+	}
+
+	// claim errors stop the dispatch before anything is delivered
+	or := &mocks.OutboxRepository{}
+	or.On("ClaimOutboxMessages", ctx, outboxBatchSize, outboxLease).Return(nil, failed)
+	d := NewOutboxDispatcher(or, &mocks.IdentityProvider{}, &mocks.CRMProvider{}, log.NewNopLogger())
+	require.Equal(t, failed, d.Dispatch(ctx))
+	or.AssertNotCalled(t, "UpdateOutboxMessage", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/resume"
+)
+
+/* --------------- Resume --------------- */
+
+// ImportResume extracts the text of a PDF or DOCX resume and maps it to a draft profile of a Candidate.
+// Companies, Institutions, Courses and Skills found in the resume are created if they do not exist yet,
+// so the draft references them by ID, but nothing is added to the profile of the Candidate.
+// The Candidate confirms the draft by creating the JobHistories, AcademicHistories and UserSkills they want to keep
+func (s *service) ImportResume(ctx context.Context, cid uint64, doc []byte) (*models.Candidate, error) {
+	u, err := s.repository.GetCandidateByID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || u.Candidate == nil {
+		return nil, errCandidateNotFound
+	}
+
+	text, err := resume.ExtractText(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	skills, err := s.repository.GetAllSkills(ctx, models.SkillFilters{})
+	if err != nil {
+		return nil, err
+	}
+	known := make([]string, len(skills))
+	for i, sk := range skills {
+		known[i] = sk.Name
+	}
+	d := resume.Parse(text, known)
+
+	c := &models.Candidate{
+		ID:          cid,
+		LinkedInURL: d.LinkedInURL,
+		SCMURL:      d.SCMURL,
+		Summary:     s.sanitizer.Sanitize(d.Summary),
+	}
+
+	for _, name := range d.Skills {
+		sk, err := s.repository.CreateSkill(ctx, &models.Skill{Name: name})
+		if err != nil {
+			return nil, err
+		}
+		c.Skills = append(c.Skills, sk)
+	}
+
+	for _, j := range d.Jobs {
+		jh := &models.JobHistory{
+			CandidateID: cid,
+			Title:       j.Title,
+			StartDate:   j.StartDate,
+			EndDate:     j.EndDate,
+			Description: s.sanitizer.Sanitize(j.Description),
+		}
+		if j.Company != "" {
+			co, err := s.repository.CreateCompany(ctx, &models.Company{Name: j.Company})
+			if err != nil {
+				return nil, err
+			}
+			jh.CompanyID = co.ID
+			jh.Company = co
+		}
+		c.Jobs = append(c.Jobs, jh)
+	}
+
+	for _, a := range d.Academics {
+		ah := &models.AcademicHistory{
+			CandidateID:  cid,
+			YearObtained: a.YearObtained,
+			Grade:        a.Grade,
+		}
+		if a.Institution != "" {
+			i, err := s.repository.CreateInstitution(ctx, &models.Institution{Name: a.Institution})
+			if err != nil {
+				return nil, err
+			}
+			ah.InstitutionID = i.ID
+			ah.Institution = i
+		}
+		if a.Course != "" {
+			cr, err := s.repository.CreateCourse(ctx, &models.Course{Name: a.Course, Level: a.Level})
+			if err != nil {
+				return nil, err
+			}
+			ah.CourseID = cr.ID
+			ah.Course = cr
+		}
+		c.Academics = append(c.Academics, ah)
+	}
+
+	return c, nil
+}
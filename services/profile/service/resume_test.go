@@ -0,0 +1,111 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/resume"
+	"in-backend/services/profile/tests/mocks"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newResume(t *testing.T, paragraphs ...string) []byte {
+	var body bytes.Buffer
+	for _, p := range paragraphs {
+		body.WriteString("<w:p><w:r><w:t>" + p + "</w:t></w:r></w:p>")
+	}
+
+	var b bytes.Buffer
+	z := zip.NewWriter(&b)
+	w, err := z.Create("word/document.xml")
+	require.NoError(t, err)
+	w.Write([]byte(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+		body.String() + `</w:body></w:document>`))
+	require.NoError(t, z.Close())
+	return b.Bytes()
+}
+
+func TestImportResume(t *testing.T) {
+	doc := newResume(t,
+		"Summary",
+		"Backend engineer &lt;b&gt;who ships&lt;/b&gt;",
+		"Experience",
+		"Software Engineer | Acme Pte Ltd | Jan 2019 - Present",
+		"- Built payment services",
+		"Education",
+		"National University of Singapore",
+		"Bachelor of Computing, 2016",
+		"Skills",
+		"golang, Docker",
+	)
+	start := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		name    string
+		cid     uint64
+		doc     []byte
+		want    *models.Candidate
+		wantErr error
+	}{
+		{
+			"valid",
+			1,
+			doc,
+			&models.Candidate{
+				ID:      1,
+				Summary: "Backend engineer who ships",
+				Skills:  []*models.Skill{{ID: 1, Name: "Go"}, {ID: 2, Name: "Docker"}},
+				Jobs: []*models.JobHistory{{
+					CandidateID: 1,
+					CompanyID:   3,
+					Company:     &models.Company{ID: 3, Name: "Acme Pte Ltd"},
+					Title:       "Software Engineer",
+					StartDate:   &start,
+					Description: "Built payment services",
+				}},
+				Academics: []*models.AcademicHistory{{
+					CandidateID:   1,
+					InstitutionID: 4,
+					Institution:   &models.Institution{ID: 4, Name: "National University of Singapore"},
+					CourseID:      5,
+					Course:        &models.Course{ID: 5, Name: "Bachelor of Computing", Level: "bachelor"},
+					YearObtained:  2016,
+				}},
+			},
+			nil,
+		},
+		{"candidate not found", 2, doc, nil, errCandidateNotFound},
+		{"unsupported format", 1, []byte("Software Engineer"), nil, resume.ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.Repository{}
+			repo.On("GetCandidateByID", ctx, uint64(1)).Return(&models.User{ID: 1, Candidate: &models.Candidate{ID: 1}}, nil)
+			repo.On("GetCandidateByID", ctx, uint64(2)).Return(nil, nil)
+			repo.On("GetAllSkills", ctx, models.SkillFilters{}).Return([]*models.Skill{{ID: 1, Name: "Go"}}, nil)
+			// the existing Go skill is found by the case insensitive dedupe of the repository
+			repo.On("CreateSkill", ctx, &models.Skill{Name: "golang"}).Return(&models.Skill{ID: 1, Name: "Go"}, nil)
+			repo.On("CreateSkill", ctx, &models.Skill{Name: "Docker"}).Return(&models.Skill{ID: 2, Name: "Docker"}, nil)
+			repo.On("CreateCompany", ctx, mock.Anything).Return(&models.Company{ID: 3, Name: "Acme Pte Ltd"}, nil)
+			repo.On("CreateInstitution", ctx, mock.Anything).Return(&models.Institution{ID: 4, Name: "National University of Singapore"}, nil)
+			repo.On("CreateCourse", ctx, &models.Course{Name: "Bachelor of Computing", Level: "bachelor"}).
+				Return(&models.Course{ID: 5, Name: "Bachelor of Computing", Level: "bachelor"}, nil)
+
+			s := New(repo, nil, bluemonday.StrictPolicy(), nil)
+			got, err := s.ImportResume(ctx, tt.cid, tt.doc)
+			require.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.want, got)
+			// nothing is added to the profile until the candidate confirms the draft
+			repo.AssertNotCalled(t, "CreateJobHistory", mock.Anything, mock.Anything)
+			repo.AssertNotCalled(t, "CreateAcademicHistory", mock.Anything, mock.Anything)
+			repo.AssertNotCalled(t, "CreateUserSkill", mock.Anything, mock.Anything)
+		})
+	}
+}
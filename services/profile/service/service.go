@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 
+	"in-backend/pagination"
 	"in-backend/services/profile/interfaces"
 	"in-backend/services/profile/models"
+	"in-backend/services/profile/providers"
 
 	"github.com/microcosm-cc/bluemonday"
 )
@@ -12,14 +14,20 @@ import (
 // Service implements the profile Service interface
 type service struct {
 	repository interfaces.Repository
+	identity   providers.IdentityProvider
 	sanitizer  *bluemonday.Policy
+
+	// emailHashKey is the secret that the emails of erased Users are hashed with
+	emailHashKey []byte
 }
 
 // New creates and returns a new Service that implements the profile Service interface
-func New(r interfaces.Repository, p *bluemonday.Policy) interfaces.Service {
+func New(r interfaces.Repository, i providers.IdentityProvider, p *bluemonday.Policy, emailHashKey []byte) interfaces.Service {
 	return &service{
-		repository: r,
-		sanitizer:  p,
+		repository:   r,
+		identity:     i,
+		sanitizer:    p,
+		emailHashKey: emailHashKey,
 	}
 }
 
@@ -78,12 +86,12 @@ func (s *service) CreateCandidate(ctx context.Context, candidate *models.Candida
 }
 
 // GetAllCandidates returns all Candidates
-func (s *service) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, error) {
-	c, err := s.repository.GetAllCandidates(ctx, f)
+func (s *service) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, *pagination.Info, error) {
+	c, page, err := s.repository.GetAllCandidates(ctx, f)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return c, err
+	return c, page, err
 }
 
 // GetCandidateByID returns a Candidate by ID
@@ -95,6 +103,20 @@ func (s *service) GetCandidateByID(ctx context.Context, id uint64) (*models.User
 	return c, err
 }
 
+// LocalGetSCMLogins returns the logins of the git host accounts linked to the identity provider account of a User,
+// which unlike the SCM URL of a Candidate have been verified by the git host
+// This method is only for local server to server communication
+func (s *service) LocalGetSCMLogins(ctx context.Context, uid uint64) (map[string]string, error) {
+	u, err := s.repository.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return map[string]string{}, nil
+	}
+	return s.identity.GetSCMLogins(u.AuthID)
+}
+
 // UpdateCandidate updates a Candidate
 func (s *service) UpdateCandidate(ctx context.Context, candidate *models.Candidate) (*models.Candidate, error) {
 	// sanitize candidate summary
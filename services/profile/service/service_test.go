@@ -30,13 +30,30 @@ func TestNew(t *testing.T) {
 		repository: r,
 	}
 
-	got := New(r, nil)
+	got := New(r, nil, nil, nil)
 
 	require.Equal(t, expect, got)
 }
 
+func TestLocalGetSCMLogins(t *testing.T) {
+	repo := &mocks.Repository{}
+	repo.On("GetUserByID", ctx, uint64(1)).Return(&models.User{ID: 1, AuthID: "github|1", CandidateID: 2}, nil)
+	repo.On("GetUserByID", ctx, uint64(2)).Return(nil, nil)
+	identity := &mocks.IdentityProvider{}
+	identity.On("GetSCMLogins", "github|1").Return(map[string]string{"github.com": "octocat"}, nil)
+	s := New(repo, identity, nil, nil)
+
+	got, err := s.LocalGetSCMLogins(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"github.com": "octocat"}, got)
+
+	got, err = s.LocalGetSCMLogins(ctx, 2)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
 func TestAllCRUD(t *testing.T) {
-	s := New(r, nil)
+	s := New(r, nil, nil, nil)
 
 	testCreateCandidate(t, s)
 	testGetAllCandidates(t, s)
@@ -152,9 +169,9 @@ func testGetAllCandidates(t *testing.T, s interfaces.Service) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r.On("GetAllCandidates", tt.args.ctx).Return(tt.exp.output, tt.exp.err)
+			r.On("GetAllCandidates", tt.args.ctx).Return(tt.exp.output, nil, tt.exp.err)
 
-			got, err := s.GetAllCandidates(tt.args.ctx, *tt.args.f)
+			got, _, err := s.GetAllCandidates(tt.args.ctx, *tt.args.f)
 			assert.Equal(t, len(tt.exp.output), len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
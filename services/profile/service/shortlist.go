@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"in-backend/helpers"
+	"in-backend/pagination"
+	"in-backend/services/profile/models"
+)
+
+var (
+	errShortlistInvalid          = errors.New("Shortlist must have a name and a user")
+	errShortlistNotFound         = errors.New("Shortlist not found")
+	errShortlistCandidateInvalid = errors.New("Shortlist candidate must have a shortlist and a candidate")
+	errShortlistShareInvalid     = errors.New("Shortlists can only be shared with company users")
+)
+
+/* --------------- Shortlist --------------- */
+
+// CreateShortlist creates a new Shortlist
+func (s *service) CreateShortlist(ctx context.Context, shortlist *models.Shortlist) (*models.Shortlist, error) {
+	if shortlist == nil || shortlist.Name == "" || shortlist.UserID == 0 {
+		return nil, errShortlistInvalid
+	}
+
+	m, err := s.repository.CreateShortlist(ctx, shortlist)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetAllShortlists returns all Shortlists that match the filters
+func (s *service) GetAllShortlists(ctx context.Context, f models.ShortlistFilters) ([]*models.Shortlist, error) {
+	m, err := s.repository.GetAllShortlists(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// GetShortlistByID returns a Shortlist by ID with the profiles of its Candidates
+func (s *service) GetShortlistByID(ctx context.Context, id uint64) (*models.Shortlist, error) {
+	m, err := s.repository.GetShortlistByID(ctx, id)
+	if err != nil || m == nil || len(m.Candidates) == 0 {
+		return m, err
+	}
+
+	cids := make([]uint64, len(m.Candidates))
+	for i, c := range m.Candidates {
+		cids[i] = c.CandidateID
+	}
+	byCandidateID := make(map[uint64]*models.User, len(cids))
+	f := models.CandidateFilters{CandidateID: cids, Page: pagination.Params{PageSize: pagination.MaxPageSize}}
+	for {
+		users, page, err := s.repository.GetAllCandidates(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			byCandidateID[u.CandidateID] = u
+		}
+		if page == nil || page.NextPageToken == "" {
+			break
+		}
+		f.Page.PageToken = page.NextPageToken
+	}
+	for _, c := range m.Candidates {
+		c.User = byCandidateID[c.CandidateID]
+	}
+	return m, nil
+}
+
+// UpdateShortlist updates the name of a Shortlist
+func (s *service) UpdateShortlist(ctx context.Context, shortlist *models.Shortlist) (*models.Shortlist, error) {
+	if shortlist == nil || shortlist.Name == "" {
+		return nil, errShortlistInvalid
+	}
+
+	existing, err := s.repository.GetShortlistByID(ctx, shortlist.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, errShortlistNotFound
+	}
+	// shortlists cannot be moved to another user
+	shortlist.UserID = existing.UserID
+
+	m, err := s.repository.UpdateShortlist(ctx, shortlist)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// DeleteShortlist deletes a Shortlist by ID
+func (s *service) DeleteShortlist(ctx context.Context, id uint64) error {
+	err := s.repository.DeleteShortlist(ctx, id)
+	if err != nil {
+		return err
+	}
+	return err
+}
+
+/* --------------- Shortlist Candidate --------------- */
+
+// AddShortlistCandidate adds a Candidate with notes to a Shortlist.
+// Adding a Candidate that is already in the Shortlist replaces its notes
+func (s *service) AddShortlistCandidate(ctx context.Context, candidate *models.ShortlistCandidate) (*models.ShortlistCandidate, error) {
+	if candidate == nil || candidate.ShortlistID == 0 || candidate.CandidateID == 0 {
+		return nil, errShortlistCandidateInvalid
+	}
+	// sanitize notes, which are shown to the company users that the shortlist is shared with
+	candidate.Notes = s.sanitizer.Sanitize(candidate.Notes)
+
+	m, err := s.repository.CreateShortlistCandidate(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// RemoveShortlistCandidate removes a Candidate from a Shortlist
+func (s *service) RemoveShortlistCandidate(ctx context.Context, sid, cid uint64) error {
+	err := s.repository.DeleteShortlistCandidate(ctx, sid, cid)
+	if err != nil {
+		return err
+	}
+	return err
+}
+
+/* --------------- Shortlist Share --------------- */
+
+// ShareShortlist shares a Shortlist with a company user
+func (s *service) ShareShortlist(ctx context.Context, sid, uid uint64) (*models.ShortlistShare, error) {
+	shortlist, err := s.repository.GetShortlistByID(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	if shortlist == nil {
+		return nil, errShortlistNotFound
+	}
+
+	u, err := s.repository.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || !helpers.IsStringInSlice("Company", u.Roles) {
+		return nil, errShortlistShareInvalid
+	}
+
+	m, err := s.repository.CreateShortlistShare(ctx, &models.ShortlistShare{ShortlistID: sid, UserID: uid})
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// UnshareShortlist stops sharing a Shortlist with a company user
+func (s *service) UnshareShortlist(ctx context.Context, sid, uid uint64) error {
+	err := s.repository.DeleteShortlistShare(ctx, sid, uid)
+	if err != nil {
+		return err
+	}
+	return err
+}
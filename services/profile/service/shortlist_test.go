@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"in-backend/pagination"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/tests/mocks"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetShortlistByID(t *testing.T) {
+	sl := &models.Shortlist{
+		ID:     1,
+		UserID: 3,
+		Name:   "Backend",
+		Candidates: []*models.ShortlistCandidate{
+			{ShortlistID: 1, CandidateID: 10, Notes: "Strong Go"},
+			{ShortlistID: 1, CandidateID: 20},
+		},
+	}
+	u := &models.User{ID: 5, CandidateID: 10, Email: "jane@example.com"}
+
+	repo := &mocks.Repository{}
+	repo.On("GetShortlistByID", ctx, uint64(1)).Return(sl, nil)
+	repo.On("GetShortlistByID", ctx, uint64(2)).Return(nil, nil)
+	f := models.CandidateFilters{CandidateID: []uint64{10, 20}, Page: pagination.Params{PageSize: pagination.MaxPageSize}}
+	repo.On("GetAllCandidates", ctx, f).Return([]*models.User{u}, &pagination.Info{}, nil)
+
+	s := New(repo, nil, nil, nil)
+	got, err := s.GetShortlistByID(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, u, got.Candidates[0].User)
+	// candidates that no longer have a profile are still listed
+	require.Nil(t, got.Candidates[1].User)
+
+	got, err = s.GetShortlistByID(ctx, 2)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestUpdateShortlist(t *testing.T) {
+	repo := &mocks.Repository{}
+	repo.On("GetShortlistByID", ctx, uint64(1)).Return(&models.Shortlist{ID: 1, UserID: 3, Name: "Backend"}, nil)
+	repo.On("GetShortlistByID", ctx, uint64(2)).Return(nil, nil)
+	repo.On("UpdateShortlist", ctx, mock.Anything).Return(func(_ context.Context, m *models.Shortlist) *models.Shortlist {
+		return m
+	}, nil)
+
+	var tests = []struct {
+		name    string
+		input   *models.Shortlist
+		want    *models.Shortlist
+		wantErr error
+	}{
+		{"renamed", &models.Shortlist{ID: 1, Name: "Go"}, &models.Shortlist{ID: 1, UserID: 3, Name: "Go"}, nil},
+		{"cannot be moved", &models.Shortlist{ID: 1, UserID: 4, Name: "Go"}, &models.Shortlist{ID: 1, UserID: 3, Name: "Go"}, nil},
+		{"no name", &models.Shortlist{ID: 1}, nil, errShortlistInvalid},
+		{"not found", &models.Shortlist{ID: 2, Name: "Go"}, nil, errShortlistNotFound},
+	}
+
+	s := New(repo, nil, nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.UpdateShortlist(ctx, tt.input)
+			require.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddShortlistCandidate(t *testing.T) {
+	repo := &mocks.Repository{}
+	repo.On("CreateShortlistCandidate", ctx, mock.Anything).Return(func(_ context.Context, m *models.ShortlistCandidate) *models.ShortlistCandidate {
+		return m
+	}, nil)
+
+	s := New(repo, nil, bluemonday.UGCPolicy(), nil)
+	got, err := s.AddShortlistCandidate(ctx, &models.ShortlistCandidate{ShortlistID: 1, CandidateID: 10, Notes: `Strong Go<script>alert(1)</script>`})
+	require.NoError(t, err)
+	require.Equal(t, "Strong Go", got.Notes)
+
+	_, err = s.AddShortlistCandidate(ctx, &models.ShortlistCandidate{ShortlistID: 1})
+	require.Equal(t, errShortlistCandidateInvalid, err)
+}
+
+func TestShareShortlist(t *testing.T) {
+	repo := &mocks.Repository{}
+	repo.On("GetShortlistByID", ctx, uint64(1)).Return(&models.Shortlist{ID: 1, UserID: 3}, nil)
+	repo.On("GetShortlistByID", ctx, uint64(2)).Return(nil, nil)
+	repo.On("GetUserByID", ctx, uint64(7)).Return(&models.User{ID: 7, Roles: []string{"Company"}}, nil)
+	repo.On("GetUserByID", ctx, uint64(8)).Return(&models.User{ID: 8, Roles: []string{"Candidate"}}, nil)
+	repo.On("GetUserByID", ctx, uint64(9)).Return(nil, nil)
+	repo.On("CreateShortlistShare", ctx, &models.ShortlistShare{ShortlistID: 1, UserID: 7}).
+		Return(&models.ShortlistShare{ID: 1, ShortlistID: 1, UserID: 7}, nil)
+
+	var tests = []struct {
+		name    string
+		sid     uint64
+		uid     uint64
+		want    *models.ShortlistShare
+		wantErr error
+	}{
+		{"company user", 1, 7, &models.ShortlistShare{ID: 1, ShortlistID: 1, UserID: 7}, nil},
+		{"candidate", 1, 8, nil, errShortlistShareInvalid},
+		{"user not found", 1, 9, nil, errShortlistShareInvalid},
+		{"shortlist not found", 2, 7, nil, errShortlistNotFound},
+	}
+
+	s := New(repo, nil, nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.ShareShortlist(ctx, tt.sid, tt.uid)
+			require.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,12 +3,11 @@
 package mocks
 
 import (
-	context "context"
-
-	grpc "google.golang.org/grpc"
-
 	mock "github.com/stretchr/testify/mock"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
 
+	context "context"
 	pb "in-backend/services/profile/pb"
 )
 
@@ -17,6 +16,36 @@ type ProfileServiceClient struct {
 	mock.Mock
 }
 
+// AddShortlistCandidate provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) AddShortlistCandidate(ctx context.Context, in *pb.AddShortlistCandidateRequest, opts ...grpc.CallOption) (*pb.ShortlistCandidate, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ShortlistCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.AddShortlistCandidateRequest, ...grpc.CallOption) *pb.ShortlistCandidate); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ShortlistCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.AddShortlistCandidateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAcademicHistory provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) CreateAcademicHistory(ctx context.Context, in *pb.CreateAcademicHistoryRequest, opts ...grpc.CallOption) (*pb.AcademicHistory, error) {
 	_va := make([]interface{}, len(opts))
@@ -227,6 +256,36 @@ func (_m *ProfileServiceClient) CreateJobHistory(ctx context.Context, in *pb.Cre
 	return r0, r1
 }
 
+// CreateShortlist provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) CreateShortlist(ctx context.Context, in *pb.CreateShortlistRequest, opts ...grpc.CallOption) (*pb.Shortlist, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateShortlistRequest, ...grpc.CallOption) *pb.Shortlist); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateShortlistRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSkill provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) CreateSkill(ctx context.Context, in *pb.CreateSkillRequest, opts ...grpc.CallOption) (*pb.Skill, error) {
 	_va := make([]interface{}, len(opts))
@@ -407,6 +466,36 @@ func (_m *ProfileServiceClient) DeleteJobHistory(ctx context.Context, in *pb.Del
 	return r0, r1
 }
 
+// DeleteShortlist provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) DeleteShortlist(ctx context.Context, in *pb.DeleteShortlistRequest, opts ...grpc.CallOption) (*pb.DeleteShortlistResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.DeleteShortlistResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteShortlistRequest, ...grpc.CallOption) *pb.DeleteShortlistResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteShortlistResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.DeleteShortlistRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteUser provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest, opts ...grpc.CallOption) (*pb.DeleteUserResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -467,6 +556,96 @@ func (_m *ProfileServiceClient) DeleteUserSkill(ctx context.Context, in *pb.Dele
 	return r0, r1
 }
 
+// EraseUser provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) EraseUser(ctx context.Context, in *pb.EraseUserRequest, opts ...grpc.CallOption) (*pb.ErasureReceipt, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.EraseUserRequest, ...grpc.CallOption) *pb.ErasureReceipt); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.EraseUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportCandidateProfile provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) ExportCandidateProfile(ctx context.Context, in *pb.ExportCandidateProfileRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *httpbody.HttpBody
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ExportCandidateProfileRequest, ...grpc.CallOption) *httpbody.HttpBody); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*httpbody.HttpBody)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ExportCandidateProfileRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportMyData provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) ExportMyData(ctx context.Context, in *pb.ExportMyDataRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *httpbody.HttpBody
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ExportMyDataRequest, ...grpc.CallOption) *httpbody.HttpBody); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*httpbody.HttpBody)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ExportMyDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAcademicHistory provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) GetAcademicHistory(ctx context.Context, in *pb.GetAcademicHistoryRequest, opts ...grpc.CallOption) (*pb.AcademicHistory, error) {
 	_va := make([]interface{}, len(opts))
@@ -557,6 +736,36 @@ func (_m *ProfileServiceClient) GetAllCompanies(ctx context.Context, in *pb.GetA
 	return r0, r1
 }
 
+// GetAllContactConsents provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) GetAllContactConsents(ctx context.Context, in *pb.GetAllContactConsentsRequest, opts ...grpc.CallOption) (*pb.GetAllContactConsentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAllContactConsentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllContactConsentsRequest, ...grpc.CallOption) *pb.GetAllContactConsentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllContactConsentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllContactConsentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCourses provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) GetAllCourses(ctx context.Context, in *pb.GetAllCoursesRequest, opts ...grpc.CallOption) (*pb.GetAllCoursesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -647,6 +856,36 @@ func (_m *ProfileServiceClient) GetAllInstitutions(ctx context.Context, in *pb.G
 	return r0, r1
 }
 
+// GetAllShortlists provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) GetAllShortlists(ctx context.Context, in *pb.GetAllShortlistsRequest, opts ...grpc.CallOption) (*pb.GetAllShortlistsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAllShortlistsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllShortlistsRequest, ...grpc.CallOption) *pb.GetAllShortlistsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllShortlistsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllShortlistsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllSkills provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) GetAllSkills(ctx context.Context, in *pb.GetAllSkillsRequest, opts ...grpc.CallOption) (*pb.GetAllSkillsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -797,6 +1036,36 @@ func (_m *ProfileServiceClient) GetDepartment(ctx context.Context, in *pb.GetDep
 	return r0, r1
 }
 
+// GetErasureReceipt provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) GetErasureReceipt(ctx context.Context, in *pb.GetErasureReceiptRequest, opts ...grpc.CallOption) (*pb.ErasureReceipt, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetErasureReceiptRequest, ...grpc.CallOption) *pb.ErasureReceipt); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetErasureReceiptRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInstitution provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) GetInstitution(ctx context.Context, in *pb.GetInstitutionRequest, opts ...grpc.CallOption) (*pb.Institution, error) {
 	_va := make([]interface{}, len(opts))
@@ -857,6 +1126,96 @@ func (_m *ProfileServiceClient) GetJobHistory(ctx context.Context, in *pb.GetJob
 	return r0, r1
 }
 
+// GetMatchingCandidates provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) GetMatchingCandidates(ctx context.Context, in *pb.GetMatchingCandidatesRequest, opts ...grpc.CallOption) (*pb.GetMatchesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetMatchesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetMatchingCandidatesRequest, ...grpc.CallOption) *pb.GetMatchesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetMatchesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetMatchingCandidatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMatchingJobPosts provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) GetMatchingJobPosts(ctx context.Context, in *pb.GetMatchingJobPostsRequest, opts ...grpc.CallOption) (*pb.GetMatchesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetMatchesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetMatchingJobPostsRequest, ...grpc.CallOption) *pb.GetMatchesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetMatchesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetMatchingJobPostsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShortlistByID provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) GetShortlistByID(ctx context.Context, in *pb.GetShortlistByIDRequest, opts ...grpc.CallOption) (*pb.Shortlist, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetShortlistByIDRequest, ...grpc.CallOption) *pb.Shortlist); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetShortlistByIDRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSkill provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) GetSkill(ctx context.Context, in *pb.GetSkillRequest, opts ...grpc.CallOption) (*pb.Skill, error) {
 	_va := make([]interface{}, len(opts))
@@ -917,6 +1276,216 @@ func (_m *ProfileServiceClient) GetUserByID(ctx context.Context, in *pb.GetUserB
 	return r0, r1
 }
 
+// GrantContactConsent provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) GrantContactConsent(ctx context.Context, in *pb.GrantContactConsentRequest, opts ...grpc.CallOption) (*pb.ContactConsent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ContactConsent
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GrantContactConsentRequest, ...grpc.CallOption) *pb.ContactConsent); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ContactConsent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GrantContactConsentRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportResume provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) ImportResume(ctx context.Context, in *pb.ImportResumeRequest, opts ...grpc.CallOption) (*pb.Candidate, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.Candidate
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ImportResumeRequest, ...grpc.CallOption) *pb.Candidate); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Candidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ImportResumeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetSCMLogins provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) LocalGetSCMLogins(ctx context.Context, in *pb.GetSCMLoginsRequest, opts ...grpc.CallOption) (*pb.SCMLogins, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.SCMLogins
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetSCMLoginsRequest, ...grpc.CallOption) *pb.SCMLogins); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.SCMLogins)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetSCMLoginsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShortlistCandidate provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) RemoveShortlistCandidate(ctx context.Context, in *pb.RemoveShortlistCandidateRequest, opts ...grpc.CallOption) (*pb.RemoveShortlistCandidateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.RemoveShortlistCandidateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RemoveShortlistCandidateRequest, ...grpc.CallOption) *pb.RemoveShortlistCandidateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RemoveShortlistCandidateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RemoveShortlistCandidateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeContactConsent provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) RevokeContactConsent(ctx context.Context, in *pb.RevokeContactConsentRequest, opts ...grpc.CallOption) (*pb.RevokeContactConsentResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.RevokeContactConsentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RevokeContactConsentRequest, ...grpc.CallOption) *pb.RevokeContactConsentResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RevokeContactConsentResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RevokeContactConsentRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareShortlist provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) ShareShortlist(ctx context.Context, in *pb.ShareShortlistRequest, opts ...grpc.CallOption) (*pb.ShortlistShare, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ShortlistShare
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ShareShortlistRequest, ...grpc.CallOption) *pb.ShortlistShare); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ShortlistShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ShareShortlistRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnshareShortlist provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) UnshareShortlist(ctx context.Context, in *pb.UnshareShortlistRequest, opts ...grpc.CallOption) (*pb.UnshareShortlistResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.UnshareShortlistResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UnshareShortlistRequest, ...grpc.CallOption) *pb.UnshareShortlistResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.UnshareShortlistResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UnshareShortlistRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateAcademicHistory provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) UpdateAcademicHistory(ctx context.Context, in *pb.UpdateAcademicHistoryRequest, opts ...grpc.CallOption) (*pb.AcademicHistory, error) {
 	_va := make([]interface{}, len(opts))
@@ -1007,6 +1576,36 @@ func (_m *ProfileServiceClient) UpdateJobHistory(ctx context.Context, in *pb.Upd
 	return r0, r1
 }
 
+// UpdateShortlist provides a mock function with given fields: ctx, in, opts
+func (_m *ProfileServiceClient) UpdateShortlist(ctx context.Context, in *pb.UpdateShortlistRequest, opts ...grpc.CallOption) (*pb.Shortlist, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UpdateShortlistRequest, ...grpc.CallOption) *pb.Shortlist); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UpdateShortlistRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: ctx, in, opts
 func (_m *ProfileServiceClient) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest, opts ...grpc.CallOption) (*pb.User, error) {
 	_va := make([]interface{}, len(opts))
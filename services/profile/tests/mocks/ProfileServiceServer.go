@@ -3,10 +3,11 @@
 package mocks
 
 import (
+	mock "github.com/stretchr/testify/mock"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+
 	context "context"
 	pb "in-backend/services/profile/pb"
-
-	mock "github.com/stretchr/testify/mock"
 )
 
 // ProfileServiceServer is an autogenerated mock type for the ProfileServiceServer type
@@ -14,6 +15,29 @@ type ProfileServiceServer struct {
 	mock.Mock
 }
 
+// AddShortlistCandidate provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) AddShortlistCandidate(_a0 context.Context, _a1 *pb.AddShortlistCandidateRequest) (*pb.ShortlistCandidate, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ShortlistCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.AddShortlistCandidateRequest) *pb.ShortlistCandidate); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ShortlistCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.AddShortlistCandidateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAcademicHistory provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) CreateAcademicHistory(_a0 context.Context, _a1 *pb.CreateAcademicHistoryRequest) (*pb.AcademicHistory, error) {
 	ret := _m.Called(_a0, _a1)
@@ -175,6 +199,29 @@ func (_m *ProfileServiceServer) CreateJobHistory(_a0 context.Context, _a1 *pb.Cr
 	return r0, r1
 }
 
+// CreateShortlist provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) CreateShortlist(_a0 context.Context, _a1 *pb.CreateShortlistRequest) (*pb.Shortlist, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateShortlistRequest) *pb.Shortlist); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateShortlistRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSkill provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) CreateSkill(_a0 context.Context, _a1 *pb.CreateSkillRequest) (*pb.Skill, error) {
 	ret := _m.Called(_a0, _a1)
@@ -313,6 +360,29 @@ func (_m *ProfileServiceServer) DeleteJobHistory(_a0 context.Context, _a1 *pb.De
 	return r0, r1
 }
 
+// DeleteShortlist provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) DeleteShortlist(_a0 context.Context, _a1 *pb.DeleteShortlistRequest) (*pb.DeleteShortlistResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.DeleteShortlistResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteShortlistRequest) *pb.DeleteShortlistResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteShortlistResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.DeleteShortlistRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteUser provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) DeleteUser(_a0 context.Context, _a1 *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -359,6 +429,75 @@ func (_m *ProfileServiceServer) DeleteUserSkill(_a0 context.Context, _a1 *pb.Del
 	return r0, r1
 }
 
+// EraseUser provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) EraseUser(_a0 context.Context, _a1 *pb.EraseUserRequest) (*pb.ErasureReceipt, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.EraseUserRequest) *pb.ErasureReceipt); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.EraseUserRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportCandidateProfile provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) ExportCandidateProfile(_a0 context.Context, _a1 *pb.ExportCandidateProfileRequest) (*httpbody.HttpBody, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *httpbody.HttpBody
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ExportCandidateProfileRequest) *httpbody.HttpBody); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*httpbody.HttpBody)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ExportCandidateProfileRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportMyData provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) ExportMyData(_a0 context.Context, _a1 *pb.ExportMyDataRequest) (*httpbody.HttpBody, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *httpbody.HttpBody
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ExportMyDataRequest) *httpbody.HttpBody); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*httpbody.HttpBody)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ExportMyDataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAcademicHistory provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) GetAcademicHistory(_a0 context.Context, _a1 *pb.GetAcademicHistoryRequest) (*pb.AcademicHistory, error) {
 	ret := _m.Called(_a0, _a1)
@@ -428,6 +567,29 @@ func (_m *ProfileServiceServer) GetAllCompanies(_a0 context.Context, _a1 *pb.Get
 	return r0, r1
 }
 
+// GetAllContactConsents provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) GetAllContactConsents(_a0 context.Context, _a1 *pb.GetAllContactConsentsRequest) (*pb.GetAllContactConsentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAllContactConsentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllContactConsentsRequest) *pb.GetAllContactConsentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllContactConsentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllContactConsentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCourses provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) GetAllCourses(_a0 context.Context, _a1 *pb.GetAllCoursesRequest) (*pb.GetAllCoursesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -497,6 +659,29 @@ func (_m *ProfileServiceServer) GetAllInstitutions(_a0 context.Context, _a1 *pb.
 	return r0, r1
 }
 
+// GetAllShortlists provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) GetAllShortlists(_a0 context.Context, _a1 *pb.GetAllShortlistsRequest) (*pb.GetAllShortlistsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAllShortlistsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllShortlistsRequest) *pb.GetAllShortlistsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllShortlistsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllShortlistsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllSkills provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) GetAllSkills(_a0 context.Context, _a1 *pb.GetAllSkillsRequest) (*pb.GetAllSkillsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -612,6 +797,29 @@ func (_m *ProfileServiceServer) GetDepartment(_a0 context.Context, _a1 *pb.GetDe
 	return r0, r1
 }
 
+// GetErasureReceipt provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) GetErasureReceipt(_a0 context.Context, _a1 *pb.GetErasureReceiptRequest) (*pb.ErasureReceipt, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetErasureReceiptRequest) *pb.ErasureReceipt); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetErasureReceiptRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInstitution provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) GetInstitution(_a0 context.Context, _a1 *pb.GetInstitutionRequest) (*pb.Institution, error) {
 	ret := _m.Called(_a0, _a1)
@@ -658,6 +866,75 @@ func (_m *ProfileServiceServer) GetJobHistory(_a0 context.Context, _a1 *pb.GetJo
 	return r0, r1
 }
 
+// GetMatchingCandidates provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) GetMatchingCandidates(_a0 context.Context, _a1 *pb.GetMatchingCandidatesRequest) (*pb.GetMatchesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetMatchesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetMatchingCandidatesRequest) *pb.GetMatchesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetMatchesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetMatchingCandidatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMatchingJobPosts provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) GetMatchingJobPosts(_a0 context.Context, _a1 *pb.GetMatchingJobPostsRequest) (*pb.GetMatchesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetMatchesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetMatchingJobPostsRequest) *pb.GetMatchesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetMatchesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetMatchingJobPostsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShortlistByID provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) GetShortlistByID(_a0 context.Context, _a1 *pb.GetShortlistByIDRequest) (*pb.Shortlist, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetShortlistByIDRequest) *pb.Shortlist); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetShortlistByIDRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSkill provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) GetSkill(_a0 context.Context, _a1 *pb.GetSkillRequest) (*pb.Skill, error) {
 	ret := _m.Called(_a0, _a1)
@@ -704,6 +981,144 @@ func (_m *ProfileServiceServer) GetUserByID(_a0 context.Context, _a1 *pb.GetUser
 	return r0, r1
 }
 
+// GrantContactConsent provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) GrantContactConsent(_a0 context.Context, _a1 *pb.GrantContactConsentRequest) (*pb.ContactConsent, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ContactConsent
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GrantContactConsentRequest) *pb.ContactConsent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ContactConsent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GrantContactConsentRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportResume provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) ImportResume(_a0 context.Context, _a1 *pb.ImportResumeRequest) (*pb.Candidate, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.Candidate
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ImportResumeRequest) *pb.Candidate); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Candidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ImportResumeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShortlistCandidate provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) RemoveShortlistCandidate(_a0 context.Context, _a1 *pb.RemoveShortlistCandidateRequest) (*pb.RemoveShortlistCandidateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.RemoveShortlistCandidateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RemoveShortlistCandidateRequest) *pb.RemoveShortlistCandidateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RemoveShortlistCandidateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RemoveShortlistCandidateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeContactConsent provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) RevokeContactConsent(_a0 context.Context, _a1 *pb.RevokeContactConsentRequest) (*pb.RevokeContactConsentResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.RevokeContactConsentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RevokeContactConsentRequest) *pb.RevokeContactConsentResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RevokeContactConsentResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RevokeContactConsentRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShareShortlist provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) ShareShortlist(_a0 context.Context, _a1 *pb.ShareShortlistRequest) (*pb.ShortlistShare, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ShortlistShare
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ShareShortlistRequest) *pb.ShortlistShare); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ShortlistShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ShareShortlistRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnshareShortlist provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) UnshareShortlist(_a0 context.Context, _a1 *pb.UnshareShortlistRequest) (*pb.UnshareShortlistResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.UnshareShortlistResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UnshareShortlistRequest) *pb.UnshareShortlistResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.UnshareShortlistResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UnshareShortlistRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateAcademicHistory provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) UpdateAcademicHistory(_a0 context.Context, _a1 *pb.UpdateAcademicHistoryRequest) (*pb.AcademicHistory, error) {
 	ret := _m.Called(_a0, _a1)
@@ -773,6 +1188,29 @@ func (_m *ProfileServiceServer) UpdateJobHistory(_a0 context.Context, _a1 *pb.Up
 	return r0, r1
 }
 
+// UpdateShortlist provides a mock function with given fields: _a0, _a1
+func (_m *ProfileServiceServer) UpdateShortlist(_a0 context.Context, _a1 *pb.UpdateShortlistRequest) (*pb.Shortlist, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UpdateShortlistRequest) *pb.Shortlist); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UpdateShortlistRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: _a0, _a1
 func (_m *ProfileServiceServer) UpdateUser(_a0 context.Context, _a1 *pb.UpdateUserRequest) (*pb.User, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5,11 +5,11 @@ package mocks
 import (
 	context "context"
 
+	pg "github.com/go-pg/pg/v10"
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "in-backend/pagination"
 	models "in-backend/services/profile/models"
-
-	pg "github.com/go-pg/pg/v10"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -86,6 +86,29 @@ func (_m *Repository) CreateCompany(ctx context.Context, c *models.Company) (*mo
 	return r0, r1
 }
 
+// CreateContactConsent provides a mock function with given fields: ctx, m
+func (_m *Repository) CreateContactConsent(ctx context.Context, m *models.ContactConsent) (*models.ContactConsent, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ContactConsent
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ContactConsent) *models.ContactConsent); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContactConsent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ContactConsent) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCourse provides a mock function with given fields: ctx, c
 func (_m *Repository) CreateCourse(ctx context.Context, c *models.Course) (*models.Course, error) {
 	ret := _m.Called(ctx, c)
@@ -178,6 +201,75 @@ func (_m *Repository) CreateJobHistory(ctx context.Context, a *models.JobHistory
 	return r0, r1
 }
 
+// CreateShortlist provides a mock function with given fields: ctx, m
+func (_m *Repository) CreateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Shortlist) *models.Shortlist); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Shortlist) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShortlistCandidate provides a mock function with given fields: ctx, m
+func (_m *Repository) CreateShortlistCandidate(ctx context.Context, m *models.ShortlistCandidate) (*models.ShortlistCandidate, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ShortlistCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ShortlistCandidate) *models.ShortlistCandidate); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ShortlistCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ShortlistCandidate) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShortlistShare provides a mock function with given fields: ctx, m
+func (_m *Repository) CreateShortlistShare(ctx context.Context, m *models.ShortlistShare) (*models.ShortlistShare, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ShortlistShare
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ShortlistShare) *models.ShortlistShare); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ShortlistShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ShortlistShare) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSkill provides a mock function with given fields: ctx, s
 func (_m *Repository) CreateSkill(ctx context.Context, s *models.Skill) (*models.Skill, error) {
 	ret := _m.Called(ctx, s)
@@ -275,6 +367,20 @@ func (_m *Repository) DeleteCandidate(ctx context.Context, id uint64) error {
 	return r0
 }
 
+// DeleteContactConsent provides a mock function with given fields: ctx, cid, coid
+func (_m *Repository) DeleteContactConsent(ctx context.Context, cid uint64, coid uint64) error {
+	ret := _m.Called(ctx, cid, coid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, cid, coid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJobHistory provides a mock function with given fields: ctx, cid, jhid
 func (_m *Repository) DeleteJobHistory(ctx context.Context, cid uint64, jhid uint64) error {
 	ret := _m.Called(ctx, cid, jhid)
@@ -289,6 +395,48 @@ func (_m *Repository) DeleteJobHistory(ctx context.Context, cid uint64, jhid uin
 	return r0
 }
 
+// DeleteShortlist provides a mock function with given fields: ctx, id
+func (_m *Repository) DeleteShortlist(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteShortlistCandidate provides a mock function with given fields: ctx, sid, cid
+func (_m *Repository) DeleteShortlistCandidate(ctx context.Context, sid uint64, cid uint64) error {
+	ret := _m.Called(ctx, sid, cid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, sid, cid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteShortlistShare provides a mock function with given fields: ctx, sid, uid
+func (_m *Repository) DeleteShortlistShare(ctx context.Context, sid uint64, uid uint64) error {
+	ret := _m.Called(ctx, sid, uid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, sid, uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUser provides a mock function with given fields: ctx, id
 func (_m *Repository) DeleteUser(ctx context.Context, id uint64) error {
 	ret := _m.Called(ctx, id)
@@ -317,6 +465,75 @@ func (_m *Repository) DeleteUserSkill(ctx context.Context, cid uint64, sid uint6
 	return r0
 }
 
+// EraseCandidateData provides a mock function with given fields: ctx, uid, cid
+func (_m *Repository) EraseCandidateData(ctx context.Context, uid uint64, cid uint64) (map[string]uint64, error) {
+	ret := _m.Called(ctx, uid, cid)
+
+	var r0 map[string]uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) map[string]uint64); ok {
+		r0 = rf(ctx, uid, cid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]uint64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, uid, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EraseUser provides a mock function with given fields: ctx, u, receipt
+func (_m *Repository) EraseUser(ctx context.Context, u *models.User, receipt *models.ErasureReceipt) (*models.ErasureReceipt, error) {
+	ret := _m.Called(ctx, u, receipt)
+
+	var r0 *models.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, *models.User, *models.ErasureReceipt) *models.ErasureReceipt); ok {
+		r0 = rf(ctx, u, receipt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.User, *models.ErasureReceipt) error); ok {
+		r1 = rf(ctx, u, receipt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportCandidateData provides a mock function with given fields: ctx, uid, cid
+func (_m *Repository) ExportCandidateData(ctx context.Context, uid uint64, cid uint64) (map[string][]byte, error) {
+	ret := _m.Called(ctx, uid, cid)
+
+	var r0 map[string][]byte
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) map[string][]byte); ok {
+		r0 = rf(ctx, uid, cid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, uid, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAcademicHistory provides a mock function with given fields: ctx, id
 func (_m *Repository) GetAcademicHistory(ctx context.Context, id uint64) (*models.AcademicHistory, error) {
 	ret := _m.Called(ctx, id)
@@ -341,7 +558,7 @@ func (_m *Repository) GetAcademicHistory(ctx context.Context, id uint64) (*model
 }
 
 // GetAllCandidates provides a mock function with given fields: ctx, f
-func (_m *Repository) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, error) {
+func (_m *Repository) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.User
@@ -353,14 +570,23 @@ func (_m *Repository) GetAllCandidates(ctx context.Context, f models.CandidateFi
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.CandidateFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.CandidateFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.CandidateFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetAllCompanies provides a mock function with given fields: ctx, f
@@ -386,6 +612,29 @@ func (_m *Repository) GetAllCompanies(ctx context.Context, f models.CompanyFilte
 	return r0, r1
 }
 
+// GetAllContactConsents provides a mock function with given fields: ctx, f
+func (_m *Repository) GetAllContactConsents(ctx context.Context, f models.ContactConsentFilters) ([]*models.ContactConsent, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.ContactConsent
+	if rf, ok := ret.Get(0).(func(context.Context, models.ContactConsentFilters) []*models.ContactConsent); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ContactConsent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, models.ContactConsentFilters) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCourses provides a mock function with given fields: ctx, f
 func (_m *Repository) GetAllCourses(ctx context.Context, f models.CourseFilters) ([]*models.Course, error) {
 	ret := _m.Called(ctx, f)
@@ -455,6 +704,61 @@ func (_m *Repository) GetAllInstitutions(ctx context.Context, f models.Instituti
 	return r0, r1
 }
 
+// GetAllJobPosts provides a mock function with given fields: ctx, p
+func (_m *Repository) GetAllJobPosts(ctx context.Context, p pagination.Params) ([]*models.JobPost, *pagination.Info, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []*models.JobPost
+	if rf, ok := ret.Get(0).(func(context.Context, pagination.Params) []*models.JobPost); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.JobPost)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, pagination.Params) *pagination.Info); ok {
+		r1 = rf(ctx, p)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, pagination.Params) error); ok {
+		r2 = rf(ctx, p)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllShortlists provides a mock function with given fields: ctx, f
+func (_m *Repository) GetAllShortlists(ctx context.Context, f models.ShortlistFilters) ([]*models.Shortlist, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, models.ShortlistFilters) []*models.Shortlist); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, models.ShortlistFilters) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllSkills provides a mock function with given fields: ctx, f
 func (_m *Repository) GetAllSkills(ctx context.Context, f models.SkillFilters) ([]*models.Skill, error) {
 	ret := _m.Called(ctx, f)
@@ -478,6 +782,52 @@ func (_m *Repository) GetAllSkills(ctx context.Context, f models.SkillFilters) (
 	return r0, r1
 }
 
+// GetApplicantIDs provides a mock function with given fields: ctx, coid, cids
+func (_m *Repository) GetApplicantIDs(ctx context.Context, coid uint64, cids []uint64) ([]uint64, error) {
+	ret := _m.Called(ctx, coid, cids)
+
+	var r0 []uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64) []uint64); ok {
+		r0 = rf(ctx, coid, cids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []uint64) error); ok {
+		r1 = rf(ctx, coid, cids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAssessmentScores provides a mock function with given fields: ctx, uids
+func (_m *Repository) GetAssessmentScores(ctx context.Context, uids []uint64) ([]*models.AssessmentScore, error) {
+	ret := _m.Called(ctx, uids)
+
+	var r0 []*models.AssessmentScore
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []*models.AssessmentScore); ok {
+		r0 = rf(ctx, uids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AssessmentScore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, uids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCandidateByID provides a mock function with given fields: ctx, id
 func (_m *Repository) GetCandidateByID(ctx context.Context, id uint64) (*models.User, error) {
 	ret := _m.Called(ctx, id)
@@ -570,6 +920,29 @@ func (_m *Repository) GetDepartment(ctx context.Context, id uint64) (*models.Dep
 	return r0, r1
 }
 
+// GetErasureReceipt provides a mock function with given fields: ctx, uid
+func (_m *Repository) GetErasureReceipt(ctx context.Context, uid uint64) (*models.ErasureReceipt, error) {
+	ret := _m.Called(ctx, uid)
+
+	var r0 *models.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ErasureReceipt); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInstitution provides a mock function with given fields: ctx, id
 func (_m *Repository) GetInstitution(ctx context.Context, id uint64) (*models.Institution, error) {
 	ret := _m.Called(ctx, id)
@@ -616,6 +989,107 @@ func (_m *Repository) GetJobHistory(ctx context.Context, id uint64) (*models.Job
 	return r0, r1
 }
 
+// GetJobPostByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetJobPostByID(ctx context.Context, id uint64) (*models.JobPost, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.JobPost
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.JobPost); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.JobPost)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMatchCandidates provides a mock function with given fields: ctx, sids, p
+func (_m *Repository) GetMatchCandidates(ctx context.Context, sids []uint64, p pagination.Params) ([]*models.User, *pagination.Info, error) {
+	ret := _m.Called(ctx, sids, p)
+
+	var r0 []*models.User
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, pagination.Params) []*models.User); ok {
+		r0 = rf(ctx, sids, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64, pagination.Params) *pagination.Info); ok {
+		r1 = rf(ctx, sids, p)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, []uint64, pagination.Params) error); ok {
+		r2 = rf(ctx, sids, p)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetPersonalData provides a mock function with given fields: ctx, id
+func (_m *Repository) GetPersonalData(ctx context.Context, id uint64) (*models.PersonalData, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.PersonalData
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.PersonalData); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PersonalData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShortlistByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetShortlistByID(ctx context.Context, id uint64) (*models.Shortlist, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.Shortlist); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSkill provides a mock function with given fields: ctx, id
 func (_m *Repository) GetSkill(ctx context.Context, id uint64) (*models.Skill, error) {
 	ret := _m.Called(ctx, id)
@@ -685,6 +1159,29 @@ func (_m *Repository) GetUserByID(ctx context.Context, id uint64) (*models.User,
 	return r0, r1
 }
 
+// GetUserWithDeleted provides a mock function with given fields: ctx, id
+func (_m *Repository) GetUserWithDeleted(ctx context.Context, id uint64) (*models.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.User
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateAcademicHistory provides a mock function with given fields: ctx, a
 func (_m *Repository) UpdateAcademicHistory(ctx context.Context, a *models.AcademicHistory) (*models.AcademicHistory, error) {
 	ret := _m.Called(ctx, a)
@@ -754,6 +1251,29 @@ func (_m *Repository) UpdateJobHistory(ctx context.Context, j *models.JobHistory
 	return r0, r1
 }
 
+// UpdateShortlist provides a mock function with given fields: ctx, m
+func (_m *Repository) UpdateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Shortlist) *models.Shortlist); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Shortlist) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: ctx, c
 func (_m *Repository) UpdateUser(ctx context.Context, c *models.User) (*models.User, error) {
 	ret := _m.Called(ctx, c)
@@ -7,6 +7,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "in-backend/pagination"
 	models "in-backend/services/profile/models"
 )
 
@@ -15,6 +16,29 @@ type Service struct {
 	mock.Mock
 }
 
+// AddShortlistCandidate provides a mock function with given fields: ctx, m
+func (_m *Service) AddShortlistCandidate(ctx context.Context, m *models.ShortlistCandidate) (*models.ShortlistCandidate, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ShortlistCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ShortlistCandidate) *models.ShortlistCandidate); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ShortlistCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ShortlistCandidate) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAcademicHistory provides a mock function with given fields: ctx, a
 func (_m *Service) CreateAcademicHistory(ctx context.Context, a *models.AcademicHistory) (*models.AcademicHistory, error) {
 	ret := _m.Called(ctx, a)
@@ -176,6 +200,29 @@ func (_m *Service) CreateJobHistory(ctx context.Context, a *models.JobHistory) (
 	return r0, r1
 }
 
+// CreateShortlist provides a mock function with given fields: ctx, m
+func (_m *Service) CreateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Shortlist) *models.Shortlist); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Shortlist) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSkill provides a mock function with given fields: ctx, s
 func (_m *Service) CreateSkill(ctx context.Context, s *models.Skill) (*models.Skill, error) {
 	ret := _m.Called(ctx, s)
@@ -287,6 +334,20 @@ func (_m *Service) DeleteJobHistory(ctx context.Context, cid uint64, jhid uint64
 	return r0
 }
 
+// DeleteShortlist provides a mock function with given fields: ctx, id
+func (_m *Service) DeleteShortlist(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUser provides a mock function with given fields: ctx, id
 func (_m *Service) DeleteUser(ctx context.Context, id uint64) error {
 	ret := _m.Called(ctx, id)
@@ -315,6 +376,75 @@ func (_m *Service) DeleteUserSkill(ctx context.Context, cid uint64, sid uint64)
 	return r0
 }
 
+// EraseUser provides a mock function with given fields: ctx, id
+func (_m *Service) EraseUser(ctx context.Context, id uint64) (*models.ErasureReceipt, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ErasureReceipt); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportCandidateProfile provides a mock function with given fields: ctx, id, o
+func (_m *Service) ExportCandidateProfile(ctx context.Context, id uint64, o models.ExportOptions) (*models.ProfileExport, error) {
+	ret := _m.Called(ctx, id, o)
+
+	var r0 *models.ProfileExport
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.ExportOptions) *models.ProfileExport); ok {
+		r0 = rf(ctx, id, o)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProfileExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.ExportOptions) error); ok {
+		r1 = rf(ctx, id, o)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportMyData provides a mock function with given fields: ctx, id
+func (_m *Service) ExportMyData(ctx context.Context, id uint64) (*models.ProfileExport, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ProfileExport
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ProfileExport); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProfileExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAcademicHistory provides a mock function with given fields: ctx, id
 func (_m *Service) GetAcademicHistory(ctx context.Context, id uint64) (*models.AcademicHistory, error) {
 	ret := _m.Called(ctx, id)
@@ -339,7 +469,7 @@ func (_m *Service) GetAcademicHistory(ctx context.Context, id uint64) (*models.A
 }
 
 // GetAllCandidates provides a mock function with given fields: ctx, f
-func (_m *Service) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, error) {
+func (_m *Service) GetAllCandidates(ctx context.Context, f models.CandidateFilters) ([]*models.User, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.User
@@ -351,14 +481,23 @@ func (_m *Service) GetAllCandidates(ctx context.Context, f models.CandidateFilte
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.CandidateFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.CandidateFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.CandidateFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetAllCompanies provides a mock function with given fields: ctx, f
@@ -384,6 +523,29 @@ func (_m *Service) GetAllCompanies(ctx context.Context, f models.CompanyFilters)
 	return r0, r1
 }
 
+// GetAllContactConsents provides a mock function with given fields: ctx, f
+func (_m *Service) GetAllContactConsents(ctx context.Context, f models.ContactConsentFilters) ([]*models.ContactConsent, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.ContactConsent
+	if rf, ok := ret.Get(0).(func(context.Context, models.ContactConsentFilters) []*models.ContactConsent); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ContactConsent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, models.ContactConsentFilters) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCourses provides a mock function with given fields: ctx, f
 func (_m *Service) GetAllCourses(ctx context.Context, f models.CourseFilters) ([]*models.Course, error) {
 	ret := _m.Called(ctx, f)
@@ -453,6 +615,29 @@ func (_m *Service) GetAllInstitutions(ctx context.Context, f models.InstitutionF
 	return r0, r1
 }
 
+// GetAllShortlists provides a mock function with given fields: ctx, f
+func (_m *Service) GetAllShortlists(ctx context.Context, f models.ShortlistFilters) ([]*models.Shortlist, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, models.ShortlistFilters) []*models.Shortlist); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, models.ShortlistFilters) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllSkills provides a mock function with given fields: ctx, f
 func (_m *Service) GetAllSkills(ctx context.Context, f models.SkillFilters) ([]*models.Skill, error) {
 	ret := _m.Called(ctx, f)
@@ -568,6 +753,29 @@ func (_m *Service) GetDepartment(ctx context.Context, id uint64) (*models.Depart
 	return r0, r1
 }
 
+// GetErasureReceipt provides a mock function with given fields: ctx, uid
+func (_m *Service) GetErasureReceipt(ctx context.Context, uid uint64) (*models.ErasureReceipt, error) {
+	ret := _m.Called(ctx, uid)
+
+	var r0 *models.ErasureReceipt
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ErasureReceipt); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ErasureReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInstitution provides a mock function with given fields: ctx, id
 func (_m *Service) GetInstitution(ctx context.Context, id uint64) (*models.Institution, error) {
 	ret := _m.Called(ctx, id)
@@ -614,6 +822,75 @@ func (_m *Service) GetJobHistory(ctx context.Context, id uint64) (*models.JobHis
 	return r0, r1
 }
 
+// GetMatchingCandidates provides a mock function with given fields: ctx, jpid, f
+func (_m *Service) GetMatchingCandidates(ctx context.Context, jpid uint64, f models.MatchFilters) ([]*models.Match, error) {
+	ret := _m.Called(ctx, jpid, f)
+
+	var r0 []*models.Match
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.MatchFilters) []*models.Match); ok {
+		r0 = rf(ctx, jpid, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Match)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.MatchFilters) error); ok {
+		r1 = rf(ctx, jpid, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMatchingJobPosts provides a mock function with given fields: ctx, cid, f
+func (_m *Service) GetMatchingJobPosts(ctx context.Context, cid uint64, f models.MatchFilters) ([]*models.Match, error) {
+	ret := _m.Called(ctx, cid, f)
+
+	var r0 []*models.Match
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.MatchFilters) []*models.Match); ok {
+		r0 = rf(ctx, cid, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Match)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.MatchFilters) error); ok {
+		r1 = rf(ctx, cid, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShortlistByID provides a mock function with given fields: ctx, id
+func (_m *Service) GetShortlistByID(ctx context.Context, id uint64) (*models.Shortlist, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.Shortlist); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSkill provides a mock function with given fields: ctx, id
 func (_m *Service) GetSkill(ctx context.Context, id uint64) (*models.Skill, error) {
 	ret := _m.Called(ctx, id)
@@ -660,6 +937,140 @@ func (_m *Service) GetUserByID(ctx context.Context, id uint64) (*models.User, er
 	return r0, r1
 }
 
+// GrantContactConsent provides a mock function with given fields: ctx, m
+func (_m *Service) GrantContactConsent(ctx context.Context, m *models.ContactConsent) (*models.ContactConsent, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ContactConsent
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ContactConsent) *models.ContactConsent); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContactConsent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ContactConsent) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportResume provides a mock function with given fields: ctx, cid, doc
+func (_m *Service) ImportResume(ctx context.Context, cid uint64, doc []byte) (*models.Candidate, error) {
+	ret := _m.Called(ctx, cid, doc)
+
+	var r0 *models.Candidate
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []byte) *models.Candidate); ok {
+		r0 = rf(ctx, cid, doc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Candidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []byte) error); ok {
+		r1 = rf(ctx, cid, doc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetSCMLogins provides a mock function with given fields: ctx, uid
+func (_m *Service) LocalGetSCMLogins(ctx context.Context, uid uint64) (map[string]string, error) {
+	ret := _m.Called(ctx, uid)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) map[string]string); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShortlistCandidate provides a mock function with given fields: ctx, sid, cid
+func (_m *Service) RemoveShortlistCandidate(ctx context.Context, sid uint64, cid uint64) error {
+	ret := _m.Called(ctx, sid, cid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, sid, cid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeContactConsent provides a mock function with given fields: ctx, cid, coid
+func (_m *Service) RevokeContactConsent(ctx context.Context, cid uint64, coid uint64) error {
+	ret := _m.Called(ctx, cid, coid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, cid, coid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShareShortlist provides a mock function with given fields: ctx, sid, uid
+func (_m *Service) ShareShortlist(ctx context.Context, sid uint64, uid uint64) (*models.ShortlistShare, error) {
+	ret := _m.Called(ctx, sid, uid)
+
+	var r0 *models.ShortlistShare
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *models.ShortlistShare); ok {
+		r0 = rf(ctx, sid, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ShortlistShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, sid, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnshareShortlist provides a mock function with given fields: ctx, sid, uid
+func (_m *Service) UnshareShortlist(ctx context.Context, sid uint64, uid uint64) error {
+	ret := _m.Called(ctx, sid, uid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, sid, uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateAcademicHistory provides a mock function with given fields: ctx, a
 func (_m *Service) UpdateAcademicHistory(ctx context.Context, a *models.AcademicHistory) (*models.AcademicHistory, error) {
 	ret := _m.Called(ctx, a)
@@ -729,6 +1140,29 @@ func (_m *Service) UpdateJobHistory(ctx context.Context, j *models.JobHistory) (
 	return r0, r1
 }
 
+// UpdateShortlist provides a mock function with given fields: ctx, m
+func (_m *Service) UpdateShortlist(ctx context.Context, m *models.Shortlist) (*models.Shortlist, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.Shortlist
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Shortlist) *models.Shortlist); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Shortlist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Shortlist) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: ctx, m
 func (_m *Service) UpdateUser(ctx context.Context, m *models.User) (*models.User, error) {
 	ret := _m.Called(ctx, m)
@@ -2,12 +2,14 @@ package transport
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/profile/endpoints"
 	"in-backend/services/profile/models"
 	"in-backend/services/profile/pb"
 
 	"github.com/go-kit/kit/log"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -24,6 +26,11 @@ type grpcServer struct {
 	getCandidateByID kitgrpc.Handler
 	updateCandidate  kitgrpc.Handler
 	deleteCandidate  kitgrpc.Handler
+	importResume     kitgrpc.Handler
+
+	exportCandidateProfile kitgrpc.Handler
+
+	localGetSCMLogins kitgrpc.Handler
 
 	createSkill  kitgrpc.Handler
 	getSkill     kitgrpc.Handler
@@ -58,6 +65,26 @@ type grpcServer struct {
 	updateJobHistory kitgrpc.Handler
 	deleteJobHistory kitgrpc.Handler
 
+	getMatchingJobPosts   kitgrpc.Handler
+	getMatchingCandidates kitgrpc.Handler
+
+	createShortlist          kitgrpc.Handler
+	getAllShortlists         kitgrpc.Handler
+	getShortlistByID         kitgrpc.Handler
+	updateShortlist          kitgrpc.Handler
+	deleteShortlist          kitgrpc.Handler
+	addShortlistCandidate    kitgrpc.Handler
+	removeShortlistCandidate kitgrpc.Handler
+	shareShortlist           kitgrpc.Handler
+	unshareShortlist         kitgrpc.Handler
+	grantContactConsent      kitgrpc.Handler
+	getAllContactConsents    kitgrpc.Handler
+	revokeContactConsent     kitgrpc.Handler
+
+	exportMyData      kitgrpc.Handler
+	eraseUser         kitgrpc.Handler
+	getErasureReceipt kitgrpc.Handler
+
 	logger log.Logger
 }
 
@@ -114,6 +141,12 @@ func NewGRPCServer(
 			encodeGetCandidateByIDResponse,
 			options...,
 		),
+		localGetSCMLogins: kitgrpc.NewServer(
+			endpoints.LocalGetSCMLogins,
+			decodeGetSCMLoginsRequest,
+			encodeGetSCMLoginsResponse,
+			options...,
+		),
 		updateCandidate: kitgrpc.NewServer(
 			endpoints.UpdateCandidate,
 			decodeUpdateCandidateRequest,
@@ -126,6 +159,19 @@ func NewGRPCServer(
 			encodeDeleteCandidateResponse,
 			options...,
 		),
+		importResume: kitgrpc.NewServer(
+			endpoints.ImportResume,
+			decodeImportResumeRequest,
+			encodeImportResumeResponse,
+			options...,
+		),
+
+		exportCandidateProfile: kitgrpc.NewServer(
+			endpoints.ExportCandidateProfile,
+			decodeExportCandidateProfileRequest,
+			encodeExportCandidateProfileResponse,
+			options...,
+		),
 
 		createSkill: kitgrpc.NewServer(
 			endpoints.CreateSkill,
@@ -285,6 +331,111 @@ func NewGRPCServer(
 			options...,
 		),
 
+		getMatchingJobPosts: kitgrpc.NewServer(
+			endpoints.GetMatchingJobPosts,
+			decodeGetMatchingJobPostsRequest,
+			encodeGetMatchesResponse,
+			options...,
+		),
+		getMatchingCandidates: kitgrpc.NewServer(
+			endpoints.GetMatchingCandidates,
+			decodeGetMatchingCandidatesRequest,
+			encodeGetMatchesResponse,
+			options...,
+		),
+
+		createShortlist: kitgrpc.NewServer(
+			endpoints.CreateShortlist,
+			decodeCreateShortlistRequest,
+			encodeCreateShortlistResponse,
+			options...,
+		),
+		getAllShortlists: kitgrpc.NewServer(
+			endpoints.GetAllShortlists,
+			decodeGetAllShortlistsRequest,
+			encodeGetAllShortlistsResponse,
+			options...,
+		),
+		getShortlistByID: kitgrpc.NewServer(
+			endpoints.GetShortlistByID,
+			decodeGetShortlistByIDRequest,
+			encodeGetShortlistByIDResponse,
+			options...,
+		),
+		updateShortlist: kitgrpc.NewServer(
+			endpoints.UpdateShortlist,
+			decodeUpdateShortlistRequest,
+			encodeUpdateShortlistResponse,
+			options...,
+		),
+		deleteShortlist: kitgrpc.NewServer(
+			endpoints.DeleteShortlist,
+			decodeDeleteShortlistRequest,
+			encodeDeleteShortlistResponse,
+			options...,
+		),
+		addShortlistCandidate: kitgrpc.NewServer(
+			endpoints.AddShortlistCandidate,
+			decodeAddShortlistCandidateRequest,
+			encodeAddShortlistCandidateResponse,
+			options...,
+		),
+		removeShortlistCandidate: kitgrpc.NewServer(
+			endpoints.RemoveShortlistCandidate,
+			decodeRemoveShortlistCandidateRequest,
+			encodeRemoveShortlistCandidateResponse,
+			options...,
+		),
+		shareShortlist: kitgrpc.NewServer(
+			endpoints.ShareShortlist,
+			decodeShareShortlistRequest,
+			encodeShareShortlistResponse,
+			options...,
+		),
+		unshareShortlist: kitgrpc.NewServer(
+			endpoints.UnshareShortlist,
+			decodeUnshareShortlistRequest,
+			encodeUnshareShortlistResponse,
+			options...,
+		),
+		grantContactConsent: kitgrpc.NewServer(
+			endpoints.GrantContactConsent,
+			decodeGrantContactConsentRequest,
+			encodeGrantContactConsentResponse,
+			options...,
+		),
+		getAllContactConsents: kitgrpc.NewServer(
+			endpoints.GetAllContactConsents,
+			decodeGetAllContactConsentsRequest,
+			encodeGetAllContactConsentsResponse,
+			options...,
+		),
+		revokeContactConsent: kitgrpc.NewServer(
+			endpoints.RevokeContactConsent,
+			decodeRevokeContactConsentRequest,
+			encodeRevokeContactConsentResponse,
+			options...,
+		),
+
+		exportMyData: kitgrpc.NewServer(
+			endpoints.ExportMyData,
+			decodeExportMyDataRequest,
+			encodeExportMyDataResponse,
+			options...,
+		),
+		eraseUser: kitgrpc.NewServer(
+			endpoints.EraseUser,
+			decodeEraseUserRequest,
+			encodeErasureReceiptResponse,
+			options...,
+		),
+		getErasureReceipt: kitgrpc.NewServer(
+			endpoints.GetErasureReceipt,
+			decodeGetErasureReceiptRequest,
+			encodeErasureReceiptResponse,
+			options...,
+		),
+
 		logger: logger,
 	}
 }
@@ -430,7 +581,18 @@ func (s *grpcServer) GetAllCandidates(ctx context.Context, req *pb.GetAllCandida
 // decodeGetAllCandidatesRequest decodes the incoming grpc payload to our go kit payload
 func decodeGetAllCandidatesRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(*pb.GetAllCandidatesRequest)
-	return endpoints.GetAllCandidatesRequest{ID: req.Id}, nil
+	return endpoints.GetAllCandidatesRequest{
+		ID:          req.Id,
+		CandidateID: req.CandidateId,
+		Query:       req.Query,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
+	}, nil
 }
 
 // encodeGetAllCandidatesResponse encodes the outgoing go kit payload to the grpc payload
@@ -442,7 +604,15 @@ func encodeGetAllCandidatesResponse(_ context.Context, response interface{}) (in
 		for _, candidate := range res.Candidates {
 			candidates = append(candidates, candidate.ToProto())
 		}
-		return &pb.GetAllCandidatesResponse{Candidates: candidates}, nil
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllCandidatesResponse{
+			Candidates:    candidates,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
 	}
 	return nil, err
 }
@@ -472,6 +642,32 @@ func encodeGetCandidateByIDResponse(_ context.Context, response interface{}) (in
 	return nil, err
 }
 
+// LocalGetSCMLogins returns the logins of the git host accounts linked to a User
+// This method is only for local server to server communication
+func (s *grpcServer) LocalGetSCMLogins(ctx context.Context, req *pb.GetSCMLoginsRequest) (*pb.SCMLogins, error) {
+	_, rep, err := s.localGetSCMLogins.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.SCMLogins), nil
+}
+
+// decodeGetSCMLoginsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetSCMLoginsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetSCMLoginsRequest)
+	return endpoints.GetSCMLoginsRequest{UserID: req.UserId}, nil
+}
+
+// encodeGetSCMLoginsResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetSCMLoginsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetSCMLoginsResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.SCMLogins{Logins: res.Logins}, nil
+	}
+	return nil, err
+}
+
 // UpdateCandidate updates a Candidate
 func (s *grpcServer) UpdateCandidate(ctx context.Context, req *pb.UpdateCandidateRequest) (*pb.Candidate, error) {
 	_, rep, err := s.updateCandidate.ServeGRPC(ctx, req)
@@ -522,6 +718,66 @@ func encodeDeleteCandidateResponse(_ context.Context, response interface{}) (int
 	return nil, err
 }
 
+// ImportResume parses a resume into a draft Candidate profile
+func (s *grpcServer) ImportResume(ctx context.Context, req *pb.ImportResumeRequest) (*pb.Candidate, error) {
+	_, rep, err := s.importResume.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Candidate), nil
+}
+
+// decodeImportResumeRequest decodes the incoming grpc payload to our go kit payload
+func decodeImportResumeRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ImportResumeRequest)
+	return endpoints.ImportResumeRequest{ID: req.Id, Content: req.Content}, nil
+}
+
+// encodeImportResumeResponse encodes the outgoing go kit payload to the grpc payload
+func encodeImportResumeResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.ImportResumeResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Candidate.ToProto(), nil
+	}
+	return nil, err
+}
+
+/* --------------- Export --------------- */
+
+// ExportCandidateProfile renders a Candidate profile as a JSON Resume or a PDF document
+func (s *grpcServer) ExportCandidateProfile(ctx context.Context, req *pb.ExportCandidateProfileRequest) (*httpbody.HttpBody, error) {
+	_, rep, err := s.exportCandidateProfile.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*httpbody.HttpBody), nil
+}
+
+// decodeExportCandidateProfileRequest decodes the incoming grpc payload to our go kit payload
+func decodeExportCandidateProfileRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ExportCandidateProfileRequest)
+	return endpoints.ExportCandidateProfileRequest{
+		ID: req.Id,
+		Options: models.ExportOptions{
+			Format:            req.Format,
+			HideEmail:         req.HideEmail,
+			HideContactNumber: req.HideContactNumber,
+			HideSalary:        req.HideSalary,
+		},
+	}, nil
+}
+
+// encodeExportCandidateProfileResponse encodes the outgoing go kit payload to the grpc payload
+func encodeExportCandidateProfileResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.ExportCandidateProfileResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &httpbody.HttpBody{ContentType: res.Export.ContentType, Data: res.Export.Data}, nil
+	}
+	return nil, err
+}
+
 /* --------------- Skill --------------- */
 
 // CreateSkill creates a new Skill
@@ -1183,6 +1439,445 @@ func encodeDeleteJobHistoryResponse(_ context.Context, response interface{}) (in
 	return nil, err
 }
 
+/* --------------- Matching --------------- */
+
+// GetMatchingJobPosts ranks JobPosts by how well they match a Candidate
+func (s *grpcServer) GetMatchingJobPosts(ctx context.Context, req *pb.GetMatchingJobPostsRequest) (*pb.GetMatchesResponse, error) {
+	_, rep, err := s.getMatchingJobPosts.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetMatchesResponse), nil
+}
+
+// decodeGetMatchingJobPostsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetMatchingJobPostsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetMatchingJobPostsRequest)
+	return endpoints.GetMatchingJobPostsRequest{
+		CandidateID: req.CandidateId,
+		Filters:     models.MatchFilters{Limit: req.Limit, MinScore: req.MinScore},
+	}, nil
+}
+
+// GetMatchingCandidates ranks Candidates by how well they match a JobPost
+func (s *grpcServer) GetMatchingCandidates(ctx context.Context, req *pb.GetMatchingCandidatesRequest) (*pb.GetMatchesResponse, error) {
+	_, rep, err := s.getMatchingCandidates.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetMatchesResponse), nil
+}
+
+// decodeGetMatchingCandidatesRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetMatchingCandidatesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetMatchingCandidatesRequest)
+	return endpoints.GetMatchingCandidatesRequest{
+		JobPostID: req.JobPostId,
+		Filters:   models.MatchFilters{Limit: req.Limit, MinScore: req.MinScore},
+	}, nil
+}
+
+// encodeGetMatchesResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetMatchesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetMatchesResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var matches []*pb.Match
+		for _, m := range res.Matches {
+			matches = append(matches, m.ToProto())
+		}
+		return &pb.GetMatchesResponse{Matches: matches}, nil
+	}
+	return nil, err
+}
+
+/* --------------- Shortlist --------------- */
+
+// CreateShortlist creates a new Shortlist
+func (s *grpcServer) CreateShortlist(ctx context.Context, req *pb.CreateShortlistRequest) (*pb.Shortlist, error) {
+	_, rep, err := s.createShortlist.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Shortlist), nil
+}
+
+// decodeCreateShortlistRequest decodes the incoming grpc payload to our go kit payload
+func decodeCreateShortlistRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CreateShortlistRequest)
+	return endpoints.CreateShortlistRequest{Shortlist: models.ShortlistToORM(req.Shortlist)}, nil
+}
+
+// encodeCreateShortlistResponse encodes the outgoing go kit payload to the grpc payload
+func encodeCreateShortlistResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.CreateShortlistResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Shortlist.ToProto(), nil
+	}
+	return nil, err
+}
+
+// GetAllShortlists returns all Shortlists that match the filters
+func (s *grpcServer) GetAllShortlists(ctx context.Context, req *pb.GetAllShortlistsRequest) (*pb.GetAllShortlistsResponse, error) {
+	_, rep, err := s.getAllShortlists.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetAllShortlistsResponse), nil
+}
+
+// decodeGetAllShortlistsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetAllShortlistsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetAllShortlistsRequest)
+	return endpoints.GetAllShortlistsRequest{UserID: req.UserId}, nil
+}
+
+// encodeGetAllShortlistsResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetAllShortlistsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetAllShortlistsResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var shortlists []*pb.Shortlist
+		for _, m := range res.Shortlists {
+			shortlists = append(shortlists, m.ToProto())
+		}
+		return &pb.GetAllShortlistsResponse{Shortlists: shortlists}, nil
+	}
+	return nil, err
+}
+
+// GetShortlistByID returns a Shortlist by ID
+func (s *grpcServer) GetShortlistByID(ctx context.Context, req *pb.GetShortlistByIDRequest) (*pb.Shortlist, error) {
+	_, rep, err := s.getShortlistByID.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Shortlist), nil
+}
+
+// decodeGetShortlistByIDRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetShortlistByIDRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetShortlistByIDRequest)
+	return endpoints.GetShortlistByIDRequest{ID: req.Id}, nil
+}
+
+// encodeGetShortlistByIDResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetShortlistByIDResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetShortlistByIDResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Shortlist.ToProto(), nil
+	}
+	return nil, err
+}
+
+// UpdateShortlist updates a Shortlist
+func (s *grpcServer) UpdateShortlist(ctx context.Context, req *pb.UpdateShortlistRequest) (*pb.Shortlist, error) {
+	_, rep, err := s.updateShortlist.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Shortlist), nil
+}
+
+// decodeUpdateShortlistRequest decodes the incoming grpc payload to our go kit payload
+func decodeUpdateShortlistRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.UpdateShortlistRequest)
+	m := models.ShortlistToORM(req.Shortlist)
+	if m != nil {
+		m.ID = req.Id
+	}
+	return endpoints.UpdateShortlistRequest{Shortlist: m}, nil
+}
+
+// encodeUpdateShortlistResponse encodes the outgoing go kit payload to the grpc payload
+func encodeUpdateShortlistResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.UpdateShortlistResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Shortlist.ToProto(), nil
+	}
+	return nil, err
+}
+
+// DeleteShortlist deletes a Shortlist by ID
+func (s *grpcServer) DeleteShortlist(ctx context.Context, req *pb.DeleteShortlistRequest) (*pb.DeleteShortlistResponse, error) {
+	_, rep, err := s.deleteShortlist.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.DeleteShortlistResponse), nil
+}
+
+// decodeDeleteShortlistRequest decodes the incoming grpc payload to our go kit payload
+func decodeDeleteShortlistRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.DeleteShortlistRequest)
+	return endpoints.DeleteShortlistRequest{ID: req.Id}, nil
+}
+
+// encodeDeleteShortlistResponse encodes the outgoing go kit payload to the grpc payload
+func encodeDeleteShortlistResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.DeleteShortlistResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.DeleteShortlistResponse{}, nil
+	}
+	return nil, err
+}
+
+// AddShortlistCandidate adds a Candidate with notes to a Shortlist
+func (s *grpcServer) AddShortlistCandidate(ctx context.Context, req *pb.AddShortlistCandidateRequest) (*pb.ShortlistCandidate, error) {
+	_, rep, err := s.addShortlistCandidate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ShortlistCandidate), nil
+}
+
+// decodeAddShortlistCandidateRequest decodes the incoming grpc payload to our go kit payload
+func decodeAddShortlistCandidateRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.AddShortlistCandidateRequest)
+	return endpoints.AddShortlistCandidateRequest{
+		ShortlistCandidate: &models.ShortlistCandidate{
+			ShortlistID: req.ShortlistId,
+			CandidateID: req.CandidateId,
+			Notes:       req.Notes,
+		},
+	}, nil
+}
+
+// encodeAddShortlistCandidateResponse encodes the outgoing go kit payload to the grpc payload
+func encodeAddShortlistCandidateResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.AddShortlistCandidateResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.ShortlistCandidate.ToProto(), nil
+	}
+	return nil, err
+}
+
+// RemoveShortlistCandidate removes a Candidate from a Shortlist
+func (s *grpcServer) RemoveShortlistCandidate(ctx context.Context, req *pb.RemoveShortlistCandidateRequest) (*pb.RemoveShortlistCandidateResponse, error) {
+	_, rep, err := s.removeShortlistCandidate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.RemoveShortlistCandidateResponse), nil
+}
+
+// decodeRemoveShortlistCandidateRequest decodes the incoming grpc payload to our go kit payload
+func decodeRemoveShortlistCandidateRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.RemoveShortlistCandidateRequest)
+	return endpoints.RemoveShortlistCandidateRequest{ShortlistID: req.ShortlistId, CandidateID: req.CandidateId}, nil
+}
+
+// encodeRemoveShortlistCandidateResponse encodes the outgoing go kit payload to the grpc payload
+func encodeRemoveShortlistCandidateResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.RemoveShortlistCandidateResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.RemoveShortlistCandidateResponse{}, nil
+	}
+	return nil, err
+}
+
+// ShareShortlist shares a Shortlist with a company user
+func (s *grpcServer) ShareShortlist(ctx context.Context, req *pb.ShareShortlistRequest) (*pb.ShortlistShare, error) {
+	_, rep, err := s.shareShortlist.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ShortlistShare), nil
+}
+
+// decodeShareShortlistRequest decodes the incoming grpc payload to our go kit payload
+func decodeShareShortlistRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ShareShortlistRequest)
+	return endpoints.ShareShortlistRequest{ShortlistID: req.ShortlistId, UserID: req.UserId}, nil
+}
+
+// encodeShareShortlistResponse encodes the outgoing go kit payload to the grpc payload
+func encodeShareShortlistResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.ShareShortlistResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.ShortlistShare.ToProto(), nil
+	}
+	return nil, err
+}
+
+// UnshareShortlist stops sharing a Shortlist with a company user
+func (s *grpcServer) UnshareShortlist(ctx context.Context, req *pb.UnshareShortlistRequest) (*pb.UnshareShortlistResponse, error) {
+	_, rep, err := s.unshareShortlist.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UnshareShortlistResponse), nil
+}
+
+// decodeUnshareShortlistRequest decodes the incoming grpc payload to our go kit payload
+func decodeUnshareShortlistRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.UnshareShortlistRequest)
+	return endpoints.UnshareShortlistRequest{ShortlistID: req.ShortlistId, UserID: req.UserId}, nil
+}
+
+// encodeUnshareShortlistResponse encodes the outgoing go kit payload to the grpc payload
+func encodeUnshareShortlistResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.UnshareShortlistResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.UnshareShortlistResponse{}, nil
+	}
+	return nil, err
+}
+
+// GrantContactConsent lets the users of a Company see the personal details of a Candidate
+func (s *grpcServer) GrantContactConsent(ctx context.Context, req *pb.GrantContactConsentRequest) (*pb.ContactConsent, error) {
+	_, rep, err := s.grantContactConsent.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ContactConsent), nil
+}
+
+// decodeGrantContactConsentRequest decodes the incoming grpc payload to our go kit payload
+func decodeGrantContactConsentRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GrantContactConsentRequest)
+	return endpoints.GrantContactConsentRequest{CandidateID: req.CandidateId, CompanyID: req.CompanyId}, nil
+}
+
+// encodeGrantContactConsentResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGrantContactConsentResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GrantContactConsentResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.ContactConsent.ToProto(), nil
+	}
+	return nil, err
+}
+
+// GetAllContactConsents returns all ContactConsents of a Candidate
+func (s *grpcServer) GetAllContactConsents(ctx context.Context, req *pb.GetAllContactConsentsRequest) (*pb.GetAllContactConsentsResponse, error) {
+	_, rep, err := s.getAllContactConsents.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetAllContactConsentsResponse), nil
+}
+
+// decodeGetAllContactConsentsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetAllContactConsentsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetAllContactConsentsRequest)
+	return endpoints.GetAllContactConsentsRequest{CandidateID: req.CandidateId}, nil
+}
+
+// encodeGetAllContactConsentsResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetAllContactConsentsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetAllContactConsentsResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var consents []*pb.ContactConsent
+		for _, m := range res.ContactConsents {
+			consents = append(consents, m.ToProto())
+		}
+		return &pb.GetAllContactConsentsResponse{Consents: consents}, nil
+	}
+	return nil, err
+}
+
+// RevokeContactConsent stops letting the users of a Company see the personal details of a Candidate
+func (s *grpcServer) RevokeContactConsent(ctx context.Context, req *pb.RevokeContactConsentRequest) (*pb.RevokeContactConsentResponse, error) {
+	_, rep, err := s.revokeContactConsent.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.RevokeContactConsentResponse), nil
+}
+
+// decodeRevokeContactConsentRequest decodes the incoming grpc payload to our go kit payload
+func decodeRevokeContactConsentRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.RevokeContactConsentRequest)
+	return endpoints.RevokeContactConsentRequest{CandidateID: req.CandidateId, CompanyID: req.CompanyId}, nil
+}
+
+// encodeRevokeContactConsentResponse encodes the outgoing go kit payload to the grpc payload
+func encodeRevokeContactConsentResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.RevokeContactConsentResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.RevokeContactConsentResponse{}, nil
+	}
+	return nil, err
+}
+
+/* --------------- Personal Data --------------- */
+
+// ExportMyData returns a zip archive of the data about a user kept by all services
+func (s *grpcServer) ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest) (*httpbody.HttpBody, error) {
+	_, rep, err := s.exportMyData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*httpbody.HttpBody), nil
+}
+
+// decodeExportMyDataRequest decodes the incoming grpc payload to our go kit payload
+func decodeExportMyDataRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ExportMyDataRequest)
+	return endpoints.ExportMyDataRequest{ID: req.Id}, nil
+}
+
+// encodeExportMyDataResponse encodes the outgoing go kit payload to the grpc payload
+func encodeExportMyDataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.ExportMyDataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &httpbody.HttpBody{ContentType: res.Export.ContentType, Data: res.Export.Data}, nil
+	}
+	return nil, err
+}
+
+// EraseUser hard deletes the data about a user kept by all services
+func (s *grpcServer) EraseUser(ctx context.Context, req *pb.EraseUserRequest) (*pb.ErasureReceipt, error) {
+	_, rep, err := s.eraseUser.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ErasureReceipt), nil
+}
+
+// decodeEraseUserRequest decodes the incoming grpc payload to our go kit payload
+func decodeEraseUserRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.EraseUserRequest)
+	return endpoints.EraseUserRequest{ID: req.Id}, nil
+}
+
+// GetErasureReceipt returns the erasure receipt of an erased user
+func (s *grpcServer) GetErasureReceipt(ctx context.Context, req *pb.GetErasureReceiptRequest) (*pb.ErasureReceipt, error) {
+	_, rep, err := s.getErasureReceipt.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ErasureReceipt), nil
+}
+
+// decodeGetErasureReceiptRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetErasureReceiptRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetErasureReceiptRequest)
+	return endpoints.GetErasureReceiptRequest{UserID: req.UserId}, nil
+}
+
+// encodeErasureReceiptResponse encodes the outgoing go kit payload to the grpc payload
+func encodeErasureReceiptResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.ErasureReceiptResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.ErasureReceipt.ToProto(), nil
+	}
+	return nil, err
+}
+
 func getError(err error) error {
 	switch err {
 	case nil:
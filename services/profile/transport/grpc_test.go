@@ -224,7 +224,7 @@ func TestGetAllCandidates(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			svc.On("GetAllCandidates", mockCtx).Return(tt.exp.svcOutput, tt.exp.err)
+			svc.On("GetAllCandidates", mockCtx).Return(tt.exp.svcOutput, nil, tt.exp.err)
 
 			got, err := client.GetAllCandidates(tt.args.ctx, tt.args.input)
 			if tt.exp.output != nil {
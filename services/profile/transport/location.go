@@ -0,0 +1,382 @@
+package transport
+
+import (
+	"context"
+	"in-backend/services/profile/endpoints"
+	"in-backend/services/profile/models"
+	"in-backend/services/profile/pb"
+
+	"github.com/go-kit/kit/log"
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
+)
+
+// grpc transport service for Location Service.
+type locationGrpcServer struct {
+	getRegions kitgrpc.Handler
+
+	getCountries kitgrpc.Handler
+
+	createState kitgrpc.Handler
+	getStates   kitgrpc.Handler
+
+	createCity kitgrpc.Handler
+	getCities  kitgrpc.Handler
+
+	createAddress kitgrpc.Handler
+	getAddress    kitgrpc.Handler
+	updateAddress kitgrpc.Handler
+	deleteAddress kitgrpc.Handler
+}
+
+// NewLocationGRPCServer returns a new gRPC service for the provided Go kit location endpoints
+func NewLocationGRPCServer(
+	endpoints endpoints.LocationEndpoints,
+	options []kitgrpc.ServerOption,
+	logger log.Logger,
+) pb.LocationServiceServer {
+	errorLogger := kitgrpc.ServerErrorLogger(logger)
+	options = append(options, errorLogger)
+
+	return &locationGrpcServer{
+		getRegions: kitgrpc.NewServer(
+			endpoints.GetRegions,
+			decodeGetRegionsRequest,
+			encodeGetRegionsResponse,
+			options...,
+		),
+		getCountries: kitgrpc.NewServer(
+			endpoints.GetCountries,
+			decodeGetCountriesRequest,
+			encodeGetCountriesResponse,
+			options...,
+		),
+		createState: kitgrpc.NewServer(
+			endpoints.CreateState,
+			decodeCreateStateRequest,
+			encodeCreateStateResponse,
+			options...,
+		),
+		getStates: kitgrpc.NewServer(
+			endpoints.GetStates,
+			decodeGetStatesRequest,
+			encodeGetStatesResponse,
+			options...,
+		),
+		createCity: kitgrpc.NewServer(
+			endpoints.CreateCity,
+			decodeCreateCityRequest,
+			encodeCreateCityResponse,
+			options...,
+		),
+		getCities: kitgrpc.NewServer(
+			endpoints.GetCities,
+			decodeGetCitiesRequest,
+			encodeGetCitiesResponse,
+			options...,
+		),
+		createAddress: kitgrpc.NewServer(
+			endpoints.CreateAddress,
+			decodeCreateAddressRequest,
+			encodeCreateAddressResponse,
+			options...,
+		),
+		getAddress: kitgrpc.NewServer(
+			endpoints.GetAddress,
+			decodeGetAddressRequest,
+			encodeGetAddressResponse,
+			options...,
+		),
+		updateAddress: kitgrpc.NewServer(
+			endpoints.UpdateAddress,
+			decodeUpdateAddressRequest,
+			encodeUpdateAddressResponse,
+			options...,
+		),
+		deleteAddress: kitgrpc.NewServer(
+			endpoints.DeleteAddress,
+			decodeDeleteAddressRequest,
+			encodeDeleteAddressResponse,
+			options...,
+		),
+	}
+}
+
+/* --------------- Region --------------- */
+
+// GetRegions returns all Regions
+func (s *locationGrpcServer) GetRegions(ctx context.Context, req *pb.Empty) (*pb.GetRegionsResponse, error) {
+	_, rep, err := s.getRegions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetRegionsResponse), nil
+}
+
+// decodeGetRegionsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetRegionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	_ = request.(*pb.Empty)
+	return endpoints.GetRegionsRequest{}, nil
+}
+
+// encodeGetRegionsResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetRegionsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetRegionsResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var regions []*pb.Region
+		for _, m := range res.Regions {
+			regions = append(regions, m.ToProto())
+		}
+		return &pb.GetRegionsResponse{Regions: regions}, nil
+	}
+	return nil, err
+}
+
+/* --------------- Country --------------- */
+
+// GetCountries returns all Countries
+func (s *locationGrpcServer) GetCountries(ctx context.Context, req *pb.GetCountriesRequest) (*pb.GetCountriesResponse, error) {
+	_, rep, err := s.getCountries.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetCountriesResponse), nil
+}
+
+// decodeGetCountriesRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetCountriesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetCountriesRequest)
+	return endpoints.GetCountriesRequest{RegionID: req.RegionId}, nil
+}
+
+// encodeGetCountriesResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetCountriesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetCountriesResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var countries []*pb.Country
+		for _, m := range res.Countries {
+			countries = append(countries, m.ToProto())
+		}
+		return &pb.GetCountriesResponse{Countries: countries}, nil
+	}
+	return nil, err
+}
+
+/* --------------- State --------------- */
+
+// CreateState creates a new State
+func (s *locationGrpcServer) CreateState(ctx context.Context, req *pb.CreateStateRequest) (*pb.State, error) {
+	_, rep, err := s.createState.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.State), nil
+}
+
+// decodeCreateStateRequest decodes the incoming grpc payload to our go kit payload
+func decodeCreateStateRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CreateStateRequest)
+	return endpoints.CreateStateRequest{State: models.StateToORM(req.State)}, nil
+}
+
+// encodeCreateStateResponse encodes the outgoing go kit payload to the grpc payload
+func encodeCreateStateResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.CreateStateResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.State.ToProto(), nil
+	}
+	return nil, err
+}
+
+// GetStates returns all States
+func (s *locationGrpcServer) GetStates(ctx context.Context, req *pb.GetStatesRequest) (*pb.GetStatesResponse, error) {
+	_, rep, err := s.getStates.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetStatesResponse), nil
+}
+
+// decodeGetStatesRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetStatesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetStatesRequest)
+	return endpoints.GetStatesRequest{CountryID: req.CountryId}, nil
+}
+
+// encodeGetStatesResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetStatesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetStatesResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var states []*pb.State
+		for _, m := range res.States {
+			states = append(states, m.ToProto())
+		}
+		return &pb.GetStatesResponse{States: states}, nil
+	}
+	return nil, err
+}
+
+/* --------------- City --------------- */
+
+// CreateCity creates a new City
+func (s *locationGrpcServer) CreateCity(ctx context.Context, req *pb.CreateCityRequest) (*pb.City, error) {
+	_, rep, err := s.createCity.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.City), nil
+}
+
+// decodeCreateCityRequest decodes the incoming grpc payload to our go kit payload
+func decodeCreateCityRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CreateCityRequest)
+	return endpoints.CreateCityRequest{City: models.CityToORM(req.City)}, nil
+}
+
+// encodeCreateCityResponse encodes the outgoing go kit payload to the grpc payload
+func encodeCreateCityResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.CreateCityResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.City.ToProto(), nil
+	}
+	return nil, err
+}
+
+// GetCities returns all Cities
+func (s *locationGrpcServer) GetCities(ctx context.Context, req *pb.GetCitiesRequest) (*pb.GetCitiesResponse, error) {
+	_, rep, err := s.getCities.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetCitiesResponse), nil
+}
+
+// decodeGetCitiesRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetCitiesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetCitiesRequest)
+	return endpoints.GetCitiesRequest{StateID: req.StateId}, nil
+}
+
+// encodeGetCitiesResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetCitiesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetCitiesResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var cities []*pb.City
+		for _, m := range res.Cities {
+			cities = append(cities, m.ToProto())
+		}
+		return &pb.GetCitiesResponse{Cities: cities}, nil
+	}
+	return nil, err
+}
+
+/* --------------- Address --------------- */
+
+// CreateAddress creates a new Address
+func (s *locationGrpcServer) CreateAddress(ctx context.Context, req *pb.CreateAddressRequest) (*pb.Address, error) {
+	_, rep, err := s.createAddress.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Address), nil
+}
+
+// decodeCreateAddressRequest decodes the incoming grpc payload to our go kit payload
+func decodeCreateAddressRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CreateAddressRequest)
+	return endpoints.CreateAddressRequest{Address: models.AddressToORM(req.Address)}, nil
+}
+
+// encodeCreateAddressResponse encodes the outgoing go kit payload to the grpc payload
+func encodeCreateAddressResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.CreateAddressResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Address.ToProto(), nil
+	}
+	return nil, err
+}
+
+// GetAddress returns an Address by ID
+func (s *locationGrpcServer) GetAddress(ctx context.Context, req *pb.GetAddressRequest) (*pb.Address, error) {
+	_, rep, err := s.getAddress.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Address), nil
+}
+
+// decodeGetAddressRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetAddressRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetAddressRequest)
+	return endpoints.GetAddressRequest{ID: req.Id}, nil
+}
+
+// encodeGetAddressResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetAddressResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetAddressResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Address.ToProto(), nil
+	}
+	return nil, err
+}
+
+// UpdateAddress updates an Address
+func (s *locationGrpcServer) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRequest) (*pb.Address, error) {
+	_, rep, err := s.updateAddress.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Address), nil
+}
+
+// decodeUpdateAddressRequest decodes the incoming grpc payload to our go kit payload
+func decodeUpdateAddressRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.UpdateAddressRequest)
+	m := models.AddressToORM(req.Address)
+	if m != nil {
+		m.ID = req.Id
+	}
+	return endpoints.UpdateAddressRequest{ID: req.Id, Address: m}, nil
+}
+
+// encodeUpdateAddressResponse encodes the outgoing go kit payload to the grpc payload
+func encodeUpdateAddressResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.UpdateAddressResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.Address.ToProto(), nil
+	}
+	return nil, err
+}
+
+// DeleteAddress deletes an Address by ID
+func (s *locationGrpcServer) DeleteAddress(ctx context.Context, req *pb.DeleteAddressRequest) (*pb.Empty, error) {
+	_, rep, err := s.deleteAddress.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Empty), nil
+}
+
+// decodeDeleteAddressRequest decodes the incoming grpc payload to our go kit payload
+func decodeDeleteAddressRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.DeleteAddressRequest)
+	return endpoints.DeleteAddressRequest{ID: req.Id}, nil
+}
+
+// encodeDeleteAddressResponse encodes the outgoing go kit payload to the grpc payload
+func encodeDeleteAddressResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.DeleteAddressResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.Empty{}, nil
+	}
+	return nil, err
+}
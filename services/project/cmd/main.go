@@ -2,10 +2,14 @@ package main
 
 import (
 	"fmt"
+	"in-backend/auth"
+	"in-backend/rbac"
+	profilePb "in-backend/services/profile/pb"
 	"in-backend/services/project/configs"
 	"in-backend/services/project/database"
 	"in-backend/services/project/endpoints"
 	"in-backend/services/project/pb"
+	"in-backend/services/project/providers"
 	"in-backend/services/project/service"
 	"in-backend/services/project/service/middlewares"
 	"in-backend/services/project/transport"
@@ -14,16 +18,38 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 	kitoc "github.com/go-kit/kit/tracing/opencensus"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"github.com/gocraft/work"
+	"github.com/gomodule/redigo/redis"
 	"github.com/oklog/oklog/pkg/group"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
+// Make a redis pool
+var redisPool = &redis.Pool{
+	MaxActive: 5,
+	MaxIdle:   5,
+	Wait:      true,
+	Dial: func() (redis.Conn, error) {
+		return redis.Dial("tcp", "redis:6379")
+	},
+}
+
+const (
+	appName string = "hubbedin"
+
+	profileSvcAddr string = "profile-service:50051"
+
+	// gitHostTimeout bounds each request to the APIs of git hosts
+	gitHostTimeout = 30 * time.Second
+)
+
 func main() {
 	// load configs
 	cfg, err := configs.LoadConfig(configs.FileName)
@@ -52,16 +78,50 @@ func main() {
 	db := database.NewDatabase(opt)
 	defer db.Close()
 
+	// calls to other services are authenticated as a service, as they may call Local methods
+	creds := grpc.WithPerRPCCredentials(rbac.ServiceCredentials(cfg.Auth.ServiceToken))
+	pfConn, err := grpc.Dial(profileSvcAddr, grpc.WithInsecure(), creds)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to get Profile Service connection")
+	}
+	defer pfConn.Close()
+
+	pfClient := profilePb.NewProfileServiceClient(pfConn)
+
 	// Build the layers of the service "onion" from the inside out. First, the
 	// business logic service; then, the set of endpoints that wrap the service;
 	// and finally, a series of concrete transport adapters
 
-	repo := database.NewRepository(db)
+	repo := database.NewRepository(db, pfClient)
 	client := &http.Client{}
-	svc := service.New(repo, client, logger)
-	svc = middlewares.NewAuthMiddleware(svc, repo)
+	verifier := auth.NewVerifier(auth.Config{
+		JWKSURL:  cfg.Auth.JWKSURL,
+		Issuer:   cfg.Auth.Issuer,
+		Audience: cfg.Auth.Audience,
+	}, client)
+	runner := service.NewExecRunner()
+	analyzer, err := service.NewAnalyzer(cfg.Scanner, runner, client)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to create analyzer", "err", err)
+		os.Exit(-1)
+	}
+	scanner := service.NewScanner(cfg.Scanner, runner, analyzer)
+	enqueuer := work.NewEnqueuer(appName, redisPool)
+	hosts := providers.NewGitHosts(cfg, &http.Client{Timeout: gitHostTimeout})
+	policy, err := rbac.LoadPolicy(rbac.FileName)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to load policy", "err", err)
+		os.Exit(-1)
+	}
+	authorizer := rbac.NewAuthorizer(policy, verifier, endpoints.MakeOwners(repo)).WithServiceToken(cfg.Auth.ServiceToken)
+
+	svc := service.New(repo, scanner, enqueuer, hosts, logger)
 	svc = middlewares.NewLogMiddleware(logger, svc)
 	endpoints := endpoints.MakeEndpoints(svc)
+	if err := authorizer.Wrap(&endpoints); err != nil {
+		level.Error(logger).Log("msg", "Failed to authorize endpoints", "err", err)
+		os.Exit(-1)
+	}
 
 	// set-up grpc transport
 	var (
@@ -2,6 +2,7 @@ package configs
 
 import (
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
@@ -24,6 +25,10 @@ type Config struct {
 	AppName  string       `mapstructure:"appname"`
 	Server   ServerConfig `mapstructure:",squash"`
 	Database DbConfig     `mapstructure:",squash"`
+	Auth     Auth         `mapstructure:",squash"`
+	Scanner  Scanner      `mapstructure:",squash"`
+	GitHub   GitHub       `mapstructure:",squash"`
+	GitLab   GitLab       `mapstructure:",squash"`
 }
 
 // ServerConfig declares server variables
@@ -43,6 +48,41 @@ type DbConfig struct {
 	Drivername string `mapstructure:"database_drivername"`
 }
 
+// Auth declares variables for verifying access tokens
+type Auth struct {
+	JWKSURL  string `mapstructure:"auth_jwks_url"`
+	Issuer   string `mapstructure:"auth_issuer"`
+	Audience string `mapstructure:"auth_audience"`
+
+	// ServiceToken authenticates the calls of services to each other
+	ServiceToken string `mapstructure:"auth_service_token"`
+}
+
+// Scanner declares variables for scanning project repositories.
+// AllowedHosts and AllowedSchemes are comma separated lists of the repository URLs that can be scanned,
+// and Analyzer is the analyzer that rates them, either sonarqube or go
+type Scanner struct {
+	AllowedHosts   string        `mapstructure:"scanner_allowed_hosts"`
+	AllowedSchemes string        `mapstructure:"scanner_allowed_schemes"`
+	Timeout        time.Duration `mapstructure:"scanner_timeout"`
+	Analyzer       string        `mapstructure:"scanner_analyzer"`
+	SonarqubeURL   string        `mapstructure:"sonarqube_url"`
+}
+
+// GitHub declares variables for importing repository metadata from GitHub.
+// The token is optional, but raises the API rate limit
+type GitHub struct {
+	APIURL string `mapstructure:"github_api_url"`
+	Token  string `mapstructure:"github_token"`
+}
+
+// GitLab declares variables for importing repository metadata from GitLab.
+// The token is optional, but is needed to list the contributors of some repositories
+type GitLab struct {
+	APIURL string `mapstructure:"gitlab_api_url"`
+	Token  string `mapstructure:"gitlab_token"`
+}
+
 // LoadConfig load config from file
 func LoadConfig(fileName string) (Config, error) {
 	var result map[string]interface{}
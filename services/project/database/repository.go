@@ -7,19 +7,23 @@ import (
 	pg "github.com/go-pg/pg/v10"
 	"github.com/go-pg/pg/v10/orm"
 
+	"in-backend/pagination"
+	profilePb "in-backend/services/profile/pb"
 	"in-backend/services/project"
 	"in-backend/services/project/models"
 )
 
 // Repository implements the project Repository interface
 type repository struct {
-	DB *pg.DB
+	DB       *pg.DB
+	pfClient profilePb.ProfileServiceClient
 }
 
 // NewRepository declares a new Repository that implements project Repository
-func NewRepository(db *pg.DB) project.Repository {
+func NewRepository(db *pg.DB, pc profilePb.ProfileServiceClient) project.Repository {
 	return &repository{
-		DB: db,
+		DB:       db,
+		pfClient: pc,
 	}
 }
 
@@ -43,7 +47,12 @@ func (r *repository) CreateProject(ctx context.Context, m *models.Project) (*mod
 }
 
 // GetAllProjects returns all Projects
-func (r *repository) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, error) {
+func (r *repository) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error) {
+	page, err := pagination.New(f.Page, projectSorts, "p.id", "id", false)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var m []*models.Project
 	q := r.DB.WithContext(ctx).Model(&m).Relation(relProjectRating, func(q *orm.Query) (*orm.Query, error) {
 		return q.Order("r.created_at desc"), nil
@@ -60,7 +69,7 @@ func (r *repository) GetAllProjects(ctx context.Context, f models.ProjectFilters
 			Returning("project_id").
 			Select(&pids)
 		if err != nil {
-			return nil, candidateIDErr(err, f.CandidateID)
+			return nil, nil, candidateIDErr(err, f.CandidateID)
 		}
 
 		/*
@@ -82,8 +91,22 @@ func (r *repository) GetAllProjects(ctx context.Context, f models.ProjectFilters
 		q = q.Where("lower(p.repo_url) like ?", "%"+strings.ToLower(f.RepoURL)+"%")
 	}
 
-	err := q.Returning("*").Select()
-	return m, err
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	if err = page.Apply(q).Returning("*").Select(); err != nil {
+		return nil, nil, err
+	}
+
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
 }
 
 // GetProjectByID returns a Project by ID
@@ -175,6 +198,35 @@ func (r *repository) GetCandidateProject(ctx context.Context, cid, pid uint64) (
 	return m, err
 }
 
+// GetCandidateProjectsByProjectID gets the CandidateProjects of a Project
+func (r *repository) GetCandidateProjectsByProjectID(ctx context.Context, pid uint64) ([]*models.CandidateProject, error) {
+	var m []*models.CandidateProject
+	err := r.DB.WithContext(ctx).Model(&m).
+		Where("cp.project_id = ?", pid).
+		Order("cp.id").
+		Select()
+	return m, err
+}
+
+// UpdateCandidateProject updates the username and verified contribution of a CandidateProject.
+// The Candidate and Project of a CandidateProject cannot be changed
+func (r *repository) UpdateCandidateProject(ctx context.Context, m *models.CandidateProject) error {
+	if m == nil {
+		return nilErr("candidate project")
+	}
+
+	res, err := r.DB.WithContext(ctx).Model(m).WherePK().
+		Column("username", "commits", "commit_share", "verified_at").
+		Update()
+	if err != nil {
+		return updateErr(err, "candidate project", m.ID)
+	}
+	if res.RowsAffected() == 0 {
+		return updateErr(nil, "candidate project", m.ID)
+	}
+	return nil
+}
+
 // DeleteCandidateProject deletes a CandidateProject by ID
 func (r *repository) DeleteCandidateProject(ctx context.Context, id uint64) error {
 	m := &models.CandidateProject{ID: id}
@@ -185,6 +237,248 @@ func (r *repository) DeleteCandidateProject(ctx context.Context, id uint64) erro
 	return nil
 }
 
+// GetCandidateProjectsByCandidateID gets the CandidateProjects of a Candidate
+func (r *repository) GetCandidateProjectsByCandidateID(ctx context.Context, cid uint64) ([]*models.CandidateProject, error) {
+	var m []*models.CandidateProject
+	err := r.DB.WithContext(ctx).Model(&m).
+		Where("cp.candidate_id = ?", cid).
+		Order("cp.id").
+		Select()
+	return m, err
+}
+
+// DeleteCandidateData deletes the CandidateProjects of a Candidate, and removes the username and name
+// of the ProjectContributors that were matched to the Candidate by the login verified for a CandidateProject.
+// The usernames of CandidateProjects that were not verified may belong to someone else, so they are never matched.
+// Returns how many records were deleted or anonymised
+func (r *repository) DeleteCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Close()
+
+	var cps []*models.CandidateProject
+	_, err = tx.Model(&cps).
+		Where("candidate_id = ?", cid).
+		Returning("*").
+		Delete()
+	if err != nil {
+		tx.Rollback()
+		return 0, candidateIDErr(err, cid)
+	}
+
+	erased := uint64(len(cps))
+	for _, cp := range cps {
+		if cp.Username == "" || cp.VerifiedAt == nil {
+			continue
+		}
+		res, err := tx.Model((*models.ProjectContributor)(nil)).
+			Set("username = ''").
+			Set("name = ''").
+			Where("project_id = ?", cp.ProjectID).
+			Where("lower(username) = lower(?)", cp.Username).
+			Update()
+		if err != nil {
+			tx.Rollback()
+			return 0, updateErr(err, "contributors of project", cp.ProjectID)
+		}
+		erased += uint64(res.RowsAffected())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return erased, nil
+}
+
+// GetSCMLogins gets the logins of the git host accounts linked to the identity provider account of a User
+func (r *repository) GetSCMLogins(ctx context.Context, uid uint64) (map[string]string, error) {
+	l, err := r.pfClient.LocalGetSCMLogins(ctx, &profilePb.GetSCMLoginsRequest{UserId: uid})
+	if err != nil {
+		return nil, err
+	}
+	return l.GetLogins(), nil
+}
+
+/* --------------- Project Metadata --------------- */
+
+// SaveProjectMetadata creates or replaces the ProjectMetadata of a Project, with its languages and contributors
+func (r *repository) SaveProjectMetadata(ctx context.Context, m *models.ProjectMetadata) (*models.ProjectMetadata, error) {
+	if m == nil {
+		return nil, nilErr("project metadata")
+	}
+
+	tx, err := r.DB.BeginContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close()
+
+	_, err = tx.Model(m).
+		OnConflict("(project_id) DO UPDATE").
+		Returning("*").
+		Insert()
+	if err != nil {
+		tx.Rollback()
+		return nil, failedToInsertErr(err, "project metadata", m)
+	}
+
+	_, err = tx.Model((*models.ProjectLanguage)(nil)).Where("project_id = ?", m.ProjectID).Delete()
+	if err != nil {
+		tx.Rollback()
+		return nil, deleteErr(err, "languages of project", m.ProjectID)
+	}
+	for _, l := range m.Languages {
+		l.ID, l.ProjectID = 0, m.ProjectID
+	}
+	if len(m.Languages) > 0 {
+		_, err = tx.Model(&m.Languages).Returning("*").Insert()
+		if err != nil {
+			tx.Rollback()
+			return nil, failedToInsertErr(err, "project languages", m.ProjectID)
+		}
+	}
+
+	_, err = tx.Model((*models.ProjectContributor)(nil)).Where("project_id = ?", m.ProjectID).Delete()
+	if err != nil {
+		tx.Rollback()
+		return nil, deleteErr(err, "contributors of project", m.ProjectID)
+	}
+	for _, c := range m.Contributors {
+		c.ID, c.ProjectID = 0, m.ProjectID
+	}
+	if len(m.Contributors) > 0 {
+		_, err = tx.Model(&m.Contributors).Returning("*").Insert()
+		if err != nil {
+			tx.Rollback()
+			return nil, failedToInsertErr(err, "project contributors", m.ProjectID)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetProjectMetadata gets the ProjectMetadata of a Project, with its languages by share
+// and its contributors by commits
+func (r *repository) GetProjectMetadata(ctx context.Context, pid uint64) (*models.ProjectMetadata, error) {
+	m := &models.ProjectMetadata{}
+	err := r.DB.WithContext(ctx).Model(m).
+		Where("pm.project_id = ?", pid).
+		First()
+	//pg returns error when no rows in the result set
+	if err == pg.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.DB.WithContext(ctx).Model(&m.Languages).
+		Where("pl.project_id = ?", pid).
+		Order("pl.share DESC", "pl.id").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.DB.WithContext(ctx).Model(&m.Contributors).
+		Where("pc.project_id = ?", pid).
+		Order("pc.commits DESC", "pc.id").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+/* --------------- Scan Job --------------- */
+
+// CreateScanJob creates a new ScanJob
+func (r *repository) CreateScanJob(ctx context.Context, m *models.ScanJob) (*models.ScanJob, error) {
+	if m == nil {
+		return nil, nilErr("scan job")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).
+		Returning("*").
+		Insert()
+	if err != nil {
+		return nil, failedToInsertErr(err, "scan job", m)
+	}
+
+	return m, nil
+}
+
+// GetAllScanJobs returns all ScanJobs
+func (r *repository) GetAllScanJobs(ctx context.Context, f models.ScanJobFilters) ([]*models.ScanJob, *pagination.Info, error) {
+	page, err := pagination.New(f.Page, scanJobSorts, "sj.id", "id", true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m []*models.ScanJob
+	q := r.DB.WithContext(ctx).Model(&m)
+
+	if f.ProjectID > 0 {
+		q = q.Where("sj.project_id = ?", f.ProjectID)
+	}
+	if f.Status != "" {
+		q = q.Where("sj.status = ?", f.Status)
+	}
+
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	if err = page.Apply(q).Select(); err != nil {
+		return nil, nil, err
+	}
+
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
+}
+
+// GetActiveScanJob returns the latest Queued or Running ScanJob of a Project
+func (r *repository) GetActiveScanJob(ctx context.Context, pid uint64) (*models.ScanJob, error) {
+	m := &models.ScanJob{}
+	err := r.DB.WithContext(ctx).Model(m).
+		Where("sj.project_id = ?", pid).
+		Where("sj.status in (?)", pg.In([]string{models.ScanJobQueued, models.ScanJobRunning})).
+		OrderExpr("sj.id desc").
+		First()
+	//pg returns error when no rows in the result set
+	if err == pg.ErrNoRows {
+		return nil, nil
+	}
+	return m, err
+}
+
+// UpdateScanJob updates a ScanJob
+func (r *repository) UpdateScanJob(ctx context.Context, m *models.ScanJob) (*models.ScanJob, error) {
+	if m == nil {
+		return nil, nilErr("scan job")
+	}
+
+	_, err := r.DB.WithContext(ctx).Model(m).WherePK().
+		Returning("*").
+		Update()
+	if err != nil {
+		return nil, updateErr(err, "scan job", m.ID)
+	}
+
+	return m, nil
+}
+
 /* --------------- Rating --------------- */
 
 // CreateRating creates a new Rating
@@ -212,3 +506,116 @@ func (r *repository) DeleteRating(ctx context.Context, id uint64) error {
 	}
 	return nil
 }
+
+// GetProjectRatingHistory returns the Ratings of a Project, newest first by default
+func (r *repository) GetProjectRatingHistory(ctx context.Context, f models.RatingHistoryFilters) ([]*models.Rating, *pagination.Info, error) {
+	page, err := pagination.New(f.Page, ratingSorts, "r.id", "created_at", true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m []*models.Rating
+	q := r.DB.WithContext(ctx).Model(&m).Where("r.project_id = ?", f.ProjectID)
+
+	if f.From != nil {
+		q = q.Where("r.created_at >= ?", f.From)
+	}
+	if f.To != nil {
+		q = q.Where("r.created_at <= ?", f.To)
+	}
+
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	if err = page.Apply(q).Select(); err != nil {
+		return nil, nil, err
+	}
+
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.ID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
+}
+
+// GetLatestRatings returns the latest Rating of each Project by ID.
+// Projects that have not been rated are left out
+func (r *repository) GetLatestRatings(ctx context.Context, pids []uint64) ([]*models.Rating, error) {
+	var m []*models.Rating
+	if len(pids) == 0 {
+		return m, nil
+	}
+	err := r.latestRatings(ctx, &m).
+		Where("r.project_id in (?)", pg.In(pids)).
+		Select()
+	return m, err
+}
+
+// latestRatings returns a query of the latest Rating of each Project
+func (r *repository) latestRatings(ctx context.Context, model interface{}) *orm.Query {
+	return r.DB.WithContext(ctx).Model(model).
+		DistinctOn("r.project_id").
+		OrderExpr("r.project_id, r.created_at desc, r.id desc")
+}
+
+/* --------------- Quality Score --------------- */
+
+// ratingScore is the score of a Rating from 0 to 100, which is the average of its measured ratings
+// scaled so that a rating of 1 (A) scores 100 and a rating of 5 (E) scores 0.
+// Ratings that have no measured ratings have no score
+const ratingScore = `(select 100 * (5 - avg(v)) / 4 from unnest(array[
+	r.reliability_rating, r.maintainability_rating, r.security_rating, r.security_review_rating
+]) as v where v between 1 and 5)`
+
+// GetAllQualityScores returns the QualityScores of Candidates, highest first by default.
+// The score of a Candidate is the average score of the latest Ratings of their Projects weighted by Lines,
+// and every Project weighs at least one line so that Projects with no lines of code still count.
+// Candidates without rated Projects have no QualityScore
+func (r *repository) GetAllQualityScores(ctx context.Context, f models.QualityScoreFilters) ([]*models.QualityScore, *pagination.Info, error) {
+	page, err := pagination.New(f.Page, qualityScoreSorts, "qs.candidate_id", "score", true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latest := r.latestRatings(ctx, (*models.Rating)(nil)).
+		Column("r.project_id", "r.lines").
+		ColumnExpr(ratingScore + " AS score")
+
+	scores := r.DB.WithContext(ctx).Model((*models.CandidateProject)(nil)).
+		ColumnExpr("cp.candidate_id").
+		ColumnExpr("(sum(lr.score * greatest(lr.lines, 1)) / sum(greatest(lr.lines, 1)))::float8 AS score").
+		ColumnExpr("sum(lr.lines) AS lines").
+		ColumnExpr("count(*) AS projects").
+		Join("JOIN projects AS p ON p.id = cp.project_id AND p.deleted_at IS NULL").
+		Join("JOIN (?) AS lr ON lr.project_id = cp.project_id", latest).
+		Where("lr.score IS NOT NULL").
+		Group("cp.candidate_id")
+
+	var m []*models.QualityScore
+	q := r.DB.WithContext(ctx).Model(&m).TableExpr("(?)", scores)
+
+	if len(f.CandidateID) > 0 {
+		q = q.Where("qs.candidate_id in (?)", pg.In(f.CandidateID))
+	}
+
+	info := &pagination.Info{}
+	if info.TotalCount, err = page.Total(q); err != nil {
+		return nil, nil, err
+	}
+	if err = page.Apply(q).Select(); err != nil {
+		return nil, nil, err
+	}
+
+	if page.HasNext(len(m)) {
+		m = m[:page.Size()]
+		last := m[len(m)-1]
+		if info.NextPageToken, err = page.NextToken(last.CandidateID, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, info, nil
+}
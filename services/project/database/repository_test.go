@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"in-backend/pagination"
+	profileMocks "in-backend/services/profile/tests/mocks"
 	"in-backend/services/project"
 	"in-backend/services/project/configs"
 	"in-backend/services/project/models"
@@ -29,19 +31,33 @@ var (
 		testDeleteProject,
 
 		testCreateCandidateProject,
+		testUpdateCandidateProject,
 		testDeleteCandidateProject,
 
+		testSaveProjectMetadata,
+		testDeleteCandidateData,
+
+		testCreateScanJob,
+		testGetAllScanJobs,
+		testGetActiveScanJob,
+		testUpdateScanJob,
+
 		testCreateRating,
 		testDeleteRating,
+		testGetProjectRatingHistory,
+		testGetLatestRatings,
+		testGetAllQualityScores,
 	}
 )
 
 func TestNewRepository(t *testing.T) {
+	pfClient := &profileMocks.ProfileServiceClient{}
 	want := &repository{
-		DB: &pg.DB{},
+		DB:       &pg.DB{},
+		pfClient: pfClient,
 	}
 
-	got := NewRepository(&pg.DB{})
+	got := NewRepository(&pg.DB{}, pfClient)
 
 	require.EqualValues(t, want, got)
 }
@@ -58,7 +74,7 @@ func TestAllCRUD(t *testing.T) {
 	db, err := setupDB(c, opt, "../tests/migrations/")
 	require.NoError(t, err)
 
-	r := NewRepository(db)
+	r := NewRepository(db, &profileMocks.ProfileServiceClient{})
 
 	// run all tests in test suite
 	for _, test := range testRoutine {
@@ -148,7 +164,7 @@ func testGetAllProjects(t *testing.T, r project.Repository, db *pg.DB) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := r.GetAllProjects(tt.args.ctx, *tt.args.f)
+			got, _, err := r.GetAllProjects(tt.args.ctx, *tt.args.f)
 			assert.Equal(t, tt.exp.cnt, len(got))
 			if tt.exp.err != nil && err != nil {
 				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
@@ -345,6 +361,212 @@ func testDeleteCandidateProject(t *testing.T, r project.Repository, db *pg.DB) {
 	}
 }
 
+func testUpdateCandidateProject(t *testing.T, r project.Repository, db *pg.DB) {
+	existing := &models.CandidateProject{}
+	err := db.WithContext(ctx).Model(existing).First()
+	require.NoError(t, err)
+
+	existing.Username = "octocat"
+	existing.Commits = 6
+	existing.CommitShare = 0.6
+	existing.VerifiedAt = &now
+	require.NoError(t, r.UpdateCandidateProject(ctx, existing))
+
+	got, err := r.GetCandidateProjectsByProjectID(ctx, existing.ProjectID)
+	require.NoError(t, err)
+	var updated *models.CandidateProject
+	for _, cp := range got {
+		require.Equal(t, existing.ProjectID, cp.ProjectID)
+		if cp.ID == existing.ID {
+			updated = cp
+		}
+	}
+	require.NotNil(t, updated)
+	require.Equal(t, existing.Username, updated.Username)
+	require.Equal(t, existing.Commits, updated.Commits)
+	require.Equal(t, existing.CommitShare, updated.CommitShare)
+	require.NotNil(t, updated.VerifiedAt)
+
+	require.Error(t, r.UpdateCandidateProject(ctx, &models.CandidateProject{ID: 999999}))
+	require.EqualError(t, r.UpdateCandidateProject(ctx, nil), nilErr("candidate project").Error())
+}
+
+/* --------------- Project Metadata --------------- */
+
+func testSaveProjectMetadata(t *testing.T, r project.Repository, db *pg.DB) {
+	p := &models.Project{Name: "metadata", RepoURL: "https://github.com/octocat/metadata"}
+	_, err := db.WithContext(ctx).Model(p).Insert()
+	require.NoError(t, err)
+
+	got, err := r.GetProjectMetadata(ctx, p.ID)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	m := &models.ProjectMetadata{
+		ProjectID:    p.ID,
+		Host:         "github.com",
+		Stars:        1,
+		Commits:      3,
+		LastCommitAt: &now,
+		Languages:    []*models.ProjectLanguage{{Language: "Shell", Share: 20}, {Language: "Go", Share: 80}},
+		Contributors: []*models.ProjectContributor{{Username: "hubot", Commits: 1}, {Username: "octocat", Commits: 2}},
+		RefreshedAt:  &now,
+	}
+	_, err = r.SaveProjectMetadata(ctx, m)
+	require.NoError(t, err)
+
+	// saving again replaces the metadata, languages and contributors
+	m = &models.ProjectMetadata{
+		ProjectID:    p.ID,
+		Host:         "github.com",
+		Stars:        42,
+		Commits:      10,
+		Languages:    []*models.ProjectLanguage{{Language: "Go", Share: 100}},
+		Contributors: []*models.ProjectContributor{{Username: "hubot", Commits: 4}, {Username: "octocat", Commits: 6}},
+		RefreshedAt:  &now,
+	}
+	_, err = r.SaveProjectMetadata(ctx, m)
+	require.NoError(t, err)
+
+	got, err = r.GetProjectMetadata(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), got.Stars)
+	require.Nil(t, got.LastCommitAt)
+	require.Len(t, got.Languages, 1)
+	require.Equal(t, "Go", got.Languages[0].Language)
+	require.Len(t, got.Contributors, 2)
+	require.Equal(t, "octocat", got.Contributors[0].Username)
+
+	_, err = r.SaveProjectMetadata(ctx, nil)
+	require.EqualError(t, err, nilErr("project metadata").Error())
+}
+
+func testDeleteCandidateData(t *testing.T, r project.Repository, db *pg.DB) {
+	p := &models.Project{Name: "erasure", RepoURL: "https://github.com/octocat/erasure"}
+	_, err := db.WithContext(ctx).Model(p).Insert()
+	require.NoError(t, err)
+	_, err = r.SaveProjectMetadata(ctx, &models.ProjectMetadata{
+		ProjectID:    p.ID,
+		Host:         "github.com",
+		Contributors: []*models.ProjectContributor{{Username: "hubot", Commits: 1}, {Username: "octocat", Commits: 2}},
+		RefreshedAt:  &now,
+	})
+	require.NoError(t, err)
+
+	verified := &models.CandidateProject{CandidateID: 77, ProjectID: p.ID, Username: "OctoCat", Commits: 2, VerifiedAt: &now}
+	// usernames that were never verified may belong to someone else
+	unverified := &models.CandidateProject{CandidateID: 78, ProjectID: p.ID, Username: "hubot"}
+	_, err = db.WithContext(ctx).Model(verified).Insert()
+	require.NoError(t, err)
+	_, err = db.WithContext(ctx).Model(unverified).Insert()
+	require.NoError(t, err)
+
+	n, err := r.DeleteCandidateData(ctx, 78)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), n)
+	n, err = r.DeleteCandidateData(ctx, 77)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), n)
+
+	got, err := r.GetProjectMetadata(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Contributors, 2)
+	require.Empty(t, got.Contributors[0].Username)
+	require.Equal(t, "hubot", got.Contributors[1].Username)
+}
+
+/* --------------- Scan Job --------------- */
+
+func testCreateScanJob(t *testing.T, r project.Repository, db *pg.DB) {
+	test := testmodels.ScanJobValid
+	testNoProject := &models.ScanJob{ProjectID: 100, Status: models.ScanJobQueued}
+
+	type args struct {
+		ctx   context.Context
+		input *models.ScanJob
+	}
+
+	type expect struct {
+		output *models.ScanJob
+		err    error
+	}
+
+	var tests = []struct {
+		name string
+		args args
+		exp  expect
+	}{
+		{"nil", args{ctx, nil}, expect{nil, nilErr("scan job")}},
+		{"valid", args{ctx, test}, expect{test, nil}},
+		{"failed foreign key", args{ctx, testNoProject}, expect{nil, errors.New("Failed to insert scan job")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.CreateScanJob(tt.args.ctx, tt.args.input)
+			if tt.exp.err != nil && err != nil {
+				assert.Condition(t, func() bool { return strings.Contains(err.Error(), tt.exp.err.Error()) })
+			} else {
+				assert.Equal(t, tt.exp.err, err)
+				assert.NotZero(t, got.ID)
+			}
+		})
+	}
+}
+
+func testGetAllScanJobs(t *testing.T, r project.Repository, db *pg.DB) {
+	var tests = []struct {
+		name   string
+		input  models.ScanJobFilters
+		expect int
+	}{
+		{"no filter", models.ScanJobFilters{}, 1},
+		{"project", models.ScanJobFilters{ProjectID: 1}, 1},
+		{"other project", models.ScanJobFilters{ProjectID: 2}, 0},
+		{"status", models.ScanJobFilters{Status: models.ScanJobQueued}, 1},
+		{"other status", models.ScanJobFilters{Status: models.ScanJobFailed}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := r.GetAllScanJobs(ctx, tt.input)
+			require.NoError(t, err)
+			require.Len(t, got, tt.expect)
+		})
+	}
+}
+
+func testGetActiveScanJob(t *testing.T, r project.Repository, db *pg.DB) {
+	got, err := r.GetActiveScanJob(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	require.Equal(t, models.ScanJobQueued, got.Status)
+
+	got, err = r.GetActiveScanJob(ctx, 2)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func testUpdateScanJob(t *testing.T, r project.Repository, db *pg.DB) {
+	_, err := r.UpdateScanJob(ctx, nil)
+	require.Equal(t, nilErr("scan job"), err)
+
+	jobs, _, err := r.GetAllScanJobs(ctx, models.ScanJobFilters{ProjectID: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, jobs)
+
+	job := jobs[0]
+	job.Status = models.ScanJobFailed
+	job.Error = "Failed to clone the repository"
+	_, err = r.UpdateScanJob(ctx, job)
+	require.NoError(t, err)
+
+	got, _, err := r.GetAllScanJobs(ctx, models.ScanJobFilters{Status: models.ScanJobFailed})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, job.Error, got[0].Error)
+}
+
 /* --------------- Rating --------------- */
 
 func testCreateRating(t *testing.T, r project.Repository, db *pg.DB) {
@@ -414,3 +636,112 @@ func testDeleteRating(t *testing.T, r project.Repository, db *pg.DB) {
 		})
 	}
 }
+
+// createRatedProject creates a Project of candidate cid with a Rating for each of ratings,
+// created a day apart with the last one created now
+func createRatedProject(t *testing.T, db *pg.DB, cid uint64, ratings ...*models.Rating) *models.Project {
+	p := &models.Project{Name: "rated", RepoURL: "https://github.com/hubbedin/rated"}
+	_, err := db.WithContext(ctx).Model(p).Insert()
+	require.NoError(t, err)
+	_, err = db.WithContext(ctx).Model(&models.CandidateProject{CandidateID: cid, ProjectID: p.ID}).Insert()
+	require.NoError(t, err)
+
+	for i, rating := range ratings {
+		createdAt := now.AddDate(0, 0, i+1-len(ratings))
+		rating.ProjectID = p.ID
+		_, err = db.WithContext(ctx).Model(rating).Value("created_at", "?", createdAt).Insert()
+		require.NoError(t, err)
+	}
+	return p
+}
+
+func testGetProjectRatingHistory(t *testing.T, r project.Repository, db *pg.DB) {
+	p := createRatedProject(t, db, 100,
+		&models.Rating{MaintainabilityRating: 3, Lines: 10},
+		&models.Rating{MaintainabilityRating: 2, Lines: 20},
+		&models.Rating{MaintainabilityRating: 1, Lines: 30},
+	)
+	yesterday := now.AddDate(0, 0, -1).Add(-time.Minute)
+	hourAgo := now.Add(-time.Hour)
+
+	var tests = []struct {
+		name   string
+		input  models.RatingHistoryFilters
+		expect []uint64
+	}{
+		{"all", models.RatingHistoryFilters{ProjectID: p.ID}, []uint64{30, 20, 10}},
+		{"from", models.RatingHistoryFilters{ProjectID: p.ID, From: &yesterday}, []uint64{30, 20}},
+		{"to", models.RatingHistoryFilters{ProjectID: p.ID, To: &hourAgo}, []uint64{20, 10}},
+		{"range", models.RatingHistoryFilters{ProjectID: p.ID, From: &yesterday, To: &hourAgo}, []uint64{20}},
+		{"oldest first", models.RatingHistoryFilters{ProjectID: p.ID, Page: pagination.Params{SortBy: "created_at"}}, []uint64{10, 20, 30}},
+		{"other project", models.RatingHistoryFilters{ProjectID: 1000}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := r.GetProjectRatingHistory(ctx, tt.input)
+			require.NoError(t, err)
+			var lines []uint64
+			for _, rating := range got {
+				lines = append(lines, rating.Lines)
+			}
+			require.Equal(t, tt.expect, lines)
+		})
+	}
+}
+
+func testGetLatestRatings(t *testing.T, r project.Repository, db *pg.DB) {
+	p1 := createRatedProject(t, db, 101, &models.Rating{Lines: 1}, &models.Rating{Lines: 2})
+	p2 := createRatedProject(t, db, 101, &models.Rating{Lines: 3})
+	p3 := createRatedProject(t, db, 101)
+
+	got, err := r.GetLatestRatings(ctx, []uint64{p1.ID, p2.ID, p3.ID})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, p1.ID, got[0].ProjectID)
+	require.Equal(t, uint64(2), got[0].Lines)
+	require.Equal(t, p2.ID, got[1].ProjectID)
+	require.Equal(t, uint64(3), got[1].Lines)
+
+	got, err = r.GetLatestRatings(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func testGetAllQualityScores(t *testing.T, r project.Repository, db *pg.DB) {
+	// only the latest rating of a project counts, and unmeasured ratings are ignored,
+	// so this project scores 100 and the next one 0
+	createRatedProject(t, db, 200,
+		&models.Rating{ReliabilityRating: 5, Lines: 1000},
+		&models.Rating{ReliabilityRating: 1, MaintainabilityRating: 1, Lines: 300},
+	)
+	createRatedProject(t, db, 200, &models.Rating{SecurityRating: 5, Lines: 100})
+	// projects without measured ratings have no score
+	createRatedProject(t, db, 200, &models.Rating{Lines: 5000})
+	createRatedProject(t, db, 201, &models.Rating{ReliabilityRating: 3, Lines: 0})
+	createRatedProject(t, db, 202)
+
+	filters := models.QualityScoreFilters{CandidateID: []uint64{200, 201, 202}}
+	got, _, err := r.GetAllQualityScores(ctx, filters)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, uint64(200), got[0].CandidateID)
+	require.InDelta(t, 75, got[0].Score, 0.001)
+	require.Equal(t, uint64(400), got[0].Lines)
+	require.Equal(t, uint64(2), got[0].Projects)
+	require.Equal(t, uint64(201), got[1].CandidateID)
+	require.InDelta(t, 50, got[1].Score, 0.001)
+
+	// candidates can be paged through by score
+	filters.Page = pagination.Params{PageSize: 1, WithTotal: true}
+	first, info, err := r.GetAllQualityScores(ctx, filters)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), info.TotalCount)
+	require.Len(t, first, 1)
+	filters.Page.PageToken = info.NextPageToken
+	second, info, err := r.GetAllQualityScores(ctx, filters)
+	require.NoError(t, err)
+	require.Len(t, second, 1)
+	require.Equal(t, uint64(201), second[0].CandidateID)
+	require.Empty(t, info.NextPageToken)
+}
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"in-backend/pagination"
+	"in-backend/services/project/models"
+)
+
+// projectSorts declares the fields that Projects can be sorted by
+var projectSorts = pagination.Sorts{
+	"id":         {Column: "p.id"},
+	"name":       {Column: "p.name", Value: func(m interface{}) interface{} { return m.(*models.Project).Name }},
+	"created_at": {Column: "p.created_at", Value: func(m interface{}) interface{} { return m.(*models.Project).CreatedAt }},
+	"updated_at": {Column: "p.updated_at", Value: func(m interface{}) interface{} { return m.(*models.Project).UpdatedAt }},
+}
+
+// scanJobSorts declares the fields that ScanJobs can be sorted by
+var scanJobSorts = pagination.Sorts{
+	"id":         {Column: "sj.id"},
+	"created_at": {Column: "sj.created_at", Value: func(m interface{}) interface{} { return m.(*models.ScanJob).CreatedAt }},
+}
+
+// ratingSorts declares the fields that Ratings can be sorted by
+var ratingSorts = pagination.Sorts{
+	"id":         {Column: "r.id"},
+	"created_at": {Column: "r.created_at", Value: func(m interface{}) interface{} { return m.(*models.Rating).CreatedAt }},
+}
+
+// qualityScoreSorts declares the fields that QualityScores can be sorted by
+var qualityScoreSorts = pagination.Sorts{
+	"candidate_id": {Column: "qs.candidate_id"},
+	"score":        {Column: "qs.score", Value: func(m interface{}) interface{} { return m.(*models.QualityScore).Score }},
+	"lines":        {Column: "qs.lines", Value: func(m interface{}) interface{} { return m.(*models.QualityScore).Lines }},
+}
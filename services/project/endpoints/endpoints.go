@@ -2,46 +2,78 @@ package endpoints
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
 
+	"in-backend/pagination"
 	"in-backend/services/project"
 	"in-backend/services/project/models"
 )
 
 // Endpoints holds all Go kit endpoints for the Project Service.
 type Endpoints struct {
-	CreateProject  endpoint.Endpoint
-	GetAllProjects endpoint.Endpoint
-	GetProjectByID endpoint.Endpoint
-	UpdateProject  endpoint.Endpoint
-	DeleteProject  endpoint.Endpoint
+	CreateProject       endpoint.Endpoint
+	GetAllProjects      endpoint.Endpoint
+	LocalGetAllProjects endpoint.Endpoint
+	GetProjectByID      endpoint.Endpoint
+	UpdateProject       endpoint.Endpoint
+	DeleteProject       endpoint.Endpoint
 
-	ScanProject endpoint.Endpoint
+	ScanProject      endpoint.Endpoint
+	LocalScanProject endpoint.Endpoint
+
+	RefreshProjectMetadata      endpoint.Endpoint
+	LocalRefreshProjectMetadata endpoint.Endpoint
+	GetProjectMetadata          endpoint.Endpoint
+
+	GetAllScanJobs endpoint.Endpoint
 
 	CreateCandidateProject endpoint.Endpoint
 	DeleteCandidateProject endpoint.Endpoint
 
-	CreateRating endpoint.Endpoint
-	DeleteRating endpoint.Endpoint
+	CreateRating            endpoint.Endpoint
+	DeleteRating            endpoint.Endpoint
+	GetProjectRatingHistory endpoint.Endpoint
+	GetLatestRatings        endpoint.Endpoint
+
+	GetAllQualityScores endpoint.Endpoint
+
+	LocalExportCandidateData endpoint.Endpoint
+	LocalEraseCandidateData  endpoint.Endpoint
 }
 
 // MakeEndpoints initializes all Go kit endpoints for the Project service.
 func MakeEndpoints(s project.Service) Endpoints {
 	return Endpoints{
-		CreateProject:  makeCreateProjectEndpoint(s),
-		GetAllProjects: makeGetAllProjectsEndpoint(s),
-		GetProjectByID: makeGetProjectByIDEndpoint(s),
-		UpdateProject:  makeUpdateProjectEndpoint(s),
-		DeleteProject:  makeDeleteProjectEndpoint(s),
+		CreateProject:       makeCreateProjectEndpoint(s),
+		GetAllProjects:      makeGetAllProjectsEndpoint(s),
+		LocalGetAllProjects: makeLocalGetAllProjectsEndpoint(s),
+		GetProjectByID:      makeGetProjectByIDEndpoint(s),
+		UpdateProject:       makeUpdateProjectEndpoint(s),
+		DeleteProject:       makeDeleteProjectEndpoint(s),
+
+		ScanProject:      makeScanProjectEndpoint(s),
+		LocalScanProject: makeLocalScanProjectEndpoint(s),
+
+		RefreshProjectMetadata:      makeRefreshProjectMetadataEndpoint(s),
+		LocalRefreshProjectMetadata: makeLocalRefreshProjectMetadataEndpoint(s),
+		GetProjectMetadata:          makeGetProjectMetadataEndpoint(s),
 
-		ScanProject: makeScanProjectEndpoint(s),
+		GetAllScanJobs: makeGetAllScanJobsEndpoint(s),
 
 		CreateCandidateProject: makeCreateCandidateProjectEndpoint(s),
 		DeleteCandidateProject: makeDeleteCandidateProjectEndpoint(s),
 
-		CreateRating: makeCreateRatingEndpoint(s),
-		DeleteRating: makeDeleteRatingEndpoint(s),
+		CreateRating:            makeCreateRatingEndpoint(s),
+		DeleteRating:            makeDeleteRatingEndpoint(s),
+		GetProjectRatingHistory: makeGetProjectRatingHistoryEndpoint(s),
+		GetLatestRatings:        makeGetLatestRatingsEndpoint(s),
+
+		GetAllQualityScores: makeGetAllQualityScoresEndpoint(s),
+
+		LocalExportCandidateData: makeLocalExportCandidateDataEndpoint(s),
+		LocalEraseCandidateData:  makeLocalEraseCandidateDataEndpoint(s),
 	}
 }
 
@@ -71,8 +103,8 @@ func makeGetAllProjectsEndpoint(s project.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetAllProjectsRequest)
 		f := models.ProjectFilters(req)
-		m, err := s.GetAllProjects(ctx, f)
-		return GetAllProjectsResponse{Projects: m, Err: err}, nil
+		m, page, err := s.GetAllProjects(ctx, f)
+		return GetAllProjectsResponse{Projects: m, Page: page, Err: err}, nil
 	}
 }
 
@@ -82,14 +114,25 @@ type GetAllProjectsRequest struct {
 	CandidateID uint64
 	Name        string
 	RepoURL     string
+	Page        pagination.Params
 }
 
 // GetAllProjectsResponse declares the outputs after attempting to get all Projects
 type GetAllProjectsResponse struct {
 	Projects []*models.Project
+	Page     *pagination.Info
 	Err      error
 }
 
+func makeLocalGetAllProjectsEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAllProjectsRequest)
+		f := models.ProjectFilters(req)
+		m, page, err := s.LocalGetAllProjects(ctx, f)
+		return GetAllProjectsResponse{Projects: m, Page: page, Err: err}, nil
+	}
+}
+
 func makeGetProjectByIDEndpoint(s project.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetProjectByIDRequest)
@@ -150,8 +193,8 @@ type DeleteProjectResponse struct {
 func makeScanProjectEndpoint(s project.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(ScanProjectRequest)
-		err := s.ScanProject(ctx, req.ID)
-		return ScanProjectResponse{Err: err}, nil
+		m, err := s.ScanProject(ctx, req.ID)
+		return ScanProjectResponse{ScanJob: m, Err: err}, nil
 	}
 }
 
@@ -162,7 +205,83 @@ type ScanProjectRequest struct {
 
 // ScanProjectResponse declares the outputs after attempting to scanning a Project
 type ScanProjectResponse struct {
-	Err error
+	ScanJob *models.ScanJob
+	Err     error
+}
+
+func makeLocalScanProjectEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ScanProjectRequest)
+		m, err := s.LocalScanProject(ctx, req.ID)
+		return ScanProjectResponse{ScanJob: m, Err: err}, nil
+	}
+}
+
+/* -------------- Project Metadata -------------- */
+
+func makeRefreshProjectMetadataEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RefreshProjectMetadataRequest)
+		m, err := s.RefreshProjectMetadata(ctx, req.ID)
+		return ProjectMetadataResponse{ProjectMetadata: m, Err: err}, nil
+	}
+}
+
+// RefreshProjectMetadataRequest declares the inputs required for refreshing the metadata of a Project
+type RefreshProjectMetadataRequest struct {
+	ID uint64
+}
+
+// ProjectMetadataResponse declares the outputs after attempting to refresh or get the metadata of a Project
+type ProjectMetadataResponse struct {
+	ProjectMetadata *models.ProjectMetadata
+	Err             error
+}
+
+func makeLocalRefreshProjectMetadataEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RefreshProjectMetadataRequest)
+		m, err := s.LocalRefreshProjectMetadata(ctx, req.ID)
+		return ProjectMetadataResponse{ProjectMetadata: m, Err: err}, nil
+	}
+}
+
+func makeGetProjectMetadataEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetProjectMetadataRequest)
+		m, err := s.GetProjectMetadata(ctx, req.ID)
+		return ProjectMetadataResponse{ProjectMetadata: m, Err: err}, nil
+	}
+}
+
+// GetProjectMetadataRequest declares the inputs required for getting the metadata of a Project
+type GetProjectMetadataRequest struct {
+	ID uint64
+}
+
+/* -------------- Scan Job -------------- */
+
+func makeGetAllScanJobsEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAllScanJobsRequest)
+		f := models.ScanJobFilters(req)
+		m, page, err := s.GetAllScanJobs(ctx, f)
+		return GetAllScanJobsResponse{ScanJobs: m, Page: page, Err: err}, nil
+	}
+}
+
+// GetAllScanJobsRequest declares the inputs required for getting all ScanJobs
+type GetAllScanJobsRequest struct {
+	ProjectID uint64
+	Status    string
+	Page      pagination.Params
+}
+
+// GetAllScanJobsResponse declares the outputs after attempting to get all ScanJobs
+type GetAllScanJobsResponse struct {
+	ScanJobs []*models.ScanJob
+	Page     *pagination.Info
+	Err      error
 }
 
 /* -------------- Candidate Project -------------- */
@@ -240,3 +359,105 @@ type DeleteRatingRequest struct {
 type DeleteRatingResponse struct {
 	Err error
 }
+
+func makeGetProjectRatingHistoryEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetProjectRatingHistoryRequest)
+		f := models.RatingHistoryFilters(req)
+		m, page, err := s.GetProjectRatingHistory(ctx, f)
+		return GetProjectRatingHistoryResponse{Ratings: m, Page: page, Err: err}, nil
+	}
+}
+
+// GetProjectRatingHistoryRequest declares the inputs required for getting the Ratings of a Project
+type GetProjectRatingHistoryRequest struct {
+	ProjectID uint64
+	From      *time.Time
+	To        *time.Time
+	Page      pagination.Params
+}
+
+// GetProjectRatingHistoryResponse declares the outputs after attempting to get the Ratings of a Project
+type GetProjectRatingHistoryResponse struct {
+	Ratings []*models.Rating
+	Page    *pagination.Info
+	Err     error
+}
+
+func makeGetLatestRatingsEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetLatestRatingsRequest)
+		m, err := s.GetLatestRatings(ctx, req.ProjectID)
+		return GetLatestRatingsResponse{Ratings: m, Err: err}, nil
+	}
+}
+
+// GetLatestRatingsRequest declares the inputs required for getting the latest Ratings of Projects
+type GetLatestRatingsRequest struct {
+	ProjectID []uint64
+}
+
+// GetLatestRatingsResponse declares the outputs after attempting to get the latest Ratings of Projects
+type GetLatestRatingsResponse struct {
+	Ratings []*models.Rating
+	Err     error
+}
+
+/* -------------- Quality Score -------------- */
+
+func makeGetAllQualityScoresEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAllQualityScoresRequest)
+		f := models.QualityScoreFilters(req)
+		m, page, err := s.GetAllQualityScores(ctx, f)
+		return GetAllQualityScoresResponse{QualityScores: m, Page: page, Err: err}, nil
+	}
+}
+
+// GetAllQualityScoresRequest declares the inputs required for getting all QualityScores
+type GetAllQualityScoresRequest struct {
+	CandidateID []uint64
+	Page        pagination.Params
+}
+
+// GetAllQualityScoresResponse declares the outputs after attempting to get all QualityScores
+type GetAllQualityScoresResponse struct {
+	QualityScores []*models.QualityScore
+	Page          *pagination.Info
+	Err           error
+}
+
+/* -------------- Candidate Data -------------- */
+
+func makeLocalExportCandidateDataEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CandidateDataRequest)
+		m, err := s.LocalExportCandidateData(ctx, req.CandidateID)
+		return LocalExportCandidateDataResponse{CandidateData: m, Err: err}, nil
+	}
+}
+
+// CandidateDataRequest declares the inputs required for exporting or erasing the data about a Candidate
+type CandidateDataRequest struct {
+	CandidateID uint64
+}
+
+// LocalExportCandidateDataResponse declares the outputs after attempting to export the data about a Candidate
+type LocalExportCandidateDataResponse struct {
+	CandidateData *models.CandidateData
+	Err           error
+}
+
+func makeLocalEraseCandidateDataEndpoint(s project.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CandidateDataRequest)
+		n, err := s.LocalEraseCandidateData(ctx, req.CandidateID)
+		return LocalEraseCandidateDataResponse{Erased: n, Err: err}, nil
+	}
+}
+
+// LocalEraseCandidateDataResponse declares the outputs after attempting to erase the data about a Candidate
+type LocalEraseCandidateDataResponse struct {
+	Erased uint64
+	Err    error
+}
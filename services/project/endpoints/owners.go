@@ -0,0 +1,78 @@
+package endpoints
+
+import (
+	"context"
+
+	"in-backend/rbac"
+	"in-backend/services/project"
+)
+
+// MakeOwners returns the owners of the resources of the requests that are authorized by ownership.
+// A Project is owned by the caller when the caller is one of its candidates
+func MakeOwners(r project.Repository) rbac.Owners {
+	candidateProject := func(ctx context.Context, pid uint64) ([]rbac.Owner, error) {
+		s := rbac.FromContext(ctx)
+		if s == nil || pid == 0 {
+			return nil, nil
+		}
+		cp, err := r.GetCandidateProject(ctx, s.ID, pid)
+		if err != nil || cp == nil {
+			return nil, err
+		}
+		return []rbac.Owner{{UserID: cp.CandidateID}}, nil
+	}
+	candidate := func(cid uint64) ([]rbac.Owner, error) {
+		if cid == 0 {
+			return nil, nil
+		}
+		return []rbac.Owner{{UserID: cid}}, nil
+	}
+
+	return rbac.Owners{
+		"CreateProject": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(CreateProjectRequest).CandidateID)
+		},
+		"GetAllProjects": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidate(request.(GetAllProjectsRequest).CandidateID)
+		},
+		"GetProjectByID": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidateProject(ctx, request.(GetProjectByIDRequest).ID)
+		},
+		"UpdateProject": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(UpdateProjectRequest).Project
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return candidateProject(ctx, m.ID)
+		},
+		"DeleteProject": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidateProject(ctx, request.(DeleteProjectRequest).ID)
+		},
+		"ScanProject": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidateProject(ctx, request.(ScanProjectRequest).ID)
+		},
+		"RefreshProjectMetadata": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidateProject(ctx, request.(RefreshProjectMetadataRequest).ID)
+		},
+		"GetAllScanJobs": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			return candidateProject(ctx, request.(GetAllScanJobsRequest).ProjectID)
+		},
+		"CreateCandidateProject": func(_ context.Context, request interface{}) ([]rbac.Owner, error) {
+			m := request.(CreateCandidateProjectRequest).CandidateProject
+			if m == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return candidate(m.CandidateID)
+		},
+		"DeleteCandidateProject": func(ctx context.Context, request interface{}) ([]rbac.Owner, error) {
+			cp, err := r.GetCandidateProjectByID(ctx, request.(DeleteCandidateProjectRequest).ID)
+			if err != nil {
+				return nil, err
+			}
+			if cp == nil {
+				return nil, rbac.ErrForbidden
+			}
+			return candidate(cp.CandidateID)
+		},
+	}
+}
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // Comparator compares whether two models are equal
 type Comparator interface {
 	IsEqual(m2 interface{}) bool
@@ -38,12 +40,24 @@ func (m1 *CandidateProject) IsEqual(m2 interface{}) bool {
 	}
 
 	if m1.CandidateID != m2.(*CandidateProject).CandidateID ||
-		m1.ProjectID != m2.(*CandidateProject).ProjectID {
+		m1.ProjectID != m2.(*CandidateProject).ProjectID ||
+		m1.Username != m2.(*CandidateProject).Username ||
+		m1.Commits != m2.(*CandidateProject).Commits ||
+		m1.CommitShare != m2.(*CandidateProject).CommitShare ||
+		!isTimeEqual(m1.VerifiedAt, m2.(*CandidateProject).VerifiedAt) {
 		return false
 	}
 	return true
 }
 
+// isTimeEqual checks the equivalence of two optional times
+func isTimeEqual(t1, t2 *time.Time) bool {
+	if t1 == nil || t2 == nil {
+		return t1 == t2
+	}
+	return t1.Equal(*t2)
+}
+
 // IsEqual checks the equivalence of two Rating objects
 func (m1 *Rating) IsEqual(m2 interface{}) bool {
 	if m1 == nil && m2.(*Rating) == nil {
@@ -63,6 +77,9 @@ func (m1 *Rating) IsEqual(m2 interface{}) bool {
 		m1.Coverage != m2.(*Rating).Coverage ||
 		m1.Duplications != m2.(*Rating).Duplications ||
 		m1.Lines != m2.(*Rating).Lines ||
+		m1.TestFileRatio != m2.(*Rating).TestFileRatio ||
+		m1.Complexity != m2.(*Rating).Complexity ||
+		m1.Analyzer != m2.(*Rating).Analyzer ||
 		*m1.CreatedAt != *m2.(*Rating).CreatedAt {
 		return false
 	}
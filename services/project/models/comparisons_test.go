@@ -37,6 +37,9 @@ func TestRatingIsEqual(t *testing.T) {
 		Coverage:              1.0,
 		Duplications:          1.0,
 		Lines:                 1,
+		TestFileRatio:         0.5,
+		Complexity:            2.5,
+		Analyzer:              "go",
 		CreatedAt:             &timeAt,
 	}
 	m3 := &Rating{}
@@ -49,6 +52,9 @@ func TestCandidateProjectIsEqual(t *testing.T) {
 	m2 := &CandidateProject{
 		CandidateID: 1,
 		ProjectID:   2,
+		Username:    "octocat",
+		Commits:     3,
+		CommitShare: 0.5,
 	}
 	m3 := &CandidateProject{}
 
@@ -1,11 +1,18 @@
 package models
 
+import (
+	"time"
+
+	"in-backend/pagination"
+)
+
 // ProjectFilters define filters for Project model
 type ProjectFilters struct {
 	ID          []uint64
 	CandidateID uint64
 	Name        string
 	RepoURL     string
+	Page        pagination.Params
 }
 
 // RatingFilters define filters for Rating model
@@ -16,3 +23,25 @@ type RatingFilters struct {
 	SecurityRating        []int32
 	SecurityReviewRating  []int32
 }
+
+// RatingHistoryFilters define filters for the Ratings of a Project.
+// From and To are inclusive bounds of when the Ratings were created
+type RatingHistoryFilters struct {
+	ProjectID uint64
+	From      *time.Time
+	To        *time.Time
+	Page      pagination.Params
+}
+
+// QualityScoreFilters define filters for QualityScore model
+type QualityScoreFilters struct {
+	CandidateID []uint64
+	Page        pagination.Params
+}
+
+// ScanJobFilters define filters for ScanJob model
+type ScanJobFilters struct {
+	ProjectID uint64
+	Status    string
+	Page      pagination.Params
+}
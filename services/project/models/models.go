@@ -38,6 +38,14 @@ type CandidateProject struct {
 	ID          uint64 `json:"id"`
 	CandidateID uint64 `json:"candidate_id"`
 	ProjectID   uint64 `json:"project_id"`
+	// Username is the login of the candidate on the host of the repository, which is only set
+	// when their contribution is verified against a git host account linked to their identity provider account
+	Username string `json:"username,omitempty"`
+	// Commits and CommitShare are the commits of the candidate to the repository,
+	// and VerifiedAt is when the candidate was found to have contributed to it
+	Commits     uint64     `json:"commits" pg:",use_zero"`
+	CommitShare float32    `json:"commit_share" pg:",use_zero"`
+	VerifiedAt  *time.Time `json:"verified_at,omitempty"`
 }
 
 // Rating declares the model for Rating
@@ -53,6 +61,9 @@ type Rating struct {
 	Coverage              float32    `json:"coverage" pg:",use_zero"`
 	Duplications          float32    `json:"duplications" pg:",use_zero"`
 	Lines                 uint64     `json:"lines"`
+	TestFileRatio         float32    `json:"test_file_ratio" pg:",use_zero"`
+	Complexity            float32    `json:"complexity" pg:",use_zero"`
+	Analyzer              string     `json:"analyzer,omitempty"`
 	CreatedAt             *time.Time `json:"created_at,omitempty" pg:"default:now()"`
 }
 
@@ -61,3 +72,94 @@ func (m *Rating) BeforeInsert(ctx context.Context) (context.Context, error) {
 	m.CreatedAt = &now
 	return ctx, nil
 }
+
+// ProjectMetadata declares the model for the metadata of a Project imported from the host of its repository
+type ProjectMetadata struct {
+	tableName struct{} `pg:"project_metadata,alias:pm"`
+
+	ProjectID    uint64                `json:"project_id" pg:",pk"`
+	Host         string                `json:"host" pg:",notnull"`
+	Stars        uint64                `json:"stars" pg:",use_zero"`
+	Commits      uint64                `json:"commits" pg:",use_zero"`
+	LastCommitAt *time.Time            `json:"last_commit_at,omitempty"`
+	Languages    []*ProjectLanguage    `json:"languages,omitempty" pg:"-"`
+	Contributors []*ProjectContributor `json:"contributors,omitempty" pg:"-"`
+	RefreshedAt  *time.Time            `json:"refreshed_at,omitempty" pg:"default:now()"`
+}
+
+// ProjectLanguage declares the model for a language of a Project,
+// and Share is the percentage of the code of the Project in the language
+type ProjectLanguage struct {
+	tableName struct{} `pg:"project_languages,alias:pl"`
+
+	ID        uint64  `json:"id"`
+	ProjectID uint64  `json:"project_id" pg:",notnull"`
+	Language  string  `json:"language" pg:",notnull"`
+	Share     float32 `json:"share" pg:",use_zero"`
+}
+
+// ProjectContributor declares the model for a contributor to a Project
+type ProjectContributor struct {
+	tableName struct{} `pg:"project_contributors,alias:pc"`
+
+	ID        uint64 `json:"id"`
+	ProjectID uint64 `json:"project_id" pg:",notnull"`
+	Username  string `json:"username,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Commits   uint64 `json:"commits" pg:",use_zero"`
+}
+
+// QualityScore declares the code quality score of a Candidate,
+// which combines the latest Ratings of all their Projects weighted by their Lines.
+// Scores range from 0 (worst) to 100 (best)
+type QualityScore struct {
+	tableName struct{} `pg:"_,alias:qs"`
+
+	CandidateID uint64  `json:"candidate_id"`
+	Score       float64 `json:"score"`
+	Lines       uint64  `json:"lines"`
+	Projects    uint64  `json:"projects"`
+}
+
+// ScanJob statuses
+const (
+	ScanJobQueued    string = "Queued"
+	ScanJobRunning   string = "Running"
+	ScanJobSucceeded string = "Succeeded"
+	ScanJobFailed    string = "Failed"
+)
+
+// ScanJob declares the model for a run of the code scanner on a Project
+type ScanJob struct {
+	tableName struct{} `pg:"scan_jobs,alias:sj"`
+
+	ID         uint64     `json:"id"`
+	ProjectID  uint64     `json:"project_id" pg:",notnull"`
+	Status     string     `json:"status" pg:",notnull"`
+	Logs       string     `json:"logs,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty" pg:"default:now()"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty" pg:"default:now()"`
+}
+
+func (m *ScanJob) BeforeInsert(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.CreatedAt = &now
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+func (m *ScanJob) BeforeUpdate(ctx context.Context) (context.Context, error) {
+	now := time.Now()
+	m.UpdatedAt = &now
+	return ctx, nil
+}
+
+// CandidateData declares the data about a Candidate that is kept by the project service,
+// which is exported to the Candidate on request
+type CandidateData struct {
+	Projects          []*Project          `json:"projects"`
+	CandidateProjects []*CandidateProject `json:"candidate_projects"`
+}
@@ -50,11 +50,15 @@ func RatingToORM(m *pb.Rating) *Rating {
 		Coverage:              m.Coverage,
 		Duplications:          m.Duplications,
 		Lines:                 m.Lines,
+		TestFileRatio:         m.TestFileRatio,
+		Complexity:            m.Complexity,
+		Analyzer:              m.Analyzer,
 		CreatedAt:             createdAt,
 	}
 }
 
-// CandidateProjectToORM maps the proto Rating model to the ORM model
+// CandidateProjectToORM maps the proto Rating model to the ORM model.
+// The commits and verification of a CandidateProject are only set by imports, so they are not mapped
 func CandidateProjectToORM(m *pb.CandidateProject) *CandidateProject {
 	if m == nil {
 		return nil
@@ -64,5 +68,6 @@ func CandidateProjectToORM(m *pb.CandidateProject) *CandidateProject {
 		ID:          m.Id,
 		CandidateID: m.CandidateId,
 		ProjectID:   m.ProjectId,
+		Username:    m.Username,
 	}
 }
@@ -101,6 +101,9 @@ func TestRatingToORM(t *testing.T) {
 		Coverage:              1.0,
 		Duplications:          1.0,
 		Lines:                 1,
+		TestFileRatio:         0.5,
+		Complexity:            2.5,
+		Analyzer:              "go",
 		CreatedAt:             testPbTime,
 	}
 
@@ -113,6 +116,9 @@ func TestRatingToORM(t *testing.T) {
 		Coverage:              1.0,
 		Duplications:          1.0,
 		Lines:                 1,
+		TestFileRatio:         0.5,
+		Complexity:            2.5,
+		Analyzer:              "go",
 		CreatedAt:             &testTime,
 	}
 
@@ -125,12 +131,18 @@ func TestCandidateProjectToORM(t *testing.T) {
 		Id:          1,
 		CandidateId: 1,
 		ProjectId:   1,
+		Username:    "octocat",
+		Commits:     6,
+		CommitShare: 1,
+		VerifiedAt:  ptypes.TimestampNow(),
 	}
 
+	// candidates cannot verify their own contributions
 	expect := &CandidateProject{
 		ID:          1,
 		CandidateID: 1,
 		ProjectID:   1,
+		Username:    "octocat",
 	}
 
 	got := CandidateProjectToORM(input)
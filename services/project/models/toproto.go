@@ -50,6 +50,9 @@ func (m *Rating) ToProto() *pb.Rating {
 		Coverage:              m.Coverage,
 		Duplications:          m.Duplications,
 		Lines:                 m.Lines,
+		TestFileRatio:         m.TestFileRatio,
+		Complexity:            m.Complexity,
+		Analyzer:              m.Analyzer,
 		CreatedAt:             createdAt,
 	}
 }
@@ -60,9 +63,84 @@ func (m *CandidateProject) ToProto() *pb.CandidateProject {
 		return nil
 	}
 
+	verifiedAt := helpers.TimeToProto(m.VerifiedAt)
+
 	return &pb.CandidateProject{
 		Id:          m.ID,
 		CandidateId: m.CandidateID,
 		ProjectId:   m.ProjectID,
+		Username:    m.Username,
+		Commits:     m.Commits,
+		CommitShare: m.CommitShare,
+		VerifiedAt:  verifiedAt,
+	}
+}
+
+// ToProto maps the ORM ProjectMetadata model to the proto model
+func (m *ProjectMetadata) ToProto() *pb.ProjectMetadata {
+	if m == nil {
+		return nil
+	}
+
+	languages := []*pb.ProjectLanguage{}
+	for _, l := range m.Languages {
+		languages = append(languages, &pb.ProjectLanguage{Language: l.Language, Share: l.Share})
+	}
+
+	contributors := []*pb.ProjectContributor{}
+	for _, c := range m.Contributors {
+		contributors = append(contributors, &pb.ProjectContributor{Username: c.Username, Name: c.Name, Commits: c.Commits})
+	}
+
+	lastCommitAt := helpers.TimeToProto(m.LastCommitAt)
+	refreshedAt := helpers.TimeToProto(m.RefreshedAt)
+
+	return &pb.ProjectMetadata{
+		ProjectId:    m.ProjectID,
+		Host:         m.Host,
+		Stars:        m.Stars,
+		Commits:      m.Commits,
+		LastCommitAt: lastCommitAt,
+		Languages:    languages,
+		Contributors: contributors,
+		RefreshedAt:  refreshedAt,
+	}
+}
+
+// ToProto maps the ORM ScanJob model to the proto model
+func (m *ScanJob) ToProto() *pb.ScanJob {
+	if m == nil {
+		return nil
+	}
+
+	startedAt := helpers.TimeToProto(m.StartedAt)
+	finishedAt := helpers.TimeToProto(m.FinishedAt)
+	createdAt := helpers.TimeToProto(m.CreatedAt)
+	updatedAt := helpers.TimeToProto(m.UpdatedAt)
+
+	return &pb.ScanJob{
+		Id:         m.ID,
+		ProjectId:  m.ProjectID,
+		Status:     m.Status,
+		Logs:       m.Logs,
+		Error:      m.Error,
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+// ToProto maps the ORM QualityScore model to the proto model
+func (m *QualityScore) ToProto() *pb.QualityScore {
+	if m == nil {
+		return nil
+	}
+
+	return &pb.QualityScore{
+		CandidateId: m.CandidateID,
+		Score:       m.Score,
+		Lines:       m.Lines,
+		Projects:    m.Projects,
 	}
 }
@@ -101,6 +101,9 @@ func TestRatingToProto(t *testing.T) {
 		Coverage:              1.0,
 		Duplications:          1.0,
 		Lines:                 1,
+		TestFileRatio:         0.5,
+		Complexity:            2.5,
+		Analyzer:              "go",
 		CreatedAt:             &testTime,
 	}
 
@@ -113,6 +116,9 @@ func TestRatingToProto(t *testing.T) {
 		Coverage:              1.0,
 		Duplications:          1.0,
 		Lines:                 1,
+		TestFileRatio:         0.5,
+		Complexity:            2.5,
+		Analyzer:              "go",
 		CreatedAt:             testPbTime,
 	}
 
@@ -121,18 +127,122 @@ func TestRatingToProto(t *testing.T) {
 }
 
 func TestCandidateProjectToProto(t *testing.T) {
+	testPbTime := ptypes.TimestampNow()
+	testTime, err := ptypes.Timestamp(testPbTime)
+	require.NoError(t, err)
+
 	input := &CandidateProject{
 		ID:          1,
 		CandidateID: 1,
 		ProjectID:   1,
+		Username:    "octocat",
+		Commits:     6,
+		CommitShare: 0.6,
+		VerifiedAt:  &testTime,
 	}
 
 	expect := &pb.CandidateProject{
 		Id:          1,
 		CandidateId: 1,
 		ProjectId:   1,
+		Username:    "octocat",
+		Commits:     6,
+		CommitShare: 0.6,
+		VerifiedAt:  testPbTime,
 	}
 
 	got := input.ToProto()
 	require.EqualValues(t, expect, got)
 }
+
+func TestProjectMetadataToProto(t *testing.T) {
+	testPbTime := ptypes.TimestampNow()
+	testTime, err := ptypes.Timestamp(testPbTime)
+	require.NoError(t, err)
+
+	input := &ProjectMetadata{
+		ProjectID:    1,
+		Host:         "github.com",
+		Stars:        42,
+		Commits:      10,
+		LastCommitAt: &testTime,
+		Languages:    []*ProjectLanguage{{ID: 1, ProjectID: 1, Language: "Go", Share: 75}},
+		Contributors: []*ProjectContributor{{ID: 1, ProjectID: 1, Username: "octocat", Commits: 6}},
+		RefreshedAt:  &testTime,
+	}
+
+	expect := &pb.ProjectMetadata{
+		ProjectId:    1,
+		Host:         "github.com",
+		Stars:        42,
+		Commits:      10,
+		LastCommitAt: testPbTime,
+		Languages:    []*pb.ProjectLanguage{{Language: "Go", Share: 75}},
+		Contributors: []*pb.ProjectContributor{{Username: "octocat", Commits: 6}},
+		RefreshedAt:  testPbTime,
+	}
+
+	got := input.ToProto()
+	require.EqualValues(t, expect, got)
+
+	var nilMetadata *ProjectMetadata
+	require.Nil(t, nilMetadata.ToProto())
+}
+
+func TestScanJobToProto(t *testing.T) {
+	testPbTime := ptypes.TimestampNow()
+	testTime, err := ptypes.Timestamp(testPbTime)
+	require.NoError(t, err)
+
+	input := &ScanJob{
+		ID:         1,
+		ProjectID:  1,
+		Status:     ScanJobFailed,
+		Logs:       "Cloning into 'repo'...",
+		Error:      "Failed to clone the repository",
+		StartedAt:  &testTime,
+		FinishedAt: &testTime,
+		CreatedAt:  &testTime,
+		UpdatedAt:  &testTime,
+	}
+
+	expect := &pb.ScanJob{
+		Id:         1,
+		ProjectId:  1,
+		Status:     ScanJobFailed,
+		Logs:       "Cloning into 'repo'...",
+		Error:      "Failed to clone the repository",
+		StartedAt:  testPbTime,
+		FinishedAt: testPbTime,
+		CreatedAt:  testPbTime,
+		UpdatedAt:  testPbTime,
+	}
+
+	got := input.ToProto()
+	require.EqualValues(t, expect, got)
+
+	var nilJob *ScanJob
+	require.Nil(t, nilJob.ToProto())
+}
+
+func TestQualityScoreToProto(t *testing.T) {
+	input := &QualityScore{
+		CandidateID: 1,
+		Score:       72.5,
+		Lines:       1200,
+		Projects:    3,
+	}
+
+	expect := &pb.QualityScore{
+		CandidateId: 1,
+		Score:       72.5,
+		Lines:       1200,
+		Projects:    3,
+	}
+
+	got := input.ToProto()
+	require.EqualValues(t, expect, got)
+
+	nilScore := (*QualityScore)(nil)
+	require.Nil(t, nilScore.ToProto())
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
-// 	protoc        v3.13.0
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
 // source: project.proto
 
 package pb
@@ -9,13 +9,13 @@ package pb
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -36,13 +36,13 @@ type Project struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	RepoUrl   string               `protobuf:"bytes,3,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
-	Ratings   []*Rating            `protobuf:"bytes,4,rep,name=ratings,proto3" json:"ratings,omitempty"`
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeletedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RepoUrl   string                 `protobuf:"bytes,3,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Ratings   []*Rating              `protobuf:"bytes,4,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 }
 
 func (x *Project) Reset() {
@@ -105,21 +105,21 @@ func (x *Project) GetRatings() []*Rating {
 	return nil
 }
 
-func (x *Project) GetCreatedAt() *timestamp.Timestamp {
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Project) GetUpdatedAt() *timestamp.Timestamp {
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Project) GetDeletedAt() *timestamp.Timestamp {
+func (x *Project) GetDeletedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DeletedAt
 	}
@@ -190,6 +190,11 @@ type GetAllProjectsRequest struct {
 	CandidateId uint64   `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
 	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	RepoUrl     string   `protobuf:"bytes,4,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	PageSize    uint64   `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string   `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy      string   `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc    bool     `protobuf:"varint,8,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	WithTotal   bool     `protobuf:"varint,9,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
 }
 
 func (x *GetAllProjectsRequest) Reset() {
@@ -252,12 +257,49 @@ func (x *GetAllProjectsRequest) GetRepoUrl() string {
 	return ""
 }
 
+func (x *GetAllProjectsRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllProjectsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetAllProjectsRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *GetAllProjectsRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
 type GetAllProjectsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    uint64     `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
 func (x *GetAllProjectsResponse) Reset() {
@@ -299,6 +341,20 @@ func (x *GetAllProjectsResponse) GetProjects() []*Project {
 	return nil
 }
 
+func (x *GetAllProjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAllProjectsResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type GetProjectByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -537,6 +593,8 @@ type ScanProjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ScanJob *ScanJob `protobuf:"bytes,1,opt,name=scan_job,json=scanJob,proto3" json:"scan_job,omitempty"`
 }
 
 func (x *ScanProjectResponse) Reset() {
@@ -571,18 +629,30 @@ func (*ScanProjectResponse) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{9}
 }
 
-type CandidateProject struct {
+func (x *ScanProjectResponse) GetScanJob() *ScanJob {
+	if x != nil {
+		return x.ScanJob
+	}
+	return nil
+}
+
+type ProjectMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CandidateId uint64 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
-	ProjectId   uint64 `protobuf:"varint,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectId    uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Host         string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Stars        uint64                 `protobuf:"varint,3,opt,name=stars,proto3" json:"stars,omitempty"`
+	Commits      uint64                 `protobuf:"varint,4,opt,name=commits,proto3" json:"commits,omitempty"`
+	LastCommitAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_commit_at,json=lastCommitAt,proto3" json:"last_commit_at,omitempty"`
+	Languages    []*ProjectLanguage     `protobuf:"bytes,6,rep,name=languages,proto3" json:"languages,omitempty"`
+	Contributors []*ProjectContributor  `protobuf:"bytes,7,rep,name=contributors,proto3" json:"contributors,omitempty"`
+	RefreshedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
 }
 
-func (x *CandidateProject) Reset() {
-	*x = CandidateProject{}
+func (x *ProjectMetadata) Reset() {
+	*x = ProjectMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_project_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,13 +660,13 @@ func (x *CandidateProject) Reset() {
 	}
 }
 
-func (x *CandidateProject) String() string {
+func (x *ProjectMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CandidateProject) ProtoMessage() {}
+func (*ProjectMetadata) ProtoMessage() {}
 
-func (x *CandidateProject) ProtoReflect() protoreflect.Message {
+func (x *ProjectMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_project_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -608,43 +678,78 @@ func (x *CandidateProject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CandidateProject.ProtoReflect.Descriptor instead.
-func (*CandidateProject) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectMetadata.ProtoReflect.Descriptor instead.
+func (*ProjectMetadata) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CandidateProject) GetId() uint64 {
+func (x *ProjectMetadata) GetProjectId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ProjectId
 	}
 	return 0
 }
 
-func (x *CandidateProject) GetCandidateId() uint64 {
+func (x *ProjectMetadata) GetHost() string {
 	if x != nil {
-		return x.CandidateId
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ProjectMetadata) GetStars() uint64 {
+	if x != nil {
+		return x.Stars
 	}
 	return 0
 }
 
-func (x *CandidateProject) GetProjectId() uint64 {
+func (x *ProjectMetadata) GetCommits() uint64 {
 	if x != nil {
-		return x.ProjectId
+		return x.Commits
 	}
 	return 0
 }
 
-type CreateCandidateProjectRequest struct {
+func (x *ProjectMetadata) GetLastCommitAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCommitAt
+	}
+	return nil
+}
+
+func (x *ProjectMetadata) GetLanguages() []*ProjectLanguage {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *ProjectMetadata) GetContributors() []*ProjectContributor {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+func (x *ProjectMetadata) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+type ProjectLanguage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CandidateId      uint64            `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
-	CandidateProject *CandidateProject `protobuf:"bytes,2,opt,name=candidate_project,json=candidateProject,proto3" json:"candidate_project,omitempty"`
+	Language string  `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Share    float32 `protobuf:"fixed32,2,opt,name=share,proto3" json:"share,omitempty"`
 }
 
-func (x *CreateCandidateProjectRequest) Reset() {
-	*x = CreateCandidateProjectRequest{}
+func (x *ProjectLanguage) Reset() {
+	*x = ProjectLanguage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_project_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -652,13 +757,13 @@ func (x *CreateCandidateProjectRequest) Reset() {
 	}
 }
 
-func (x *CreateCandidateProjectRequest) String() string {
+func (x *ProjectLanguage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCandidateProjectRequest) ProtoMessage() {}
+func (*ProjectLanguage) ProtoMessage() {}
 
-func (x *CreateCandidateProjectRequest) ProtoReflect() protoreflect.Message {
+func (x *ProjectLanguage) ProtoReflect() protoreflect.Message {
 	mi := &file_project_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,33 +775,37 @@ func (x *CreateCandidateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCandidateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateCandidateProjectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectLanguage.ProtoReflect.Descriptor instead.
+func (*ProjectLanguage) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateCandidateProjectRequest) GetCandidateId() uint64 {
+func (x *ProjectLanguage) GetLanguage() string {
 	if x != nil {
-		return x.CandidateId
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCandidateProjectRequest) GetCandidateProject() *CandidateProject {
+func (x *ProjectLanguage) GetShare() float32 {
 	if x != nil {
-		return x.CandidateProject
+		return x.Share
 	}
-	return nil
+	return 0
 }
 
-type CreateCandidateProjectResponse struct {
+type ProjectContributor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Commits  uint64 `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`
 }
 
-func (x *CreateCandidateProjectResponse) Reset() {
-	*x = CreateCandidateProjectResponse{}
+func (x *ProjectContributor) Reset() {
+	*x = ProjectContributor{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_project_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,13 +813,13 @@ func (x *CreateCandidateProjectResponse) Reset() {
 	}
 }
 
-func (x *CreateCandidateProjectResponse) String() string {
+func (x *ProjectContributor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCandidateProjectResponse) ProtoMessage() {}
+func (*ProjectContributor) ProtoMessage() {}
 
-func (x *CreateCandidateProjectResponse) ProtoReflect() protoreflect.Message {
+func (x *ProjectContributor) ProtoReflect() protoreflect.Message {
 	mi := &file_project_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,12 +831,33 @@ func (x *CreateCandidateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCandidateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateCandidateProjectResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectContributor.ProtoReflect.Descriptor instead.
+func (*ProjectContributor) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{12}
 }
 
-type DeleteCandidateProjectRequest struct {
+func (x *ProjectContributor) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ProjectContributor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectContributor) GetCommits() uint64 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+type RefreshProjectMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -735,8 +865,8 @@ type DeleteCandidateProjectRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteCandidateProjectRequest) Reset() {
-	*x = DeleteCandidateProjectRequest{}
+func (x *RefreshProjectMetadataRequest) Reset() {
+	*x = RefreshProjectMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_project_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -744,13 +874,13 @@ func (x *DeleteCandidateProjectRequest) Reset() {
 	}
 }
 
-func (x *DeleteCandidateProjectRequest) String() string {
+func (x *RefreshProjectMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCandidateProjectRequest) ProtoMessage() {}
+func (*RefreshProjectMetadataRequest) ProtoMessage() {}
 
-func (x *DeleteCandidateProjectRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshProjectMetadataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_project_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -762,26 +892,28 @@ func (x *DeleteCandidateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCandidateProjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCandidateProjectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshProjectMetadataRequest.ProtoReflect.Descriptor instead.
+func (*RefreshProjectMetadataRequest) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeleteCandidateProjectRequest) GetId() uint64 {
+func (x *RefreshProjectMetadataRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteCandidateProjectResponse struct {
+type GetProjectMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteCandidateProjectResponse) Reset() {
-	*x = DeleteCandidateProjectResponse{}
+func (x *GetProjectMetadataRequest) Reset() {
+	*x = GetProjectMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_project_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,13 +921,13 @@ func (x *DeleteCandidateProjectResponse) Reset() {
 	}
 }
 
-func (x *DeleteCandidateProjectResponse) String() string {
+func (x *GetProjectMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCandidateProjectResponse) ProtoMessage() {}
+func (*GetProjectMetadataRequest) ProtoMessage() {}
 
-func (x *DeleteCandidateProjectResponse) ProtoReflect() protoreflect.Message {
+func (x *GetProjectMetadataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_project_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,30 +939,36 @@ func (x *DeleteCandidateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCandidateProjectResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCandidateProjectResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProjectMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectMetadataRequest) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{14}
 }
 
-type Rating struct {
+func (x *GetProjectMetadataRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ScanJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                    uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId             uint64               `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	ReliabilityRating     int32                `protobuf:"varint,3,opt,name=reliability_rating,json=reliabilityRating,proto3" json:"reliability_rating,omitempty"`
-	MaintainabilityRating int32                `protobuf:"varint,4,opt,name=maintainability_rating,json=maintainabilityRating,proto3" json:"maintainability_rating,omitempty"`
-	SecurityRating        int32                `protobuf:"varint,5,opt,name=security_rating,json=securityRating,proto3" json:"security_rating,omitempty"`
-	SecurityReviewRating  int32                `protobuf:"varint,6,opt,name=security_review_rating,json=securityReviewRating,proto3" json:"security_review_rating,omitempty"`
-	Coverage              float32              `protobuf:"fixed32,7,opt,name=coverage,proto3" json:"coverage,omitempty"`
-	Duplications          float32              `protobuf:"fixed32,8,opt,name=duplications,proto3" json:"duplications,omitempty"`
-	Lines                 uint64               `protobuf:"varint,9,opt,name=lines,proto3" json:"lines,omitempty"`
-	CreatedAt             *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId  uint64                 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Status     string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Logs       string                 `protobuf:"bytes,4,opt,name=logs,proto3" json:"logs,omitempty"`
+	Error      string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *Rating) Reset() {
-	*x = Rating{}
+func (x *ScanJob) Reset() {
+	*x = ScanJob{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_project_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -838,13 +976,13 @@ func (x *Rating) Reset() {
 	}
 }
 
-func (x *Rating) String() string {
+func (x *ScanJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rating) ProtoMessage() {}
+func (*ScanJob) ProtoMessage() {}
 
-func (x *Rating) ProtoReflect() protoreflect.Message {
+func (x *ScanJob) ProtoReflect() protoreflect.Message {
 	mi := &file_project_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,92 +994,90 @@ func (x *Rating) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
-func (*Rating) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScanJob.ProtoReflect.Descriptor instead.
+func (*ScanJob) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Rating) GetId() uint64 {
+func (x *ScanJob) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Rating) GetProjectId() uint64 {
+func (x *ScanJob) GetProjectId() uint64 {
 	if x != nil {
 		return x.ProjectId
 	}
 	return 0
 }
 
-func (x *Rating) GetReliabilityRating() int32 {
-	if x != nil {
-		return x.ReliabilityRating
-	}
-	return 0
-}
-
-func (x *Rating) GetMaintainabilityRating() int32 {
+func (x *ScanJob) GetStatus() string {
 	if x != nil {
-		return x.MaintainabilityRating
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *Rating) GetSecurityRating() int32 {
+func (x *ScanJob) GetLogs() string {
 	if x != nil {
-		return x.SecurityRating
+		return x.Logs
 	}
-	return 0
+	return ""
 }
 
-func (x *Rating) GetSecurityReviewRating() int32 {
+func (x *ScanJob) GetError() string {
 	if x != nil {
-		return x.SecurityReviewRating
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *Rating) GetCoverage() float32 {
+func (x *ScanJob) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Coverage
+		return x.StartedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Rating) GetDuplications() float32 {
+func (x *ScanJob) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Duplications
+		return x.FinishedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Rating) GetLines() uint64 {
+func (x *ScanJob) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Lines
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Rating) GetCreatedAt() *timestamp.Timestamp {
+func (x *ScanJob) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type CreateRatingRequest struct {
+type GetAllScanJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectId uint64  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Rating    *Rating `protobuf:"bytes,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Status    string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize  uint64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy    string `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc  bool   `protobuf:"varint,6,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	WithTotal bool   `protobuf:"varint,7,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
 }
 
-func (x *CreateRatingRequest) Reset() {
-	*x = CreateRatingRequest{}
+func (x *GetAllScanJobsRequest) Reset() {
+	*x = GetAllScanJobsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_project_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,13 +1085,13 @@ func (x *CreateRatingRequest) Reset() {
 	}
 }
 
-func (x *CreateRatingRequest) String() string {
+func (x *GetAllScanJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRatingRequest) ProtoMessage() {}
+func (*GetAllScanJobsRequest) ProtoMessage() {}
 
-func (x *CreateRatingRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAllScanJobsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_project_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,89 +1103,87 @@ func (x *CreateRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRatingRequest.ProtoReflect.Descriptor instead.
-func (*CreateRatingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllScanJobsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllScanJobsRequest) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateRatingRequest) GetProjectId() uint64 {
+func (x *GetAllScanJobsRequest) GetProjectId() uint64 {
 	if x != nil {
 		return x.ProjectId
 	}
 	return 0
 }
 
-func (x *CreateRatingRequest) GetRating() *Rating {
+func (x *GetAllScanJobsRequest) GetStatus() string {
 	if x != nil {
-		return x.Rating
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type CreateRatingResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetAllScanJobsRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *CreateRatingResponse) Reset() {
-	*x = CreateRatingResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_project_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetAllScanJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
+	return ""
 }
 
-func (x *CreateRatingResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetAllScanJobsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
 }
 
-func (*CreateRatingResponse) ProtoMessage() {}
-
-func (x *CreateRatingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_project_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetAllScanJobsRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use CreateRatingResponse.ProtoReflect.Descriptor instead.
-func (*CreateRatingResponse) Descriptor() ([]byte, []int) {
-	return file_project_proto_rawDescGZIP(), []int{17}
+func (x *GetAllScanJobsRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
 }
 
-type DeleteRatingRequest struct {
+type GetAllScanJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ScanJobs      []*ScanJob `protobuf:"bytes,1,rep,name=scan_jobs,json=scanJobs,proto3" json:"scan_jobs,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    uint64     `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
-func (x *DeleteRatingRequest) Reset() {
-	*x = DeleteRatingRequest{}
+func (x *GetAllScanJobsResponse) Reset() {
+	*x = GetAllScanJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_project_proto_msgTypes[18]
+		mi := &file_project_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRatingRequest) String() string {
+func (x *GetAllScanJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRatingRequest) ProtoMessage() {}
+func (*GetAllScanJobsResponse) ProtoMessage() {}
 
-func (x *DeleteRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_project_proto_msgTypes[18]
+func (x *GetAllScanJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,48 +1194,1236 @@ func (x *DeleteRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRatingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRatingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllScanJobsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllScanJobsResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAllScanJobsResponse) GetScanJobs() []*ScanJob {
+	if x != nil {
+		return x.ScanJobs
+	}
+	return nil
+}
+
+func (x *GetAllScanJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAllScanJobsResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CandidateProject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CandidateId uint64                 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	ProjectId   uint64                 `protobuf:"varint,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Username    string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Commits     uint64                 `protobuf:"varint,5,opt,name=commits,proto3" json:"commits,omitempty"`
+	CommitShare float32                `protobuf:"fixed32,6,opt,name=commit_share,json=commitShare,proto3" json:"commit_share,omitempty"`
+	VerifiedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+}
+
+func (x *CandidateProject) Reset() {
+	*x = CandidateProject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandidateProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateProject) ProtoMessage() {}
+
+func (x *CandidateProject) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateProject.ProtoReflect.Descriptor instead.
+func (*CandidateProject) Descriptor() ([]byte, []int) {
 	return file_project_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteRatingRequest) GetId() uint64 {
+func (x *CandidateProject) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CandidateProject) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *CandidateProject) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *CandidateProject) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CandidateProject) GetCommits() uint64 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *CandidateProject) GetCommitShare() float32 {
+	if x != nil {
+		return x.CommitShare
+	}
+	return 0
+}
+
+func (x *CandidateProject) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type CreateCandidateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId      uint64            `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	CandidateProject *CandidateProject `protobuf:"bytes,2,opt,name=candidate_project,json=candidateProject,proto3" json:"candidate_project,omitempty"`
+}
+
+func (x *CreateCandidateProjectRequest) Reset() {
+	*x = CreateCandidateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCandidateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCandidateProjectRequest) ProtoMessage() {}
+
+func (x *CreateCandidateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCandidateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateCandidateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateCandidateProjectRequest) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *CreateCandidateProjectRequest) GetCandidateProject() *CandidateProject {
+	if x != nil {
+		return x.CandidateProject
+	}
+	return nil
+}
+
+type CreateCandidateProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateCandidateProjectResponse) Reset() {
+	*x = CreateCandidateProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCandidateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCandidateProjectResponse) ProtoMessage() {}
+
+func (x *CreateCandidateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCandidateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateCandidateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{20}
+}
+
+type DeleteCandidateProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteCandidateProjectRequest) Reset() {
+	*x = DeleteCandidateProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCandidateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCandidateProjectRequest) ProtoMessage() {}
+
+func (x *DeleteCandidateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCandidateProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCandidateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteCandidateProjectRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCandidateProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCandidateProjectResponse) Reset() {
+	*x = DeleteCandidateProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCandidateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCandidateProjectResponse) ProtoMessage() {}
+
+func (x *DeleteCandidateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCandidateProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCandidateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{22}
+}
+
+type Rating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId             uint64                 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ReliabilityRating     int32                  `protobuf:"varint,3,opt,name=reliability_rating,json=reliabilityRating,proto3" json:"reliability_rating,omitempty"`
+	MaintainabilityRating int32                  `protobuf:"varint,4,opt,name=maintainability_rating,json=maintainabilityRating,proto3" json:"maintainability_rating,omitempty"`
+	SecurityRating        int32                  `protobuf:"varint,5,opt,name=security_rating,json=securityRating,proto3" json:"security_rating,omitempty"`
+	SecurityReviewRating  int32                  `protobuf:"varint,6,opt,name=security_review_rating,json=securityReviewRating,proto3" json:"security_review_rating,omitempty"`
+	Coverage              float32                `protobuf:"fixed32,7,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	Duplications          float32                `protobuf:"fixed32,8,opt,name=duplications,proto3" json:"duplications,omitempty"`
+	Lines                 uint64                 `protobuf:"varint,9,opt,name=lines,proto3" json:"lines,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TestFileRatio         float32                `protobuf:"fixed32,11,opt,name=test_file_ratio,json=testFileRatio,proto3" json:"test_file_ratio,omitempty"`
+	Complexity            float32                `protobuf:"fixed32,12,opt,name=complexity,proto3" json:"complexity,omitempty"`
+	Analyzer              string                 `protobuf:"bytes,13,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rating) ProtoMessage() {}
+
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Rating) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rating) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *Rating) GetReliabilityRating() int32 {
+	if x != nil {
+		return x.ReliabilityRating
+	}
+	return 0
+}
+
+func (x *Rating) GetMaintainabilityRating() int32 {
+	if x != nil {
+		return x.MaintainabilityRating
+	}
+	return 0
+}
+
+func (x *Rating) GetSecurityRating() int32 {
+	if x != nil {
+		return x.SecurityRating
+	}
+	return 0
+}
+
+func (x *Rating) GetSecurityReviewRating() int32 {
+	if x != nil {
+		return x.SecurityReviewRating
+	}
+	return 0
+}
+
+func (x *Rating) GetCoverage() float32 {
+	if x != nil {
+		return x.Coverage
+	}
+	return 0
+}
+
+func (x *Rating) GetDuplications() float32 {
+	if x != nil {
+		return x.Duplications
+	}
+	return 0
+}
+
+func (x *Rating) GetLines() uint64 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *Rating) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rating) GetTestFileRatio() float32 {
+	if x != nil {
+		return x.TestFileRatio
+	}
+	return 0
+}
+
+func (x *Rating) GetComplexity() float32 {
+	if x != nil {
+		return x.Complexity
+	}
+	return 0
+}
+
+func (x *Rating) GetAnalyzer() string {
+	if x != nil {
+		return x.Analyzer
+	}
+	return ""
+}
+
+type CreateRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Rating    *Rating `protobuf:"bytes,2,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *CreateRatingRequest) Reset() {
+	*x = CreateRatingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatingRequest) ProtoMessage() {}
+
+func (x *CreateRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatingRequest.ProtoReflect.Descriptor instead.
+func (*CreateRatingRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateRatingRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *CreateRatingRequest) GetRating() *Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+type CreateRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateRatingResponse) Reset() {
+	*x = CreateRatingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatingResponse) ProtoMessage() {}
+
+func (x *CreateRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatingResponse.ProtoReflect.Descriptor instead.
+func (*CreateRatingResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{25}
+}
+
+type DeleteRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *DeleteRatingRequest) Reset() {
+	*x = DeleteRatingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatingRequest) ProtoMessage() {}
+
+func (x *DeleteRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRatingRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteRatingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteRatingRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type DeleteRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRatingResponse) Reset() {
+	*x = DeleteRatingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatingResponse) ProtoMessage() {}
+
+func (x *DeleteRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRatingResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{27}
+}
+
+type GetProjectRatingHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	From      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	PageSize  uint64                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy    string                 `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc  bool                   `protobuf:"varint,7,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	WithTotal bool                   `protobuf:"varint,8,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
+}
+
+func (x *GetProjectRatingHistoryRequest) Reset() {
+	*x = GetProjectRatingHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectRatingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRatingHistoryRequest) ProtoMessage() {}
+
+func (x *GetProjectRatingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRatingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRatingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetProjectRatingHistoryRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetProjectRatingHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetProjectRatingHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetProjectRatingHistoryRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetProjectRatingHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetProjectRatingHistoryRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetProjectRatingHistoryRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *GetProjectRatingHistoryRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+type GetProjectRatingHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ratings       []*Rating `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    uint64    `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetProjectRatingHistoryResponse) Reset() {
+	*x = GetProjectRatingHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectRatingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRatingHistoryResponse) ProtoMessage() {}
+
+func (x *GetProjectRatingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRatingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectRatingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetProjectRatingHistoryResponse) GetRatings() []*Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *GetProjectRatingHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetProjectRatingHistoryResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetLatestRatingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId []uint64 `protobuf:"varint,1,rep,packed,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetLatestRatingsRequest) Reset() {
+	*x = GetLatestRatingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatestRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestRatingsRequest) ProtoMessage() {}
+
+func (x *GetLatestRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetLatestRatingsRequest) GetProjectId() []uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return nil
+}
+
+type GetLatestRatingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ratings []*Rating `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+}
+
+func (x *GetLatestRatingsResponse) Reset() {
+	*x = GetLatestRatingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatestRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestRatingsResponse) ProtoMessage() {}
+
+func (x *GetLatestRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestRatingsResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetLatestRatingsResponse) GetRatings() []*Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+type QualityScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId uint64  `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Score       float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Lines       uint64  `protobuf:"varint,3,opt,name=lines,proto3" json:"lines,omitempty"`
+	Projects    uint64  `protobuf:"varint,4,opt,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *QualityScore) Reset() {
+	*x = QualityScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QualityScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityScore) ProtoMessage() {}
+
+func (x *QualityScore) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityScore.ProtoReflect.Descriptor instead.
+func (*QualityScore) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QualityScore) GetCandidateId() uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *QualityScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QualityScore) GetLines() uint64 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *QualityScore) GetProjects() uint64 {
+	if x != nil {
+		return x.Projects
+	}
+	return 0
+}
+
+type GetAllQualityScoresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId []uint64 `protobuf:"varint,1,rep,packed,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	PageSize    uint64   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy      string   `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc    bool     `protobuf:"varint,5,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	WithTotal   bool     `protobuf:"varint,6,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
+}
+
+func (x *GetAllQualityScoresRequest) Reset() {
+	*x = GetAllQualityScoresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllQualityScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllQualityScoresRequest) ProtoMessage() {}
+
+func (x *GetAllQualityScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllQualityScoresRequest.ProtoReflect.Descriptor instead.
+func (*GetAllQualityScoresRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAllQualityScoresRequest) GetCandidateId() []uint64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return nil
+}
+
+func (x *GetAllQualityScoresRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllQualityScoresRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllQualityScoresRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetAllQualityScoresRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *GetAllQualityScoresRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+type GetAllQualityScoresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QualityScores []*QualityScore `protobuf:"bytes,1,rep,name=quality_scores,json=qualityScores,proto3" json:"quality_scores,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    uint64          `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetAllQualityScoresResponse) Reset() {
+	*x = GetAllQualityScoresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllQualityScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllQualityScoresResponse) ProtoMessage() {}
+
+func (x *GetAllQualityScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllQualityScoresResponse.ProtoReflect.Descriptor instead.
+func (*GetAllQualityScoresResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAllQualityScoresResponse) GetQualityScores() []*QualityScore {
+	if x != nil {
+		return x.QualityScores
+	}
+	return nil
+}
+
+func (x *GetAllQualityScoresResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAllQualityScoresResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CandidateDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId uint64 `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+}
+
+func (x *CandidateDataRequest) Reset() {
+	*x = CandidateDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandidateDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateDataRequest) ProtoMessage() {}
+
+func (x *CandidateDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateDataRequest.ProtoReflect.Descriptor instead.
+func (*CandidateDataRequest) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CandidateDataRequest) GetCandidateId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.CandidateId
 	}
 	return 0
 }
 
-func (x *DeleteRatingRequest) GetProjectId() uint64 {
+// CandidateData is the data about a candidate that is kept by the service, encoded as JSON
+type CandidateData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CandidateData) Reset() {
+	*x = CandidateData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_project_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandidateData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateData) ProtoMessage() {}
+
+func (x *CandidateData) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateData.ProtoReflect.Descriptor instead.
+func (*CandidateData) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CandidateData) GetData() []byte {
 	if x != nil {
-		return x.ProjectId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type DeleteRatingResponse struct {
+type EraseCandidateDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Number of records about the candidate that were deleted or anonymised
+	Erased uint64 `protobuf:"varint,1,opt,name=erased,proto3" json:"erased,omitempty"`
 }
 
-func (x *DeleteRatingResponse) Reset() {
-	*x = DeleteRatingResponse{}
+func (x *EraseCandidateDataResponse) Reset() {
+	*x = EraseCandidateDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_project_proto_msgTypes[19]
+		mi := &file_project_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRatingResponse) String() string {
+func (x *EraseCandidateDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRatingResponse) ProtoMessage() {}
+func (*EraseCandidateDataResponse) ProtoMessage() {}
 
-func (x *DeleteRatingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_project_proto_msgTypes[19]
+func (x *EraseCandidateDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,9 +2434,16 @@ func (x *DeleteRatingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRatingResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRatingResponse) Descriptor() ([]byte, []int) {
-	return file_project_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use EraseCandidateDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseCandidateDataResponse) Descriptor() ([]byte, []int) {
+	return file_project_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EraseCandidateDataResponse) GetErased() uint64 {
+	if x != nil {
+		return x.Erased
+	}
+	return 0
 }
 
 var File_project_proto protoreflect.FileDescriptor
@@ -1149,40 +2478,150 @@ var file_project_proto_rawDesc = []byte{
 	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
 	0x65, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72,
-	0x6c, 0x22, 0x41, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x26, 0x0a, 0x14,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a,
-	0x12, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x10, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55,
+	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x8a, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x53, 0x63, 0x61,
+	0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x3d, 0x0a, 0x13, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6a,
+	0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63,
+	0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x22, 0xe4,
+	0x02, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52,
+	0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x1d, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe8, 0x02, 0x0a, 0x07, 0x53, 0x63, 0x61,
+	0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x63,
+	0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x72,
+	0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6f,
+	0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8b, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x53, 0x63, 0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x09, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x4a, 0x6f, 0x62,
+	0x52, 0x08, 0x73, 0x63, 0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74,
 	0x22, 0x85, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69,
 	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f,
@@ -1198,7 +2637,7 @@ var file_project_proto_rawDesc = []byte{
 	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x44,
 	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x03,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf1, 0x03,
 	0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
 	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72,
@@ -1223,86 +2662,237 @@ var file_project_proto_rawDesc = []byte{
 	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x58, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78,
+	0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x72, 0x22, 0x58, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x16, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xac, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x44, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x08,
-	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x4f, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
-	0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a, 0x01,
-	0x2a, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x22, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x5f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a,
-	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x12, 0x5e, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63,
-	0x61, 0x6e, 0x12, 0x91, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x21, 0x2e,
+	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73,
+	0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73,
+	0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0x90, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x40, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x79, 0x0a, 0x0c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12, 0x1d,
+	0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x9f, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x39, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x0d, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x34, 0x0a, 0x1a, 0x45, 0x72, 0x61, 0x73, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65,
+	0x72, 0x61, 0x73, 0x65, 0x64, 0x32, 0xdf, 0x10, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x13, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5a, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x5f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5e, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61,
+	0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a,
+	0x16, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x55, 0x0a, 0x1b, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x63, 0x61, 0x6e, 0x4a, 0x6f,
+	0x62, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x63,
+	0x61, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x63, 0x61, 0x6e, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x6a, 0x6f, 0x62, 0x73, 0x12,
+	0x91, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
 	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x15, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x3a, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
-	0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x06, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x62, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x3a, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x21,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x62, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x70, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x18, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x53, 0x0a, 0x17, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x72, 0x61, 0x73, 0x65,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x61,
+	0x73, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
@@ -1319,66 +2909,122 @@ func file_project_proto_rawDescGZIP() []byte {
 	return file_project_proto_rawDescData
 }
 
-var file_project_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_project_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_project_proto_goTypes = []interface{}{
-	(*Project)(nil),                        // 0: pb.Project
-	(*CreateProjectRequest)(nil),           // 1: pb.CreateProjectRequest
-	(*GetAllProjectsRequest)(nil),          // 2: pb.GetAllProjectsRequest
-	(*GetAllProjectsResponse)(nil),         // 3: pb.GetAllProjectsResponse
-	(*GetProjectByIDRequest)(nil),          // 4: pb.GetProjectByIDRequest
-	(*UpdateProjectRequest)(nil),           // 5: pb.UpdateProjectRequest
-	(*DeleteProjectRequest)(nil),           // 6: pb.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil),          // 7: pb.DeleteProjectResponse
-	(*ScanProjectRequest)(nil),             // 8: pb.ScanProjectRequest
-	(*ScanProjectResponse)(nil),            // 9: pb.ScanProjectResponse
-	(*CandidateProject)(nil),               // 10: pb.CandidateProject
-	(*CreateCandidateProjectRequest)(nil),  // 11: pb.CreateCandidateProjectRequest
-	(*CreateCandidateProjectResponse)(nil), // 12: pb.CreateCandidateProjectResponse
-	(*DeleteCandidateProjectRequest)(nil),  // 13: pb.DeleteCandidateProjectRequest
-	(*DeleteCandidateProjectResponse)(nil), // 14: pb.DeleteCandidateProjectResponse
-	(*Rating)(nil),                         // 15: pb.Rating
-	(*CreateRatingRequest)(nil),            // 16: pb.CreateRatingRequest
-	(*CreateRatingResponse)(nil),           // 17: pb.CreateRatingResponse
-	(*DeleteRatingRequest)(nil),            // 18: pb.DeleteRatingRequest
-	(*DeleteRatingResponse)(nil),           // 19: pb.DeleteRatingResponse
-	(*timestamp.Timestamp)(nil),            // 20: google.protobuf.Timestamp
+	(*Project)(nil),                         // 0: pb.Project
+	(*CreateProjectRequest)(nil),            // 1: pb.CreateProjectRequest
+	(*GetAllProjectsRequest)(nil),           // 2: pb.GetAllProjectsRequest
+	(*GetAllProjectsResponse)(nil),          // 3: pb.GetAllProjectsResponse
+	(*GetProjectByIDRequest)(nil),           // 4: pb.GetProjectByIDRequest
+	(*UpdateProjectRequest)(nil),            // 5: pb.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),            // 6: pb.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),           // 7: pb.DeleteProjectResponse
+	(*ScanProjectRequest)(nil),              // 8: pb.ScanProjectRequest
+	(*ScanProjectResponse)(nil),             // 9: pb.ScanProjectResponse
+	(*ProjectMetadata)(nil),                 // 10: pb.ProjectMetadata
+	(*ProjectLanguage)(nil),                 // 11: pb.ProjectLanguage
+	(*ProjectContributor)(nil),              // 12: pb.ProjectContributor
+	(*RefreshProjectMetadataRequest)(nil),   // 13: pb.RefreshProjectMetadataRequest
+	(*GetProjectMetadataRequest)(nil),       // 14: pb.GetProjectMetadataRequest
+	(*ScanJob)(nil),                         // 15: pb.ScanJob
+	(*GetAllScanJobsRequest)(nil),           // 16: pb.GetAllScanJobsRequest
+	(*GetAllScanJobsResponse)(nil),          // 17: pb.GetAllScanJobsResponse
+	(*CandidateProject)(nil),                // 18: pb.CandidateProject
+	(*CreateCandidateProjectRequest)(nil),   // 19: pb.CreateCandidateProjectRequest
+	(*CreateCandidateProjectResponse)(nil),  // 20: pb.CreateCandidateProjectResponse
+	(*DeleteCandidateProjectRequest)(nil),   // 21: pb.DeleteCandidateProjectRequest
+	(*DeleteCandidateProjectResponse)(nil),  // 22: pb.DeleteCandidateProjectResponse
+	(*Rating)(nil),                          // 23: pb.Rating
+	(*CreateRatingRequest)(nil),             // 24: pb.CreateRatingRequest
+	(*CreateRatingResponse)(nil),            // 25: pb.CreateRatingResponse
+	(*DeleteRatingRequest)(nil),             // 26: pb.DeleteRatingRequest
+	(*DeleteRatingResponse)(nil),            // 27: pb.DeleteRatingResponse
+	(*GetProjectRatingHistoryRequest)(nil),  // 28: pb.GetProjectRatingHistoryRequest
+	(*GetProjectRatingHistoryResponse)(nil), // 29: pb.GetProjectRatingHistoryResponse
+	(*GetLatestRatingsRequest)(nil),         // 30: pb.GetLatestRatingsRequest
+	(*GetLatestRatingsResponse)(nil),        // 31: pb.GetLatestRatingsResponse
+	(*QualityScore)(nil),                    // 32: pb.QualityScore
+	(*GetAllQualityScoresRequest)(nil),      // 33: pb.GetAllQualityScoresRequest
+	(*GetAllQualityScoresResponse)(nil),     // 34: pb.GetAllQualityScoresResponse
+	(*CandidateDataRequest)(nil),            // 35: pb.CandidateDataRequest
+	(*CandidateData)(nil),                   // 36: pb.CandidateData
+	(*EraseCandidateDataResponse)(nil),      // 37: pb.EraseCandidateDataResponse
+	(*timestamppb.Timestamp)(nil),           // 38: google.protobuf.Timestamp
 }
 var file_project_proto_depIdxs = []int32{
-	15, // 0: pb.Project.ratings:type_name -> pb.Rating
-	20, // 1: pb.Project.created_at:type_name -> google.protobuf.Timestamp
-	20, // 2: pb.Project.updated_at:type_name -> google.protobuf.Timestamp
-	20, // 3: pb.Project.deleted_at:type_name -> google.protobuf.Timestamp
+	23, // 0: pb.Project.ratings:type_name -> pb.Rating
+	38, // 1: pb.Project.created_at:type_name -> google.protobuf.Timestamp
+	38, // 2: pb.Project.updated_at:type_name -> google.protobuf.Timestamp
+	38, // 3: pb.Project.deleted_at:type_name -> google.protobuf.Timestamp
 	0,  // 4: pb.CreateProjectRequest.project:type_name -> pb.Project
 	0,  // 5: pb.GetAllProjectsResponse.projects:type_name -> pb.Project
 	0,  // 6: pb.UpdateProjectRequest.project:type_name -> pb.Project
-	10, // 7: pb.CreateCandidateProjectRequest.candidate_project:type_name -> pb.CandidateProject
-	20, // 8: pb.Rating.created_at:type_name -> google.protobuf.Timestamp
-	15, // 9: pb.CreateRatingRequest.rating:type_name -> pb.Rating
-	1,  // 10: pb.ProjectService.CreateProject:input_type -> pb.CreateProjectRequest
-	2,  // 11: pb.ProjectService.GetAllProjects:input_type -> pb.GetAllProjectsRequest
-	4,  // 12: pb.ProjectService.GetProjectByID:input_type -> pb.GetProjectByIDRequest
-	5,  // 13: pb.ProjectService.UpdateProject:input_type -> pb.UpdateProjectRequest
-	6,  // 14: pb.ProjectService.DeleteProject:input_type -> pb.DeleteProjectRequest
-	8,  // 15: pb.ProjectService.ScanProject:input_type -> pb.ScanProjectRequest
-	11, // 16: pb.ProjectService.CreateCandidateProject:input_type -> pb.CreateCandidateProjectRequest
-	13, // 17: pb.ProjectService.DeleteCandidateProject:input_type -> pb.DeleteCandidateProjectRequest
-	16, // 18: pb.ProjectService.CreateRating:input_type -> pb.CreateRatingRequest
-	18, // 19: pb.ProjectService.DeleteRating:input_type -> pb.DeleteRatingRequest
-	0,  // 20: pb.ProjectService.CreateProject:output_type -> pb.Project
-	3,  // 21: pb.ProjectService.GetAllProjects:output_type -> pb.GetAllProjectsResponse
-	0,  // 22: pb.ProjectService.GetProjectByID:output_type -> pb.Project
-	0,  // 23: pb.ProjectService.UpdateProject:output_type -> pb.Project
-	7,  // 24: pb.ProjectService.DeleteProject:output_type -> pb.DeleteProjectResponse
-	9,  // 25: pb.ProjectService.ScanProject:output_type -> pb.ScanProjectResponse
-	12, // 26: pb.ProjectService.CreateCandidateProject:output_type -> pb.CreateCandidateProjectResponse
-	14, // 27: pb.ProjectService.DeleteCandidateProject:output_type -> pb.DeleteCandidateProjectResponse
-	17, // 28: pb.ProjectService.CreateRating:output_type -> pb.CreateRatingResponse
-	19, // 29: pb.ProjectService.DeleteRating:output_type -> pb.DeleteRatingResponse
-	20, // [20:30] is the sub-list for method output_type
-	10, // [10:20] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	15, // 7: pb.ScanProjectResponse.scan_job:type_name -> pb.ScanJob
+	38, // 8: pb.ProjectMetadata.last_commit_at:type_name -> google.protobuf.Timestamp
+	11, // 9: pb.ProjectMetadata.languages:type_name -> pb.ProjectLanguage
+	12, // 10: pb.ProjectMetadata.contributors:type_name -> pb.ProjectContributor
+	38, // 11: pb.ProjectMetadata.refreshed_at:type_name -> google.protobuf.Timestamp
+	38, // 12: pb.ScanJob.started_at:type_name -> google.protobuf.Timestamp
+	38, // 13: pb.ScanJob.finished_at:type_name -> google.protobuf.Timestamp
+	38, // 14: pb.ScanJob.created_at:type_name -> google.protobuf.Timestamp
+	38, // 15: pb.ScanJob.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 16: pb.GetAllScanJobsResponse.scan_jobs:type_name -> pb.ScanJob
+	38, // 17: pb.CandidateProject.verified_at:type_name -> google.protobuf.Timestamp
+	18, // 18: pb.CreateCandidateProjectRequest.candidate_project:type_name -> pb.CandidateProject
+	38, // 19: pb.Rating.created_at:type_name -> google.protobuf.Timestamp
+	23, // 20: pb.CreateRatingRequest.rating:type_name -> pb.Rating
+	38, // 21: pb.GetProjectRatingHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	38, // 22: pb.GetProjectRatingHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	23, // 23: pb.GetProjectRatingHistoryResponse.ratings:type_name -> pb.Rating
+	23, // 24: pb.GetLatestRatingsResponse.ratings:type_name -> pb.Rating
+	32, // 25: pb.GetAllQualityScoresResponse.quality_scores:type_name -> pb.QualityScore
+	1,  // 26: pb.ProjectService.CreateProject:input_type -> pb.CreateProjectRequest
+	2,  // 27: pb.ProjectService.GetAllProjects:input_type -> pb.GetAllProjectsRequest
+	2,  // 28: pb.ProjectService.LocalGetAllProjects:input_type -> pb.GetAllProjectsRequest
+	4,  // 29: pb.ProjectService.GetProjectByID:input_type -> pb.GetProjectByIDRequest
+	5,  // 30: pb.ProjectService.UpdateProject:input_type -> pb.UpdateProjectRequest
+	6,  // 31: pb.ProjectService.DeleteProject:input_type -> pb.DeleteProjectRequest
+	8,  // 32: pb.ProjectService.ScanProject:input_type -> pb.ScanProjectRequest
+	8,  // 33: pb.ProjectService.LocalScanProject:input_type -> pb.ScanProjectRequest
+	13, // 34: pb.ProjectService.RefreshProjectMetadata:input_type -> pb.RefreshProjectMetadataRequest
+	13, // 35: pb.ProjectService.LocalRefreshProjectMetadata:input_type -> pb.RefreshProjectMetadataRequest
+	14, // 36: pb.ProjectService.GetProjectMetadata:input_type -> pb.GetProjectMetadataRequest
+	16, // 37: pb.ProjectService.GetAllScanJobs:input_type -> pb.GetAllScanJobsRequest
+	19, // 38: pb.ProjectService.CreateCandidateProject:input_type -> pb.CreateCandidateProjectRequest
+	21, // 39: pb.ProjectService.DeleteCandidateProject:input_type -> pb.DeleteCandidateProjectRequest
+	24, // 40: pb.ProjectService.CreateRating:input_type -> pb.CreateRatingRequest
+	26, // 41: pb.ProjectService.DeleteRating:input_type -> pb.DeleteRatingRequest
+	28, // 42: pb.ProjectService.GetProjectRatingHistory:input_type -> pb.GetProjectRatingHistoryRequest
+	30, // 43: pb.ProjectService.GetLatestRatings:input_type -> pb.GetLatestRatingsRequest
+	33, // 44: pb.ProjectService.GetAllQualityScores:input_type -> pb.GetAllQualityScoresRequest
+	35, // 45: pb.ProjectService.LocalExportCandidateData:input_type -> pb.CandidateDataRequest
+	35, // 46: pb.ProjectService.LocalEraseCandidateData:input_type -> pb.CandidateDataRequest
+	0,  // 47: pb.ProjectService.CreateProject:output_type -> pb.Project
+	3,  // 48: pb.ProjectService.GetAllProjects:output_type -> pb.GetAllProjectsResponse
+	3,  // 49: pb.ProjectService.LocalGetAllProjects:output_type -> pb.GetAllProjectsResponse
+	0,  // 50: pb.ProjectService.GetProjectByID:output_type -> pb.Project
+	0,  // 51: pb.ProjectService.UpdateProject:output_type -> pb.Project
+	7,  // 52: pb.ProjectService.DeleteProject:output_type -> pb.DeleteProjectResponse
+	9,  // 53: pb.ProjectService.ScanProject:output_type -> pb.ScanProjectResponse
+	9,  // 54: pb.ProjectService.LocalScanProject:output_type -> pb.ScanProjectResponse
+	10, // 55: pb.ProjectService.RefreshProjectMetadata:output_type -> pb.ProjectMetadata
+	10, // 56: pb.ProjectService.LocalRefreshProjectMetadata:output_type -> pb.ProjectMetadata
+	10, // 57: pb.ProjectService.GetProjectMetadata:output_type -> pb.ProjectMetadata
+	17, // 58: pb.ProjectService.GetAllScanJobs:output_type -> pb.GetAllScanJobsResponse
+	20, // 59: pb.ProjectService.CreateCandidateProject:output_type -> pb.CreateCandidateProjectResponse
+	22, // 60: pb.ProjectService.DeleteCandidateProject:output_type -> pb.DeleteCandidateProjectResponse
+	25, // 61: pb.ProjectService.CreateRating:output_type -> pb.CreateRatingResponse
+	27, // 62: pb.ProjectService.DeleteRating:output_type -> pb.DeleteRatingResponse
+	29, // 63: pb.ProjectService.GetProjectRatingHistory:output_type -> pb.GetProjectRatingHistoryResponse
+	31, // 64: pb.ProjectService.GetLatestRatings:output_type -> pb.GetLatestRatingsResponse
+	34, // 65: pb.ProjectService.GetAllQualityScores:output_type -> pb.GetAllQualityScoresResponse
+	36, // 66: pb.ProjectService.LocalExportCandidateData:output_type -> pb.CandidateData
+	37, // 67: pb.ProjectService.LocalEraseCandidateData:output_type -> pb.EraseCandidateDataResponse
+	47, // [47:68] is the sub-list for method output_type
+	26, // [26:47] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_project_proto_init() }
@@ -1508,7 +3154,7 @@ func file_project_proto_init() {
 			}
 		}
 		file_project_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CandidateProject); i {
+			switch v := v.(*ProjectMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1520,7 +3166,7 @@ func file_project_proto_init() {
 			}
 		}
 		file_project_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCandidateProjectRequest); i {
+			switch v := v.(*ProjectLanguage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1532,7 +3178,7 @@ func file_project_proto_init() {
 			}
 		}
 		file_project_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCandidateProjectResponse); i {
+			switch v := v.(*ProjectContributor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1544,7 +3190,7 @@ func file_project_proto_init() {
 			}
 		}
 		file_project_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCandidateProjectRequest); i {
+			switch v := v.(*RefreshProjectMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1556,6 +3202,102 @@ func file_project_proto_init() {
 			}
 		}
 		file_project_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllScanJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllScanJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandidateProject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCandidateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCandidateProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCandidateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteCandidateProjectResponse); i {
 			case 0:
 				return &v.state
@@ -1567,8 +3309,128 @@ func file_project_proto_init() {
 				return nil
 			}
 		}
-		file_project_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rating); i {
+		file_project_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRatingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRatingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRatingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRatingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectRatingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectRatingHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestRatingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestRatingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualityScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_project_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllQualityScoresRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1579,8 +3441,8 @@ func file_project_proto_init() {
 				return nil
 			}
 		}
-		file_project_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRatingRequest); i {
+		file_project_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllQualityScoresResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1591,8 +3453,8 @@ func file_project_proto_init() {
 				return nil
 			}
 		}
-		file_project_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRatingResponse); i {
+		file_project_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandidateDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1603,8 +3465,8 @@ func file_project_proto_init() {
 				return nil
 			}
 		}
-		file_project_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRatingRequest); i {
+		file_project_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandidateData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1615,8 +3477,8 @@ func file_project_proto_init() {
 				return nil
 			}
 		}
-		file_project_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRatingResponse); i {
+		file_project_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseCandidateDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,7 +3496,7 @@ func file_project_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_project_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1662,14 +3524,27 @@ const _ = grpc.SupportPackageIsVersion6
 type ProjectServiceClient interface {
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error)
 	GetAllProjects(ctx context.Context, in *GetAllProjectsRequest, opts ...grpc.CallOption) (*GetAllProjectsResponse, error)
+	LocalGetAllProjects(ctx context.Context, in *GetAllProjectsRequest, opts ...grpc.CallOption) (*GetAllProjectsResponse, error)
 	GetProjectByID(ctx context.Context, in *GetProjectByIDRequest, opts ...grpc.CallOption) (*Project, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
 	ScanProject(ctx context.Context, in *ScanProjectRequest, opts ...grpc.CallOption) (*ScanProjectResponse, error)
+	LocalScanProject(ctx context.Context, in *ScanProjectRequest, opts ...grpc.CallOption) (*ScanProjectResponse, error)
+	RefreshProjectMetadata(ctx context.Context, in *RefreshProjectMetadataRequest, opts ...grpc.CallOption) (*ProjectMetadata, error)
+	LocalRefreshProjectMetadata(ctx context.Context, in *RefreshProjectMetadataRequest, opts ...grpc.CallOption) (*ProjectMetadata, error)
+	GetProjectMetadata(ctx context.Context, in *GetProjectMetadataRequest, opts ...grpc.CallOption) (*ProjectMetadata, error)
+	GetAllScanJobs(ctx context.Context, in *GetAllScanJobsRequest, opts ...grpc.CallOption) (*GetAllScanJobsResponse, error)
 	CreateCandidateProject(ctx context.Context, in *CreateCandidateProjectRequest, opts ...grpc.CallOption) (*CreateCandidateProjectResponse, error)
 	DeleteCandidateProject(ctx context.Context, in *DeleteCandidateProjectRequest, opts ...grpc.CallOption) (*DeleteCandidateProjectResponse, error)
 	CreateRating(ctx context.Context, in *CreateRatingRequest, opts ...grpc.CallOption) (*CreateRatingResponse, error)
 	DeleteRating(ctx context.Context, in *DeleteRatingRequest, opts ...grpc.CallOption) (*DeleteRatingResponse, error)
+	GetProjectRatingHistory(ctx context.Context, in *GetProjectRatingHistoryRequest, opts ...grpc.CallOption) (*GetProjectRatingHistoryResponse, error)
+	GetLatestRatings(ctx context.Context, in *GetLatestRatingsRequest, opts ...grpc.CallOption) (*GetLatestRatingsResponse, error)
+	// Candidates are ranked by quality score here, sorted by score by default. GetAllCandidates of the
+	// profile service does not sort by quality score, as the scores are kept by this service
+	GetAllQualityScores(ctx context.Context, in *GetAllQualityScoresRequest, opts ...grpc.CallOption) (*GetAllQualityScoresResponse, error)
+	LocalExportCandidateData(ctx context.Context, in *CandidateDataRequest, opts ...grpc.CallOption) (*CandidateData, error)
+	LocalEraseCandidateData(ctx context.Context, in *CandidateDataRequest, opts ...grpc.CallOption) (*EraseCandidateDataResponse, error)
 }
 
 type projectServiceClient struct {
@@ -1698,6 +3573,15 @@ func (c *projectServiceClient) GetAllProjects(ctx context.Context, in *GetAllPro
 	return out, nil
 }
 
+func (c *projectServiceClient) LocalGetAllProjects(ctx context.Context, in *GetAllProjectsRequest, opts ...grpc.CallOption) (*GetAllProjectsResponse, error) {
+	out := new(GetAllProjectsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/LocalGetAllProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) GetProjectByID(ctx context.Context, in *GetProjectByIDRequest, opts ...grpc.CallOption) (*Project, error) {
 	out := new(Project)
 	err := c.cc.Invoke(ctx, "/pb.ProjectService/GetProjectByID", in, out, opts...)
@@ -1734,6 +3618,51 @@ func (c *projectServiceClient) ScanProject(ctx context.Context, in *ScanProjectR
 	return out, nil
 }
 
+func (c *projectServiceClient) LocalScanProject(ctx context.Context, in *ScanProjectRequest, opts ...grpc.CallOption) (*ScanProjectResponse, error) {
+	out := new(ScanProjectResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/LocalScanProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RefreshProjectMetadata(ctx context.Context, in *RefreshProjectMetadataRequest, opts ...grpc.CallOption) (*ProjectMetadata, error) {
+	out := new(ProjectMetadata)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/RefreshProjectMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) LocalRefreshProjectMetadata(ctx context.Context, in *RefreshProjectMetadataRequest, opts ...grpc.CallOption) (*ProjectMetadata, error) {
+	out := new(ProjectMetadata)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/LocalRefreshProjectMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProjectMetadata(ctx context.Context, in *GetProjectMetadataRequest, opts ...grpc.CallOption) (*ProjectMetadata, error) {
+	out := new(ProjectMetadata)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/GetProjectMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetAllScanJobs(ctx context.Context, in *GetAllScanJobsRequest, opts ...grpc.CallOption) (*GetAllScanJobsResponse, error) {
+	out := new(GetAllScanJobsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/GetAllScanJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) CreateCandidateProject(ctx context.Context, in *CreateCandidateProjectRequest, opts ...grpc.CallOption) (*CreateCandidateProjectResponse, error) {
 	out := new(CreateCandidateProjectResponse)
 	err := c.cc.Invoke(ctx, "/pb.ProjectService/CreateCandidateProject", in, out, opts...)
@@ -1770,18 +3699,76 @@ func (c *projectServiceClient) DeleteRating(ctx context.Context, in *DeleteRatin
 	return out, nil
 }
 
+func (c *projectServiceClient) GetProjectRatingHistory(ctx context.Context, in *GetProjectRatingHistoryRequest, opts ...grpc.CallOption) (*GetProjectRatingHistoryResponse, error) {
+	out := new(GetProjectRatingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/GetProjectRatingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetLatestRatings(ctx context.Context, in *GetLatestRatingsRequest, opts ...grpc.CallOption) (*GetLatestRatingsResponse, error) {
+	out := new(GetLatestRatingsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/GetLatestRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetAllQualityScores(ctx context.Context, in *GetAllQualityScoresRequest, opts ...grpc.CallOption) (*GetAllQualityScoresResponse, error) {
+	out := new(GetAllQualityScoresResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/GetAllQualityScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) LocalExportCandidateData(ctx context.Context, in *CandidateDataRequest, opts ...grpc.CallOption) (*CandidateData, error) {
+	out := new(CandidateData)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/LocalExportCandidateData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) LocalEraseCandidateData(ctx context.Context, in *CandidateDataRequest, opts ...grpc.CallOption) (*EraseCandidateDataResponse, error) {
+	out := new(EraseCandidateDataResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProjectService/LocalEraseCandidateData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 type ProjectServiceServer interface {
 	CreateProject(context.Context, *CreateProjectRequest) (*Project, error)
 	GetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error)
+	LocalGetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error)
 	GetProjectByID(context.Context, *GetProjectByIDRequest) (*Project, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*Project, error)
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
 	ScanProject(context.Context, *ScanProjectRequest) (*ScanProjectResponse, error)
+	LocalScanProject(context.Context, *ScanProjectRequest) (*ScanProjectResponse, error)
+	RefreshProjectMetadata(context.Context, *RefreshProjectMetadataRequest) (*ProjectMetadata, error)
+	LocalRefreshProjectMetadata(context.Context, *RefreshProjectMetadataRequest) (*ProjectMetadata, error)
+	GetProjectMetadata(context.Context, *GetProjectMetadataRequest) (*ProjectMetadata, error)
+	GetAllScanJobs(context.Context, *GetAllScanJobsRequest) (*GetAllScanJobsResponse, error)
 	CreateCandidateProject(context.Context, *CreateCandidateProjectRequest) (*CreateCandidateProjectResponse, error)
 	DeleteCandidateProject(context.Context, *DeleteCandidateProjectRequest) (*DeleteCandidateProjectResponse, error)
 	CreateRating(context.Context, *CreateRatingRequest) (*CreateRatingResponse, error)
 	DeleteRating(context.Context, *DeleteRatingRequest) (*DeleteRatingResponse, error)
+	GetProjectRatingHistory(context.Context, *GetProjectRatingHistoryRequest) (*GetProjectRatingHistoryResponse, error)
+	GetLatestRatings(context.Context, *GetLatestRatingsRequest) (*GetLatestRatingsResponse, error)
+	// Candidates are ranked by quality score here, sorted by score by default. GetAllCandidates of the
+	// profile service does not sort by quality score, as the scores are kept by this service
+	GetAllQualityScores(context.Context, *GetAllQualityScoresRequest) (*GetAllQualityScoresResponse, error)
+	LocalExportCandidateData(context.Context, *CandidateDataRequest) (*CandidateData, error)
+	LocalEraseCandidateData(context.Context, *CandidateDataRequest) (*EraseCandidateDataResponse, error)
 }
 
 // UnimplementedProjectServiceServer can be embedded to have forward compatible implementations.
@@ -1794,6 +3781,9 @@ func (*UnimplementedProjectServiceServer) CreateProject(context.Context, *Create
 func (*UnimplementedProjectServiceServer) GetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProjects not implemented")
 }
+func (*UnimplementedProjectServiceServer) LocalGetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalGetAllProjects not implemented")
+}
 func (*UnimplementedProjectServiceServer) GetProjectByID(context.Context, *GetProjectByIDRequest) (*Project, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProjectByID not implemented")
 }
@@ -1806,6 +3796,21 @@ func (*UnimplementedProjectServiceServer) DeleteProject(context.Context, *Delete
 func (*UnimplementedProjectServiceServer) ScanProject(context.Context, *ScanProjectRequest) (*ScanProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScanProject not implemented")
 }
+func (*UnimplementedProjectServiceServer) LocalScanProject(context.Context, *ScanProjectRequest) (*ScanProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalScanProject not implemented")
+}
+func (*UnimplementedProjectServiceServer) RefreshProjectMetadata(context.Context, *RefreshProjectMetadataRequest) (*ProjectMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshProjectMetadata not implemented")
+}
+func (*UnimplementedProjectServiceServer) LocalRefreshProjectMetadata(context.Context, *RefreshProjectMetadataRequest) (*ProjectMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalRefreshProjectMetadata not implemented")
+}
+func (*UnimplementedProjectServiceServer) GetProjectMetadata(context.Context, *GetProjectMetadataRequest) (*ProjectMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectMetadata not implemented")
+}
+func (*UnimplementedProjectServiceServer) GetAllScanJobs(context.Context, *GetAllScanJobsRequest) (*GetAllScanJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllScanJobs not implemented")
+}
 func (*UnimplementedProjectServiceServer) CreateCandidateProject(context.Context, *CreateCandidateProjectRequest) (*CreateCandidateProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCandidateProject not implemented")
 }
@@ -1818,6 +3823,21 @@ func (*UnimplementedProjectServiceServer) CreateRating(context.Context, *CreateR
 func (*UnimplementedProjectServiceServer) DeleteRating(context.Context, *DeleteRatingRequest) (*DeleteRatingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRating not implemented")
 }
+func (*UnimplementedProjectServiceServer) GetProjectRatingHistory(context.Context, *GetProjectRatingHistoryRequest) (*GetProjectRatingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectRatingHistory not implemented")
+}
+func (*UnimplementedProjectServiceServer) GetLatestRatings(context.Context, *GetLatestRatingsRequest) (*GetLatestRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestRatings not implemented")
+}
+func (*UnimplementedProjectServiceServer) GetAllQualityScores(context.Context, *GetAllQualityScoresRequest) (*GetAllQualityScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllQualityScores not implemented")
+}
+func (*UnimplementedProjectServiceServer) LocalExportCandidateData(context.Context, *CandidateDataRequest) (*CandidateData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalExportCandidateData not implemented")
+}
+func (*UnimplementedProjectServiceServer) LocalEraseCandidateData(context.Context, *CandidateDataRequest) (*EraseCandidateDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalEraseCandidateData not implemented")
+}
 
 func RegisterProjectServiceServer(s *grpc.Server, srv ProjectServiceServer) {
 	s.RegisterService(&_ProjectService_serviceDesc, srv)
@@ -1859,6 +3879,24 @@ func _ProjectService_GetAllProjects_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_LocalGetAllProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).LocalGetAllProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/LocalGetAllProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).LocalGetAllProjects(ctx, req.(*GetAllProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_GetProjectByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProjectByIDRequest)
 	if err := dec(in); err != nil {
@@ -1931,6 +3969,96 @@ func _ProjectService_ScanProject_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_LocalScanProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).LocalScanProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/LocalScanProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).LocalScanProject(ctx, req.(*ScanProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RefreshProjectMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshProjectMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RefreshProjectMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/RefreshProjectMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RefreshProjectMetadata(ctx, req.(*RefreshProjectMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_LocalRefreshProjectMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshProjectMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).LocalRefreshProjectMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/LocalRefreshProjectMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).LocalRefreshProjectMetadata(ctx, req.(*RefreshProjectMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProjectMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/GetProjectMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectMetadata(ctx, req.(*GetProjectMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetAllScanJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllScanJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetAllScanJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/GetAllScanJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetAllScanJobs(ctx, req.(*GetAllScanJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_CreateCandidateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCandidateProjectRequest)
 	if err := dec(in); err != nil {
@@ -2003,6 +4131,96 @@ func _ProjectService_DeleteRating_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_GetProjectRatingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRatingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectRatingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/GetProjectRatingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectRatingHistory(ctx, req.(*GetProjectRatingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetLatestRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetLatestRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/GetLatestRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetLatestRatings(ctx, req.(*GetLatestRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetAllQualityScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllQualityScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetAllQualityScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/GetAllQualityScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetAllQualityScores(ctx, req.(*GetAllQualityScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_LocalExportCandidateData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandidateDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).LocalExportCandidateData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/LocalExportCandidateData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).LocalExportCandidateData(ctx, req.(*CandidateDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_LocalEraseCandidateData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandidateDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).LocalEraseCandidateData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProjectService/LocalEraseCandidateData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).LocalEraseCandidateData(ctx, req.(*CandidateDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProjectService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.ProjectService",
 	HandlerType: (*ProjectServiceServer)(nil),
@@ -2015,6 +4233,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllProjects",
 			Handler:    _ProjectService_GetAllProjects_Handler,
 		},
+		{
+			MethodName: "LocalGetAllProjects",
+			Handler:    _ProjectService_LocalGetAllProjects_Handler,
+		},
 		{
 			MethodName: "GetProjectByID",
 			Handler:    _ProjectService_GetProjectByID_Handler,
@@ -2031,6 +4253,26 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ScanProject",
 			Handler:    _ProjectService_ScanProject_Handler,
 		},
+		{
+			MethodName: "LocalScanProject",
+			Handler:    _ProjectService_LocalScanProject_Handler,
+		},
+		{
+			MethodName: "RefreshProjectMetadata",
+			Handler:    _ProjectService_RefreshProjectMetadata_Handler,
+		},
+		{
+			MethodName: "LocalRefreshProjectMetadata",
+			Handler:    _ProjectService_LocalRefreshProjectMetadata_Handler,
+		},
+		{
+			MethodName: "GetProjectMetadata",
+			Handler:    _ProjectService_GetProjectMetadata_Handler,
+		},
+		{
+			MethodName: "GetAllScanJobs",
+			Handler:    _ProjectService_GetAllScanJobs_Handler,
+		},
 		{
 			MethodName: "CreateCandidateProject",
 			Handler:    _ProjectService_CreateCandidateProject_Handler,
@@ -2047,6 +4289,26 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRating",
 			Handler:    _ProjectService_DeleteRating_Handler,
 		},
+		{
+			MethodName: "GetProjectRatingHistory",
+			Handler:    _ProjectService_GetProjectRatingHistory_Handler,
+		},
+		{
+			MethodName: "GetLatestRatings",
+			Handler:    _ProjectService_GetLatestRatings_Handler,
+		},
+		{
+			MethodName: "GetAllQualityScores",
+			Handler:    _ProjectService_GetAllQualityScores_Handler,
+		},
+		{
+			MethodName: "LocalExportCandidateData",
+			Handler:    _ProjectService_LocalExportCandidateData_Handler,
+		},
+		{
+			MethodName: "LocalEraseCandidateData",
+			Handler:    _ProjectService_LocalEraseCandidateData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "project.proto",
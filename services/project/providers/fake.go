@@ -0,0 +1,187 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FakeGitHost is an http.Handler that serves the parts of the APIs of GitHub and GitLab used by GitHosts,
+// from Repositories in memory keyed by their path, so that imports can be tested without calling the hosts.
+// GitHub's API is served under /repos and GitLab's API under /projects
+type FakeGitHost struct {
+	Repositories map[string]*Repository
+	// PageSize is the largest number of contributors in a page, which defaults to the requested page size
+	PageSize int
+
+	mu     sync.Mutex
+	header http.Header
+}
+
+// NewFakeGitHostServer starts and returns a new server of a FakeGitHost with repos,
+// which should be closed by the caller
+func NewFakeGitHostServer(repos map[string]*Repository) (*httptest.Server, *FakeGitHost) {
+	f := &FakeGitHost{Repositories: repos}
+	return httptest.NewServer(f), f
+}
+
+// Header returns the headers of the last request to f
+func (f *FakeGitHost) Header() http.Header {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.header
+}
+
+func (f *FakeGitHost) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.header = r.Header.Clone()
+	f.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/repos/"):
+		f.serveGitHub(w, r)
+	case strings.HasPrefix(r.URL.Path, "/projects/"):
+		f.serveGitLab(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *FakeGitHost) serveGitHub(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/repos/")
+	resource := ""
+	for _, suffix := range []string{"/languages", "/contributors", "/commits"} {
+		if strings.HasSuffix(path, suffix) {
+			path, resource = strings.TrimSuffix(path, suffix), suffix
+			break
+		}
+	}
+	repo, ok := f.Repositories[path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch resource {
+	case "":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"full_name": path, "stargazers_count": repo.Stars})
+	case "/languages":
+		bytes := make(map[string]uint64, len(repo.Languages))
+		for lang, share := range repo.Languages {
+			bytes[lang] = uint64(share * 100)
+		}
+		writeJSON(w, http.StatusOK, bytes)
+	case "/contributors":
+		start, end, next := f.page(r, len(repo.Contributors))
+		if end == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		res := []*githubContributor{}
+		for _, c := range repo.Contributors[start:end] {
+			gc := &githubContributor{Login: c.Username, Contributions: c.Commits}
+			if c.Username == "" {
+				gc.Name, gc.Email = c.Name, c.Email
+			}
+			res = append(res, gc)
+		}
+		if next > 0 {
+			u := *r.URL
+			u.Scheme, u.Host = "http", r.Host
+			q := u.Query()
+			q.Set("page", strconv.Itoa(next))
+			u.RawQuery = q.Encode()
+			w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, u.String()))
+		}
+		writeJSON(w, http.StatusOK, res)
+	case "/commits":
+		if repo.LastCommitAt == nil {
+			writeJSON(w, http.StatusConflict, map[string]string{"message": "Git Repository is empty."})
+			return
+		}
+		c := &githubCommit{}
+		c.Commit.Committer.Date = repo.LastCommitAt
+		writeJSON(w, http.StatusOK, []*githubCommit{c})
+	}
+}
+
+func (f *FakeGitHost) serveGitLab(w http.ResponseWriter, r *http.Request) {
+	// the path of the project is url encoded as a single segment
+	escaped := strings.TrimPrefix(r.URL.EscapedPath(), "/projects/")
+	resource := ""
+	if i := strings.Index(escaped, "/"); i >= 0 {
+		escaped, resource = escaped[:i], escaped[i:]
+	}
+	path, err := url.PathUnescape(escaped)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	repo, ok := f.Repositories[path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch resource {
+	case "":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"path_with_namespace": path, "star_count": repo.Stars})
+	case "/languages":
+		writeJSON(w, http.StatusOK, repo.Languages)
+	case "/repository/contributors":
+		start, end, next := f.page(r, len(repo.Contributors))
+		res := []*gitlabContributor{}
+		for _, c := range repo.Contributors[start:end] {
+			res = append(res, &gitlabContributor{Name: c.Name, Email: c.Email, Commits: c.Commits})
+		}
+		if next > 0 {
+			w.Header().Set("X-Next-Page", strconv.Itoa(next))
+		}
+		writeJSON(w, http.StatusOK, res)
+	case "/repository/commits":
+		res := []*gitlabCommit{}
+		if repo.LastCommitAt != nil {
+			res = append(res, &gitlabCommit{CommittedDate: repo.LastCommitAt})
+		}
+		writeJSON(w, http.StatusOK, res)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// page returns the range of n items in the page requested in r, and the number of the next page,
+// which is zero on the last page
+func (f *FakeGitHost) page(r *http.Request, n int) (int, int, int) {
+	size, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if f.PageSize > 0 && (size <= 0 || size > f.PageSize) {
+		size = f.PageSize
+	}
+	if size <= 0 {
+		size = n
+	}
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	start := (page - 1) * size
+	if start > n {
+		start = n
+	}
+	end := start + size
+	if end >= n {
+		return start, n, 0
+	}
+	return start, end, page + 1
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
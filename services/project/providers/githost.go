@@ -0,0 +1,159 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"in-backend/services/project"
+	"in-backend/services/project/configs"
+)
+
+// Hosts of repositories that metadata can be imported from
+const (
+	HostGitHub string = "github.com"
+	HostGitLab string = "gitlab.com"
+)
+
+// maxPages is the largest number of pages of contributors requested from a git host,
+// which bounds the requests made for very large repositories
+const maxPages = 5
+
+var (
+	// ErrRepositoryNotFound is returned when a git host does not have a repository,
+	// or the repository is private
+	ErrRepositoryNotFound = errors.New("Repository not found")
+
+	errInvalidRepoURL = errors.New("Invalid repository URL")
+)
+
+// Repository declares the metadata of a repository on a git host
+type Repository struct {
+	Host  string
+	Stars uint64
+	// Commits is the number of commits of all Contributors
+	Commits      uint64
+	LastCommitAt *time.Time
+	// Languages maps the languages of the repository to the percentage of code in them
+	Languages    map[string]float32
+	Contributors []*Contributor
+}
+
+// Contributor declares a contributor to a repository.
+// Hosts only return some of the fields, for example GitHub does not return the emails of its users,
+// and GitLab only returns the name and email of the commit author
+type Contributor struct {
+	Username string
+	Name     string
+	Email    string
+	Commits  uint64
+}
+
+// GitHost describes a git host that the metadata of repositories can be imported from
+type GitHost interface {
+	// GetRepository returns the metadata of the repository at path, such as owner/repo
+	GetRepository(ctx context.Context, path string) (*Repository, error)
+}
+
+// GitHosts maps hostnames to the GitHost of repositories on them
+type GitHosts map[string]GitHost
+
+// NewGitHosts creates and returns the GitHosts of GitHub and GitLab configured in cfg
+func NewGitHosts(cfg configs.Config, c project.HTTPClient) GitHosts {
+	return GitHosts{
+		HostGitHub: NewGitHub(cfg.GitHub, c),
+		HostGitLab: NewGitLab(cfg.GitLab, c),
+	}
+}
+
+// Resolve returns the GitHost and the path of the repository at repoURL.
+// Both https and scp-like ssh URLs are accepted
+func (h GitHosts) Resolve(repoURL string) (GitHost, string, error) {
+	host, path, err := splitRepoURL(repoURL)
+	if err != nil {
+		return nil, "", err
+	}
+	g, ok := h[host]
+	if !ok {
+		return nil, "", fmt.Errorf("Importing metadata from %s is not supported", host)
+	}
+	return g, path, nil
+}
+
+// splitRepoURL splits repoURL into its lower case hostname and its path without the .git suffix
+func splitRepoURL(repoURL string) (string, string, error) {
+	raw := strings.TrimSpace(repoURL)
+	// scp-like URLs such as git@github.com:owner/repo.git
+	if !strings.Contains(raw, "://") {
+		if i := strings.Index(raw, ":"); i > 0 {
+			raw = "ssh://" + raw[:i] + "/" + raw[i+1:]
+		}
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return "", "", errInvalidRepoURL
+	}
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if strings.Count(path, "/") < 1 {
+		return "", "", errInvalidRepoURL
+	}
+	return strings.ToLower(u.Hostname()), path, nil
+}
+
+// sumCommits returns the number of commits of cs
+func sumCommits(cs []*Contributor) uint64 {
+	var n uint64
+	for _, c := range cs {
+		n += c.Commits
+	}
+	return n
+}
+
+// statusError is returned when a git host responds with an unexpected status
+type statusError struct {
+	host string
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s returned status %d", e.host, e.code)
+}
+
+// getJSON gets u with header from a git host and decodes the response into v,
+// which is left unchanged if the response has no content.
+// It returns the headers of the response so that the next page can be found
+func getJSON(ctx context.Context, c project.HTTPClient, host, u string, header http.Header, v interface{}) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+
+	res, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrRepositoryNotFound
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, &statusError{host: host, code: res.StatusCode}
+	}
+	if res.StatusCode == http.StatusNoContent {
+		return res.Header, nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return nil, fmt.Errorf("Failed to decode response of %s: %v", host, err)
+	}
+	return res.Header, nil
+}
@@ -0,0 +1,141 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"in-backend/services/project/configs"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	hosts := NewGitHosts(configs.Config{}, http.DefaultClient)
+
+	var tests = []struct {
+		name     string
+		repoURL  string
+		wantHost GitHost
+		wantPath string
+		wantErr  bool
+	}{
+		{"github", "https://github.com/octocat/hello-world", &githubHost{}, "octocat/hello-world", false},
+		{"github .git", "https://github.com/octocat/hello-world.git", &githubHost{}, "octocat/hello-world", false},
+		{"github ssh", "git@github.com:octocat/hello-world.git", &githubHost{}, "octocat/hello-world", false},
+		{"gitlab subgroup", "https://GitLab.com/group/sub/project/", &gitlabHost{}, "group/sub/project", false},
+		{"unsupported host", "https://bitbucket.org/octocat/hello-world", nil, "", true},
+		{"no repository", "https://github.com/octocat", nil, "", true},
+		{"invalid", "hello world", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, path, err := hosts.Resolve(tt.repoURL)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tt.wantHost, got)
+			require.Equal(t, tt.wantPath, path)
+		})
+	}
+}
+
+func newTestRepository() *Repository {
+	lastCommitAt := time.Date(2020, 10, 1, 8, 30, 0, 0, time.UTC)
+	return &Repository{
+		Stars:        42,
+		LastCommitAt: &lastCommitAt,
+		Languages:    map[string]float32{"Go": 75, "Shell": 25},
+		Contributors: []*Contributor{
+			{Username: "octocat", Name: "The Octocat", Email: "octocat@github.com", Commits: 6},
+			{Username: "hubot", Name: "Hubot", Email: "hubot@github.com", Commits: 3},
+			{Name: "Anonymous", Email: "anon@example.com", Commits: 1},
+		},
+	}
+}
+
+func TestGitHub(t *testing.T) {
+	srv, fake := NewFakeGitHostServer(map[string]*Repository{
+		"octocat/hello-world": newTestRepository(),
+		"octocat/empty":       {},
+	})
+	defer srv.Close()
+	fake.PageSize = 2
+
+	g := NewGitHub(configs.GitHub{APIURL: srv.URL, Token: "secret"}, srv.Client())
+	ctx := context.Background()
+
+	got, err := g.GetRepository(ctx, "octocat/hello-world")
+	require.NoError(t, err)
+	require.Equal(t, "token secret", fake.Header().Get("Authorization"))
+	require.Equal(t, HostGitHub, got.Host)
+	require.Equal(t, uint64(42), got.Stars)
+	require.Equal(t, uint64(10), got.Commits)
+	require.True(t, newTestRepository().LastCommitAt.Equal(*got.LastCommitAt))
+	require.Equal(t, map[string]float32{"Go": 75, "Shell": 25}, got.Languages)
+	// GitHub returns logins for users, and names and emails for anonymous contributors
+	require.Equal(t, []*Contributor{
+		{Username: "octocat", Commits: 6},
+		{Username: "hubot", Commits: 3},
+		{Name: "Anonymous", Email: "anon@example.com", Commits: 1},
+	}, got.Contributors)
+
+	got, err = g.GetRepository(ctx, "octocat/empty")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), got.Commits)
+	require.Nil(t, got.LastCommitAt)
+	require.Empty(t, got.Contributors)
+
+	_, err = g.GetRepository(ctx, "octocat/missing")
+	require.Equal(t, ErrRepositoryNotFound, err)
+
+	g = NewGitHub(configs.GitHub{APIURL: srv.URL}, srv.Client())
+	_, err = g.GetRepository(ctx, "octocat/hello-world")
+	require.NoError(t, err)
+	require.Empty(t, fake.Header().Get("Authorization"))
+}
+
+func TestGitLab(t *testing.T) {
+	srv, fake := NewFakeGitHostServer(map[string]*Repository{
+		"group/sub/project": newTestRepository(),
+		"group/empty":       {},
+	})
+	defer srv.Close()
+	fake.PageSize = 2
+
+	g := NewGitLab(configs.GitLab{APIURL: srv.URL + "/", Token: "secret"}, srv.Client())
+	ctx := context.Background()
+
+	got, err := g.GetRepository(ctx, "group/sub/project")
+	require.NoError(t, err)
+	require.Equal(t, "secret", fake.Header().Get("PRIVATE-TOKEN"))
+	require.Equal(t, HostGitLab, got.Host)
+	require.Equal(t, uint64(42), got.Stars)
+	require.Equal(t, uint64(10), got.Commits)
+	require.True(t, newTestRepository().LastCommitAt.Equal(*got.LastCommitAt))
+	require.Equal(t, map[string]float32{"Go": 75, "Shell": 25}, got.Languages)
+	// GitLab only returns the names and emails of commit authors
+	require.Equal(t, []*Contributor{
+		{Name: "The Octocat", Email: "octocat@github.com", Commits: 6},
+		{Name: "Hubot", Email: "hubot@github.com", Commits: 3},
+		{Name: "Anonymous", Email: "anon@example.com", Commits: 1},
+	}, got.Contributors)
+
+	got, err = g.GetRepository(ctx, "group/empty")
+	require.NoError(t, err)
+	require.Nil(t, got.LastCommitAt)
+	require.Empty(t, got.Contributors)
+	require.Empty(t, got.Languages)
+
+	_, err = g.GetRepository(ctx, "group/missing")
+	require.Equal(t, ErrRepositoryNotFound, err)
+}
+
+func TestLanguageShares(t *testing.T) {
+	require.Equal(t, map[string]float32{}, languageShares(nil))
+	require.Equal(t, map[string]float32{"Go": 80, "Makefile": 20}, languageShares(map[string]uint64{"Go": 4000, "Makefile": 1000}))
+}
@@ -0,0 +1,148 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"in-backend/services/project"
+	"in-backend/services/project/configs"
+)
+
+const defaultGitHubAPIURL = "https://api.github.com"
+
+// githubNextLink matches the URL of the next page in the Link header of GitHub's API
+var githubNextLink = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+type githubHost struct {
+	url    string
+	header http.Header
+	client project.HTTPClient
+}
+
+// NewGitHub creates and returns a new GitHost backed by the API of GitHub.
+// Requests are anonymous unless a token is configured
+func NewGitHub(cfg configs.GitHub, c project.HTTPClient) GitHost {
+	apiURL := cfg.APIURL
+	if apiURL == "" {
+		apiURL = defaultGitHubAPIURL
+	}
+	header := http.Header{}
+	header.Set("Accept", "application/vnd.github.v3+json")
+	if cfg.Token != "" {
+		header.Set("Authorization", "token "+cfg.Token)
+	}
+	return &githubHost{
+		url:    strings.TrimSuffix(apiURL, "/"),
+		header: header,
+		client: c,
+	}
+}
+
+// githubContributor declares the model of a contributor response from GitHub's API.
+// Anonymous contributors have a name and email instead of a login
+type githubContributor struct {
+	Login         string `json:"login"`
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+	Contributions uint64 `json:"contributions"`
+}
+
+// githubCommit declares the model of a commit response from GitHub's API
+type githubCommit struct {
+	Commit struct {
+		Committer struct {
+			Date *time.Time `json:"date"`
+		} `json:"committer"`
+	} `json:"commit"`
+}
+
+// GetRepository returns the metadata of the GitHub repository at path
+func (g *githubHost) GetRepository(ctx context.Context, path string) (*Repository, error) {
+	base := g.url + "/repos/" + path
+
+	var repo struct {
+		Stars uint64 `json:"stargazers_count"`
+	}
+	if _, err := g.get(ctx, base, &repo); err != nil {
+		return nil, err
+	}
+
+	var bytes map[string]uint64
+	if _, err := g.get(ctx, base+"/languages", &bytes); err != nil {
+		return nil, err
+	}
+
+	contributors, err := g.getContributors(ctx, base+"/contributors?per_page=100&anon=1")
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []*githubCommit
+	if _, err := g.get(ctx, base+"/commits?per_page=1", &commits); err != nil {
+		// GitHub returns a conflict for repositories without commits
+		if e, ok := err.(*statusError); !ok || e.code != http.StatusConflict {
+			return nil, err
+		}
+	}
+
+	m := &Repository{
+		Host:         HostGitHub,
+		Stars:        repo.Stars,
+		Commits:      sumCommits(contributors),
+		Languages:    languageShares(bytes),
+		Contributors: contributors,
+	}
+	if len(commits) > 0 {
+		m.LastCommitAt = commits[0].Commit.Committer.Date
+	}
+	return m, nil
+}
+
+// getContributors gets the pages of contributors starting at u, following the Link header
+func (g *githubHost) getContributors(ctx context.Context, u string) ([]*Contributor, error) {
+	var contributors []*Contributor
+	for page := 0; page < maxPages && u != ""; page++ {
+		var res []*githubContributor
+		header, err := g.get(ctx, u, &res)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res {
+			contributors = append(contributors, &Contributor{
+				Username: c.Login,
+				Name:     c.Name,
+				Email:    c.Email,
+				Commits:  c.Contributions,
+			})
+		}
+
+		u = ""
+		if match := githubNextLink.FindStringSubmatch(header.Get("Link")); match != nil {
+			u = match[1]
+		}
+	}
+	return contributors, nil
+}
+
+func (g *githubHost) get(ctx context.Context, u string, v interface{}) (http.Header, error) {
+	return getJSON(ctx, g.client, "GitHub", u, g.header, v)
+}
+
+// languageShares converts the bytes of code in each language to the percentage of code in them
+func languageShares(bytes map[string]uint64) map[string]float32 {
+	var total uint64
+	for _, b := range bytes {
+		total += b
+	}
+	shares := make(map[string]float32, len(bytes))
+	if total == 0 {
+		return shares
+	}
+	for lang, b := range bytes {
+		shares[lang] = float32(b) / float32(total) * 100
+	}
+	return shares
+}
@@ -0,0 +1,124 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"in-backend/services/project"
+	"in-backend/services/project/configs"
+)
+
+const defaultGitLabAPIURL = "https://gitlab.com/api/v4"
+
+type gitlabHost struct {
+	url    string
+	header http.Header
+	client project.HTTPClient
+}
+
+// NewGitLab creates and returns a new GitHost backed by the API of GitLab.
+// Requests are anonymous unless a token is configured
+func NewGitLab(cfg configs.GitLab, c project.HTTPClient) GitHost {
+	apiURL := cfg.APIURL
+	if apiURL == "" {
+		apiURL = defaultGitLabAPIURL
+	}
+	header := http.Header{}
+	if cfg.Token != "" {
+		header.Set("PRIVATE-TOKEN", cfg.Token)
+	}
+	return &gitlabHost{
+		url:    strings.TrimSuffix(apiURL, "/"),
+		header: header,
+		client: c,
+	}
+}
+
+// gitlabContributor declares the model of a contributor response from GitLab's API,
+// which groups commits by the email of their author
+type gitlabContributor struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Commits uint64 `json:"commits"`
+}
+
+// gitlabCommit declares the model of a commit response from GitLab's API
+type gitlabCommit struct {
+	CommittedDate *time.Time `json:"committed_date"`
+}
+
+// GetRepository returns the metadata of the GitLab project at path
+func (g *gitlabHost) GetRepository(ctx context.Context, path string) (*Repository, error) {
+	// GitLab identifies projects by their url encoded path
+	base := g.url + "/projects/" + url.PathEscape(path)
+
+	var repo struct {
+		Stars uint64 `json:"star_count"`
+	}
+	if _, err := g.get(ctx, base, &repo); err != nil {
+		return nil, err
+	}
+
+	var languages map[string]float32
+	if _, err := g.get(ctx, base+"/languages", &languages); err != nil {
+		return nil, err
+	}
+	if languages == nil {
+		languages = map[string]float32{}
+	}
+
+	contributors, err := g.getContributors(ctx, base+"/repository/contributors?per_page=100")
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []*gitlabCommit
+	if _, err := g.get(ctx, base+"/repository/commits?per_page=1", &commits); err != nil {
+		return nil, err
+	}
+
+	m := &Repository{
+		Host:         HostGitLab,
+		Stars:        repo.Stars,
+		Commits:      sumCommits(contributors),
+		Languages:    languages,
+		Contributors: contributors,
+	}
+	if len(commits) > 0 {
+		m.LastCommitAt = commits[0].CommittedDate
+	}
+	return m, nil
+}
+
+// getContributors gets the pages of contributors at u, following the X-Next-Page header
+func (g *gitlabHost) getContributors(ctx context.Context, u string) ([]*Contributor, error) {
+	var contributors []*Contributor
+	next := u
+	for page := 0; page < maxPages && next != ""; page++ {
+		var res []*gitlabContributor
+		header, err := g.get(ctx, next, &res)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res {
+			contributors = append(contributors, &Contributor{
+				Name:    c.Name,
+				Email:   c.Email,
+				Commits: c.Commits,
+			})
+		}
+
+		next = ""
+		if p := header.Get("X-Next-Page"); p != "" {
+			next = u + "&page=" + url.QueryEscape(p)
+		}
+	}
+	return contributors, nil
+}
+
+func (g *gitlabHost) get(ctx context.Context, u string, v interface{}) (http.Header, error) {
+	return getJSON(ctx, g.client, "GitLab", u, g.header, v)
+}
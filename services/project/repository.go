@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/project/models"
 )
 
@@ -13,7 +14,7 @@ type Repository interface {
 	CreateProject(ctx context.Context, m *models.Project) (*models.Project, error)
 
 	// GetAllProjects returns all candidates
-	GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, error)
+	GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error)
 
 	// GetProjectByID finds and returns a candidate by ID
 	GetProjectByID(ctx context.Context, id uint64) (*models.Project, error)
@@ -35,9 +36,48 @@ type Repository interface {
 	// GetCandidateProject gets a CandidateProject by Candidate ID and Project ID
 	GetCandidateProject(ctx context.Context, cid, pid uint64) (*models.CandidateProject, error)
 
+	// GetCandidateProjectsByProjectID gets the CandidateProjects of a Project
+	GetCandidateProjectsByProjectID(ctx context.Context, pid uint64) ([]*models.CandidateProject, error)
+
+	// UpdateCandidateProject updates the username and verified contribution of a CandidateProject
+	UpdateCandidateProject(ctx context.Context, m *models.CandidateProject) error
+
 	// DeleteCandidateroject deletes a Candidate Project
 	DeleteCandidateProject(ctx context.Context, id uint64) error
 
+	// GetCandidateProjectsByCandidateID gets the CandidateProjects of a Candidate
+	GetCandidateProjectsByCandidateID(ctx context.Context, cid uint64) ([]*models.CandidateProject, error)
+
+	// DeleteCandidateData deletes the CandidateProjects of a Candidate and anonymises their ProjectContributors,
+	// and returns how many records were deleted or anonymised
+	DeleteCandidateData(ctx context.Context, cid uint64) (uint64, error)
+
+	// GetSCMLogins gets the logins of the git host accounts linked to the identity provider account of a User,
+	// keyed by the host of the git host
+	GetSCMLogins(ctx context.Context, uid uint64) (map[string]string, error)
+
+	/* --------------- Project Metadata --------------- */
+
+	// SaveProjectMetadata creates or replaces the ProjectMetadata of a Project
+	SaveProjectMetadata(ctx context.Context, m *models.ProjectMetadata) (*models.ProjectMetadata, error)
+
+	// GetProjectMetadata gets the ProjectMetadata of a Project
+	GetProjectMetadata(ctx context.Context, pid uint64) (*models.ProjectMetadata, error)
+
+	/* --------------- Scan Job --------------- */
+
+	// CreateScanJob creates a new ScanJob
+	CreateScanJob(ctx context.Context, m *models.ScanJob) (*models.ScanJob, error)
+
+	// GetAllScanJobs returns all ScanJobs
+	GetAllScanJobs(ctx context.Context, f models.ScanJobFilters) ([]*models.ScanJob, *pagination.Info, error)
+
+	// GetActiveScanJob returns the latest Queued or Running ScanJob of a Project
+	GetActiveScanJob(ctx context.Context, pid uint64) (*models.ScanJob, error)
+
+	// UpdateScanJob updates a ScanJob
+	UpdateScanJob(ctx context.Context, m *models.ScanJob) (*models.ScanJob, error)
+
 	/* --------------- Rating --------------- */
 
 	// CreateRating creates a new Project Rating
@@ -45,4 +85,15 @@ type Repository interface {
 
 	// DeleteRating deletes a Project Rating
 	DeleteRating(ctx context.Context, id uint64) error
+
+	// GetProjectRatingHistory returns the Ratings of a Project
+	GetProjectRatingHistory(ctx context.Context, f models.RatingHistoryFilters) ([]*models.Rating, *pagination.Info, error)
+
+	// GetLatestRatings returns the latest Rating of each Project by ID
+	GetLatestRatings(ctx context.Context, pids []uint64) ([]*models.Rating, error)
+
+	/* --------------- Quality Score --------------- */
+
+	// GetAllQualityScores returns the code quality scores of Candidates
+	GetAllQualityScores(ctx context.Context, f models.QualityScoreFilters) ([]*models.QualityScore, *pagination.Info, error)
 }
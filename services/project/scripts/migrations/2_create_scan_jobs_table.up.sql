@@ -0,0 +1,14 @@
+create table if not exists scan_jobs (
+    id bigserial not null primary key,
+    project_id bigint not null,
+    status text not null,
+    logs text,
+    error text,
+    started_at timestamptz,
+    finished_at timestamptz,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+    constraint fk_projects foreign key(project_id) references projects(id) on delete cascade on update cascade
+);
+
+create index on scan_jobs (project_id, status);
@@ -0,0 +1,3 @@
+alter table ratings drop column if exists complexity;
+alter table ratings drop column if exists test_file_ratio;
+alter table ratings drop column if exists analyzer;
@@ -0,0 +1,3 @@
+alter table ratings add column if not exists analyzer text;
+alter table ratings add column if not exists test_file_ratio real;
+alter table ratings add column if not exists complexity real;
@@ -0,0 +1,8 @@
+alter table candidates_projects drop column if exists verified_at;
+alter table candidates_projects drop column if exists commit_share;
+alter table candidates_projects drop column if exists commits;
+alter table candidates_projects drop column if exists username;
+
+drop table if exists project_contributors;
+drop table if exists project_languages;
+drop table if exists project_metadata;
@@ -0,0 +1,35 @@
+create table if not exists project_metadata (
+    project_id bigint not null primary key,
+    host text not null,
+    stars bigint not null default 0,
+    commits bigint not null default 0,
+    last_commit_at timestamptz,
+    refreshed_at timestamptz not null default now(),
+    constraint fk_projects foreign key(project_id) references projects(id) on delete cascade on update cascade
+);
+
+create table if not exists project_languages (
+    id bigserial not null primary key,
+    project_id bigint not null,
+    language text not null,
+    share real not null,
+    constraint fk_projects foreign key(project_id) references projects(id) on delete cascade on update cascade
+);
+
+create index on project_languages (project_id);
+
+create table if not exists project_contributors (
+    id bigserial not null primary key,
+    project_id bigint not null,
+    username text,
+    name text,
+    commits bigint not null,
+    constraint fk_projects foreign key(project_id) references projects(id) on delete cascade on update cascade
+);
+
+create index on project_contributors (project_id);
+
+alter table candidates_projects add column if not exists username text;
+alter table candidates_projects add column if not exists commits bigint not null default 0;
+alter table candidates_projects add column if not exists commit_share real not null default 0;
+alter table candidates_projects add column if not exists verified_at timestamptz;
@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"in-backend/pagination"
 	"in-backend/services/project/models"
 )
 
@@ -13,7 +14,11 @@ type Service interface {
 	CreateProject(ctx context.Context, m *models.Project, cid uint64) (*models.Project, error)
 
 	// GetAllProjects returns all Projects
-	GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, error)
+	GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error)
+
+	// LocalGetAllProjects returns all Projects
+	// This method is only for local server to server communication
+	LocalGetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error)
 
 	// GetProjectByID finds and returns a Project by ID
 	GetProjectByID(ctx context.Context, id uint64) (*models.Project, error)
@@ -24,8 +29,29 @@ type Service interface {
 	// DeleteProject deletes a Project by ID
 	DeleteProject(ctx context.Context, id uint64) error
 
-	// ScanProject scans a Project using sonarqube
-	ScanProject(ctx context.Context, id uint64) error
+	// ScanProject queues a scan of a Project using sonarqube
+	ScanProject(ctx context.Context, id uint64) (*models.ScanJob, error)
+
+	// LocalScanProject scans a Project using sonarqube and waits for the scan to finish
+	// This method is only for local server to server communication
+	LocalScanProject(ctx context.Context, id uint64) (*models.ScanJob, error)
+
+	/* --------------- Project Metadata --------------- */
+
+	// RefreshProjectMetadata imports the metadata of a Project from the host of its repository
+	RefreshProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error)
+
+	// LocalRefreshProjectMetadata imports the metadata of a Project from the host of its repository
+	// This method is only for local server to server communication
+	LocalRefreshProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error)
+
+	// GetProjectMetadata returns the metadata of a Project
+	GetProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error)
+
+	/* --------------- Scan Job --------------- */
+
+	// GetAllScanJobs returns all ScanJobs
+	GetAllScanJobs(ctx context.Context, f models.ScanJobFilters) ([]*models.ScanJob, *pagination.Info, error)
 
 	/* --------------- Candidate Project --------------- */
 
@@ -42,4 +68,25 @@ type Service interface {
 
 	// DeleteRating deletes a Project Rating
 	DeleteRating(ctx context.Context, id uint64) error
+
+	// GetProjectRatingHistory returns the Ratings of a Project
+	GetProjectRatingHistory(ctx context.Context, f models.RatingHistoryFilters) ([]*models.Rating, *pagination.Info, error)
+
+	// GetLatestRatings returns the latest Rating of each Project by ID
+	GetLatestRatings(ctx context.Context, pids []uint64) ([]*models.Rating, error)
+
+	/* --------------- Quality Score --------------- */
+
+	// GetAllQualityScores returns the code quality scores of Candidates
+	GetAllQualityScores(ctx context.Context, f models.QualityScoreFilters) ([]*models.QualityScore, *pagination.Info, error)
+
+	/* --------------- Candidate Data --------------- */
+
+	// LocalExportCandidateData returns all data about a Candidate
+	// This method is only for local server to server communication
+	LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error)
+
+	// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased
+	// This method is only for local server to server communication
+	LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error)
 }
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"in-backend/services/project"
+	"in-backend/services/project/configs"
+)
+
+// Analyzers that can be configured to rate Projects
+const (
+	AnalyzerSonarqube string = "sonarqube"
+	AnalyzerGo        string = "go"
+)
+
+// Metrics declares the quality metrics of a repository, normalised across analyzers.
+// Ratings range from 1 (best) to 5 (worst), and are 0 when the analyzer does not measure them
+type Metrics struct {
+	ReliabilityRating     int32
+	MaintainabilityRating int32
+	SecurityRating        int32
+	SecurityReviewRating  int32
+	// Coverage is the percentage of lines covered by tests
+	Coverage float32
+	// Duplications is the percentage of lines that are duplicated
+	Duplications float32
+	// Lines is the number of lines of code, without blank lines and comments
+	Lines uint64
+	// TestFileRatio is the number of test files per source file
+	TestFileRatio float32
+	// Complexity is the average cyclomatic complexity of functions
+	Complexity float32
+}
+
+// Analyzer describes a code analyzer that rates the quality of repositories
+type Analyzer interface {
+	// Name returns the name of the analyzer, which is stored with the Ratings it creates
+	Name() string
+
+	// Analyze analyses the checkout of a repository in dir as the project key.
+	// It returns the metrics of the repository and the output of the analysis,
+	// which is also returned when the analysis fails
+	Analyze(ctx context.Context, dir, key string) (*Metrics, string, error)
+}
+
+// NewAnalyzer creates and returns the Analyzer chosen in cfg, which defaults to sonarqube
+func NewAnalyzer(cfg configs.Scanner, r CommandRunner, c project.HTTPClient) (Analyzer, error) {
+	switch cfg.Analyzer {
+	case "", AnalyzerSonarqube:
+		return NewSonarqubeAnalyzer(cfg.SonarqubeURL, r, c), nil
+	case AnalyzerGo:
+		return NewGoAnalyzer(), nil
+	default:
+		return nil, fmt.Errorf("Unknown analyzer %q", cfg.Analyzer)
+	}
+}
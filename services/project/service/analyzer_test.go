@@ -0,0 +1,33 @@
+package service
+
+import (
+	"in-backend/services/project/configs"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnalyzer(t *testing.T) {
+	var tests = []struct {
+		analyzer string
+		want     string
+		wantErr  bool
+	}{
+		{"", AnalyzerSonarqube, false},
+		{AnalyzerSonarqube, AnalyzerSonarqube, false},
+		{AnalyzerGo, AnalyzerGo, false},
+		{"eslint", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.analyzer, func(t *testing.T) {
+			got, err := NewAnalyzer(configs.Scanner{Analyzer: tt.analyzer}, &fakeRunner{}, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Name())
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var errNoGoFiles = errors.New("Repository has no Go files")
+
+// complexityRatings are the highest average cyclomatic complexity of each maintainability rating,
+// so that an average of up to 5 is rated 1 (A), and an average above 30 is rated 5 (E)
+var complexityRatings = []float32{5, 10, 20, 30}
+
+type goAnalyzer struct{}
+
+// NewGoAnalyzer creates and returns a new Analyzer that measures Go code with go/ast,
+// which does not need an external code analyzer
+func NewGoAnalyzer() Analyzer {
+	return goAnalyzer{}
+}
+
+// Name returns go
+func (goAnalyzer) Name() string {
+	return AnalyzerGo
+}
+
+// goStats declares the totals of the Go files of a repository
+type goStats struct {
+	files     int
+	testFiles int
+	lines     uint64
+	funcs     int
+	// complexity is the sum of the cyclomatic complexity of all functions
+	complexity int
+}
+
+// Analyze measures the Go files in dir, skipping vendored code, test data and hidden directories.
+// The maintainability rating is derived from the average cyclomatic complexity of non-test functions
+func (a goAnalyzer) Analyze(ctx context.Context, dir, key string) (*Metrics, string, error) {
+	stats := &goStats{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if path != dir && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() || !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		return stats.add(path)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if stats.files == 0 && stats.testFiles == 0 {
+		return nil, "", errNoGoFiles
+	}
+
+	m := &Metrics{Lines: stats.lines}
+	if stats.files > 0 {
+		m.TestFileRatio = float32(stats.testFiles) / float32(stats.files)
+	}
+	if stats.funcs > 0 {
+		m.Complexity = float32(stats.complexity) / float32(stats.funcs)
+		m.MaintainabilityRating = complexityRating(m.Complexity)
+	}
+
+	logs := fmt.Sprintf("Analysed %d Go files and %d test files: %d lines, %d functions, average complexity %.2f\n",
+		stats.files, stats.testFiles, stats.lines, stats.funcs, m.Complexity)
+	return m, logs, nil
+}
+
+// add adds the totals of the Go file at path to s.
+// Files that cannot be parsed are only counted as lines
+func (s *goStats) add(path string) error {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	isTest := strings.HasSuffix(path, "_test.go")
+	if isTest {
+		s.testFiles++
+	} else {
+		s.files++
+	}
+	s.lines += countLines(src)
+
+	if isTest {
+		return nil
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), path, src, 0)
+	if err != nil {
+		return nil
+	}
+	for _, d := range f.Decls {
+		if fn, ok := d.(*ast.FuncDecl); ok && fn.Body != nil {
+			s.funcs++
+			s.complexity += cyclomaticComplexity(fn)
+		}
+	}
+	return nil
+}
+
+// countLines returns the number of lines of Go source that have code on them,
+// which excludes blank lines and lines that only have comments
+func countLines(src []byte) uint64 {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+
+	var s scanner.Scanner
+	s.Init(file, src, nil, 0)
+
+	lines := make(map[int]bool)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		// automatically inserted semicolons are not code
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+		lines[file.Line(pos)] = true
+	}
+	return uint64(len(lines))
+}
+
+// cyclomaticComplexity returns the cyclomatic complexity of a function,
+// which is one plus the number of branches and boolean operators
+func cyclomaticComplexity(fn ast.Node) int {
+	c := 1
+	ast.Inspect(fn, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			c++
+		case *ast.CaseClause:
+			if n.List != nil {
+				c++
+			}
+		case *ast.CommClause:
+			if n.Comm != nil {
+				c++
+			}
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				c++
+			}
+		}
+		return true
+	})
+	return c
+}
+
+// complexityRating returns the maintainability rating of an average cyclomatic complexity
+func complexityRating(complexity float32) int32 {
+	for i, max := range complexityRatings {
+		if complexity <= max {
+			return int32(i + 1)
+		}
+	}
+	return int32(len(complexityRatings) + 1)
+}
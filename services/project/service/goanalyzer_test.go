@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeFiles writes files, keyed by their path relative to dir
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, src := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(src), 0644))
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	src := `// Package a is a package
+package a
+
+/*
+block comment
+*/
+func a() int {
+
+	return 1 // one
+}
+`
+	require.Equal(t, uint64(4), countLines([]byte(src)))
+}
+
+func TestCyclomaticComplexity(t *testing.T) {
+	var tests = []struct {
+		name string
+		body string
+		want int
+	}{
+		{"straight", `x := 1; _ = x`, 1},
+		{"if", `if true { return }`, 2},
+		{"boolean operators", `if a && b || c { return }`, 4},
+		{"loops", `for i := 0; i < 1; i++ {}; for range s {}`, 3},
+		{"switch", `switch x { case 1, 2: ; case 3: ; default: }`, 3},
+		{"select", `select { case <-c: ; default: }`, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parser.ParseFile(token.NewFileSet(), "", "package a\nfunc f() {"+tt.body+"}", 0)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, cyclomaticComplexity(f.Decls[0]))
+		})
+	}
+}
+
+func TestComplexityRating(t *testing.T) {
+	require.Equal(t, int32(1), complexityRating(1))
+	require.Equal(t, int32(1), complexityRating(5))
+	require.Equal(t, int32(2), complexityRating(5.5))
+	require.Equal(t, int32(4), complexityRating(30))
+	require.Equal(t, int32(5), complexityRating(31))
+}
+
+func TestGoAnalyze(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goanalyzer-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFiles(t, dir, map[string]string{
+		"main.go":             "package main\n\nfunc main() {\n\tif true {\n\t\treturn\n\t}\n}\n",
+		"util/util.go":        "package util\n\n// Add adds\nfunc Add(a, b int) int {\n\treturn a + b\n}\n",
+		"util/util_test.go":   "package util\n\nfunc helper() {}\n",
+		"vendor/dep/dep.go":   "package dep\n\nfunc Dep() {}\n",
+		"testdata/fixture.go": "package fixture\n",
+		".git/hooks/hook.go":  "package hooks\n",
+		"README.md":           "# readme\n",
+		"broken/broken.go":    "package broken\n\nfunc {\n",
+	})
+
+	got, logs, err := NewGoAnalyzer().Analyze(ctx, dir, "key")
+	require.NoError(t, err)
+	require.Contains(t, logs, "Analysed 3 Go files and 1 test files")
+	// main.go has 6 lines, util.go 4, util_test.go 2, broken.go 2
+	require.Equal(t, uint64(14), got.Lines)
+	require.InDelta(t, 1.0/3, got.TestFileRatio, 0.001)
+	// main has a complexity of 2 and Add of 1
+	require.InDelta(t, 1.5, got.Complexity, 0.001)
+	require.Equal(t, int32(1), got.MaintainabilityRating)
+	require.Zero(t, got.ReliabilityRating)
+
+	empty, err := ioutil.TempDir("", "goanalyzer-")
+	require.NoError(t, err)
+	defer os.RemoveAll(empty)
+	writeFiles(t, empty, map[string]string{"index.js": "console.log(1)\n"})
+	_, _, err = NewGoAnalyzer().Analyze(ctx, empty, "key")
+	require.Equal(t, errNoGoFiles, err)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, _, err = NewGoAnalyzer().Analyze(cancelled, dir, "key")
+	require.Equal(t, context.Canceled, err)
+}
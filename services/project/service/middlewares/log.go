@@ -3,6 +3,7 @@ package middlewares
 import (
 	"context"
 	"fmt"
+	"in-backend/pagination"
 	"in-backend/services/project"
 	"in-backend/services/project/models"
 	"time"
@@ -50,9 +51,16 @@ func (mw logMiddleware) CreateProject(ctx context.Context, input *models.Project
 }
 
 // GetAllProjects returns all Projects
-func (mw logMiddleware) GetAllProjects(ctx context.Context, input models.ProjectFilters) (output []*models.Project, err error) {
+func (mw logMiddleware) GetAllProjects(ctx context.Context, input models.ProjectFilters) (output []*models.Project, page *pagination.Info, err error) {
 	defer mw.log("GetAllProjects", time.Now(), input, &output, &err)
-	output, err = mw.next.GetAllProjects(ctx, input)
+	output, page, err = mw.next.GetAllProjects(ctx, input)
+	return
+}
+
+// LocalGetAllProjects returns all Projects
+func (mw logMiddleware) LocalGetAllProjects(ctx context.Context, input models.ProjectFilters) (output []*models.Project, page *pagination.Info, err error) {
+	defer mw.log("LocalGetAllProjects", time.Now(), input, &output, &err)
+	output, page, err = mw.next.LocalGetAllProjects(ctx, input)
 	return
 }
 
@@ -77,10 +85,49 @@ func (mw logMiddleware) DeleteProject(ctx context.Context, input uint64) (err er
 	return
 }
 
-// ScanProject scans a Project using sonarqube
-func (mw logMiddleware) ScanProject(ctx context.Context, input uint64) (err error) {
-	defer mw.log("ScanProject", time.Now(), input, nil, &err)
-	err = mw.next.ScanProject(ctx, input)
+// ScanProject queues a scan of a Project using sonarqube
+func (mw logMiddleware) ScanProject(ctx context.Context, input uint64) (output *models.ScanJob, err error) {
+	defer mw.log("ScanProject", time.Now(), input, &output, &err)
+	output, err = mw.next.ScanProject(ctx, input)
+	return
+}
+
+// LocalScanProject scans a Project using sonarqube and waits for the scan to finish
+func (mw logMiddleware) LocalScanProject(ctx context.Context, input uint64) (output *models.ScanJob, err error) {
+	defer mw.log("LocalScanProject", time.Now(), input, &output, &err)
+	output, err = mw.next.LocalScanProject(ctx, input)
+	return
+}
+
+/* --------------- Project Metadata --------------- */
+
+// RefreshProjectMetadata imports the metadata of a Project from the host of its repository
+func (mw logMiddleware) RefreshProjectMetadata(ctx context.Context, input uint64) (output *models.ProjectMetadata, err error) {
+	defer mw.log("RefreshProjectMetadata", time.Now(), input, &output, &err)
+	output, err = mw.next.RefreshProjectMetadata(ctx, input)
+	return
+}
+
+// LocalRefreshProjectMetadata imports the metadata of a Project from the host of its repository
+func (mw logMiddleware) LocalRefreshProjectMetadata(ctx context.Context, input uint64) (output *models.ProjectMetadata, err error) {
+	defer mw.log("LocalRefreshProjectMetadata", time.Now(), input, &output, &err)
+	output, err = mw.next.LocalRefreshProjectMetadata(ctx, input)
+	return
+}
+
+// GetProjectMetadata returns the metadata of a Project
+func (mw logMiddleware) GetProjectMetadata(ctx context.Context, input uint64) (output *models.ProjectMetadata, err error) {
+	defer mw.log("GetProjectMetadata", time.Now(), input, &output, &err)
+	output, err = mw.next.GetProjectMetadata(ctx, input)
+	return
+}
+
+/* --------------- Scan Job --------------- */
+
+// GetAllScanJobs returns all ScanJobs
+func (mw logMiddleware) GetAllScanJobs(ctx context.Context, input models.ScanJobFilters) (output []*models.ScanJob, page *pagination.Info, err error) {
+	defer mw.log("GetAllScanJobs", time.Now(), input, &output, &err)
+	output, page, err = mw.next.GetAllScanJobs(ctx, input)
 	return
 }
 
@@ -115,3 +162,42 @@ func (mw logMiddleware) DeleteRating(ctx context.Context, input uint64) (err err
 	err = mw.next.DeleteRating(ctx, input)
 	return
 }
+
+// GetProjectRatingHistory returns the Ratings of a Project
+func (mw logMiddleware) GetProjectRatingHistory(ctx context.Context, input models.RatingHistoryFilters) (output []*models.Rating, page *pagination.Info, err error) {
+	defer mw.log("GetProjectRatingHistory", time.Now(), input, &output, &err)
+	output, page, err = mw.next.GetProjectRatingHistory(ctx, input)
+	return
+}
+
+// GetLatestRatings returns the latest Rating of each Project by ID
+func (mw logMiddleware) GetLatestRatings(ctx context.Context, input []uint64) (output []*models.Rating, err error) {
+	defer mw.log("GetLatestRatings", time.Now(), input, &output, &err)
+	output, err = mw.next.GetLatestRatings(ctx, input)
+	return
+}
+
+/* --------------- Quality Score --------------- */
+
+// GetAllQualityScores returns the code quality scores of Candidates
+func (mw logMiddleware) GetAllQualityScores(ctx context.Context, input models.QualityScoreFilters) (output []*models.QualityScore, page *pagination.Info, err error) {
+	defer mw.log("GetAllQualityScores", time.Now(), input, &output, &err)
+	output, page, err = mw.next.GetAllQualityScores(ctx, input)
+	return
+}
+
+/* --------------- Candidate Data --------------- */
+
+// LocalExportCandidateData returns all data about a Candidate
+func (mw logMiddleware) LocalExportCandidateData(ctx context.Context, input uint64) (output *models.CandidateData, err error) {
+	defer mw.log("LocalExportCandidateData", time.Now(), input, nil, &err)
+	output, err = mw.next.LocalExportCandidateData(ctx, input)
+	return
+}
+
+// LocalEraseCandidateData deletes all data about a Candidate and returns how many records were erased
+func (mw logMiddleware) LocalEraseCandidateData(ctx context.Context, input uint64) (output uint64, err error) {
+	defer mw.log("LocalEraseCandidateData", time.Now(), input, &output, &err)
+	output, err = mw.next.LocalEraseCandidateData(ctx, input)
+	return
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"in-backend/services/project/configs"
+)
+
+const (
+	defaultAllowedHosts   = "github.com,gitlab.com,bitbucket.org"
+	defaultAllowedSchemes = "https"
+	defaultScanTimeout    = 10 * time.Minute
+
+	// maxScanLogBytes is the size of scanner output kept in a ScanJob, which keeps the end of the output
+	maxScanLogBytes = 64 << 10
+)
+
+var (
+	errRepoURLInvalid    = errors.New("Repository URL is not a valid repository")
+	errRepoURLScheme     = errors.New("Repository URL scheme is not allowed")
+	errRepoURLHost       = errors.New("Repository URL host is not allowed")
+	errProjectKeyInvalid = errors.New("Project key is not valid")
+
+	// repoPath matches repository paths such as /owner/repo or /group/subgroup/repo.git.
+	// Segments must start with a letter, digit or underscore so that they cannot be relative or options
+	repoPath = regexp.MustCompile(`^(/[A-Za-z0-9_][A-Za-z0-9_.-]*){2,}/?$`)
+	// projectKey matches the sonarqube project keys that are generated for Projects
+	projectKey = regexp.MustCompile(`^[a-z0-9_][a-z0-9_.-]*$`)
+)
+
+// CommandRunner describes a runner of external commands
+type CommandRunner interface {
+	// Run runs the command name with args in dir and returns its combined output
+	Run(ctx context.Context, dir, name string, args ...string) ([]byte, error)
+}
+
+type execRunner struct{}
+
+// NewExecRunner returns a CommandRunner that runs commands directly, without a shell,
+// and kills them when ctx is done
+func NewExecRunner() CommandRunner {
+	return execRunner{}
+}
+
+// Run runs a command with git credential prompts disabled, so that private repositories fail instead of hanging
+func (execRunner) Run(ctx context.Context, dir, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	return cmd.CombinedOutput()
+}
+
+// Scanner describes a code scanner that analyses repositories
+type Scanner interface {
+	// Analyzer returns the name of the analyzer of the scanner
+	Analyzer() string
+
+	// ValidateRepoURL returns an error if repoURL is not an allowed repository URL
+	ValidateRepoURL(repoURL string) error
+
+	// Scan clones the repository at repoURL and analyses it as the project key.
+	// It returns the metrics of the repository and the output of the scan,
+	// which is also returned when the scan fails
+	Scan(ctx context.Context, repoURL, key string) (*Metrics, string, error)
+}
+
+type repoScanner struct {
+	hosts    map[string]bool
+	schemes  map[string]bool
+	timeout  time.Duration
+	runner   CommandRunner
+	analyzer Analyzer
+}
+
+// NewScanner creates and returns a new Scanner that clones repositories by running git with r,
+// and analyses them with a
+func NewScanner(cfg configs.Scanner, r CommandRunner, a Analyzer) Scanner {
+	hosts, schemes, timeout := cfg.AllowedHosts, cfg.AllowedSchemes, cfg.Timeout
+	if hosts == "" {
+		hosts = defaultAllowedHosts
+	}
+	if schemes == "" {
+		schemes = defaultAllowedSchemes
+	}
+	if timeout <= 0 {
+		timeout = defaultScanTimeout
+	}
+	return &repoScanner{
+		hosts:    toSet(hosts),
+		schemes:  toSet(schemes),
+		timeout:  timeout,
+		runner:   r,
+		analyzer: a,
+	}
+}
+
+// Analyzer returns the name of the analyzer of the scanner
+func (s *repoScanner) Analyzer() string {
+	return s.analyzer.Name()
+}
+
+// toSet returns the lower cased values of a comma separated list as a set
+func toSet(list string) map[string]bool {
+	set := make(map[string]bool)
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+			set[v] = true
+		}
+	}
+	return set
+}
+
+// ValidateRepoURL returns an error unless repoURL uses an allowed scheme and host,
+// and has no credentials, port, query or fragment
+func (s *repoScanner) ValidateRepoURL(repoURL string) error {
+	u, err := url.Parse(repoURL)
+	if err != nil || u.Opaque != "" {
+		return errRepoURLInvalid
+	}
+	if !s.schemes[strings.ToLower(u.Scheme)] {
+		return errRepoURLScheme
+	}
+	if !s.hosts[strings.ToLower(u.Hostname())] {
+		return errRepoURLHost
+	}
+	if u.User != nil || u.Port() != "" || u.RawQuery != "" || u.ForceQuery || u.Fragment != "" || !repoPath.MatchString(u.Path) {
+		return errRepoURLInvalid
+	}
+	return nil
+}
+
+// Scan clones the repository into a temporary directory and runs the analyzer on it,
+// stopping both if they take longer than the scan timeout
+func (s *repoScanner) Scan(ctx context.Context, repoURL, key string) (*Metrics, string, error) {
+	if err := s.ValidateRepoURL(repoURL); err != nil {
+		return nil, "", err
+	}
+	if !projectKey.MatchString(key) {
+		return nil, "", errProjectKeyInvalid
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "scan-")
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.RemoveAll(dir)
+
+	var logs []byte
+	out, err := s.runner.Run(ctx, dir, "git", "clone", "--depth", "1", "--", repoURL, "repo")
+	logs = append(logs, out...)
+	if err != nil {
+		return nil, truncateLogs(logs), s.stepErr(ctx, "clone", err)
+	}
+
+	m, analysis, err := s.analyzer.Analyze(ctx, filepath.Join(dir, "repo"), key)
+	logs = append(logs, analysis...)
+	if err != nil {
+		return nil, truncateLogs(logs), s.stepErr(ctx, "analyse", err)
+	}
+	return m, truncateLogs(logs), nil
+}
+
+// stepErr returns the error of a failed step of a scan
+func (s *repoScanner) stepErr(ctx context.Context, step string, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("Scan timed out after %s while trying to %s the repository", s.timeout, step)
+	}
+	return fmt.Errorf("Failed to %s the repository: %v", step, err)
+}
+
+// truncateLogs returns the last maxScanLogBytes of logs
+func truncateLogs(logs []byte) string {
+	if len(logs) > maxScanLogBytes {
+		logs = logs[len(logs)-maxScanLogBytes:]
+	}
+	return string(logs)
+}
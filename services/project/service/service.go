@@ -2,65 +2,86 @@ package service
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"net/http"
-	"net/url"
-	"os/exec"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-kit/kit/log"
+	"github.com/gocraft/work"
 
-	"github.com/mitchellh/mapstructure"
-
+	"in-backend/pagination"
 	"in-backend/services/project"
 	"in-backend/services/project/models"
+	"in-backend/services/project/providers"
+)
+
+// scanProjectJob is the name of the scheduler job that scans a Project
+const scanProjectJob = "scan_project"
+
+// importMetadataJob is the name of the scheduler job that imports the metadata of a Project
+const importMetadataJob = "import_project_metadata"
+
+// abandonedScanAfter is how long a ScanJob can be Running before it is assumed
+// that the worker running it has died, and the Project can be scanned again
+const abandonedScanAfter = 2 * time.Hour
+
+var (
+	errProjectInvalid   = errors.New("Project is required")
+	errProjectNotFound  = errors.New("Project not found")
+	errMetadataNotFound = errors.New("Project metadata has not been imported")
+	errRatingRange      = errors.New("Rating history must start before it ends")
+	errTooManyProjects  = fmt.Errorf("Latest ratings can be requested for at most %d projects", pagination.MaxPageSize)
+
+	// invalidKeyChars matches the characters of Project names that are not allowed in sonarqube project keys
+	invalidKeyChars = regexp.MustCompile(`[^a-z0-9_.-]`)
 )
 
 // Service implements the project Service interface
 type service struct {
 	repository project.Repository
-	client     HTTPClient
+	scanner    Scanner
+	enqueuer   Enqueuer
+	hosts      providers.GitHosts
 	logger     log.Logger
 }
 
-// HTTPClient describes a default http client
-type HTTPClient interface {
-	Do(req *http.Request) (*http.Response, error)
-}
-
-// Component declares the model of a metrics response from sonarqube's API
-type Component struct {
-	Measures []Measure
-	Other    map[string]interface{} `mapstructure:",remain"`
-}
-
-// Measure declares the model of a measure response from sonarqube's API
-type Measure struct {
-	Metric    string `mapstructure:"metric"`
-	Value     string `mapstructure:"value"`
-	BestValue bool   `mapstructure:"bestValue,omitempty"`
+// Enqueuer describes a queue of scheduler jobs, such as a gocraft work.Enqueuer
+type Enqueuer interface {
+	EnqueueUnique(jobName string, args map[string]interface{}) (*work.Job, error)
 }
 
 // New creates and returns a new Service that implements the project Service interface
-func New(r project.Repository, c HTTPClient, l log.Logger) project.Service {
+func New(r project.Repository, sc Scanner, e Enqueuer, h providers.GitHosts, l log.Logger) project.Service {
 	return &service{
 		repository: r,
-		client:     c,
+		scanner:    sc,
+		enqueuer:   e,
+		hosts:      h,
 		logger:     l,
 	}
 }
 
 /* --------------- Project --------------- */
 
-// CreateProject creates a new Project
+// CreateProject creates a new Project.
+// Projects can only be created for repositories that can be scanned,
+// and their metadata is imported in the background to verify the contribution of the Candidate
 func (s *service) CreateProject(ctx context.Context, model *models.Project, cid uint64) (*models.Project, error) {
+	if model == nil {
+		return nil, errProjectInvalid
+	}
+	if err := s.scanner.ValidateRepoURL(model.RepoURL); err != nil {
+		return nil, err
+	}
+
 	f := models.ProjectFilters{
 		RepoURL: model.RepoURL,
 	}
-	existing, err := s.repository.GetAllProjects(ctx, f)
+	existing, _, err := s.repository.GetAllProjects(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +105,21 @@ func (s *service) CreateProject(ctx context.Context, model *models.Project, cid
 		return nil, err
 	}
 
+	s.queueMetadataImport(m.ID)
 	return m, err
 }
 
 // GetAllProjects returns all Projects
-func (s *service) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, error) {
-	m, err := s.repository.GetAllProjects(ctx, f)
-	return m, err
+func (s *service) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error) {
+	m, page, err := s.repository.GetAllProjects(ctx, f)
+	return m, page, err
+}
+
+// LocalGetAllProjects returns all Projects
+// This method is only for local server to server communication
+func (s *service) LocalGetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error) {
+	m, page, err := s.repository.GetAllProjects(ctx, f)
+	return m, page, err
 }
 
 // GetProjectByID returns a Project by ID
@@ -99,8 +128,15 @@ func (s *service) GetProjectByID(ctx context.Context, id uint64) (*models.Projec
 	return m, err
 }
 
-// UpdateProject updates a Project
+// UpdateProject updates a Project.
+// Like new Projects, updated Projects can only point to repositories that can be scanned
 func (s *service) UpdateProject(ctx context.Context, model *models.Project) (*models.Project, error) {
+	if model == nil {
+		return nil, errProjectInvalid
+	}
+	if err := s.scanner.ValidateRepoURL(model.RepoURL); err != nil {
+		return nil, err
+	}
 	m, err := s.repository.UpdateProject(ctx, model)
 	return m, err
 }
@@ -111,94 +147,160 @@ func (s *service) DeleteProject(ctx context.Context, id uint64) error {
 	return err
 }
 
-// ScanProject scans a Project using sonarqube
-func (s *service) ScanProject(ctx context.Context, id uint64) error {
-	m, err := s.GetProjectByID(ctx, id)
+// ScanProject queues a scan of a Project using sonarqube, and returns the ScanJob that records its progress.
+// A Project is only scanned once at a time, so the active ScanJob is returned if there is one
+func (s *service) ScanProject(ctx context.Context, id uint64) (*models.ScanJob, error) {
+	m, err := s.getScannableProject(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	go s.scanAndStoreResult(m)
+	active, err := s.repository.GetActiveScanJob(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return active, nil
+	}
 
-	return nil
+	job, err := s.repository.CreateScanJob(ctx, &models.ScanJob{ProjectID: m.ID, Status: models.ScanJobQueued})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.enqueuer.EnqueueUnique(scanProjectJob, work.Q{"project_id": m.ID})
+	if err != nil {
+		s.logger.Log("method", "EnqueueUnique", "project", m.ID, "err", err)
+		s.failScanJob(ctx, job, err)
+		return nil, err
+	}
+
+	return job, nil
 }
 
-func (s *service) scanAndStoreResult(m *models.Project) error {
+// LocalScanProject scans a Project using sonarqube and waits for the scan to finish.
+// It runs the Queued ScanJob of the Project, or a new one for periodic re-scans,
+// and returns the error of the scan so that the scheduler can retry it
+// This method is only for local server to server communication
+func (s *service) LocalScanProject(ctx context.Context, id uint64) (*models.ScanJob, error) {
+	m, err := s.getScannableProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.repository.GetActiveScanJob(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	if job != nil && job.Status == models.ScanJobRunning {
+		if job.StartedAt != nil && time.Since(*job.StartedAt) < abandonedScanAfter {
+			return job, nil
+		}
+		s.failScanJob(ctx, job, errors.New("Scan was abandoned"))
+		job = nil
+	}
+	if job == nil {
+		job, err = s.repository.CreateScanJob(ctx, &models.ScanJob{ProjectID: m.ID, Status: models.ScanJobQueued})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.runScanJob(ctx, m, job)
+	return job, err
+}
+
+// getScannableProject returns a Project by ID if its repository can be scanned
+func (s *service) getScannableProject(ctx context.Context, id uint64) (*models.Project, error) {
+	m, err := s.repository.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errProjectNotFound
+	}
+	if err := s.scanner.ValidateRepoURL(m.RepoURL); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// failScanJob sets a ScanJob to Failed with the error that stopped it
+func (s *service) failScanJob(ctx context.Context, job *models.ScanJob, cause error) {
+	finished := time.Now()
+	job.Status = models.ScanJobFailed
+	job.Error = cause.Error()
+	job.FinishedAt = &finished
+	if _, err := s.repository.UpdateScanJob(ctx, job); err != nil {
+		s.logger.Log("method", "UpdateScanJob", "err", err)
+	}
+}
+
+// GetAllScanJobs returns all ScanJobs
+func (s *service) GetAllScanJobs(ctx context.Context, f models.ScanJobFilters) ([]*models.ScanJob, *pagination.Info, error) {
+	m, page, err := s.repository.GetAllScanJobs(ctx, f)
+	return m, page, err
+}
+
+// scanKey returns the sonarqube project key of a Project
+func scanKey(m *models.Project) string {
 	name := strings.ToLower(m.Name)
 	name = strings.ReplaceAll(name, " ", "_")
-	name = name + "_" + strconv.FormatUint(m.ID, 10)
-	_, err := exec.Command("/bin/sh", "-c", "./scan.sh -u "+m.RepoURL+" -n "+name).Output()
-	if err != nil {
+	name = invalidKeyChars.ReplaceAllString(name, "")
+	return strings.TrimLeft(name+"_"+strconv.FormatUint(m.ID, 10), ".-")
+}
+
+// runScanJob scans a Project and stores its Rating, recording the outcome in job
+func (s *service) runScanJob(ctx context.Context, m *models.Project, job *models.ScanJob) error {
+	started := time.Now()
+	job.Status = models.ScanJobRunning
+	job.StartedAt = &started
+	if _, err := s.repository.UpdateScanJob(ctx, job); err != nil {
+		s.logger.Log("method", "UpdateScanJob", "err", err)
 		return err
 	}
 
-	jsonBody, err := s.getRatingMeasures(name)
+	metrics, logs, err := s.scanner.Scan(ctx, m.RepoURL, scanKey(m))
+	if err == nil {
+		err = s.storeRating(ctx, m.ID, metrics)
+	}
+
+	finished := time.Now()
+	job.Logs = logs
+	job.FinishedAt = &finished
+	job.Status = models.ScanJobSucceeded
 	if err != nil {
-		s.logger.Log("method", "getRatingMeasures", "err", err)
-		return err
+		s.logger.Log("method", "runScanJob", "project", m.ID, "job", job.ID, "err", err)
+		job.Status = models.ScanJobFailed
+		job.Error = err.Error()
 	}
-	var component Component
-	mapstructure.Decode(jsonBody["component"].(map[string]interface{}), &component)
 
+	if _, uerr := s.repository.UpdateScanJob(ctx, job); uerr != nil {
+		s.logger.Log("method", "UpdateScanJob", "err", uerr)
+		return uerr
+	}
+	return err
+}
+
+// storeRating stores the metrics of a scan as a Rating of the Project
+func (s *service) storeRating(ctx context.Context, pid uint64, m *Metrics) error {
 	now := time.Now()
 	r := &models.Rating{
-		ProjectID: m.ID,
-		CreatedAt: &now,
-	}
-	for _, measure := range component.Measures {
-		switch measure.Metric {
-		case "reliability_rating":
-			v, err := strconv.ParseFloat(measure.Value, 32)
-			if err != nil {
-				s.logger.Log("method", "parseReliabilityRating", "err", err)
-				return err
-			}
-			r.ReliabilityRating = int32(v)
-		case "sqale_rating":
-			v, err := strconv.ParseFloat(measure.Value, 32)
-			if err != nil {
-				s.logger.Log("method", "parseMaintainabilityRating", "err", err)
-				return err
-			}
-			r.MaintainabilityRating = int32(v)
-		case "security_rating":
-			v, err := strconv.ParseFloat(measure.Value, 32)
-			if err != nil {
-				s.logger.Log("method", "parseSecurityRating", "err", err)
-				return err
-			}
-			r.SecurityRating = int32(v)
-		case "security_review_rating":
-			v, err := strconv.ParseFloat(measure.Value, 32)
-			if err != nil {
-				s.logger.Log("method", "parseSecurityReviewRating", "err", err)
-				return err
-			}
-			r.SecurityReviewRating = int32(v)
-		case "coverage":
-			v, err := strconv.ParseFloat(measure.Value, 32)
-			if err != nil {
-				s.logger.Log("method", "parseCoverage", "err", err)
-				return err
-			}
-			r.Coverage = float32(v)
-		case "duplicated_lines_density":
-			v, err := strconv.ParseFloat(measure.Value, 32)
-			if err != nil {
-				s.logger.Log("method", "parseDuplications", "err", err)
-				return err
-			}
-			r.Duplications = float32(v)
-		case "ncloc":
-			r.Lines, err = strconv.ParseUint(measure.Value, 10, 64)
-			if err != nil {
-				s.logger.Log("method", "parseLines", "err", err)
-				return err
-			}
-		}
+		ProjectID:             pid,
+		ReliabilityRating:     m.ReliabilityRating,
+		MaintainabilityRating: m.MaintainabilityRating,
+		SecurityRating:        m.SecurityRating,
+		SecurityReviewRating:  m.SecurityReviewRating,
+		Coverage:              m.Coverage,
+		Duplications:          m.Duplications,
+		Lines:                 m.Lines,
+		TestFileRatio:         m.TestFileRatio,
+		Complexity:            m.Complexity,
+		Analyzer:              s.scanner.Analyzer(),
+		CreatedAt:             &now,
 	}
 
-	err = s.CreateRating(context.Background(), r)
+	err := s.CreateRating(ctx, r)
 	if err != nil {
 		s.logger.Log("method", "CreateRating", "err", err)
 		return err
@@ -206,51 +308,183 @@ func (s *service) scanAndStoreResult(m *models.Project) error {
 	return nil
 }
 
-func (s *service) getRatingMeasures(name string) (map[string]interface{}, error) {
-	metrics := []string{
-		"reliability_rating",
-		"sqale_rating",
-		"security_rating",
-		"security_review_rating",
-		"coverage",
-		"duplicated_lines_density",
-		"ncloc",
+/* --------------- Project Metadata --------------- */
+
+// RefreshProjectMetadata imports the metadata of a Project from the host of its repository,
+// and verifies the contributions of the Candidates of the Project
+func (s *service) RefreshProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error) {
+	return s.refreshProjectMetadata(ctx, id)
+}
+
+// LocalRefreshProjectMetadata imports the metadata of a Project from the host of its repository,
+// and verifies the contributions of the Candidates of the Project
+// This method is only for local server to server communication
+func (s *service) LocalRefreshProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error) {
+	return s.refreshProjectMetadata(ctx, id)
+}
+
+// GetProjectMetadata returns the metadata of a Project
+func (s *service) GetProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error) {
+	m, err := s.repository.GetProjectMetadata(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errMetadataNotFound
 	}
+	return m, nil
+}
 
-	payload := url.Values{}
-	payload.Add("component", name)
-	payload.Add("metricKeys", strings.Join(metrics, ","))
-	req, err := http.NewRequest("GET", "http://sonarqube:9000/api/measures/component?"+payload.Encode(), nil)
+func (s *service) refreshProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error) {
+	p, err := s.repository.GetProjectByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if p == nil {
+		return nil, errProjectNotFound
+	}
 
-	res, err := s.client.Do(req)
+	host, path, err := s.hosts.Resolve(p.RepoURL)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
+	repo, err := host.GetRepository(ctx, path)
+	if err != nil {
+		s.logger.Log("method", "GetRepository", "project", id, "err", err)
+		return nil, err
+	}
 
-	body, err := ioutil.ReadAll(res.Body)
+	m, err := s.repository.SaveProjectMetadata(ctx, toProjectMetadata(id, repo))
 	if err != nil {
 		return nil, err
 	}
 
-	jsonBody := make(map[string]interface{})
-	err = json.Unmarshal(body, &jsonBody)
+	cps, err := s.repository.GetCandidateProjectsByProjectID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
+	for _, cp := range cps {
+		login, err := s.candidateLogin(ctx, cp.CandidateID, repo.Host)
+		if err != nil {
+			s.logger.Log("method", "GetSCMLogins", "candidate", cp.CandidateID, "err", err)
+			continue
+		}
+		if !verifyContribution(cp, login, repo, now) {
+			continue
+		}
+		if err := s.repository.UpdateCandidateProject(ctx, cp); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// queueMetadataImport queues an import of the metadata of a Project.
+// Imports are best effort, as the metadata is also refreshed periodically
+func (s *service) queueMetadataImport(pid uint64) {
+	_, err := s.enqueuer.EnqueueUnique(importMetadataJob, work.Q{"project_id": pid})
+	if err != nil {
+		s.logger.Log("method", "EnqueueUnique", "project", pid, "err", err)
+	}
+}
+
+// toProjectMetadata maps the Repository of a Project to its ProjectMetadata.
+// The emails of contributors are only used for verification, and are not stored
+func toProjectMetadata(pid uint64, repo *providers.Repository) *models.ProjectMetadata {
+	now := time.Now()
+	m := &models.ProjectMetadata{
+		ProjectID:    pid,
+		Host:         repo.Host,
+		Stars:        repo.Stars,
+		Commits:      repo.Commits,
+		LastCommitAt: repo.LastCommitAt,
+		Languages:    []*models.ProjectLanguage{},
+		Contributors: []*models.ProjectContributor{},
+		RefreshedAt:  &now,
+	}
+	for lang, share := range repo.Languages {
+		m.Languages = append(m.Languages, &models.ProjectLanguage{ProjectID: pid, Language: lang, Share: share})
+	}
+	sort.Slice(m.Languages, func(i, j int) bool {
+		if m.Languages[i].Share != m.Languages[j].Share {
+			return m.Languages[i].Share > m.Languages[j].Share
+		}
+		return m.Languages[i].Language < m.Languages[j].Language
+	})
+	for _, c := range repo.Contributors {
+		m.Contributors = append(m.Contributors, &models.ProjectContributor{
+			ProjectID: pid,
+			Username:  c.Username,
+			Name:      c.Name,
+			Commits:   c.Commits,
+		})
+	}
+	return m
+}
 
-	return jsonBody, nil
+// candidateLogin returns the login of a Candidate on host, taken from the git host accounts that are linked to
+// the identity provider account of the Candidate, so that the git host has verified it with OAuth.
+// It is empty if the Candidate has not linked an account on host
+func (s *service) candidateLogin(ctx context.Context, uid uint64, host string) (string, error) {
+	logins, err := s.repository.GetSCMLogins(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+	return logins[host], nil
+}
+
+// verifyContribution sets the commits of a CandidateProject from the contributors of repo whose username
+// is login, the verified login of the Candidate on the host of repo, and returns whether cp changed.
+// The names and emails of contributors are not verified identities, so they are never matched.
+// The Username of cp is set to the verified login, and cleared for Candidates who are no longer found
+// among the contributors, who also lose their verification
+func verifyContribution(cp *models.CandidateProject, login string, repo *providers.Repository, now time.Time) bool {
+	var commits uint64
+	found := false
+	if login != "" {
+		for _, c := range repo.Contributors {
+			if strings.EqualFold(login, c.Username) {
+				commits += c.Commits
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		if cp.VerifiedAt == nil && cp.Commits == 0 && cp.Username == "" {
+			return false
+		}
+		cp.Username, cp.Commits, cp.CommitShare, cp.VerifiedAt = "", 0, 0, nil
+		return true
+	}
+
+	cp.Username = login
+	cp.Commits = commits
+	cp.CommitShare = 0
+	if repo.Commits > 0 {
+		cp.CommitShare = float32(commits) / float32(repo.Commits)
+	}
+	cp.VerifiedAt = &now
+	return true
 }
 
 /* --------------- Candidate Project --------------- */
 
-// CreateCandidateProject creates a new CandidateProject
+// CreateCandidateProject creates a new CandidateProject,
+// and imports the metadata of the Project in the background to verify the contribution of the Candidate.
+// The username and commits of the Candidate are only set by verification, and are ignored
 func (s *service) CreateCandidateProject(ctx context.Context, m *models.CandidateProject) error {
+	if m != nil {
+		m.Username, m.Commits, m.CommitShare, m.VerifiedAt = "", 0, 0, nil
+	}
 	err := s.repository.CreateCandidateProject(ctx, m)
-	return err
+	if err != nil {
+		return err
+	}
+
+	s.queueMetadataImport(m.ProjectID)
+	return nil
 }
 
 // DeleteCandidateProject deletes a CandidateProject by ID
@@ -272,3 +506,62 @@ func (s *service) DeleteRating(ctx context.Context, id uint64) error {
 	err := s.repository.DeleteRating(ctx, id)
 	return err
 }
+
+// GetProjectRatingHistory returns the Ratings of a Project that were created between f.From and f.To
+func (s *service) GetProjectRatingHistory(ctx context.Context, f models.RatingHistoryFilters) ([]*models.Rating, *pagination.Info, error) {
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return nil, nil, errRatingRange
+	}
+	m, page, err := s.repository.GetProjectRatingHistory(ctx, f)
+	return m, page, err
+}
+
+// GetLatestRatings returns the latest Rating of each Project by ID, for up to a page of Projects
+func (s *service) GetLatestRatings(ctx context.Context, pids []uint64) ([]*models.Rating, error) {
+	if uint64(len(pids)) > pagination.MaxPageSize {
+		return nil, errTooManyProjects
+	}
+	m, err := s.repository.GetLatestRatings(ctx, pids)
+	return m, err
+}
+
+/* --------------- Quality Score --------------- */
+
+// GetAllQualityScores returns the code quality scores of Candidates
+func (s *service) GetAllQualityScores(ctx context.Context, f models.QualityScoreFilters) ([]*models.QualityScore, *pagination.Info, error) {
+	m, page, err := s.repository.GetAllQualityScores(ctx, f)
+	return m, page, err
+}
+
+/* --------------- Candidate Data --------------- */
+
+// LocalExportCandidateData returns the Projects of a Candidate and how the Candidate contributed to them
+// This method is only for local server to server communication
+func (s *service) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	var p []*models.Project
+	f := models.ProjectFilters{CandidateID: cid, Page: pagination.Params{PageSize: pagination.MaxPageSize}}
+	for {
+		m, page, err := s.repository.GetAllProjects(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, m...)
+		if page == nil || page.NextPageToken == "" {
+			break
+		}
+		f.Page.PageToken = page.NextPageToken
+	}
+	cp, err := s.repository.GetCandidateProjectsByCandidateID(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CandidateData{Projects: p, CandidateProjects: cp}, nil
+}
+
+// LocalEraseCandidateData unlinks a Candidate from their Projects and anonymises them as a contributor.
+// The Projects are kept, as they may be shared with other Candidates
+// This method is only for local server to server communication
+func (s *service) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	n, err := s.repository.DeleteCandidateData(ctx, cid)
+	return n, err
+}
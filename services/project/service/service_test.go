@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"in-backend/pagination"
+	"in-backend/services/project/configs"
+	"in-backend/services/project/models"
+	"in-backend/services/project/providers"
+	"in-backend/services/project/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gocraft/work"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var ctx = context.Background()
+
+// fakeScanner is a Scanner that returns fixed results
+type fakeScanner struct {
+	validErr error
+	logs     string
+	scanErr  error
+}
+
+func (s *fakeScanner) Analyzer() string {
+	return AnalyzerGo
+}
+
+func (s *fakeScanner) ValidateRepoURL(repoURL string) error {
+	return s.validErr
+}
+
+func (s *fakeScanner) Scan(ctx context.Context, repoURL, key string) (*Metrics, string, error) {
+	if s.scanErr != nil {
+		return nil, s.logs, s.scanErr
+	}
+	return &Metrics{Lines: 120, Complexity: 2}, s.logs, nil
+}
+
+// fakeEnqueuer is an Enqueuer that records the jobs it is given
+type fakeEnqueuer struct {
+	err   error
+	names []string
+	jobs  []work.Q
+}
+
+func (e *fakeEnqueuer) EnqueueUnique(jobName string, args map[string]interface{}) (*work.Job, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	e.names = append(e.names, jobName)
+	e.jobs = append(e.jobs, args)
+	return &work.Job{Name: jobName, Args: args}, nil
+}
+
+func TestScanKey(t *testing.T) {
+	require.Equal(t, "my_project_1", scanKey(&models.Project{ID: 1, Name: "My Project"}))
+	require.Equal(t, "projectrm_-rf_2", scanKey(&models.Project{ID: 2, Name: "project;rm -rf"}))
+	require.Equal(t, "id_3", scanKey(&models.Project{ID: 3, Name: "$(id)"}))
+	require.Equal(t, "x_4", scanKey(&models.Project{ID: 4, Name: "--x"}))
+}
+
+func TestCreateProject(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, &fakeScanner{validErr: errRepoURLHost}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+	_, err := s.CreateProject(ctx, nil, 1)
+	require.Equal(t, errProjectInvalid, err)
+	_, err = s.CreateProject(ctx, &models.Project{Name: "project", RepoURL: "file:///etc"}, 1)
+	require.Equal(t, errRepoURLHost, err)
+	repo.AssertNotCalled(t, "CreateProject", mock.Anything, mock.Anything)
+}
+
+func TestUpdateProject(t *testing.T) {
+	p := &models.Project{ID: 1, Name: "project", RepoURL: "https://github.com/hubbedin/in-backend"}
+
+	repo := &mocks.Repository{}
+	s := New(repo, &fakeScanner{validErr: errRepoURLHost}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+	_, err := s.UpdateProject(ctx, nil)
+	require.Equal(t, errProjectInvalid, err)
+	_, err = s.UpdateProject(ctx, p)
+	require.Equal(t, errRepoURLHost, err)
+	repo.AssertNotCalled(t, "UpdateProject", mock.Anything, mock.Anything)
+
+	repo.On("UpdateProject", ctx, p).Return(p, nil)
+	s = New(repo, &fakeScanner{}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+	got, err := s.UpdateProject(ctx, p)
+	require.NoError(t, err)
+	require.Equal(t, p, got)
+}
+
+func TestScanProject(t *testing.T) {
+	p := &models.Project{ID: 1, Name: "project", RepoURL: "https://github.com/hubbedin/in-backend"}
+
+	repo := &mocks.Repository{}
+	repo.On("GetProjectByID", ctx, uint64(2)).Return(nil, nil)
+	s := New(repo, &fakeScanner{}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+	_, err := s.ScanProject(ctx, 2)
+	require.Equal(t, errProjectNotFound, err)
+
+	repo = &mocks.Repository{}
+	repo.On("GetProjectByID", ctx, uint64(1)).Return(p, nil)
+	s = New(repo, &fakeScanner{validErr: errRepoURLHost}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+	_, err = s.ScanProject(ctx, 1)
+	require.Equal(t, errRepoURLHost, err)
+	repo.AssertNotCalled(t, "CreateScanJob", mock.Anything, mock.Anything)
+
+	// a new scan is queued
+	queued := &models.ScanJob{ID: 5, ProjectID: 1, Status: models.ScanJobQueued}
+	repo = &mocks.Repository{}
+	repo.On("GetProjectByID", ctx, uint64(1)).Return(p, nil)
+	repo.On("GetActiveScanJob", ctx, uint64(1)).Return(nil, nil)
+	repo.On("CreateScanJob", ctx, mock.Anything).Return(queued, nil)
+	e := &fakeEnqueuer{}
+	s = New(repo, &fakeScanner{}, e, nil, log.NewNopLogger())
+	got, err := s.ScanProject(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, queued, got)
+	require.Equal(t, []work.Q{{"project_id": uint64(1)}}, e.jobs)
+
+	// a project is not scanned twice at the same time
+	repo = &mocks.Repository{}
+	repo.On("GetProjectByID", ctx, uint64(1)).Return(p, nil)
+	repo.On("GetActiveScanJob", ctx, uint64(1)).Return(queued, nil)
+	e = &fakeEnqueuer{}
+	s = New(repo, &fakeScanner{}, e, nil, log.NewNopLogger())
+	got, err = s.ScanProject(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, queued, got)
+	require.Empty(t, e.jobs)
+	repo.AssertNotCalled(t, "CreateScanJob", mock.Anything, mock.Anything)
+
+	// the scan job fails if it cannot be queued
+	job := &models.ScanJob{ID: 6, ProjectID: 1, Status: models.ScanJobQueued}
+	repo = &mocks.Repository{}
+	repo.On("GetProjectByID", ctx, uint64(1)).Return(p, nil)
+	repo.On("GetActiveScanJob", ctx, uint64(1)).Return(nil, nil)
+	repo.On("CreateScanJob", ctx, mock.Anything).Return(job, nil)
+	repo.On("UpdateScanJob", ctx, job).Return(job, nil)
+	s = New(repo, &fakeScanner{}, &fakeEnqueuer{err: errors.New("redis is down")}, nil, log.NewNopLogger())
+	_, err = s.ScanProject(ctx, 1)
+	require.Error(t, err)
+	require.Equal(t, models.ScanJobFailed, job.Status)
+}
+
+func TestLocalScanProject(t *testing.T) {
+	p := &models.Project{ID: 1, Name: "project", RepoURL: "https://github.com/hubbedin/in-backend"}
+	recent := time.Now().Add(-time.Minute)
+	abandoned := time.Now().Add(-abandonedScanAfter - time.Minute)
+	scanErr := errors.New("Failed to clone the repository")
+
+	var tests = []struct {
+		name    string
+		active  *models.ScanJob
+		created bool
+		scanned bool
+	}{
+		{"queued", &models.ScanJob{ID: 1, Status: models.ScanJobQueued}, false, true},
+		{"periodic", nil, true, true},
+		{"running", &models.ScanJob{ID: 1, Status: models.ScanJobRunning, StartedAt: &recent}, false, false},
+		{"abandoned", &models.ScanJob{ID: 1, Status: models.ScanJobRunning, StartedAt: &abandoned}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.Repository{}
+			repo.On("GetProjectByID", ctx, uint64(1)).Return(p, nil)
+			repo.On("GetActiveScanJob", ctx, uint64(1)).Return(tt.active, nil)
+			repo.On("CreateScanJob", ctx, mock.Anything).Return(&models.ScanJob{ID: 2, Status: models.ScanJobQueued}, nil)
+			repo.On("UpdateScanJob", ctx, mock.Anything).Return(nil, nil)
+			s := New(repo, &fakeScanner{scanErr: scanErr}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+
+			_, err := s.LocalScanProject(ctx, 1)
+			if tt.created {
+				repo.AssertCalled(t, "CreateScanJob", ctx, mock.Anything)
+			} else {
+				repo.AssertNotCalled(t, "CreateScanJob", mock.Anything, mock.Anything)
+			}
+			// scan errors are returned so that the scheduler retries the scan
+			if tt.scanned {
+				require.Equal(t, scanErr, err)
+			} else {
+				require.NoError(t, err)
+				repo.AssertNotCalled(t, "UpdateScanJob", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestRunScanJob(t *testing.T) {
+	p := &models.Project{ID: 1, Name: "project", RepoURL: "https://github.com/hubbedin/in-backend"}
+
+	repo := &mocks.Repository{}
+	var statuses []string
+	repo.On("UpdateScanJob", ctx, mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+		statuses = append(statuses, args.Get(1).(*models.ScanJob).Status)
+	})
+	s := New(repo, &fakeScanner{logs: "fatal: repository not found", scanErr: errors.New("Failed to clone the repository")}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+
+	job := &models.ScanJob{ID: 1, ProjectID: 1, Status: models.ScanJobQueued}
+	err := s.(*service).runScanJob(ctx, p, job)
+	require.Error(t, err)
+	require.Equal(t, []string{models.ScanJobRunning, models.ScanJobFailed}, statuses)
+	require.Equal(t, "Failed to clone the repository", job.Error)
+	require.Equal(t, "fatal: repository not found", job.Logs)
+	require.NotNil(t, job.StartedAt)
+	require.NotNil(t, job.FinishedAt)
+	repo.AssertNotCalled(t, "CreateRating", mock.Anything, mock.Anything)
+}
+
+func TestRunScanJobStoresRating(t *testing.T) {
+	p := &models.Project{ID: 1, Name: "project", RepoURL: "https://github.com/hubbedin/in-backend"}
+
+	repo := &mocks.Repository{}
+	repo.On("UpdateScanJob", ctx, mock.Anything).Return(nil, nil)
+	var rating *models.Rating
+	repo.On("CreateRating", ctx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		rating = args.Get(1).(*models.Rating)
+	})
+	s := New(repo, &fakeScanner{}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+
+	job := &models.ScanJob{ID: 1, ProjectID: 1, Status: models.ScanJobQueued}
+	err := s.(*service).runScanJob(ctx, p, job)
+	require.NoError(t, err)
+	require.Equal(t, models.ScanJobSucceeded, job.Status)
+	require.NotNil(t, rating)
+	require.Equal(t, uint64(1), rating.ProjectID)
+	require.Equal(t, uint64(120), rating.Lines)
+	require.Equal(t, float32(2), rating.Complexity)
+	require.Equal(t, AnalyzerGo, rating.Analyzer)
+}
+
+func TestGetProjectRatingHistory(t *testing.T) {
+	from := time.Now().AddDate(0, -1, 0)
+	to := time.Now()
+
+	repo := &mocks.Repository{}
+	s := New(repo, &fakeScanner{}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+	_, _, err := s.GetProjectRatingHistory(ctx, models.RatingHistoryFilters{ProjectID: 1, From: &to, To: &from})
+	require.Equal(t, errRatingRange, err)
+	repo.AssertNotCalled(t, "GetProjectRatingHistory", mock.Anything, mock.Anything)
+
+	f := models.RatingHistoryFilters{ProjectID: 1, From: &from, To: &to}
+	repo.On("GetProjectRatingHistory", ctx, f).Return([]*models.Rating{{ID: 1}}, nil, nil)
+	got, _, err := s.GetProjectRatingHistory(ctx, f)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+}
+
+func TestGetLatestRatings(t *testing.T) {
+	repo := &mocks.Repository{}
+	s := New(repo, &fakeScanner{}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+
+	pids := make([]uint64, pagination.MaxPageSize+1)
+	_, err := s.GetLatestRatings(ctx, pids)
+	require.Equal(t, errTooManyProjects, err)
+	repo.AssertNotCalled(t, "GetLatestRatings", mock.Anything, mock.Anything)
+
+	repo.On("GetLatestRatings", ctx, pids[1:]).Return([]*models.Rating{{ID: 1}}, nil)
+	got, err := s.GetLatestRatings(ctx, pids[1:])
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+}
+
+func TestCreateCandidateProject(t *testing.T) {
+	cp := &models.CandidateProject{CandidateID: 1, ProjectID: 2, Username: "octocat"}
+
+	repo := &mocks.Repository{}
+	repo.On("CreateCandidateProject", ctx, cp).Return(errors.New("duplicate key value"))
+	e := &fakeEnqueuer{}
+	s := New(repo, &fakeScanner{}, e, nil, log.NewNopLogger())
+	require.Error(t, s.CreateCandidateProject(ctx, cp))
+	require.Empty(t, e.jobs)
+
+	// the metadata of the project is imported to verify the contribution of the candidate
+	repo = &mocks.Repository{}
+	repo.On("CreateCandidateProject", ctx, cp).Return(nil)
+	s = New(repo, &fakeScanner{}, e, nil, log.NewNopLogger())
+	require.NoError(t, s.CreateCandidateProject(ctx, cp))
+	require.Equal(t, []string{importMetadataJob}, e.names)
+	require.Equal(t, []work.Q{{"project_id": uint64(2)}}, e.jobs)
+
+	// imports are best effort
+	s = New(repo, &fakeScanner{}, &fakeEnqueuer{err: errors.New("redis is down")}, nil, log.NewNopLogger())
+	require.NoError(t, s.CreateCandidateProject(ctx, cp))
+
+	// candidates cannot claim a login or commits before they are verified
+	verifiedAt := time.Now()
+	cp = &models.CandidateProject{CandidateID: 1, ProjectID: 2, Username: "octocat", Commits: 6, CommitShare: 1, VerifiedAt: &verifiedAt}
+	require.NoError(t, s.CreateCandidateProject(ctx, cp))
+	require.Equal(t, &models.CandidateProject{CandidateID: 1, ProjectID: 2}, cp)
+}
+
+func TestRefreshProjectMetadata(t *testing.T) {
+	lastCommitAt := time.Date(2020, 10, 1, 8, 30, 0, 0, time.UTC)
+	srv, _ := providers.NewFakeGitHostServer(map[string]*providers.Repository{
+		"octocat/hello-world": {
+			Stars:        42,
+			LastCommitAt: &lastCommitAt,
+			Languages:    map[string]float32{"Go": 75, "Shell": 25},
+			Contributors: []*providers.Contributor{
+				{Username: "octocat", Commits: 6},
+				{Username: "hubot", Commits: 3},
+				{Name: "Jane Doe", Email: "jane@example.com", Commits: 1},
+			},
+		},
+	})
+	defer srv.Close()
+	hosts := providers.GitHosts{providers.HostGitHub: providers.NewGitHub(configs.GitHub{APIURL: srv.URL}, srv.Client())}
+
+	repo := &mocks.Repository{}
+	repo.On("GetProjectByID", ctx, uint64(2)).Return(nil, nil)
+	repo.On("GetProjectByID", ctx, uint64(3)).Return(&models.Project{ID: 3, RepoURL: "https://bitbucket.org/octocat/hello-world"}, nil)
+	s := New(repo, &fakeScanner{}, &fakeEnqueuer{}, hosts, log.NewNopLogger())
+	_, err := s.RefreshProjectMetadata(ctx, 2)
+	require.Equal(t, errProjectNotFound, err)
+	_, err = s.RefreshProjectMetadata(ctx, 3)
+	require.Error(t, err)
+	repo.AssertNotCalled(t, "SaveProjectMetadata", mock.Anything, mock.Anything)
+
+	verifiedAt := time.Now().AddDate(0, -1, 0)
+	octocat := &models.CandidateProject{ID: 1, CandidateID: 10, ProjectID: 1}
+	// names and emails of contributors are not verified identities
+	jane := &models.CandidateProject{ID: 2, CandidateID: 11, ProjectID: 1}
+	// a login verified before is dropped once the linked account no longer matches a contributor
+	impostor := &models.CandidateProject{ID: 3, CandidateID: 12, ProjectID: 1, Username: "octocat", Commits: 5, VerifiedAt: &verifiedAt}
+	unverified := &models.CandidateProject{ID: 4, CandidateID: 13, ProjectID: 1}
+	unavailable := &models.CandidateProject{ID: 5, CandidateID: 14, ProjectID: 1, Username: "octocat", Commits: 2, VerifiedAt: &verifiedAt}
+
+	repo = &mocks.Repository{}
+	repo.On("GetProjectByID", ctx, uint64(1)).Return(&models.Project{ID: 1, RepoURL: "https://github.com/octocat/hello-world.git"}, nil)
+	repo.On("SaveProjectMetadata", ctx, mock.Anything).Return(func(ctx context.Context, m *models.ProjectMetadata) *models.ProjectMetadata {
+		return m
+	}, nil)
+	repo.On("GetCandidateProjectsByProjectID", ctx, uint64(1)).Return([]*models.CandidateProject{octocat, jane, impostor, unverified, unavailable}, nil)
+	repo.On("GetSCMLogins", ctx, uint64(10)).Return(map[string]string{providers.HostGitHub: "OctoCat"}, nil)
+	repo.On("GetSCMLogins", ctx, uint64(11)).Return(map[string]string{}, nil)
+	repo.On("GetSCMLogins", ctx, uint64(12)).Return(map[string]string{providers.HostGitHub: "mallory"}, nil)
+	// hubot on another host is someone else
+	repo.On("GetSCMLogins", ctx, uint64(13)).Return(map[string]string{providers.HostGitLab: "hubot"}, nil)
+	repo.On("GetSCMLogins", ctx, uint64(14)).Return(nil, errors.New("profile is down"))
+	repo.On("UpdateCandidateProject", ctx, mock.Anything).Return(nil)
+	s = New(repo, &fakeScanner{}, &fakeEnqueuer{}, hosts, log.NewNopLogger())
+	got, err := s.RefreshProjectMetadata(ctx, 1)
+	require.NoError(t, err)
+
+	require.Equal(t, providers.HostGitHub, got.Host)
+	require.Equal(t, uint64(42), got.Stars)
+	require.Equal(t, uint64(10), got.Commits)
+	require.True(t, lastCommitAt.Equal(*got.LastCommitAt))
+	require.Equal(t, []*models.ProjectLanguage{
+		{ProjectID: 1, Language: "Go", Share: 75},
+		{ProjectID: 1, Language: "Shell", Share: 25},
+	}, got.Languages)
+	// emails of contributors are not stored
+	require.Equal(t, []*models.ProjectContributor{
+		{ProjectID: 1, Username: "octocat", Commits: 6},
+		{ProjectID: 1, Username: "hubot", Commits: 3},
+		{ProjectID: 1, Name: "Jane Doe", Commits: 1},
+	}, got.Contributors)
+
+	require.Equal(t, uint64(6), octocat.Commits)
+	require.Equal(t, float32(0.6), octocat.CommitShare)
+	require.NotNil(t, octocat.VerifiedAt)
+	require.Equal(t, "OctoCat", octocat.Username)
+	require.Equal(t, uint64(0), jane.Commits)
+	require.Nil(t, jane.VerifiedAt)
+	require.Equal(t, uint64(0), impostor.Commits)
+	require.Nil(t, impostor.VerifiedAt)
+	require.Empty(t, impostor.Username)
+	require.Equal(t, uint64(0), unverified.Commits)
+	require.Nil(t, unverified.VerifiedAt)
+	// candidates whose profile cannot be fetched keep their verification until the next refresh
+	require.Equal(t, uint64(2), unavailable.Commits)
+	require.NotNil(t, unavailable.VerifiedAt)
+	repo.AssertCalled(t, "UpdateCandidateProject", ctx, octocat)
+	repo.AssertCalled(t, "UpdateCandidateProject", ctx, impostor)
+	repo.AssertNumberOfCalls(t, "UpdateCandidateProject", 2)
+}
+
+func TestGetProjectMetadata(t *testing.T) {
+	repo := &mocks.Repository{}
+	repo.On("GetProjectMetadata", ctx, uint64(1)).Return(nil, nil)
+	repo.On("GetProjectMetadata", ctx, uint64(2)).Return(&models.ProjectMetadata{ProjectID: 2}, nil)
+	s := New(repo, &fakeScanner{}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+
+	_, err := s.GetProjectMetadata(ctx, 1)
+	require.Equal(t, errMetadataNotFound, err)
+	got, err := s.GetProjectMetadata(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), got.ProjectID)
+}
+
+func TestLocalExportCandidateData(t *testing.T) {
+	p := []*models.Project{{ID: 2, Name: "in-backend"}}
+	cp := []*models.CandidateProject{{ID: 1, CandidateID: 1, ProjectID: 2, Username: "octocat"}}
+
+	repo := &mocks.Repository{}
+	repo.On("GetAllProjects", ctx, models.ProjectFilters{CandidateID: 1, Page: pagination.Params{PageSize: pagination.MaxPageSize}}).
+		Return(p, &pagination.Info{}, nil)
+	repo.On("GetCandidateProjectsByCandidateID", ctx, uint64(1)).Return(cp, nil)
+	s := New(repo, &fakeScanner{}, &fakeEnqueuer{}, nil, log.NewNopLogger())
+
+	got, err := s.LocalExportCandidateData(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, &models.CandidateData{Projects: p, CandidateProjects: cp}, got)
+}
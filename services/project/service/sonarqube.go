@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"in-backend/services/project"
+)
+
+const defaultSonarqubeURL = "http://sonarqube:9000"
+
+// sonarqubeMetrics are the metric keys requested from sonarqube's API
+var sonarqubeMetrics = []string{
+	"reliability_rating",
+	"sqale_rating",
+	"security_rating",
+	"security_review_rating",
+	"coverage",
+	"duplicated_lines_density",
+	"ncloc",
+}
+
+// Component declares the model of a metrics response from sonarqube's API
+type Component struct {
+	Measures []Measure `json:"measures"`
+}
+
+// Measure declares the model of a measure response from sonarqube's API
+type Measure struct {
+	Metric    string `json:"metric"`
+	Value     string `json:"value"`
+	BestValue bool   `json:"bestValue,omitempty"`
+}
+
+type sonarqubeAnalyzer struct {
+	url    string
+	runner CommandRunner
+	client project.HTTPClient
+}
+
+// NewSonarqubeAnalyzer creates and returns a new Analyzer that runs sonar-scanner with r,
+// and gets the measures of the analysis from the sonarqube server at sonarURL
+func NewSonarqubeAnalyzer(sonarURL string, r CommandRunner, c project.HTTPClient) Analyzer {
+	if sonarURL == "" {
+		sonarURL = defaultSonarqubeURL
+	}
+	return &sonarqubeAnalyzer{
+		url:    strings.TrimSuffix(sonarURL, "/"),
+		runner: r,
+		client: c,
+	}
+}
+
+// Name returns sonarqube
+func (a *sonarqubeAnalyzer) Name() string {
+	return AnalyzerSonarqube
+}
+
+// Analyze runs sonar-scanner in dir, then gets the measures of key from sonarqube
+func (a *sonarqubeAnalyzer) Analyze(ctx context.Context, dir, key string) (*Metrics, string, error) {
+	out, err := a.runner.Run(ctx, dir, "sonar-scanner", "-Dsonar.projectKey="+key)
+	if err != nil {
+		return nil, string(out), err
+	}
+
+	c, err := a.getMeasures(ctx, key)
+	if err != nil {
+		return nil, string(out), err
+	}
+	m, err := toMetrics(c)
+	return m, string(out), err
+}
+
+// getMeasures gets the measures of the sonarqube project key
+func (a *sonarqubeAnalyzer) getMeasures(ctx context.Context, key string) (*Component, error) {
+	payload := url.Values{}
+	payload.Add("component", key)
+	payload.Add("metricKeys", strings.Join(sonarqubeMetrics, ","))
+	req, err := http.NewRequestWithContext(ctx, "GET", a.url+"/api/measures/component?"+payload.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, fmt.Errorf("Sonarqube returned status %d for %s", res.StatusCode, key)
+	}
+
+	var body struct {
+		Component *Component `json:"component"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Component == nil {
+		return nil, fmt.Errorf("Sonarqube returned no measures for %s", key)
+	}
+	return body.Component, nil
+}
+
+// toMetrics maps the measures of a sonarqube Component to Metrics
+func toMetrics(c *Component) (*Metrics, error) {
+	m := &Metrics{}
+	for _, measure := range c.Measures {
+		var err error
+		switch measure.Metric {
+		case "reliability_rating":
+			m.ReliabilityRating, err = parseRating(measure.Value)
+		case "sqale_rating":
+			m.MaintainabilityRating, err = parseRating(measure.Value)
+		case "security_rating":
+			m.SecurityRating, err = parseRating(measure.Value)
+		case "security_review_rating":
+			m.SecurityReviewRating, err = parseRating(measure.Value)
+		case "coverage":
+			m.Coverage, err = parsePercent(measure.Value)
+		case "duplicated_lines_density":
+			m.Duplications, err = parsePercent(measure.Value)
+		case "ncloc":
+			m.Lines, err = strconv.ParseUint(measure.Value, 10, 64)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse sonarqube measure %s: %v", measure.Metric, err)
+		}
+	}
+	return m, nil
+}
+
+// parseRating parses a sonarqube rating, which is a float such as 1.0 for A
+func parseRating(v string) (int32, error) {
+	f, err := strconv.ParseFloat(v, 32)
+	return int32(f), err
+}
+
+// parsePercent parses a sonarqube percentage
+func parsePercent(v string) (float32, error) {
+	f, err := strconv.ParseFloat(v, 32)
+	return float32(f), err
+}
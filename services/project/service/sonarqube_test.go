@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSonarqubeAnalyze(t *testing.T) {
+	srv := newSonarqube(t, `{"component":{"measures":[
+		{"metric":"reliability_rating","value":"1.0"},
+		{"metric":"sqale_rating","value":"2.0"},
+		{"metric":"security_rating","value":"3.0"},
+		{"metric":"security_review_rating","value":"5.0"},
+		{"metric":"coverage","value":"81.5"},
+		{"metric":"duplicated_lines_density","value":"3.2"},
+		{"metric":"ncloc","value":"1200"}
+	]}}`)
+	r := &fakeRunner{output: "ANALYSIS SUCCESSFUL\n"}
+	a := NewSonarqubeAnalyzer(srv.URL+"/", r, srv.Client())
+
+	got, logs, err := a.Analyze(ctx, "repo", "in_backend_1")
+	require.NoError(t, err)
+	require.Equal(t, "ANALYSIS SUCCESSFUL\n", logs)
+	require.Equal(t, &Metrics{
+		ReliabilityRating:     1,
+		MaintainabilityRating: 2,
+		SecurityRating:        3,
+		SecurityReviewRating:  5,
+		Coverage:              81.5,
+		Duplications:          3.2,
+		Lines:                 1200,
+	}, got)
+	require.Equal(t, [][]string{{"sonar-scanner", "-Dsonar.projectKey=in_backend_1"}}, r.commands)
+}
+
+func TestSonarqubeAnalyzeErrors(t *testing.T) {
+	// measures are not requested if the analysis fails
+	r := &fakeRunner{output: "ERROR\n", err: errors.New("exit status 1")}
+	_, logs, err := NewSonarqubeAnalyzer("http://sonarqube.invalid", r, http.DefaultClient).Analyze(ctx, "repo", "in_backend_1")
+	require.EqualError(t, err, "exit status 1")
+	require.Equal(t, "ERROR\n", logs)
+
+	srv := newSonarqube(t, `{"errors":[{"msg":"Component key 'in_backend_1' not found"}]}`)
+	_, _, err = NewSonarqubeAnalyzer(srv.URL, &fakeRunner{}, srv.Client()).Analyze(ctx, "repo", "in_backend_1")
+	require.EqualError(t, err, "Sonarqube returned no measures for in_backend_1")
+
+	srv = newSonarqube(t, `{"component":{"measures":[{"metric":"ncloc","value":"many"}]}}`)
+	_, _, err = NewSonarqubeAnalyzer(srv.URL, &fakeRunner{}, srv.Client()).Analyze(ctx, "repo", "in_backend_1")
+	require.Error(t, err)
+
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer unauthorized.Close()
+	_, _, err = NewSonarqubeAnalyzer(unauthorized.URL, &fakeRunner{}, unauthorized.Client()).Analyze(ctx, "repo", "in_backend_1")
+	require.EqualError(t, err, "Sonarqube returned status 401 for in_backend_1")
+}
@@ -227,6 +227,96 @@ func (_m *ProjectServiceClient) GetAllProjects(ctx context.Context, in *pb.GetAl
 	return r0, r1
 }
 
+// GetAllQualityScores provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) GetAllQualityScores(ctx context.Context, in *pb.GetAllQualityScoresRequest, opts ...grpc.CallOption) (*pb.GetAllQualityScoresResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAllQualityScoresResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllQualityScoresRequest, ...grpc.CallOption) *pb.GetAllQualityScoresResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllQualityScoresResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllQualityScoresRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllScanJobs provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) GetAllScanJobs(ctx context.Context, in *pb.GetAllScanJobsRequest, opts ...grpc.CallOption) (*pb.GetAllScanJobsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAllScanJobsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllScanJobsRequest, ...grpc.CallOption) *pb.GetAllScanJobsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllScanJobsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllScanJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestRatings provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) GetLatestRatings(ctx context.Context, in *pb.GetLatestRatingsRequest, opts ...grpc.CallOption) (*pb.GetLatestRatingsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetLatestRatingsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetLatestRatingsRequest, ...grpc.CallOption) *pb.GetLatestRatingsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetLatestRatingsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetLatestRatingsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProjectByID provides a mock function with given fields: ctx, in, opts
 func (_m *ProjectServiceClient) GetProjectByID(ctx context.Context, in *pb.GetProjectByIDRequest, opts ...grpc.CallOption) (*pb.Project, error) {
 	_va := make([]interface{}, len(opts))
@@ -257,6 +347,246 @@ func (_m *ProjectServiceClient) GetProjectByID(ctx context.Context, in *pb.GetPr
 	return r0, r1
 }
 
+// GetProjectMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) GetProjectMetadata(ctx context.Context, in *pb.GetProjectMetadataRequest, opts ...grpc.CallOption) (*pb.ProjectMetadata, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetProjectMetadataRequest, ...grpc.CallOption) *pb.ProjectMetadata); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetProjectMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProjectRatingHistory provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) GetProjectRatingHistory(ctx context.Context, in *pb.GetProjectRatingHistoryRequest, opts ...grpc.CallOption) (*pb.GetProjectRatingHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetProjectRatingHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetProjectRatingHistoryRequest, ...grpc.CallOption) *pb.GetProjectRatingHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetProjectRatingHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetProjectRatingHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalEraseCandidateData provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) LocalEraseCandidateData(ctx context.Context, in *pb.CandidateDataRequest, opts ...grpc.CallOption) (*pb.EraseCandidateDataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.EraseCandidateDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) *pb.EraseCandidateDataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.EraseCandidateDataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) LocalExportCandidateData(ctx context.Context, in *pb.CandidateDataRequest, opts ...grpc.CallOption) (*pb.CandidateData, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) *pb.CandidateData); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetAllProjects provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) LocalGetAllProjects(ctx context.Context, in *pb.GetAllProjectsRequest, opts ...grpc.CallOption) (*pb.GetAllProjectsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAllProjectsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllProjectsRequest, ...grpc.CallOption) *pb.GetAllProjectsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllProjectsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllProjectsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalRefreshProjectMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) LocalRefreshProjectMetadata(ctx context.Context, in *pb.RefreshProjectMetadataRequest, opts ...grpc.CallOption) (*pb.ProjectMetadata, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RefreshProjectMetadataRequest, ...grpc.CallOption) *pb.ProjectMetadata); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RefreshProjectMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalScanProject provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) LocalScanProject(ctx context.Context, in *pb.ScanProjectRequest, opts ...grpc.CallOption) (*pb.ScanProjectResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ScanProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ScanProjectRequest, ...grpc.CallOption) *pb.ScanProjectResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ScanProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ScanProjectRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshProjectMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *ProjectServiceClient) RefreshProjectMetadata(ctx context.Context, in *pb.RefreshProjectMetadataRequest, opts ...grpc.CallOption) (*pb.ProjectMetadata, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RefreshProjectMetadataRequest, ...grpc.CallOption) *pb.ProjectMetadata); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RefreshProjectMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScanProject provides a mock function with given fields: ctx, in, opts
 func (_m *ProjectServiceClient) ScanProject(ctx context.Context, in *pb.ScanProjectRequest, opts ...grpc.CallOption) (*pb.ScanProjectResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -175,6 +175,75 @@ func (_m *ProjectServiceServer) GetAllProjects(_a0 context.Context, _a1 *pb.GetA
 	return r0, r1
 }
 
+// GetAllQualityScores provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) GetAllQualityScores(_a0 context.Context, _a1 *pb.GetAllQualityScoresRequest) (*pb.GetAllQualityScoresResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAllQualityScoresResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllQualityScoresRequest) *pb.GetAllQualityScoresResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllQualityScoresResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllQualityScoresRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllScanJobs provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) GetAllScanJobs(_a0 context.Context, _a1 *pb.GetAllScanJobsRequest) (*pb.GetAllScanJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAllScanJobsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllScanJobsRequest) *pb.GetAllScanJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllScanJobsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllScanJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestRatings provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) GetLatestRatings(_a0 context.Context, _a1 *pb.GetLatestRatingsRequest) (*pb.GetLatestRatingsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetLatestRatingsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetLatestRatingsRequest) *pb.GetLatestRatingsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetLatestRatingsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetLatestRatingsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProjectByID provides a mock function with given fields: _a0, _a1
 func (_m *ProjectServiceServer) GetProjectByID(_a0 context.Context, _a1 *pb.GetProjectByIDRequest) (*pb.Project, error) {
 	ret := _m.Called(_a0, _a1)
@@ -198,6 +267,190 @@ func (_m *ProjectServiceServer) GetProjectByID(_a0 context.Context, _a1 *pb.GetP
 	return r0, r1
 }
 
+// GetProjectMetadata provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) GetProjectMetadata(_a0 context.Context, _a1 *pb.GetProjectMetadataRequest) (*pb.ProjectMetadata, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetProjectMetadataRequest) *pb.ProjectMetadata); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetProjectMetadataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProjectRatingHistory provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) GetProjectRatingHistory(_a0 context.Context, _a1 *pb.GetProjectRatingHistoryRequest) (*pb.GetProjectRatingHistoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetProjectRatingHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetProjectRatingHistoryRequest) *pb.GetProjectRatingHistoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetProjectRatingHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetProjectRatingHistoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalEraseCandidateData provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) LocalEraseCandidateData(_a0 context.Context, _a1 *pb.CandidateDataRequest) (*pb.EraseCandidateDataResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.EraseCandidateDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest) *pb.EraseCandidateDataResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.EraseCandidateDataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) LocalExportCandidateData(_a0 context.Context, _a1 *pb.CandidateDataRequest) (*pb.CandidateData, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CandidateDataRequest) *pb.CandidateData); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CandidateDataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetAllProjects provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) LocalGetAllProjects(_a0 context.Context, _a1 *pb.GetAllProjectsRequest) (*pb.GetAllProjectsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAllProjectsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAllProjectsRequest) *pb.GetAllProjectsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAllProjectsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAllProjectsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalRefreshProjectMetadata provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) LocalRefreshProjectMetadata(_a0 context.Context, _a1 *pb.RefreshProjectMetadataRequest) (*pb.ProjectMetadata, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RefreshProjectMetadataRequest) *pb.ProjectMetadata); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RefreshProjectMetadataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalScanProject provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) LocalScanProject(_a0 context.Context, _a1 *pb.ScanProjectRequest) (*pb.ScanProjectResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ScanProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ScanProjectRequest) *pb.ScanProjectResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ScanProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ScanProjectRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshProjectMetadata provides a mock function with given fields: _a0, _a1
+func (_m *ProjectServiceServer) RefreshProjectMetadata(_a0 context.Context, _a1 *pb.RefreshProjectMetadataRequest) (*pb.ProjectMetadata, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RefreshProjectMetadataRequest) *pb.ProjectMetadata); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RefreshProjectMetadataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScanProject provides a mock function with given fields: _a0, _a1
 func (_m *ProjectServiceServer) ScanProject(_a0 context.Context, _a1 *pb.ScanProjectRequest) (*pb.ScanProjectResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	pagination "in-backend/pagination"
 	models "in-backend/services/project/models"
 
 	mock "github.com/stretchr/testify/mock"
@@ -65,6 +66,50 @@ func (_m *Repository) CreateRating(ctx context.Context, m *models.Rating) error
 	return r0
 }
 
+// CreateScanJob provides a mock function with given fields: ctx, m
+func (_m *Repository) CreateScanJob(ctx context.Context, m *models.ScanJob) (*models.ScanJob, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ScanJob
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScanJob) *models.ScanJob); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ScanJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ScanJob) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCandidateData provides a mock function with given fields: ctx, cid
+func (_m *Repository) DeleteCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) uint64); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteCandidateProject provides a mock function with given fields: ctx, id
 func (_m *Repository) DeleteCandidateProject(ctx context.Context, id uint64) error {
 	ret := _m.Called(ctx, id)
@@ -107,8 +152,31 @@ func (_m *Repository) DeleteRating(ctx context.Context, id uint64) error {
 	return r0
 }
 
+// GetActiveScanJob provides a mock function with given fields: ctx, pid
+func (_m *Repository) GetActiveScanJob(ctx context.Context, pid uint64) (*models.ScanJob, error) {
+	ret := _m.Called(ctx, pid)
+
+	var r0 *models.ScanJob
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ScanJob); ok {
+		r0 = rf(ctx, pid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ScanJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, pid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllProjects provides a mock function with given fields: ctx, f
-func (_m *Repository) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, error) {
+func (_m *Repository) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.Project
@@ -120,14 +188,87 @@ func (_m *Repository) GetAllProjects(ctx context.Context, f models.ProjectFilter
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.ProjectFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.ProjectFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.ProjectFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllQualityScores provides a mock function with given fields: ctx, f
+func (_m *Repository) GetAllQualityScores(ctx context.Context, f models.QualityScoreFilters) ([]*models.QualityScore, *pagination.Info, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.QualityScore
+	if rf, ok := ret.Get(0).(func(context.Context, models.QualityScoreFilters) []*models.QualityScore); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.QualityScore)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.QualityScoreFilters) *pagination.Info); ok {
+		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.QualityScoreFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllScanJobs provides a mock function with given fields: ctx, f
+func (_m *Repository) GetAllScanJobs(ctx context.Context, f models.ScanJobFilters) ([]*models.ScanJob, *pagination.Info, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.ScanJob
+	if rf, ok := ret.Get(0).(func(context.Context, models.ScanJobFilters) []*models.ScanJob); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ScanJob)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.ScanJobFilters) *pagination.Info); ok {
+		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.ScanJobFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // GetCandidateProject provides a mock function with given fields: ctx, cid, pid
@@ -176,6 +317,75 @@ func (_m *Repository) GetCandidateProjectByID(ctx context.Context, id uint64) (*
 	return r0, r1
 }
 
+// GetCandidateProjectsByCandidateID provides a mock function with given fields: ctx, cid
+func (_m *Repository) GetCandidateProjectsByCandidateID(ctx context.Context, cid uint64) ([]*models.CandidateProject, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 []*models.CandidateProject
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*models.CandidateProject); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CandidateProject)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCandidateProjectsByProjectID provides a mock function with given fields: ctx, pid
+func (_m *Repository) GetCandidateProjectsByProjectID(ctx context.Context, pid uint64) ([]*models.CandidateProject, error) {
+	ret := _m.Called(ctx, pid)
+
+	var r0 []*models.CandidateProject
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*models.CandidateProject); ok {
+		r0 = rf(ctx, pid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CandidateProject)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, pid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestRatings provides a mock function with given fields: ctx, pids
+func (_m *Repository) GetLatestRatings(ctx context.Context, pids []uint64) ([]*models.Rating, error) {
+	ret := _m.Called(ctx, pids)
+
+	var r0 []*models.Rating
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []*models.Rating); ok {
+		r0 = rf(ctx, pids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Rating)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, pids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProjectByID provides a mock function with given fields: ctx, id
 func (_m *Repository) GetProjectByID(ctx context.Context, id uint64) (*models.Project, error) {
 	ret := _m.Called(ctx, id)
@@ -199,6 +409,121 @@ func (_m *Repository) GetProjectByID(ctx context.Context, id uint64) (*models.Pr
 	return r0, r1
 }
 
+// GetProjectMetadata provides a mock function with given fields: ctx, pid
+func (_m *Repository) GetProjectMetadata(ctx context.Context, pid uint64) (*models.ProjectMetadata, error) {
+	ret := _m.Called(ctx, pid)
+
+	var r0 *models.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ProjectMetadata); ok {
+		r0 = rf(ctx, pid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, pid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProjectRatingHistory provides a mock function with given fields: ctx, f
+func (_m *Repository) GetProjectRatingHistory(ctx context.Context, f models.RatingHistoryFilters) ([]*models.Rating, *pagination.Info, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.Rating
+	if rf, ok := ret.Get(0).(func(context.Context, models.RatingHistoryFilters) []*models.Rating); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Rating)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.RatingHistoryFilters) *pagination.Info); ok {
+		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.RatingHistoryFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetSCMLogins provides a mock function with given fields: ctx, uid
+func (_m *Repository) GetSCMLogins(ctx context.Context, uid uint64) (map[string]string, error) {
+	ret := _m.Called(ctx, uid)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) map[string]string); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveProjectMetadata provides a mock function with given fields: ctx, m
+func (_m *Repository) SaveProjectMetadata(ctx context.Context, m *models.ProjectMetadata) (*models.ProjectMetadata, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ProjectMetadata) *models.ProjectMetadata); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ProjectMetadata) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCandidateProject provides a mock function with given fields: ctx, m
+func (_m *Repository) UpdateCandidateProject(ctx context.Context, m *models.CandidateProject) error {
+	ret := _m.Called(ctx, m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CandidateProject) error); ok {
+		r0 = rf(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateProject provides a mock function with given fields: ctx, m
 func (_m *Repository) UpdateProject(ctx context.Context, m *models.Project) (*models.Project, error) {
 	ret := _m.Called(ctx, m)
@@ -221,3 +546,26 @@ func (_m *Repository) UpdateProject(ctx context.Context, m *models.Project) (*mo
 
 	return r0, r1
 }
+
+// UpdateScanJob provides a mock function with given fields: ctx, m
+func (_m *Repository) UpdateScanJob(ctx context.Context, m *models.ScanJob) (*models.ScanJob, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *models.ScanJob
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScanJob) *models.ScanJob); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ScanJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ScanJob) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	pagination "in-backend/pagination"
 	models "in-backend/services/project/models"
 
 	mock "github.com/stretchr/testify/mock"
@@ -108,7 +109,7 @@ func (_m *Service) DeleteRating(ctx context.Context, id uint64) error {
 }
 
 // GetAllProjects provides a mock function with given fields: ctx, f
-func (_m *Service) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, error) {
+func (_m *Service) GetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error) {
 	ret := _m.Called(ctx, f)
 
 	var r0 []*models.Project
@@ -120,9 +121,105 @@ func (_m *Service) GetAllProjects(ctx context.Context, f models.ProjectFilters)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.ProjectFilters) error); ok {
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.ProjectFilters) *pagination.Info); ok {
+		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.ProjectFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllQualityScores provides a mock function with given fields: ctx, f
+func (_m *Service) GetAllQualityScores(ctx context.Context, f models.QualityScoreFilters) ([]*models.QualityScore, *pagination.Info, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.QualityScore
+	if rf, ok := ret.Get(0).(func(context.Context, models.QualityScoreFilters) []*models.QualityScore); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.QualityScore)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.QualityScoreFilters) *pagination.Info); ok {
+		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.QualityScoreFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllScanJobs provides a mock function with given fields: ctx, f
+func (_m *Service) GetAllScanJobs(ctx context.Context, f models.ScanJobFilters) ([]*models.ScanJob, *pagination.Info, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.ScanJob
+	if rf, ok := ret.Get(0).(func(context.Context, models.ScanJobFilters) []*models.ScanJob); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ScanJob)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.ScanJobFilters) *pagination.Info); ok {
 		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.ScanJobFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetLatestRatings provides a mock function with given fields: ctx, pids
+func (_m *Service) GetLatestRatings(ctx context.Context, pids []uint64) ([]*models.Rating, error) {
+	ret := _m.Called(ctx, pids)
+
+	var r0 []*models.Rating
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []*models.Rating); ok {
+		r0 = rf(ctx, pids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Rating)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, pids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -153,18 +250,227 @@ func (_m *Service) GetProjectByID(ctx context.Context, id uint64) (*models.Proje
 	return r0, r1
 }
 
+// GetProjectMetadata provides a mock function with given fields: ctx, id
+func (_m *Service) GetProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ProjectMetadata); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProjectRatingHistory provides a mock function with given fields: ctx, f
+func (_m *Service) GetProjectRatingHistory(ctx context.Context, f models.RatingHistoryFilters) ([]*models.Rating, *pagination.Info, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.Rating
+	if rf, ok := ret.Get(0).(func(context.Context, models.RatingHistoryFilters) []*models.Rating); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Rating)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.RatingHistoryFilters) *pagination.Info); ok {
+		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.RatingHistoryFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LocalEraseCandidateData provides a mock function with given fields: ctx, cid
+func (_m *Service) LocalEraseCandidateData(ctx context.Context, cid uint64) (uint64, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) uint64); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalExportCandidateData provides a mock function with given fields: ctx, cid
+func (_m *Service) LocalExportCandidateData(ctx context.Context, cid uint64) (*models.CandidateData, error) {
+	ret := _m.Called(ctx, cid)
+
+	var r0 *models.CandidateData
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.CandidateData); ok {
+		r0 = rf(ctx, cid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CandidateData)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalGetAllProjects provides a mock function with given fields: ctx, f
+func (_m *Service) LocalGetAllProjects(ctx context.Context, f models.ProjectFilters) ([]*models.Project, *pagination.Info, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 []*models.Project
+	if rf, ok := ret.Get(0).(func(context.Context, models.ProjectFilters) []*models.Project); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Project)
+		}
+	}
+
+	var r1 *pagination.Info
+	if rf, ok := ret.Get(1).(func(context.Context, models.ProjectFilters) *pagination.Info); ok {
+		r1 = rf(ctx, f)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*pagination.Info)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, models.ProjectFilters) error); ok {
+		r2 = rf(ctx, f)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LocalRefreshProjectMetadata provides a mock function with given fields: ctx, id
+func (_m *Service) LocalRefreshProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ProjectMetadata); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LocalScanProject provides a mock function with given fields: ctx, id
+func (_m *Service) LocalScanProject(ctx context.Context, id uint64) (*models.ScanJob, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ScanJob
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ScanJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ScanJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshProjectMetadata provides a mock function with given fields: ctx, id
+func (_m *Service) RefreshProjectMetadata(ctx context.Context, id uint64) (*models.ProjectMetadata, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ProjectMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ProjectMetadata); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProjectMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScanProject provides a mock function with given fields: ctx, id
-func (_m *Service) ScanProject(ctx context.Context, id uint64) error {
+func (_m *Service) ScanProject(ctx context.Context, id uint64) (*models.ScanJob, error) {
 	ret := _m.Called(ctx, id)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+	var r0 *models.ScanJob
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.ScanJob); ok {
 		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ScanJob)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // UpdateProject provides a mock function with given fields: ctx, m
@@ -41,4 +41,10 @@ var (
 		Lines:                 1,
 		CreatedAt:             &now,
 	}
+
+	// ScanJobValid is a valid ScanJob that should pass tests
+	ScanJobValid = &models.ScanJob{
+		ProjectID: 1,
+		Status:    models.ScanJobQueued,
+	}
 )
@@ -2,6 +2,9 @@ package transport
 
 import (
 	"context"
+	"encoding/json"
+	"in-backend/helpers"
+	"in-backend/pagination"
 	"in-backend/services/project/endpoints"
 	"in-backend/services/project/models"
 	"in-backend/services/project/pb"
@@ -14,19 +17,34 @@ import (
 
 // grpc transport service for Project Service.
 type grpcServer struct {
-	createProject  kitgrpc.Handler
-	getAllProjects kitgrpc.Handler
-	getProjectByID kitgrpc.Handler
-	updateProject  kitgrpc.Handler
-	deleteProject  kitgrpc.Handler
+	createProject       kitgrpc.Handler
+	getAllProjects      kitgrpc.Handler
+	localGetAllProjects kitgrpc.Handler
+	getProjectByID      kitgrpc.Handler
+	updateProject       kitgrpc.Handler
+	deleteProject       kitgrpc.Handler
 
-	scanProject kitgrpc.Handler
+	scanProject      kitgrpc.Handler
+	localScanProject kitgrpc.Handler
+
+	refreshProjectMetadata      kitgrpc.Handler
+	localRefreshProjectMetadata kitgrpc.Handler
+	getProjectMetadata          kitgrpc.Handler
+
+	getAllScanJobs kitgrpc.Handler
 
 	createCandidateProject kitgrpc.Handler
 	deleteCandidateProject kitgrpc.Handler
 
-	createRating kitgrpc.Handler
-	deleteRating kitgrpc.Handler
+	createRating            kitgrpc.Handler
+	deleteRating            kitgrpc.Handler
+	getProjectRatingHistory kitgrpc.Handler
+	getLatestRatings        kitgrpc.Handler
+
+	getAllQualityScores kitgrpc.Handler
+
+	localExportCandidateData kitgrpc.Handler
+	localEraseCandidateData  kitgrpc.Handler
 
 	logger log.Logger
 }
@@ -53,6 +71,12 @@ func NewGRPCServer(
 			encodeGetAllProjectsResponse,
 			options...,
 		),
+		localGetAllProjects: kitgrpc.NewServer(
+			endpoints.LocalGetAllProjects,
+			decodeGetAllProjectsRequest,
+			encodeGetAllProjectsResponse,
+			options...,
+		),
 		getProjectByID: kitgrpc.NewServer(
 			endpoints.GetProjectByID,
 			decodeGetProjectByIDRequest,
@@ -78,6 +102,37 @@ func NewGRPCServer(
 			encodeScanProjectResponse,
 			options...,
 		),
+		localScanProject: kitgrpc.NewServer(
+			endpoints.LocalScanProject,
+			decodeScanProjectRequest,
+			encodeScanProjectResponse,
+			options...,
+		),
+		refreshProjectMetadata: kitgrpc.NewServer(
+			endpoints.RefreshProjectMetadata,
+			decodeRefreshProjectMetadataRequest,
+			encodeProjectMetadataResponse,
+			options...,
+		),
+		localRefreshProjectMetadata: kitgrpc.NewServer(
+			endpoints.LocalRefreshProjectMetadata,
+			decodeRefreshProjectMetadataRequest,
+			encodeProjectMetadataResponse,
+			options...,
+		),
+		getProjectMetadata: kitgrpc.NewServer(
+			endpoints.GetProjectMetadata,
+			decodeGetProjectMetadataRequest,
+			encodeProjectMetadataResponse,
+			options...,
+		),
+
+		getAllScanJobs: kitgrpc.NewServer(
+			endpoints.GetAllScanJobs,
+			decodeGetAllScanJobsRequest,
+			encodeGetAllScanJobsResponse,
+			options...,
+		),
 
 		createCandidateProject: kitgrpc.NewServer(
 			endpoints.CreateCandidateProject,
@@ -104,6 +159,38 @@ func NewGRPCServer(
 			encodeDeleteRatingResponse,
 			options...,
 		),
+		getProjectRatingHistory: kitgrpc.NewServer(
+			endpoints.GetProjectRatingHistory,
+			decodeGetProjectRatingHistoryRequest,
+			encodeGetProjectRatingHistoryResponse,
+			options...,
+		),
+		getLatestRatings: kitgrpc.NewServer(
+			endpoints.GetLatestRatings,
+			decodeGetLatestRatingsRequest,
+			encodeGetLatestRatingsResponse,
+			options...,
+		),
+
+		getAllQualityScores: kitgrpc.NewServer(
+			endpoints.GetAllQualityScores,
+			decodeGetAllQualityScoresRequest,
+			encodeGetAllQualityScoresResponse,
+			options...,
+		),
+
+		localExportCandidateData: kitgrpc.NewServer(
+			endpoints.LocalExportCandidateData,
+			decodeCandidateDataRequest,
+			encodeLocalExportCandidateDataResponse,
+			options...,
+		),
+		localEraseCandidateData: kitgrpc.NewServer(
+			endpoints.LocalEraseCandidateData,
+			decodeCandidateDataRequest,
+			encodeLocalEraseCandidateDataResponse,
+			options...,
+		),
 
 		logger: logger,
 	}
@@ -145,6 +232,15 @@ func (s *grpcServer) GetAllProjects(ctx context.Context, req *pb.GetAllProjectsR
 	return rep.(*pb.GetAllProjectsResponse), nil
 }
 
+// LocalGetAllProjects returns all Projects
+func (s *grpcServer) LocalGetAllProjects(ctx context.Context, req *pb.GetAllProjectsRequest) (*pb.GetAllProjectsResponse, error) {
+	_, rep, err := s.localGetAllProjects.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetAllProjectsResponse), nil
+}
+
 // decodeGetAllProjectsRequest decodes the incoming grpc payload to our go kit payload
 func decodeGetAllProjectsRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(*pb.GetAllProjectsRequest)
@@ -153,6 +249,13 @@ func decodeGetAllProjectsRequest(_ context.Context, request interface{}) (interf
 		CandidateID: req.CandidateId,
 		Name:        req.Name,
 		RepoURL:     req.RepoUrl,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
 	}
 	return decoded, nil
 }
@@ -166,7 +269,15 @@ func encodeGetAllProjectsResponse(_ context.Context, response interface{}) (inte
 		for _, project := range res.Projects {
 			projects = append(projects, project.ToProto())
 		}
-		return &pb.GetAllProjectsResponse{Projects: projects}, nil
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllProjectsResponse{
+			Projects:      projects,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
 	}
 	return nil, err
 }
@@ -246,7 +357,7 @@ func encodeDeleteProjectResponse(_ context.Context, response interface{}) (inter
 	return nil, err
 }
 
-// ScanProject scans a Project using sonarqube
+// ScanProject queues a scan of a Project using sonarqube
 func (s *grpcServer) ScanProject(ctx context.Context, req *pb.ScanProjectRequest) (*pb.ScanProjectResponse, error) {
 	_, rep, err := s.scanProject.ServeGRPC(ctx, req)
 	if err != nil {
@@ -255,6 +366,15 @@ func (s *grpcServer) ScanProject(ctx context.Context, req *pb.ScanProjectRequest
 	return rep.(*pb.ScanProjectResponse), nil
 }
 
+// LocalScanProject scans a Project using sonarqube and waits for the scan to finish
+func (s *grpcServer) LocalScanProject(ctx context.Context, req *pb.ScanProjectRequest) (*pb.ScanProjectResponse, error) {
+	_, rep, err := s.localScanProject.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ScanProjectResponse), nil
+}
+
 // decodeScanProjectRequest decodes the incoming grpc payload to our go kit payload
 func decodeScanProjectRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(*pb.ScanProjectRequest)
@@ -266,7 +386,108 @@ func encodeScanProjectResponse(_ context.Context, response interface{}) (interfa
 	res := response.(endpoints.ScanProjectResponse)
 	err := getError(res.Err)
 	if err == nil {
-		return &pb.ScanProjectResponse{}, nil
+		return &pb.ScanProjectResponse{ScanJob: res.ScanJob.ToProto()}, nil
+	}
+	return nil, err
+}
+
+/* --------------- Project Metadata --------------- */
+
+// RefreshProjectMetadata imports the metadata of a Project from the host of its repository
+func (s *grpcServer) RefreshProjectMetadata(ctx context.Context, req *pb.RefreshProjectMetadataRequest) (*pb.ProjectMetadata, error) {
+	_, rep, err := s.refreshProjectMetadata.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ProjectMetadata), nil
+}
+
+// LocalRefreshProjectMetadata imports the metadata of a Project from the host of its repository
+func (s *grpcServer) LocalRefreshProjectMetadata(ctx context.Context, req *pb.RefreshProjectMetadataRequest) (*pb.ProjectMetadata, error) {
+	_, rep, err := s.localRefreshProjectMetadata.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ProjectMetadata), nil
+}
+
+// decodeRefreshProjectMetadataRequest decodes the incoming grpc payload to our go kit payload
+func decodeRefreshProjectMetadataRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.RefreshProjectMetadataRequest)
+	return endpoints.RefreshProjectMetadataRequest{ID: req.Id}, nil
+}
+
+// GetProjectMetadata returns the metadata of a Project
+func (s *grpcServer) GetProjectMetadata(ctx context.Context, req *pb.GetProjectMetadataRequest) (*pb.ProjectMetadata, error) {
+	_, rep, err := s.getProjectMetadata.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ProjectMetadata), nil
+}
+
+// decodeGetProjectMetadataRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetProjectMetadataRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetProjectMetadataRequest)
+	return endpoints.GetProjectMetadataRequest{ID: req.Id}, nil
+}
+
+// encodeProjectMetadataResponse encodes the outgoing go kit payload to the grpc payload
+func encodeProjectMetadataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.ProjectMetadataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return res.ProjectMetadata.ToProto(), nil
+	}
+	return nil, err
+}
+
+/* --------------- Scan Job --------------- */
+
+// GetAllScanJobs returns all ScanJobs
+func (s *grpcServer) GetAllScanJobs(ctx context.Context, req *pb.GetAllScanJobsRequest) (*pb.GetAllScanJobsResponse, error) {
+	_, rep, err := s.getAllScanJobs.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetAllScanJobsResponse), nil
+}
+
+// decodeGetAllScanJobsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetAllScanJobsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetAllScanJobsRequest)
+	decoded := endpoints.GetAllScanJobsRequest{
+		ProjectID: req.ProjectId,
+		Status:    req.Status,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
+	}
+	return decoded, nil
+}
+
+// encodeGetAllScanJobsResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetAllScanJobsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetAllScanJobsResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var jobs []*pb.ScanJob
+		for _, job := range res.ScanJobs {
+			jobs = append(jobs, job.ToProto())
+		}
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllScanJobsResponse{
+			ScanJobs:      jobs,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
 	}
 	return nil, err
 }
@@ -375,6 +596,184 @@ func encodeDeleteRatingResponse(_ context.Context, response interface{}) (interf
 	return nil, err
 }
 
+// GetProjectRatingHistory returns the Ratings of a Project
+func (s *grpcServer) GetProjectRatingHistory(ctx context.Context, req *pb.GetProjectRatingHistoryRequest) (*pb.GetProjectRatingHistoryResponse, error) {
+	_, rep, err := s.getProjectRatingHistory.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetProjectRatingHistoryResponse), nil
+}
+
+// decodeGetProjectRatingHistoryRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetProjectRatingHistoryRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetProjectRatingHistoryRequest)
+	decoded := endpoints.GetProjectRatingHistoryRequest{
+		ProjectID: req.ProjectId,
+		From:      helpers.ProtoTimeToTime(req.From),
+		To:        helpers.ProtoTimeToTime(req.To),
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
+	}
+	return decoded, nil
+}
+
+// encodeGetProjectRatingHistoryResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetProjectRatingHistoryResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetProjectRatingHistoryResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var ratings []*pb.Rating
+		for _, rating := range res.Ratings {
+			ratings = append(ratings, rating.ToProto())
+		}
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetProjectRatingHistoryResponse{
+			Ratings:       ratings,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
+	}
+	return nil, err
+}
+
+// GetLatestRatings returns the latest Rating of each Project by ID
+func (s *grpcServer) GetLatestRatings(ctx context.Context, req *pb.GetLatestRatingsRequest) (*pb.GetLatestRatingsResponse, error) {
+	_, rep, err := s.getLatestRatings.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetLatestRatingsResponse), nil
+}
+
+// decodeGetLatestRatingsRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetLatestRatingsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetLatestRatingsRequest)
+	return endpoints.GetLatestRatingsRequest{ProjectID: req.ProjectId}, nil
+}
+
+// encodeGetLatestRatingsResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetLatestRatingsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetLatestRatingsResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var ratings []*pb.Rating
+		for _, rating := range res.Ratings {
+			ratings = append(ratings, rating.ToProto())
+		}
+		return &pb.GetLatestRatingsResponse{Ratings: ratings}, nil
+	}
+	return nil, err
+}
+
+/* --------------- Quality Score --------------- */
+
+// GetAllQualityScores returns the code quality scores of Candidates
+func (s *grpcServer) GetAllQualityScores(ctx context.Context, req *pb.GetAllQualityScoresRequest) (*pb.GetAllQualityScoresResponse, error) {
+	_, rep, err := s.getAllQualityScores.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetAllQualityScoresResponse), nil
+}
+
+// decodeGetAllQualityScoresRequest decodes the incoming grpc payload to our go kit payload
+func decodeGetAllQualityScoresRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GetAllQualityScoresRequest)
+	decoded := endpoints.GetAllQualityScoresRequest{
+		CandidateID: req.CandidateId,
+		Page: pagination.Params{
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			SortBy:    req.SortBy,
+			SortDesc:  req.SortDesc,
+			WithTotal: req.WithTotal,
+		},
+	}
+	return decoded, nil
+}
+
+// encodeGetAllQualityScoresResponse encodes the outgoing go kit payload to the grpc payload
+func encodeGetAllQualityScoresResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.GetAllQualityScoresResponse)
+	err := getError(res.Err)
+	if err == nil {
+		var scores []*pb.QualityScore
+		for _, score := range res.QualityScores {
+			scores = append(scores, score.ToProto())
+		}
+		page := res.Page
+		if page == nil {
+			page = &pagination.Info{}
+		}
+		return &pb.GetAllQualityScoresResponse{
+			QualityScores: scores,
+			NextPageToken: page.NextPageToken,
+			TotalCount:    page.TotalCount,
+		}, nil
+	}
+	return nil, err
+}
+
+/* --------------- Candidate Data --------------- */
+
+// LocalExportCandidateData returns all data about a candidate, for internal use
+func (s *grpcServer) LocalExportCandidateData(ctx context.Context, req *pb.CandidateDataRequest) (*pb.CandidateData, error) {
+	_, rep, err := s.localExportCandidateData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.CandidateData), nil
+}
+
+// LocalEraseCandidateData deletes all data about a candidate, for internal use
+func (s *grpcServer) LocalEraseCandidateData(ctx context.Context, req *pb.CandidateDataRequest) (*pb.EraseCandidateDataResponse, error) {
+	_, rep, err := s.localEraseCandidateData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.EraseCandidateDataResponse), nil
+}
+
+// decodeCandidateDataRequest decodes the incoming grpc payload to our go kit payload
+func decodeCandidateDataRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CandidateDataRequest)
+	return endpoints.CandidateDataRequest{CandidateID: req.CandidateId}, nil
+}
+
+// encodeLocalExportCandidateDataResponse encodes the outgoing go kit payload to the grpc payload,
+// with the data about the candidate encoded as JSON
+func encodeLocalExportCandidateDataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.LocalExportCandidateDataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		data, err := json.Marshal(res.CandidateData)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CandidateData{Data: data}, nil
+	}
+	return nil, err
+}
+
+// encodeLocalEraseCandidateDataResponse encodes the outgoing go kit payload to the grpc payload
+func encodeLocalEraseCandidateDataResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(endpoints.LocalEraseCandidateDataResponse)
+	err := getError(res.Err)
+	if err == nil {
+		return &pb.EraseCandidateDataResponse{Erased: res.Erased}, nil
+	}
+	return nil, err
+}
+
 func getError(err error) error {
 	switch err {
 	case nil: